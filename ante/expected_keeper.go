@@ -7,6 +7,11 @@ import (
 // TreasuryKeeper for tax charging & recording
 type TreasuryKeeper interface {
 	GetTaxRate(ctx sdk.Context) (taxRate sdk.Dec)
+	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int, ok bool)
+	IsExemptedFromTax(ctx sdk.Context, senderAddr string, recipientAddrs ...string) bool
+	IsExemptedRoute(ctx sdk.Context, route string) bool
+	RecordEpochTaxProceeds(ctx sdk.Context, taxes sdk.Coins, collected sdk.Int)
+	RecordWithheldTaxProceeds(ctx sdk.Context, tax sdk.Coin)
 }
 
 // OracleKeeper for feeder validation
@@ -59,16 +59,9 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 
 	msgs := feeTx.GetMsgs()
 	taxes := FilterMsgAndComputeTax(ctx, dfd.treasuryKeeper, msgs...)
-	taxesInBaseDenom := sdk.NewCoin(baseDenom, taxes.AmountOf(baseDenom))
-	for _, denom := range taxes.Denoms() {
-		if denom == baseDenom {
-			continue
-		}
-		exchangeRate, err := dfd.oracleKeeper.GetMelodyExchangeRate(ctx, denom)
-		if err != nil {
-			return ctx, fmt.Errorf("could not retrieve exchange rate for %s: %w", denom, err)
-		}
-		taxesInBaseDenom = taxesInBaseDenom.AddAmount(taxes.AmountOf(denom).ToLegacyDec().Mul(exchangeRate).TruncateInt())
+	taxesInBaseDenom, err := computeTaxInDenom(ctx, dfd.oracleKeeper, taxes, baseDenom, baseDenom)
+	if err != nil {
+		return ctx, err
 	}
 
 	// fee can be in any denom (checked for validity later)
@@ -80,6 +73,7 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	deductFeesFrom := feePayer
 
 	// If a fee granter was set, deduct fee from the fee granter's account.
+	// The tax is withheld from the fee in-kind, so the allowance is charged for both.
 	if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee grants is not enabled")
@@ -115,7 +109,13 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 
 	// deducts the fees and transfer them to the module account
 	if !fees.IsZero() {
-		err = DeductFees(dfd.txFeesKeeper, dfd.bankKeeper, ctx, deductFeesFromAcc, fees, taxesInBaseDenom)
+		// the tax is collected in-kind, in the denom the fee is paid in
+		feeTax, err := dfd.computeFeeTax(ctx, taxes, taxesInBaseDenom, fees[0], baseDenom)
+		if err != nil {
+			return ctx, err
+		}
+
+		err = DeductFees(dfd.txFeesKeeper, dfd.bankKeeper, dfd.treasuryKeeper, ctx, deductFeesFromAcc, fees, taxes, feeTax, taxesInBaseDenom)
 		if err != nil {
 			return ctx, err
 		}
@@ -130,7 +130,9 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 }
 
 // DeductFees deducts fees from the given account and transfers them to the set module account.
-func DeductFees(txFeesKeeper txfeestypes.TxFeesKeeper, bankKeeper BankKeeper, ctx sdk.Context, acc authtypes.AccountI, fees sdk.Coins, baseDenomTax sdk.Coin) error {
+// The tax is collected in-kind: feeTax, in the denom of the fee, is withheld from the fees and sent to
+// the treasury, while baseDenomTax is its base denom value recorded in the epoch tax proceeds.
+func DeductFees(txFeesKeeper txfeestypes.TxFeesKeeper, bankKeeper BankKeeper, treasuryKeeper TreasuryKeeper, ctx sdk.Context, acc authtypes.AccountI, fees sdk.Coins, taxes sdk.Coins, feeTax sdk.Coin, baseDenomTax sdk.Coin) error {
 	// Checks the validity of the fee tokens (sorted, have positive amount, valid and unique denomination)
 	if !fees.IsValid() {
 		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
@@ -142,19 +144,22 @@ func DeductFees(txFeesKeeper txfeestypes.TxFeesKeeper, bankKeeper BankKeeper, ct
 		return err
 	}
 
-	// checks if input fee is NOTE (assumes only one fee token exists in the fees array (as per the check in mempoolFeeDecorator))
-	if fees[0].Denom == baseDenom {
-		deductedFees, anyNegative := fees.SafeSub(baseDenomTax)
-		if anyNegative {
-			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees (%s) to apply tax (%s)", fees[0], baseDenomTax)
-		}
-		if baseDenomTax.IsPositive() {
-			err = bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), treasurytypes.ModuleName, sdk.Coins{baseDenomTax})
-			if err != nil {
-				return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
-			}
+	// withholds the tax from the fee (assumes only one fee token exists in the fees array (as per the check in mempoolFeeDecorator))
+	deductedFees, anyNegative := fees.SafeSub(feeTax)
+	if anyNegative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees (%s) to apply tax (%s)", fees[0], feeTax)
+	}
+	if feeTax.IsPositive() {
+		err = bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), treasurytypes.ModuleName, sdk.Coins{feeTax})
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 		}
+		treasuryKeeper.RecordEpochTaxProceeds(ctx, taxes, baseDenomTax.Amount)
+		treasuryKeeper.RecordWithheldTaxProceeds(ctx, feeTax)
+	}
 
+	// checks if input fee is NOTE
+	if fees[0].Denom == baseDenom {
 		// sends to FeeCollectorName module account, which distributes staking rewards
 		err = bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authtypes.FeeCollectorName, deductedFees)
 		if err != nil {
@@ -162,7 +167,7 @@ func DeductFees(txFeesKeeper txfeestypes.TxFeesKeeper, bankKeeper BankKeeper, ct
 		}
 	} else {
 		// sends to FeeCollectorForStakingRewardsName module account
-		err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), txfeestypes.NonNativeTxFeeCollectorName, fees)
+		err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), txfeestypes.NonNativeTxFeeCollectorName, deductedFees)
 		if err != nil {
 			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 		}
@@ -170,3 +175,59 @@ func DeductFees(txFeesKeeper txfeestypes.TxFeesKeeper, bankKeeper BankKeeper, ct
 
 	return nil
 }
+
+// computeFeeTax returns the tax to withhold from the fee, in the denom of the fee. The taxes are converted
+// at the oracle exchange rates when the fee denom has one. Otherwise, such as for the fee tokens priced by
+// their pool, the fee is valued in the base denom by x/txfees, and the tax is withheld as the same share of it.
+func (dfd DeductFeeDecorator) computeFeeTax(ctx sdk.Context, taxes sdk.Coins, taxesInBaseDenom sdk.Coin, fee sdk.Coin, baseDenom string) (sdk.Coin, error) {
+	feeTax, err := computeTaxInDenom(ctx, dfd.oracleKeeper, taxes, fee.Denom, baseDenom)
+	if err == nil {
+		return feeTax, nil
+	}
+
+	feeInBaseDenom, convertErr := dfd.txFeesKeeper.ConvertToBaseToken(ctx, fee)
+	if convertErr != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(convertErr, "could not value the fee %s to withhold the tax", fee)
+	}
+	if !feeInBaseDenom.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s is worth no %s to apply tax (%s)", fee, baseDenom, taxesInBaseDenom)
+	}
+
+	return sdk.NewCoin(fee.Denom, taxesInBaseDenom.Amount.Mul(fee.Amount).Quo(feeInBaseDenom.Amount)), nil
+}
+
+// computeTaxInDenom returns the value of the taxes in the given denom. The taxes in other denoms
+// are converted at the oracle exchange rates, where the base denom is worth one melody.
+func computeTaxInDenom(ctx sdk.Context, oracleKeeper OracleKeeper, taxes sdk.Coins, denom string, baseDenom string) (sdk.Coin, error) {
+	tax := sdk.NewCoin(denom, taxes.AmountOf(denom))
+	if taxes.IsZero() {
+		return tax, nil
+	}
+
+	denomRate := sdk.OneDec()
+	if denom != baseDenom {
+		exchangeRate, err := oracleKeeper.GetMelodyExchangeRate(ctx, denom)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("could not retrieve exchange rate for %s: %w", denom, err)
+		}
+		denomRate = exchangeRate
+	}
+
+	for _, coin := range taxes {
+		if coin.Denom == denom {
+			continue
+		}
+
+		exchangeRate := sdk.OneDec()
+		if coin.Denom != baseDenom {
+			rate, err := oracleKeeper.GetMelodyExchangeRate(ctx, coin.Denom)
+			if err != nil {
+				return sdk.Coin{}, fmt.Errorf("could not retrieve exchange rate for %s: %w", coin.Denom, err)
+			}
+			exchangeRate = rate
+		}
+		tax = tax.AddAmount(coin.Amount.ToLegacyDec().Mul(exchangeRate).Quo(denomRate).TruncateInt())
+	}
+
+	return tax, nil
+}
@@ -10,7 +10,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	marketexported "github.com/osmosis-labs/osmosis/v23/x/market/exported"
-	oracleexported "github.com/osmosis-labs/osmosis/v23/x/oracle/exported"
+	treasurytypes "github.com/osmosis-labs/osmosis/v23/x/treasury/types"
 )
 
 var IBCRegexp = regexp.MustCompile("^ibc/[a-fA-F0-9]{64}$")
@@ -20,30 +20,63 @@ func isIBCDenom(denom string) bool {
 }
 
 // FilterMsgAndComputeTax computes the stability tax on messages.
+// Messages of the treasury tax exemption routes and transfers exempted by the
+// treasury tax exemption zones are not taxed.
 func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs ...sdk.Msg) sdk.Coins {
 	taxes := sdk.Coins{}
 
 	for _, msg := range msgs {
+		if tk.IsExemptedRoute(ctx, sdk.MsgTypeURL(msg)) {
+			continue
+		}
+
 		switch msg := msg.(type) {
 		case *banktypes.MsgSend:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Amount)...)
+			if !tk.IsExemptedFromTax(ctx, msg.FromAddress, msg.ToAddress) {
+				taxes = taxes.Add(computeTax(ctx, tk, msg.Amount)...)
+			}
 
 		case *banktypes.MsgMultiSend:
+			recipients := make([]string, len(msg.Outputs))
+			for i, output := range msg.Outputs {
+				recipients[i] = output.Address
+			}
+
+			// the inputs are pooled, so the message is exempt only if every input is exempt towards every output
+			exempt := true
 			for _, input := range msg.Inputs {
-				taxes = taxes.Add(computeTax(ctx, tk, input.Coins)...)
+				if !tk.IsExemptedFromTax(ctx, input.Address, recipients...) {
+					exempt = false
+					break
+				}
+			}
+
+			if !exempt {
+				for _, input := range msg.Inputs {
+					taxes = taxes.Add(computeTax(ctx, tk, input.Coins)...)
+				}
 			}
 
 		case *marketexported.MsgSwapSend:
-			taxes = taxes.Add(computeTax(ctx, tk, sdk.NewCoins(msg.OfferCoin))...)
+			if !tk.IsExemptedFromTax(ctx, msg.FromAddress, msg.ToAddress) {
+				taxes = taxes.Add(computeTax(ctx, tk, sdk.NewCoins(msg.OfferCoin))...)
+			}
 
 		case *wasmtypes.MsgInstantiateContract:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Funds)...)
+			if !tk.IsExemptedFromTax(ctx, msg.Sender) {
+				taxes = taxes.Add(computeTax(ctx, tk, msg.Funds)...)
+			}
 
 		case *wasmtypes.MsgInstantiateContract2:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Funds)...)
+			if !tk.IsExemptedFromTax(ctx, msg.Sender) {
+				taxes = taxes.Add(computeTax(ctx, tk, msg.Funds)...)
+			}
 
 		case *wasmtypes.MsgExecuteContract:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Funds)...)
+			route := treasurytypes.ContractTaxExemptionRoute(sdk.MsgTypeURL(msg), msg.Contract)
+			if !tk.IsExemptedRoute(ctx, route) && !tk.IsExemptedFromTax(ctx, msg.Sender, msg.Contract) {
+				taxes = taxes.Add(computeTax(ctx, tk, msg.Funds)...)
+			}
 
 		case *authz.MsgExec:
 			messages, err := msg.GetMessages()
@@ -77,10 +110,9 @@ func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coi
 		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
 
 		// If tax due is greater than the tax cap, cap!
-		//taxCap := tk.GetTaxCap(ctx, coin.Denom)
-		//if taxDue.GT(taxCap) {
-		//	taxDue = taxCap
-		//}
+		if taxCap, ok := tk.GetTaxCap(ctx, coin.Denom); ok && taxDue.GT(taxCap) {
+			taxDue = taxCap
+		}
 
 		if taxDue.Equal(sdk.ZeroInt()) {
 			continue
@@ -91,18 +123,3 @@ func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coi
 
 	return taxes
 }
-
-func isOracleTx(msgs []sdk.Msg) bool {
-	for _, msg := range msgs {
-		switch msg.(type) {
-		case *oracleexported.MsgAggregateExchangeRatePrevote:
-			continue
-		case *oracleexported.MsgAggregateExchangeRateVote:
-			continue
-		default:
-			return false
-		}
-	}
-
-	return true
-}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/osmosis-labs/osmosis/v23/ante"
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"os"
 	"time"
 
@@ -15,12 +16,15 @@ import (
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
 	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	treasurytypes "github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v23/x/txfees/types"
 )
 
 func (s *AnteTestSuite) TestDeductFeeDecorator_ZeroGas() {
@@ -630,6 +634,7 @@ func (s *AnteTestSuite) TestTaxExemption() {
 				// instantiate contract then set the contract address to tax exemption
 				addr, _, err := per.Instantiate(s.ctx, CodeID, addrs[0], nil, bz, "my label", nil)
 				s.Require().NoError(err)
+				s.Require().NoError(s.app.TreasuryKeeper.AddTaxExemptionAddress(s.ctx, "exemption", addr.String()))
 				// instantiate contract then not set to tax exemption
 				addr1, _, err := per.Instantiate(s.ctx, CodeID, addrs[0], nil, bz, "my label", nil)
 				s.Require().NoError(err)
@@ -668,6 +673,12 @@ func (s *AnteTestSuite) TestTaxExemption() {
 		fmt.Printf("CASE = %s \n", c.name)
 		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 
+		// put the exemption addresses in one zone
+		s.app.TreasuryKeeper.SetZone(s.ctx, treasurytypes.NewZone("exemption", false, false))
+		for i := 0; i < 2; i++ {
+			require.NoError(s.app.TreasuryKeeper.AddTaxExemptionAddress(s.ctx, "exemption", addrs[i].String()))
+		}
+
 		mfd := ante.NewDeductFeeDecorator(s.app.TxFeesKeeper, s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.TreasuryKeeper, s.app.OracleKeeper)
 		antehandler := sdk.ChainAnteDecorators(mfd)
 
@@ -751,3 +762,355 @@ func (s *AnteTestSuite) TestOracleZeroFee() {
 	balances = s.app.BankKeeper.GetAllBalances(s.ctx, s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
 	s.Require().Equal(sdk.Coins{}, balances)
 }
+
+func (s *AnteTestSuite) TestComputeTaxCap() {
+	s.SetupTest(true) // setup
+	tk := s.app.TreasuryKeeper
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	sendAmount := int64(1000000000)
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, sendAmount))
+	msg := banktypes.NewMsgSend(addr1, addr1, sendCoins)
+
+	// uncapped denoms pay the full rate
+	expectedTax := tk.GetTaxRate(s.ctx).MulInt64(sendAmount).TruncateInt()
+	taxes := ante.FilterMsgAndComputeTax(s.ctx, tk, msg)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(assets.MicroSDRDenom, expectedTax)), taxes)
+
+	// capped denoms pay at most the cap
+	taxCap := expectedTax.QuoRaw(2)
+	tk.SetTaxCap(s.ctx, assets.MicroSDRDenom, taxCap)
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, msg)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(assets.MicroSDRDenom, taxCap)), taxes)
+
+	// caps above the tax due have no effect
+	tk.SetTaxCap(s.ctx, assets.MicroSDRDenom, expectedTax.MulRaw(2))
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, msg)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(assets.MicroSDRDenom, expectedTax)), taxes)
+}
+
+func (s *AnteTestSuite) TestComputeTaxExemption() {
+	s.SetupTest(true) // setup
+	tk := s.app.TreasuryKeeper
+
+	var addrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		_, _, addr := testdata.KeyTestPubAddr()
+		addrs = append(addrs, addr)
+	}
+
+	// 0, 1: exemption
+	// 2: normal
+	tk.SetZone(s.ctx, treasurytypes.NewZone("exemption", false, false))
+	s.Require().NoError(tk.AddTaxExemptionAddress(s.ctx, "exemption", addrs[0].String()))
+	s.Require().NoError(tk.AddTaxExemptionAddress(s.ctx, "exemption", addrs[1].String()))
+
+	sendAmount := int64(1000000)
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, sendAmount))
+	expectedTax := sdk.NewCoins(sdk.NewCoin(assets.MicroSDRDenom, tk.GetTaxRate(s.ctx).MulInt64(sendAmount).TruncateInt()))
+
+	// exemption -> exemption
+	taxes := ante.FilterMsgAndComputeTax(s.ctx, tk, banktypes.NewMsgSend(addrs[0], addrs[1], sendCoins))
+	s.Require().True(taxes.IsZero())
+
+	// exemption -> normal
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, banktypes.NewMsgSend(addrs[0], addrs[2], sendCoins))
+	s.Require().Equal(expectedTax, taxes)
+
+	// multi send is taxed as a whole unless every input is exempt towards every output
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{{Address: addrs[0].String(), Coins: sendCoins}},
+		[]banktypes.Output{{Address: addrs[1].String(), Coins: sendCoins}},
+	)
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, multiSend)
+	s.Require().True(taxes.IsZero())
+
+	multiSend.Outputs = append(multiSend.Outputs, banktypes.Output{Address: addrs[2].String(), Coins: sendCoins})
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, multiSend)
+	s.Require().Equal(expectedTax, taxes)
+
+	// a zone exempting incoming transfers takes funds from anyone tax-free
+	tk.SetZone(s.ctx, treasurytypes.NewZone("exemption", false, true))
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, banktypes.NewMsgSend(addrs[2], addrs[0], sendCoins))
+	s.Require().True(taxes.IsZero())
+}
+
+func (s *AnteTestSuite) TestComputeTaxExemptionRoute() {
+	s.SetupTest(true) // setup
+	tk := s.app.TreasuryKeeper
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, contract1 := testdata.KeyTestPubAddr()
+	_, _, contract2 := testdata.KeyTestPubAddr()
+
+	sendAmount := int64(1000000)
+	sendCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, sendAmount)
+	expectedTax := sdk.NewCoins(sdk.NewCoin(assets.MicroSDRDenom, tk.GetTaxRate(s.ctx).MulInt64(sendAmount).TruncateInt()))
+
+	swapSend := markettypes.NewMsgSwapSend(addr1, addr2, sendCoin, assets.MicroUSDDenom)
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sendCoin))
+	execute1 := &wasmtypes.MsgExecuteContract{Sender: addr1.String(), Contract: contract1.String(), Funds: sdk.NewCoins(sendCoin)}
+	execute2 := &wasmtypes.MsgExecuteContract{Sender: addr1.String(), Contract: contract2.String(), Funds: sdk.NewCoins(sendCoin)}
+
+	taxes := ante.FilterMsgAndComputeTax(s.ctx, tk, swapSend)
+	s.Require().Equal(expectedTax, taxes)
+
+	// a message type route exempts every message of the type, and only those
+	s.Require().NoError(tk.AddTaxExemptionRoute(s.ctx, sdk.MsgTypeURL(swapSend)))
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, swapSend)
+	s.Require().True(taxes.IsZero())
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, send)
+	s.Require().Equal(expectedTax, taxes)
+
+	// exempted messages wrapped in authz are exempt too
+	exec := authz.NewMsgExec(addr2, []sdk.Msg{swapSend, send})
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, &exec)
+	s.Require().Equal(expectedTax, taxes)
+
+	// a contract route exempts only the messages executing the contract
+	s.Require().NoError(tk.AddTaxExemptionRoute(s.ctx, treasurytypes.ContractTaxExemptionRoute(sdk.MsgTypeURL(execute1), contract1.String())))
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, execute1)
+	s.Require().True(taxes.IsZero())
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, execute2)
+	s.Require().Equal(expectedTax, taxes)
+
+	// removed routes are taxed again
+	s.Require().NoError(tk.RemoveTaxExemptionRoute(s.ctx, sdk.MsgTypeURL(swapSend)))
+	taxes = ante.FilterMsgAndComputeTax(s.ctx, tk, swapSend)
+	s.Require().Equal(expectedTax, taxes)
+}
+
+func (s *AnteTestSuite) TestDeductFeeDecorator_InKindTax() {
+	s.SetupTest(true) // setup
+
+	// uatom has no oracle exchange rate, and is priced by its pool at 3 note
+	txFeesKeeper := fixedPriceTxFeesKeeper{
+		TxFeesKeeper: s.app.TxFeesKeeper,
+		prices:       map[string]sdk.Dec{"uatom": sdk.NewDec(3)},
+	}
+	dfd := ante.NewDeductFeeDecorator(txFeesKeeper, s.app.AccountKeeper, s.app.BankKeeper, nil, s.app.TreasuryKeeper, s.app.OracleKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// 1 usdr -> 1.5 note, 1 uusd -> 0.5 note
+	s.app.OracleKeeper.SetParams(s.ctx, oracletypes.DefaultParams())
+	s.app.OracleKeeper.SetMelodyExchangeRate(s.ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(15, 1))
+	s.app.OracleKeeper.SetMelodyExchangeRate(s.ctx, assets.MicroUSDDenom, sdk.NewDecWithPrec(5, 1))
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	err := testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(
+		sdk.NewInt64Coin(assets.MicroSDRDenom, 10_000_000),
+		sdk.NewInt64Coin(assets.MicroUSDDenom, 10_000_000),
+		sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000),
+		sdk.NewInt64Coin("uatom", 10_000_000),
+		sdk.NewInt64Coin("ufoo", 10_000_000),
+	))
+	s.Require().NoError(err)
+
+	sendAmount := int64(1_000_000)
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, sendAmount)))
+
+	taxRate := s.app.TreasuryKeeper.GetTaxRate(s.ctx)
+	s.Require().True(taxRate.IsPositive())
+	expectedTax := taxRate.MulInt64(sendAmount).TruncateInt()
+	expectedTaxInBaseDenom := sdk.NewDecWithPrec(15, 1).MulInt(expectedTax).TruncateInt()
+
+	testCases := []struct {
+		name          string
+		fee           sdk.Coin
+		expectedTax   sdk.Coin
+		feeCollector  string
+		expectedError bool
+	}{
+		{
+			name:         "tax withheld in the transferred denom",
+			fee:          sdk.NewCoin(assets.MicroSDRDenom, expectedTax.AddRaw(100)),
+			expectedTax:  sdk.NewCoin(assets.MicroSDRDenom, expectedTax),
+			feeCollector: txfeestypes.NonNativeTxFeeCollectorName,
+		},
+		{
+			name:         "tax converted to another stable fee denom through the oracle rate",
+			fee:          sdk.NewCoin(assets.MicroUSDDenom, expectedTax.MulRaw(3).AddRaw(100)),
+			expectedTax:  sdk.NewCoin(assets.MicroUSDDenom, expectedTax.MulRaw(3)),
+			feeCollector: txfeestypes.NonNativeTxFeeCollectorName,
+		},
+		{
+			name:         "tax converted to the base denom through the oracle rate",
+			fee:          sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewDecWithPrec(15, 1).MulInt(expectedTax).TruncateInt().AddRaw(100)),
+			expectedTax:  sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewDecWithPrec(15, 1).MulInt(expectedTax).TruncateInt()),
+			feeCollector: authtypes.FeeCollectorName,
+		},
+		{
+			name:          "fee lower than the in-kind tax",
+			fee:           sdk.NewCoin(assets.MicroSDRDenom, expectedTax.SubRaw(1)),
+			expectedError: true,
+		},
+		{
+			name:         "tax withheld in a fee denom without oracle rate, at its pool price",
+			fee:          sdk.NewInt64Coin("uatom", 1_000_000),
+			expectedTax:  sdk.NewCoin("uatom", expectedTaxInBaseDenom.QuoRaw(3)),
+			feeCollector: txfeestypes.NonNativeTxFeeCollectorName,
+		},
+		{
+			name:          "fee without oracle rate lower than the in-kind tax",
+			fee:           sdk.NewInt64Coin("uatom", 1),
+			expectedError: true,
+		},
+		{
+			name:          "fee denom priced neither by the oracle nor by a pool",
+			fee:           sdk.NewInt64Coin("ufoo", 1_000_000),
+			expectedError: true,
+		},
+	}
+
+	treasuryAddr := s.app.AccountKeeper.GetModuleAddress(treasurytypes.ModuleName)
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(s.txBuilder.SetMsgs(msg))
+			s.txBuilder.SetFeeAmount(sdk.NewCoins(tc.fee))
+			s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			ctx, _ := s.ctx.CacheContext()
+			_, err = antehandler(ctx, tx, false)
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			treasuryBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, treasuryAddr, tc.fee.Denom)
+			treasuryBalanceAfter := s.app.BankKeeper.GetBalance(ctx, treasuryAddr, tc.fee.Denom)
+			s.Require().Equal(tc.expectedTax, treasuryBalanceAfter.Sub(treasuryBalanceBefore))
+
+			// the tax withheld in other denoms than the base denom is tracked for its conversion into reserve
+			withheld := s.app.TreasuryKeeper.GetWithheldTaxProceeds(ctx)
+			if tc.fee.Denom == appparams.BaseCoinUnit {
+				s.Require().True(withheld.IsZero())
+			} else {
+				s.Require().Equal(sdk.NewCoins(tc.expectedTax), withheld)
+			}
+
+			collectorAddr := s.app.AccountKeeper.GetModuleAddress(tc.feeCollector)
+			collectorBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, collectorAddr, tc.fee.Denom)
+			collectorBalanceAfter := s.app.BankKeeper.GetBalance(ctx, collectorAddr, tc.fee.Denom)
+			s.Require().Equal(tc.fee.Sub(tc.expectedTax), collectorBalanceAfter.Sub(collectorBalanceBefore))
+		})
+	}
+}
+
+// fixedPriceTxFeesKeeper prices fee tokens at fixed base denom prices instead of their pool spot price.
+type fixedPriceTxFeesKeeper struct {
+	txfeestypes.TxFeesKeeper
+	prices map[string]sdk.Dec
+}
+
+func (k fixedPriceTxFeesKeeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
+	price, ok := k.prices[inputFee.Denom]
+	if !ok {
+		return k.TxFeesKeeper.ConvertToBaseToken(ctx, inputFee)
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).TruncateInt()), nil
+}
+
+func (s *AnteTestSuite) TestDeductFeeDecorator_FeeGrant() {
+	s.SetupTest(true) // setup
+
+	dfd := ante.NewDeductFeeDecorator(s.app.TxFeesKeeper, s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TreasuryKeeper, s.app.OracleKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	s.app.OracleKeeper.SetParams(s.ctx, oracletypes.DefaultParams())
+	s.app.OracleKeeper.SetMelodyExchangeRate(s.ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(15, 1))
+
+	// keys and addresses; the grantee holds no funds, everything is paid by the granter
+	priv1, _, grantee := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	err := testutil.FundAccount(s.app.BankKeeper, s.ctx, granter, sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, 10_000_000)))
+	s.Require().NoError(err)
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, grantee))
+
+	sendAmount := int64(1_000_000)
+	msg := banktypes.NewMsgSend(grantee, recipient, sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, sendAmount)))
+
+	taxRate := s.app.TreasuryKeeper.GetTaxRate(s.ctx)
+	s.Require().True(taxRate.IsPositive())
+	expectedTax := sdk.NewCoin(assets.MicroSDRDenom, taxRate.MulInt64(sendAmount).TruncateInt())
+	fee := expectedTax.AddAmount(sdk.NewInt(100))
+
+	testCases := []struct {
+		name          string
+		spendLimit    sdk.Coins
+		grant         bool
+		expectedError bool
+	}{
+		{
+			name:       "allowance covers fee and tax",
+			spendLimit: sdk.NewCoins(fee),
+			grant:      true,
+		},
+		{
+			name:          "allowance lower than fee and tax",
+			spendLimit:    sdk.NewCoins(fee.SubAmount(sdk.OneInt())),
+			grant:         true,
+			expectedError: true,
+		},
+		{
+			name:          "no allowance granted",
+			expectedError: true,
+		},
+	}
+
+	treasuryAddr := s.app.AccountKeeper.GetModuleAddress(treasurytypes.ModuleName)
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.grant {
+				err := s.app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: tc.spendLimit})
+				s.Require().NoError(err)
+			}
+
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(s.txBuilder.SetMsgs(msg))
+			s.txBuilder.SetFeeAmount(sdk.NewCoins(fee))
+			s.txBuilder.SetFeeGranter(granter)
+			s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the granter paid for both the fee and the tax, the grantee nothing
+			granterBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, granter, fee.Denom)
+			granterBalanceAfter := s.app.BankKeeper.GetBalance(ctx, granter, fee.Denom)
+			s.Require().Equal(fee, granterBalanceBefore.Sub(granterBalanceAfter))
+			s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, grantee).IsZero())
+
+			treasuryBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, treasuryAddr, fee.Denom)
+			treasuryBalanceAfter := s.app.BankKeeper.GetBalance(ctx, treasuryAddr, fee.Denom)
+			s.Require().Equal(expectedTax, treasuryBalanceAfter.Sub(treasuryBalanceBefore))
+
+			// the allowance was spent entirely and hence removed
+			_, err = s.app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+			s.Require().Error(err)
+		})
+	}
+}
@@ -76,6 +76,73 @@ func (suite *AnteTestSuite) TestOracleSpamming() {
 	suite.Require().Error(err)
 }
 
+func (suite *AnteTestSuite) TestOracleSpamming_VoteAndPrevote() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	spd := ante.NewSpammingPreventionDecorator(dummyOracleKeeper{
+		feeders: map[string]string{
+			sdk.ValAddress(addr1).String(): addr1.String(),
+		},
+	})
+	antehandler := sdk.ChainAnteDecorators(spd)
+
+	// Set IsCheckTx to true
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// normal so ok
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVoteAndPrevote("", "", oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// do it again is blocked
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// a separate vote or prevote at the same height is blocked too
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
+	))
+	voteTx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, voteTx, false)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1)),
+	))
+	prevoteTx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, prevoteTx, false)
+	suite.Require().Error(err)
+
+	// next block; can put it again
+	suite.ctx = suite.ctx.WithBlockHeight(101)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// catch wrong feeder
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVoteAndPrevote("", "", oracletypes.AggregateVoteHash{}, addr2, sdk.ValAddress(addr1)),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(102)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
+
 type dummyOracleKeeper struct {
 	feeders map[string]string
 }
@@ -36,6 +36,7 @@ func NewAnteHandler(
 	bankKeeper txfeestypes.BankKeeper,
 	oracleKeeper osmoante.OracleKeeper,
 	treasuryKeeper osmoante.TreasuryKeeper,
+	feegrantKeeper ante.FeegrantKeeper,
 	txFeesKeeper *txfeeskeeper.Keeper,
 	spotPriceCalculator txfeestypes.SpotPriceCalculator,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
@@ -50,7 +51,7 @@ func NewAnteHandler(
 		*txFeesKeeper,
 		ak,
 		bankKeeper,
-		nil,
+		feegrantKeeper,
 		treasuryKeeper,
 		oracleKeeper,
 	)
@@ -34,6 +34,9 @@ func NewAnteHandler(
 	txCounterStoreKey storetypes.StoreKey,
 	ak ante.AccountKeeper,
 	bankKeeper txfeestypes.BankKeeper,
+	oracleKeeper osmoante.OracleKeeper,
+	treasuryKeeper osmoante.TreasuryKeeper,
+	feegrantKeeper ante.FeegrantKeeper,
 	txFeesKeeper *txfeeskeeper.Keeper,
 	spotPriceCalculator txfeestypes.SpotPriceCalculator,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
@@ -44,7 +47,14 @@ func NewAnteHandler(
 	mempoolFeeDecorator := txfeeskeeper.NewMempoolFeeDecorator(*txFeesKeeper, mempoolFeeOptions)
 	sendblockOptions := osmoante.NewSendBlockOptions(appOpts)
 	sendblockDecorator := osmoante.NewSendBlockDecorator(sendblockOptions)
-	deductFeeDecorator := txfeeskeeper.NewDeductFeeDecorator(*txFeesKeeper, ak, bankKeeper, nil)
+	deductFeeDecorator := osmoante.NewDeductFeeDecorator(
+		*txFeesKeeper,
+		ak,
+		bankKeeper,
+		feegrantKeeper,
+		treasuryKeeper,
+		oracleKeeper,
+	)
 	return sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(wasmConfig.SimulationGasLimit),
@@ -58,6 +68,7 @@ func NewAnteHandler(
 		ante.NewValidateBasicDecorator(),
 		ante.TxTimeoutHeightDecorator{},
 		ante.NewValidateMemoDecorator(ak),
+		osmoante.NewSpammingPreventionDecorator(oracleKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(ak),
 		deductFeeDecorator,
 		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
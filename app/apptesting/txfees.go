@@ -37,11 +37,13 @@ func (s *KeeperTestHelper) SetupTxFeeAnteHandlerAndChargeFee(clientCtx client.Co
 	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
 	mempoolFeeOpts.MinGasPriceForHighGasTx = osmomath.MustNewDecFromStr("0.0025")
 
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
 	uionPoolId := s.PrepareBalancerPoolWithCoins(
-		sdk.NewInt64Coin(sdk.DefaultBondDenom, 500),
+		sdk.NewInt64Coin(baseDenom, 500),
 		sdk.NewInt64Coin("uion", 500),
 	)
-	err := s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId)
+	err = s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId)
 	s.Require().NoError(err)
 
 	if gasRequested == 0 {
@@ -79,7 +81,7 @@ func (s *KeeperTestHelper) SetupTxFeeAnteHandlerAndChargeFee(clientCtx client.Co
 	tx := s.BuildTx(txBuilder, msgs, sigV2, "", txFee, gasLimit)
 
 	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, mempoolFeeOpts)
-	dfd := ante.NewDeductFeeDecorator(*s.App.TxFeesKeeper, *s.App.AccountKeeper, s.App.BankKeeper, nil, s.App.TreasuryKeeper)
+	dfd := ante.NewDeductFeeDecorator(*s.App.TxFeesKeeper, *s.App.AccountKeeper, s.App.BankKeeper, s.App.FeeGrantKeeper, s.App.TreasuryKeeper, s.App.OracleKeeper)
 	antehandlerMFD := sdk.ChainAnteDecorators(mfd, dfd)
 	_, err = antehandlerMFD(s.Ctx, tx, isSimulate)
 	return err
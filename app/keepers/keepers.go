@@ -22,6 +22,8 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -39,6 +41,8 @@ import (
 	icq "github.com/cosmos/ibc-apps/modules/async-icq/v7"
 	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v7/types"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	bridgekeeper "github.com/osmosis-labs/osmosis/v23/x/bridge/keeper"
+	bridgetypes "github.com/osmosis-labs/osmosis/v23/x/bridge/types"
 	"github.com/osmosis-labs/osmosis/v23/x/cosmwasmpool"
 	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v23/x/cosmwasmpool/types"
 	downtimedetector "github.com/osmosis-labs/osmosis/v23/x/downtime-detector"
@@ -53,6 +57,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v23/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v23/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v23/x/protorev"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury"
 	treasurykeeper "github.com/osmosis-labs/osmosis/v23/x/treasury/keeper"
 	treasurytypes "github.com/osmosis-labs/osmosis/v23/x/treasury/types"
 	ibchooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
@@ -141,6 +146,7 @@ type AppKeepers struct {
 	AccountKeeper                *authkeeper.AccountKeeper
 	BankKeeper                   *bankkeeper.BaseKeeper
 	AuthzKeeper                  *authzkeeper.Keeper
+	FeeGrantKeeper               *feegrantkeeper.Keeper
 	StakingKeeper                *stakingkeeper.Keeper
 	DistrKeeper                  *distrkeeper.Keeper
 	DowntimeKeeper               *downtimedetector.Keeper
@@ -166,6 +172,7 @@ type AppKeepers struct {
 	WasmKeeper                   *wasmkeeper.Keeper
 	ContractKeeper               *wasmkeeper.PermissionedKeeper
 	TokenFactoryKeeper           *tokenfactorykeeper.Keeper
+	BridgeKeeper                 *bridgekeeper.Keeper
 	PoolManagerKeeper            *poolmanager.Keeper
 	OracleKeeper                 *oraclekeeper.Keeper
 	MarketKeeper                 *marketkeeper.Keeper
@@ -232,6 +239,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.AuthzKeeper = &authzKeeper
 
+	feeGrantKeeper := feegrantkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[feegrant.StoreKey],
+		appKeepers.AccountKeeper,
+	)
+	appKeepers.FeeGrantKeeper = &feeGrantKeeper
+
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[stakingtypes.StoreKey],
@@ -539,9 +553,11 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
 		appKeepers.StakingKeeper,
+		appKeepers.SlashingKeeper,
 		distrtypes.ModuleName,
 	)
 	appKeepers.OracleKeeper = &oracleKeeper
+	appKeepers.TxFeesKeeper.SetOracleKeeper(appKeepers.OracleKeeper)
 
 	marketKeeper := marketkeeper.NewKeeper(
 		appCodec,
@@ -550,8 +566,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.OracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.MarketKeeper = &marketKeeper
+	appKeepers.TxFeesKeeper.SetMarketKeeper(appKeepers.MarketKeeper)
 
 	treasuryKeeper := treasurykeeper.NewKeeper(
 		appCodec,
@@ -560,7 +578,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.MarketKeeper,
-		appKeepers.OracleKeeper)
+		appKeepers.OracleKeeper,
+		appKeepers.TxFeesKeeper,
+		appKeepers.PoolManagerKeeper)
 	appKeepers.TreasuryKeeper = &treasuryKeeper
 
 	// Pass the contract keeper to all the structs (generally ICS4Wrappers for ibc middlewares) that need it
@@ -574,6 +594,20 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// set token factory contract keeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 
+	// the bridge mints and burns through the token factory msg server, so it has to be
+	// created once the token factory keeper is fully set up
+	bridgeKeeper := bridgekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[bridgetypes.StoreKey],
+		appKeepers.GetSubspace(bridgetypes.ModuleName),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.TokenFactoryKeeper,
+		tokenfactorykeeper.NewMsgServerImpl(*appKeepers.TokenFactoryKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.BridgeKeeper = &bridgeKeeper
+
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper))
 
@@ -589,6 +623,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(appKeepers.IBCKeeper.ClientKeeper)).
 		AddRoute(poolincentivestypes.RouterKey, poolincentives.NewPoolIncentivesProposalHandler(*appKeepers.PoolIncentivesKeeper)).
 		AddRoute(txfeestypes.RouterKey, txfees.NewUpdateFeeTokenProposalHandler(*appKeepers.TxFeesKeeper)).
+		AddRoute(treasurytypes.RouterKey, treasury.NewTreasuryProposalHandler(*appKeepers.TreasuryKeeper)).
 		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*appKeepers.SuperfluidKeeper, *appKeepers.EpochsKeeper, *appKeepers.GAMMKeeper)).
 		AddRoute(protorevtypes.RouterKey, protorev.NewProtoRevProposalHandler(*appKeepers.ProtoRevKeeper)).
 		AddRoute(gammtypes.RouterKey, gamm.NewGammProposalHandler(*appKeepers.GAMMKeeper)).
@@ -765,6 +800,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(gammtypes.ModuleName)
 	paramsKeeper.Subspace(wasmtypes.ModuleName)
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
+	paramsKeeper.Subspace(bridgetypes.ModuleName)
 	paramsKeeper.Subspace(twaptypes.ModuleName)
 	paramsKeeper.Subspace(ibcratelimittypes.ModuleName)
 	paramsKeeper.Subspace(concentratedliquiditytypes.ModuleName)
@@ -886,10 +922,12 @@ func KVStoreKeys() []string {
 		markettypes.StoreKey,
 		treasurytypes.StoreKey,
 		authzkeeper.StoreKey,
+		feegrant.StoreKey,
 		txfeestypes.StoreKey,
 		superfluidtypes.StoreKey,
 		wasmtypes.StoreKey,
 		tokenfactorytypes.StoreKey,
+		bridgetypes.StoreKey,
 		valsetpreftypes.StoreKey,
 		protorevtypes.StoreKey,
 		ibchookstypes.StoreKey,
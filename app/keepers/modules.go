@@ -7,9 +7,11 @@ import (
 	ibc "github.com/cosmos/ibc-go/v7/modules/core"
 	ibcclientclient "github.com/cosmos/ibc-go/v7/modules/core/02-client/client"
 	tendermint "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge"
 	"github.com/osmosis-labs/osmosis/v23/x/market"
 	"github.com/osmosis-labs/osmosis/v23/x/oracle"
 	"github.com/osmosis-labs/osmosis/v23/x/treasury"
+	treasuryclient "github.com/osmosis-labs/osmosis/v23/x/treasury/client"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -20,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
@@ -97,6 +100,14 @@ var AppModuleBasics = []module.AppModuleBasic{
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
+			treasuryclient.SubmitUpdateTaxCapsProposalHandler,
+			treasuryclient.SubmitAddTaxExemptionZoneProposalHandler,
+			treasuryclient.SubmitRemoveTaxExemptionZoneProposalHandler,
+			treasuryclient.SubmitAddTaxExemptionAddressProposalHandler,
+			treasuryclient.SubmitRemoveTaxExemptionAddressProposalHandler,
+			treasuryclient.SubmitAddTaxExemptionRouteProposalHandler,
+			treasuryclient.SubmitRemoveTaxExemptionRouteProposalHandler,
+			treasuryclient.SubmitWindDownProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
 		},
@@ -105,6 +116,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	crisis.AppModuleBasic{},
 	slashing.AppModuleBasic{},
 	authzmodule.AppModuleBasic{},
+	feegrantmodule.AppModuleBasic{},
 	consensus.AppModuleBasic{},
 	ibc.AppModuleBasic{},
 	upgrade.AppModuleBasic{},
@@ -126,6 +138,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	epochs.AppModuleBasic{},
 	superfluid.AppModuleBasic{},
 	tokenfactory.AppModuleBasic{},
+	bridge.AppModuleBasic{},
 	valsetprefmodule.AppModuleBasic{},
 	wasm.AppModuleBasic{},
 	icq.AppModuleBasic{},
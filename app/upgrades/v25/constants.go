@@ -0,0 +1,22 @@
+package v25
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/osmosis-labs/osmosis/v23/app/upgrades"
+	bridgetypes "github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Symphony v25 upgrade.
+const UpgradeName = "v25"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{feegrant.StoreKey, bridgetypes.StoreKey},
+		Deleted: []string{},
+	},
+}
@@ -0,0 +1,40 @@
+package v25
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/keepers"
+	"github.com/osmosis-labs/osmosis/v23/app/upgrades"
+	bridgetypes "github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		//  N.B.: this is done to avoid initializing genesis for the bridge module in RunMigrations(),
+		// it's initialized below.
+		fromVM[bridgetypes.ModuleName] = mm.Modules[bridgetypes.ModuleName].(module.HasConsensusVersion).ConsensusVersion()
+
+		// Run migrations before applying any other state changes.
+		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
+		// The modules missing from fromVM, such as x/feegrant, are initialized with their default genesis.
+		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// The bridge starts without signers, so inbound transfers stay disabled until governance sets them.
+		keepers.BridgeKeeper.InitGenesis(ctx, *bridgetypes.DefaultGenesis())
+
+		// The tax rate controller changed, freeze the tax rate until it has WindowProbation epochs of history.
+		keepers.TreasuryKeeper.StartProbation(ctx)
+
+		return migrations, nil
+	}
+}
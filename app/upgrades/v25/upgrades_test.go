@@ -0,0 +1,146 @@
+package v25_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting"
+	v25 "github.com/osmosis-labs/osmosis/v23/app/upgrades/v25"
+	bridgetypes "github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	treasurytypes "github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+const (
+	v25UpgradeHeight = int64(10)
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	s.Setup()
+
+	s.Require().Contains(v25.Upgrade.StoreUpgrades.Added, feegrant.StoreKey)
+	s.Require().Contains(v25.Upgrade.StoreUpgrades.Added, bridgetypes.StoreKey)
+
+	// The bridge is missing from the module versions before the upgrade
+	upgradeStore := s.Ctx.KVStore(s.App.GetKey(upgradetypes.StoreKey))
+	upgradeStore.Delete(append([]byte{upgradetypes.VersionMapByte}, bridgetypes.ModuleName...))
+	s.Require().NotContains(s.App.UpgradeKeeper.GetModuleVersionMap(s.Ctx), bridgetypes.ModuleName)
+
+	// The params added to the oracle, market and treasury modules are missing before the upgrade
+	s.App.UpgradeKeeper.SetModuleVersionMap(s.Ctx, module.VersionMap{
+		oracletypes.ModuleName:   1,
+		markettypes.ModuleName:   1,
+		treasurytypes.ModuleName: 3,
+	})
+	deleteParams(s, oracletypes.ModuleName, oracletypes.KeyJailDuration, oracletypes.KeyMaxRateAge, oracletypes.KeyHistoryKeepPeriod)
+	deleteParams(s, markettypes.ModuleName, markettypes.KeyBasePool, markettypes.KeyPoolRecoveryPeriod, markettypes.KeyMinStabilitySpread,
+		markettypes.KeyMintCaps, markettypes.KeyMintCapEpoch)
+	deleteParams(s, treasurytypes.ModuleName, treasurytypes.KeyMinTaxRate, treasurytypes.KeyMaxTaxRateChange, treasurytypes.KeyRefillInterval,
+		treasurytypes.KeyMaxRefillPerPeriod, treasurytypes.KeyDrainSurplus, treasurytypes.KeyRedemptionOnlyRatio, treasurytypes.KeyHaltRatio)
+	s.Require().Panics(func() { s.App.OracleKeeper.MaxRateAge(s.Ctx) })
+
+	// The probation of the previous upgrade is over
+	epoch := 2 * s.App.TreasuryKeeper.WindowProbation(s.Ctx)
+	s.App.TreasuryKeeper.SetEpoch(s.Ctx, epoch)
+	s.Require().False(s.App.TreasuryKeeper.IsProbation(s.Ctx))
+
+	// The market vault holds the note of the swaps made before the upgrade
+	s.FundModuleAcc(markettypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("note", 1000)))
+	s.Require().True(s.App.TreasuryKeeper.GetEpochInitialExchangePool(s.Ctx).IsZero())
+
+	bridgeParams := bridgetypes.DefaultParams()
+	bridgeParams.Signers = []string{s.TestAccs[0].String()}
+	bridgeParams.VotesNeeded = 1
+	s.App.BridgeKeeper.SetParams(s.Ctx, bridgeParams)
+
+	// Run the upgrade
+	dummyUpgrade(s)
+	s.Require().NotPanics(func() {
+		s.App.BeginBlocker(s.Ctx, abci.RequestBeginBlock{})
+	})
+
+	// The bridge is initialized with the default params
+	bridgeParams = s.App.BridgeKeeper.GetParams(s.Ctx)
+	s.Require().Empty(bridgeParams.Signers)
+	s.Require().Equal(bridgetypes.DefaultVotesNeeded, bridgeParams.VotesNeeded)
+	s.Require().Equal(bridgetypes.DefaultVoteExpiry, bridgeParams.VoteExpiry)
+	s.Require().Equal(uint64(1), s.App.UpgradeKeeper.GetModuleVersionMap(s.Ctx)[bridgetypes.ModuleName])
+
+	// The added params are set to their defaults
+	oracleParams := s.App.OracleKeeper.GetParams(s.Ctx)
+	s.Require().Equal(oracletypes.DefaultJailDuration, oracleParams.JailDuration)
+	s.Require().Equal(oracletypes.DefaultMaxRateAge, oracleParams.MaxRateAge)
+	s.Require().Equal(oracletypes.DefaultHistoryKeepPeriod, oracleParams.HistoryKeepPeriod)
+	s.Require().NoError(oracleParams.Validate())
+
+	marketParams := s.App.MarketKeeper.GetParams(s.Ctx)
+	s.Require().Equal(markettypes.DefaultBasePool, marketParams.BasePool)
+	s.Require().Equal(markettypes.DefaultPoolRecoveryPeriod, marketParams.PoolRecoveryPeriod)
+	s.Require().Equal(markettypes.DefaultMinStabilitySpread, marketParams.MinStabilitySpread)
+	s.Require().Equal(markettypes.DefaultMintCapEpoch, marketParams.MintCapEpoch)
+	s.Require().Empty(marketParams.MintCaps)
+
+	treasuryParams := s.App.TreasuryKeeper.GetParams(s.Ctx)
+	s.Require().Equal(treasurytypes.DefaultParams(), treasuryParams)
+
+	// The tax rate is frozen for a new probation
+	s.Require().Equal(epoch, s.App.TreasuryKeeper.GetProbationStartEpoch(s.Ctx))
+	s.Require().True(s.App.TreasuryKeeper.IsProbation(s.Ctx))
+
+	// The market vault balance from before the upgrade isn't counted as seigniorage
+	exchangePool := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx).Amount
+	s.Require().True(exchangePool.IsPositive())
+	s.Require().Equal(exchangePool, s.App.TreasuryKeeper.GetEpochInitialExchangePool(s.Ctx))
+	s.Require().True(s.App.TreasuryKeeper.GetEpochSeigniorage(s.Ctx).IsZero())
+
+	versions := s.App.UpgradeKeeper.GetModuleVersionMap(s.Ctx)
+	s.Require().Equal(uint64(2), versions[oracletypes.ModuleName])
+	s.Require().Equal(uint64(2), versions[markettypes.ModuleName])
+	s.Require().Equal(uint64(4), versions[treasurytypes.ModuleName])
+
+	// The fee grants can be used
+	granter, grantee := s.TestAccs[0], s.TestAccs[1]
+	expiration := s.Ctx.BlockTime().Add(time.Hour)
+	allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("note", 1000)), Expiration: &expiration}
+	s.Require().NoError(s.App.FeeGrantKeeper.GrantAllowance(s.Ctx, granter, grantee, allowance))
+	_, err := s.App.FeeGrantKeeper.GetAllowance(s.Ctx, granter, grantee)
+	s.Require().NoError(err)
+}
+
+func deleteParams(s *UpgradeTestSuite, subspace string, keys ...[]byte) {
+	store := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), append([]byte(subspace), '/'))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func dummyUpgrade(s *UpgradeTestSuite) {
+	s.Ctx = s.Ctx.WithBlockHeight(v25UpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v25.UpgradeName, Height: v25UpgradeHeight}
+	err := s.App.UpgradeKeeper.ScheduleUpgrade(s.Ctx, plan)
+	s.Require().NoError(err)
+	_, exists := s.App.UpgradeKeeper.GetUpgradePlan(s.Ctx)
+	s.Require().True(exists)
+
+	s.Ctx = s.Ctx.WithBlockHeight(v25UpgradeHeight)
+}
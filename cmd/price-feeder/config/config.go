@@ -0,0 +1,177 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+)
+
+const (
+	// ProviderTypeHTTP is the type of the providers polled over HTTP.
+	ProviderTypeHTTP = "http"
+	// ProviderTypeWebsocket is the type of the providers streaming over a WebSocket.
+	ProviderTypeWebsocket = "websocket"
+)
+
+var (
+	DefaultGas          = uint64(200000)
+	DefaultPollInterval = time.Second
+	DefaultMaxDeviation = "0.1"
+	DefaultMinProviders = 1
+	DefaultMaxPriceAge  = time.Minute
+	DefaultHTTPTimeout  = 5 * time.Second
+)
+
+// Config is the configuration of the price feeder, read from a TOML file.
+type Config struct {
+	// ChainID is the id of the chain the votes are signed for.
+	ChainID string `mapstructure:"chain_id"`
+	// RPCAddress is the CometBFT RPC endpoint of a node, used to query the chain and broadcast the votes.
+	RPCAddress string `mapstructure:"rpc_address"`
+	// Validator is the operator address of the validator voting.
+	Validator string `mapstructure:"validator"`
+	// Gas is the gas limit of the vote txs.
+	Gas uint64 `mapstructure:"gas"`
+	// GasPrices are the gas prices paid by the vote txs; oracle-only txs are free, so it is usually empty.
+	GasPrices string `mapstructure:"gas_prices"`
+	// PollInterval is the interval at which the chain height is polled.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// MaxDeviation is the relative deviation from the median above which a provider price is dropped.
+	MaxDeviation string `mapstructure:"max_deviation"`
+	// MinProviders is the number of providers that must agree on a price for it to be voted.
+	MinProviders int `mapstructure:"min_providers"`
+
+	Keyring   KeyringConfig    `mapstructure:"keyring"`
+	Providers []ProviderConfig `mapstructure:"providers"`
+}
+
+// KeyringConfig locates the feeder key, delegated by the validator through MsgDelegateFeedConsent.
+type KeyringConfig struct {
+	Backend string `mapstructure:"backend"`
+	Dir     string `mapstructure:"dir"`
+	KeyName string `mapstructure:"key_name"`
+}
+
+// ProviderConfig configures a price provider.
+type ProviderConfig struct {
+	Name string `mapstructure:"name"`
+	// Type is either "http" or "websocket".
+	Type string `mapstructure:"type"`
+	URL  string `mapstructure:"url"`
+	// Subscribe is an optional message sent to a websocket provider once connected.
+	Subscribe string `mapstructure:"subscribe"`
+	// Denoms maps the provider symbols, matched case-insensitively, to the chain denoms;
+	// the symbols are used as denoms when empty.
+	Denoms map[string]string `mapstructure:"denoms"`
+	// Timeout bounds the HTTP requests.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxPriceAge is the age above which the prices streamed by a websocket provider are ignored.
+	MaxPriceAge time.Duration `mapstructure:"max_price_age"`
+}
+
+// LoadConfig reads the configuration at the given path and validates it.
+func LoadConfig(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetDefault("gas", DefaultGas)
+	v.SetDefault("poll_interval", DefaultPollInterval)
+	v.SetDefault("max_deviation", DefaultMaxDeviation)
+	v.SetDefault("min_providers", DefaultMinProviders)
+	v.SetDefault("keyring.backend", "os")
+
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to decode config %s: %w", path, err)
+	}
+
+	for i := range cfg.Providers {
+		if cfg.Providers[i].Timeout == 0 {
+			cfg.Providers[i].Timeout = DefaultHTTPTimeout
+		}
+		if cfg.Providers[i].MaxPriceAge == 0 {
+			cfg.Providers[i].MaxPriceAge = DefaultMaxPriceAge
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the configuration is complete and well-formed.
+func (cfg Config) Validate() error {
+	if cfg.ChainID == "" {
+		return errors.New("chain_id must be set")
+	}
+	if cfg.RPCAddress == "" {
+		return errors.New("rpc_address must be set")
+	}
+	if _, err := sdk.ValAddressFromBech32(cfg.Validator); err != nil {
+		return fmt.Errorf("invalid validator address %q: %w", cfg.Validator, err)
+	}
+	if cfg.Gas == 0 {
+		return errors.New("gas must be positive")
+	}
+	if cfg.GasPrices != "" {
+		if _, err := sdk.ParseDecCoins(cfg.GasPrices); err != nil {
+			return fmt.Errorf("invalid gas_prices %q: %w", cfg.GasPrices, err)
+		}
+	}
+	if cfg.PollInterval <= 0 {
+		return errors.New("poll_interval must be positive")
+	}
+	if _, err := cfg.MaxDeviationDec(); err != nil {
+		return err
+	}
+	if cfg.MinProviders < 1 {
+		return errors.New("min_providers must be at least 1")
+	}
+	if cfg.Keyring.KeyName == "" {
+		return errors.New("keyring.key_name must be set")
+	}
+
+	if len(cfg.Providers) == 0 {
+		return errors.New("at least one provider must be configured")
+	}
+	if len(cfg.Providers) < cfg.MinProviders {
+		return fmt.Errorf("min_providers is %d, but only %d providers are configured", cfg.MinProviders, len(cfg.Providers))
+	}
+
+	names := make(map[string]bool, len(cfg.Providers))
+	for _, provider := range cfg.Providers {
+		if provider.Name == "" {
+			return errors.New("provider name must be set")
+		}
+		if names[provider.Name] {
+			return fmt.Errorf("duplicate provider %s", provider.Name)
+		}
+		names[provider.Name] = true
+
+		if provider.Type != ProviderTypeHTTP && provider.Type != ProviderTypeWebsocket {
+			return fmt.Errorf("provider %s has unknown type %q", provider.Name, provider.Type)
+		}
+		if provider.URL == "" {
+			return fmt.Errorf("provider %s has no url", provider.Name)
+		}
+	}
+
+	return nil
+}
+
+// MaxDeviationDec returns the max deviation as a decimal.
+func (cfg Config) MaxDeviationDec() (sdk.Dec, error) {
+	maxDeviation, err := sdk.NewDecFromStr(cfg.MaxDeviation)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid max_deviation %q: %w", cfg.MaxDeviation, err)
+	}
+	if !maxDeviation.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("max_deviation must be positive, got %s", maxDeviation)
+	}
+
+	return maxDeviation, nil
+}
@@ -0,0 +1,136 @@
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+)
+
+const configTemplate = `
+chain_id = "symphony-1"
+rpc_address = "tcp://localhost:26657"
+validator = "%s"
+
+[keyring]
+backend = "test"
+dir = "/tmp/keyring"
+key_name = "feeder"
+
+[[providers]]
+name = "http"
+type = "http"
+url = "http://localhost:8080/prices"
+
+[providers.denoms]
+USD = "uusd"
+
+[[providers]]
+name = "ws"
+type = "websocket"
+url = "ws://localhost:8081/prices"
+max_price_age = "30s"
+`
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "price-feeder.toml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	validator := sdk.ValAddress([]byte("validator___________")).String()
+
+	cfg, err := config.LoadConfig(writeConfig(t, fmt.Sprintf(configTemplate, validator)))
+	require.NoError(t, err)
+
+	require.Equal(t, "symphony-1", cfg.ChainID)
+	require.Equal(t, validator, cfg.Validator)
+	require.Equal(t, config.DefaultGas, cfg.Gas)
+	require.Equal(t, config.DefaultPollInterval, cfg.PollInterval)
+	require.Equal(t, config.DefaultMinProviders, cfg.MinProviders)
+	require.Equal(t, "test", cfg.Keyring.Backend)
+	require.Equal(t, "feeder", cfg.Keyring.KeyName)
+
+	require.Len(t, cfg.Providers, 2)
+	require.Equal(t, config.ProviderTypeHTTP, cfg.Providers[0].Type)
+	require.Equal(t, map[string]string{"usd": "uusd"}, cfg.Providers[0].Denoms)
+	require.Equal(t, config.DefaultHTTPTimeout, cfg.Providers[0].Timeout)
+	require.Equal(t, config.DefaultMaxPriceAge, cfg.Providers[0].MaxPriceAge)
+	require.Equal(t, config.ProviderTypeWebsocket, cfg.Providers[1].Type)
+	require.Equal(t, 30*time.Second, cfg.Providers[1].MaxPriceAge)
+
+	maxDeviation, err := cfg.MaxDeviationDec()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), maxDeviation)
+}
+
+func TestLoadConfig_Missing(t *testing.T) {
+	_, err := config.LoadConfig(filepath.Join(t.TempDir(), "missing.toml"))
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	validator := sdk.ValAddress([]byte("validator___________")).String()
+
+	valid := func() config.Config {
+		return config.Config{
+			ChainID:      "symphony-1",
+			RPCAddress:   "tcp://localhost:26657",
+			Validator:    validator,
+			Gas:          config.DefaultGas,
+			PollInterval: config.DefaultPollInterval,
+			MaxDeviation: config.DefaultMaxDeviation,
+			MinProviders: 1,
+			Keyring:      config.KeyringConfig{Backend: "test", KeyName: "feeder"},
+			Providers: []config.ProviderConfig{
+				{Name: "http", Type: config.ProviderTypeHTTP, URL: "http://localhost:8080"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(cfg *config.Config)
+		expErr   bool
+	}{
+		{"valid", func(cfg *config.Config) {}, false},
+		{"valid gas prices", func(cfg *config.Config) { cfg.GasPrices = "0.01note" }, false},
+		{"missing chain id", func(cfg *config.Config) { cfg.ChainID = "" }, true},
+		{"missing rpc address", func(cfg *config.Config) { cfg.RPCAddress = "" }, true},
+		{"invalid validator", func(cfg *config.Config) { cfg.Validator = "symphony1invalid" }, true},
+		{"zero gas", func(cfg *config.Config) { cfg.Gas = 0 }, true},
+		{"invalid gas prices", func(cfg *config.Config) { cfg.GasPrices = "note" }, true},
+		{"zero poll interval", func(cfg *config.Config) { cfg.PollInterval = 0 }, true},
+		{"invalid max deviation", func(cfg *config.Config) { cfg.MaxDeviation = "ten" }, true},
+		{"zero max deviation", func(cfg *config.Config) { cfg.MaxDeviation = "0" }, true},
+		{"zero min providers", func(cfg *config.Config) { cfg.MinProviders = 0 }, true},
+		{"missing key name", func(cfg *config.Config) { cfg.Keyring.KeyName = "" }, true},
+		{"no provider", func(cfg *config.Config) { cfg.Providers = nil }, true},
+		{"fewer providers than min providers", func(cfg *config.Config) { cfg.MinProviders = 2 }, true},
+		{"missing provider name", func(cfg *config.Config) { cfg.Providers[0].Name = "" }, true},
+		{"duplicate provider", func(cfg *config.Config) { cfg.Providers = append(cfg.Providers, cfg.Providers[0]) }, true},
+		{"unknown provider type", func(cfg *config.Config) { cfg.Providers[0].Type = "grpc" }, true},
+		{"missing provider url", func(cfg *config.Config) { cfg.Providers[0].URL = "" }, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := valid()
+			tc.malleate(&cfg)
+
+			err := cfg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package feeder
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AggregatePrices computes the exchange rate of each denom from the prices of the providers, keyed by provider.
+// The prices deviating from the median by more than maxDeviation, relatively to it, are dropped as outliers, and
+// the median of the remaining prices is kept if at least minProviders of them are left.
+func AggregatePrices(providerPrices map[string]map[string]sdk.Dec, maxDeviation sdk.Dec, minProviders int) map[string]sdk.Dec {
+	pricesByDenom := make(map[string][]sdk.Dec)
+	for _, prices := range providerPrices {
+		for denom, price := range prices {
+			pricesByDenom[denom] = append(pricesByDenom[denom], price)
+		}
+	}
+
+	exchangeRates := make(map[string]sdk.Dec, len(pricesByDenom))
+	for denom, prices := range pricesByDenom {
+		median := Median(prices)
+
+		filtered := make([]sdk.Dec, 0, len(prices))
+		for _, price := range prices {
+			if price.Sub(median).Abs().LTE(median.Mul(maxDeviation)) {
+				filtered = append(filtered, price)
+			}
+		}
+
+		if len(filtered) < minProviders || len(filtered) == 0 {
+			continue
+		}
+
+		exchangeRates[denom] = Median(filtered)
+	}
+
+	return exchangeRates
+}
+
+// Median returns the median of the given prices, the mean of the two middle ones for an even count.
+// The prices must not be empty.
+func Median(prices []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
@@ -0,0 +1,122 @@
+package feeder_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/feeder"
+)
+
+func decs(values ...string) []sdk.Dec {
+	res := make([]sdk.Dec, len(values))
+	for i, value := range values {
+		res[i] = sdk.MustNewDecFromStr(value)
+	}
+	return res
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		prices []sdk.Dec
+		exp    string
+	}{
+		{"single", decs("1.5"), "1.5"},
+		{"odd count", decs("3", "1", "2"), "2"},
+		{"even count", decs("4", "1", "3", "2"), "2.5"},
+		{"duplicates", decs("2", "2", "1"), "2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prices := append([]sdk.Dec{}, tc.prices...)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.exp), feeder.Median(tc.prices))
+			// the prices are left unsorted
+			require.Equal(t, prices, tc.prices)
+		})
+	}
+}
+
+func TestAggregatePrices(t *testing.T) {
+	maxDeviation := sdk.NewDecWithPrec(1, 1)
+
+	tests := []struct {
+		name           string
+		providerPrices map[string]map[string]sdk.Dec
+		minProviders   int
+		exp            map[string]sdk.Dec
+	}{
+		{
+			name:           "no provider",
+			providerPrices: map[string]map[string]sdk.Dec{},
+			minProviders:   1,
+			exp:            map[string]sdk.Dec{},
+		},
+		{
+			name: "median of the providers",
+			providerPrices: map[string]map[string]sdk.Dec{
+				"a": {"uusd": sdk.MustNewDecFromStr("0.99"), "usdr": sdk.MustNewDecFromStr("2")},
+				"b": {"uusd": sdk.MustNewDecFromStr("1.01"), "usdr": sdk.MustNewDecFromStr("2.1")},
+				"c": {"uusd": sdk.MustNewDecFromStr("1")},
+			},
+			minProviders: 1,
+			exp: map[string]sdk.Dec{
+				"uusd": sdk.MustNewDecFromStr("1"),
+				"usdr": sdk.MustNewDecFromStr("2.05"),
+			},
+		},
+		{
+			name: "outlier dropped",
+			providerPrices: map[string]map[string]sdk.Dec{
+				"a": {"uusd": sdk.MustNewDecFromStr("0.99")},
+				"b": {"uusd": sdk.MustNewDecFromStr("1.01")},
+				"c": {"uusd": sdk.MustNewDecFromStr("1.02")},
+				"d": {"uusd": sdk.MustNewDecFromStr("3")},
+			},
+			minProviders: 3,
+			exp: map[string]sdk.Dec{
+				"uusd": sdk.MustNewDecFromStr("1.01"),
+			},
+		},
+		{
+			name: "deviation at the bound kept",
+			providerPrices: map[string]map[string]sdk.Dec{
+				"a": {"uusd": sdk.MustNewDecFromStr("0.9")},
+				"b": {"uusd": sdk.MustNewDecFromStr("1")},
+				"c": {"uusd": sdk.MustNewDecFromStr("1.1")},
+			},
+			minProviders: 3,
+			exp: map[string]sdk.Dec{
+				"uusd": sdk.MustNewDecFromStr("1"),
+			},
+		},
+		{
+			name: "too few providers",
+			providerPrices: map[string]map[string]sdk.Dec{
+				"a": {"uusd": sdk.MustNewDecFromStr("1"), "usdr": sdk.MustNewDecFromStr("2")},
+				"b": {"uusd": sdk.MustNewDecFromStr("1")},
+			},
+			minProviders: 2,
+			exp: map[string]sdk.Dec{
+				"uusd": sdk.MustNewDecFromStr("1"),
+			},
+		},
+		{
+			name: "too few providers left after filtering",
+			providerPrices: map[string]map[string]sdk.Dec{
+				"a": {"uusd": sdk.MustNewDecFromStr("1")},
+				"b": {"uusd": sdk.MustNewDecFromStr("2")},
+			},
+			minProviders: 2,
+			exp:          map[string]sdk.Dec{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, feeder.AggregatePrices(tc.providerPrices, maxDeviation, tc.minProviders))
+		})
+	}
+}
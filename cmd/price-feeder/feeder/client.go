@@ -0,0 +1,156 @@
+package feeder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// ChainClient is the access of the feeder to the chain.
+type ChainClient interface {
+	// FeederAddress returns the address signing the txs.
+	FeederAddress() sdk.AccAddress
+	// LatestHeight returns the height of the latest committed block.
+	LatestHeight(ctx context.Context) (int64, error)
+	// VotePeriod returns the oracle vote period, in blocks.
+	VotePeriod(ctx context.Context) (uint64, error)
+	// VoteTargets returns the denoms to vote on.
+	VoteTargets(ctx context.Context) ([]string, error)
+	// BroadcastTx signs the msgs with the feeder key and broadcasts them in a tx,
+	// returning an error if the tx is rejected by CheckTx.
+	BroadcastTx(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+var _ ChainClient = &RPCChainClient{}
+
+// RPCChainClient is a ChainClient talking to a node over its CometBFT RPC endpoint.
+type RPCChainClient struct {
+	clientCtx client.Context
+	txFactory tx.Factory
+	keyName   string
+}
+
+// NewRPCChainClient returns a new RPCChainClient, signing with the key named in the config.
+func NewRPCChainClient(cfg config.Config, encodingConfig params.EncodingConfig, kr keyring.Keyring) (*RPCChainClient, error) {
+	record, err := kr.Key(cfg.Keyring.KeyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %s: %w", cfg.Keyring.KeyName, err)
+	}
+
+	feederAddr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcClient, err := client.NewClientFromNode(cfg.RPCAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the rpc client: %w", err)
+	}
+
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithChainID(cfg.ChainID).
+		WithClient(rpcClient).
+		WithNodeURI(cfg.RPCAddress).
+		WithKeyring(kr).
+		WithFromName(cfg.Keyring.KeyName).
+		WithFromAddress(feederAddr).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	txFactory := tx.Factory{}.
+		WithChainID(cfg.ChainID).
+		WithKeybase(kr).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithGas(cfg.Gas).
+		WithGasPrices(cfg.GasPrices).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	return &RPCChainClient{
+		clientCtx: clientCtx,
+		txFactory: txFactory,
+		keyName:   cfg.Keyring.KeyName,
+	}, nil
+}
+
+// FeederAddress implements ChainClient.
+func (c *RPCChainClient) FeederAddress() sdk.AccAddress {
+	return c.clientCtx.GetFromAddress()
+}
+
+// LatestHeight implements ChainClient.
+func (c *RPCChainClient) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := c.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// VotePeriod implements ChainClient.
+func (c *RPCChainClient) VotePeriod(ctx context.Context) (uint64, error) {
+	res, err := oracletypes.NewQueryClient(c.clientCtx).Params(ctx, &oracletypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Params.VotePeriod, nil
+}
+
+// VoteTargets implements ChainClient.
+func (c *RPCChainClient) VoteTargets(ctx context.Context) ([]string, error) {
+	res, err := oracletypes.NewQueryClient(c.clientCtx).VoteTargets(ctx, &oracletypes.QueryVoteTargetsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.VoteTargets, nil
+}
+
+// BroadcastTx implements ChainClient. The account number and sequence are queried for every tx.
+func (c *RPCChainClient) BroadcastTx(_ context.Context, msgs ...sdk.Msg) error {
+	txFactory, err := c.txFactory.Prepare(c.clientCtx)
+	if err != nil {
+		return fmt.Errorf("failed to prepare the tx: %w", err)
+	}
+
+	txBuilder, err := txFactory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return fmt.Errorf("failed to build the tx: %w", err)
+	}
+
+	if err := tx.Sign(txFactory, c.keyName, txBuilder, true); err != nil {
+		return fmt.Errorf("failed to sign the tx: %w", err)
+	}
+
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return fmt.Errorf("failed to encode the tx: %w", err)
+	}
+
+	res, err := c.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast the tx: %w", err)
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("tx %s rejected with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return nil
+}
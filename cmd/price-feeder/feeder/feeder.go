@@ -0,0 +1,223 @@
+package feeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/provider"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// Feeder submits the oracle votes of a validator, once per vote period.
+//
+// In every vote period, it reveals the vote committed in the previous one and commits the next vote at once,
+// through a MsgAggregateExchangeRateVoteAndPrevote. When there is nothing to reveal, e.g. on start or after a
+// missed vote period, it only submits a MsgAggregateExchangeRatePrevote.
+type Feeder struct {
+	logger       log.Logger
+	chain        ChainClient
+	providers    []provider.Provider
+	validator    sdk.ValAddress
+	maxDeviation sdk.Dec
+	minProviders int
+	pollInterval time.Duration
+
+	// lastPeriod is the last vote period a vote was attempted in, if any
+	lastPeriod *uint64
+	// pending is the vote committed by the last prevote, to be revealed in the next vote period
+	pending *pendingVote
+}
+
+type pendingVote struct {
+	period        uint64
+	salt          string
+	exchangeRates string
+}
+
+// NewFeeder returns a new Feeder.
+func NewFeeder(
+	logger log.Logger,
+	chain ChainClient,
+	providers []provider.Provider,
+	validator sdk.ValAddress,
+	maxDeviation sdk.Dec,
+	minProviders int,
+	pollInterval time.Duration,
+) *Feeder {
+	return &Feeder{
+		logger:       logger,
+		chain:        chain,
+		providers:    providers,
+		validator:    validator,
+		maxDeviation: maxDeviation,
+		minProviders: minProviders,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run polls the chain and votes until the context is done.
+func (f *Feeder) Run(ctx context.Context) error {
+	provider.Start(ctx, f.providers)
+
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := f.Tick(ctx); err != nil {
+				f.logger.Error("failed to vote", "err", err)
+			}
+		}
+	}
+}
+
+// Tick votes if the next block starts a vote period not voted in yet.
+func (f *Feeder) Tick(ctx context.Context) error {
+	height, err := f.chain.LatestHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the latest height: %w", err)
+	}
+
+	votePeriod, err := f.chain.VotePeriod(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the vote period: %w", err)
+	}
+
+	// the tx is expected to be included in the next block; it's not sent in the last block of a
+	// vote period, where it could slip to the next period and fail the reveal period check
+	nextHeight := uint64(height) + 1
+	period := nextHeight / votePeriod
+	if votePeriod > 1 && nextHeight%votePeriod == votePeriod-1 {
+		return nil
+	}
+	if f.lastPeriod != nil && period <= *f.lastPeriod {
+		return nil
+	}
+	f.lastPeriod = &period
+
+	return f.vote(ctx, period)
+}
+
+func (f *Feeder) vote(ctx context.Context, period uint64) error {
+	// a pending vote can only be revealed in the vote period following its prevote
+	reveal := f.pending
+	if reveal != nil && reveal.period+1 != period {
+		reveal = nil
+	}
+	f.pending = nil
+
+	feeder := f.chain.FeederAddress()
+
+	targets, err := f.chain.VoteTargets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the vote targets: %w", err)
+	}
+
+	exchangeRates := f.fetchExchangeRates(ctx)
+	if len(targets) == 0 || len(exchangeRates) == 0 {
+		if reveal == nil {
+			if len(targets) == 0 {
+				f.logger.Info("no vote target, skipping vote period", "period", period)
+				return nil
+			}
+			return errors.New("no exchange rate available")
+		}
+
+		// still reveal the committed vote, without committing a new one
+		msg := oracletypes.NewMsgAggregateExchangeRateVote(reveal.salt, reveal.exchangeRates, feeder, f.validator)
+		if err := f.chain.BroadcastTx(ctx, msg); err != nil {
+			return err
+		}
+		f.logger.Info("revealed vote", "period", period, "exchange_rates", reveal.exchangeRates)
+		return nil
+	}
+
+	exchangeRatesStr := FormatExchangeRates(targets, exchangeRates)
+	salt, err := GenerateSalt()
+	if err != nil {
+		return err
+	}
+	hash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, f.validator)
+
+	var msg sdk.Msg
+	if reveal != nil {
+		msg = oracletypes.NewMsgAggregateExchangeRateVoteAndPrevote(reveal.salt, reveal.exchangeRates, hash, feeder, f.validator)
+	} else {
+		msg = oracletypes.NewMsgAggregateExchangeRatePrevote(hash, feeder, f.validator)
+	}
+
+	if err := f.chain.BroadcastTx(ctx, msg); err != nil {
+		return err
+	}
+
+	if reveal != nil {
+		f.logger.Info("revealed vote and committed the next one", "period", period, "exchange_rates", reveal.exchangeRates)
+	} else {
+		f.logger.Info("committed vote", "period", period)
+	}
+
+	f.pending = &pendingVote{
+		period:        period,
+		salt:          salt,
+		exchangeRates: exchangeRatesStr,
+	}
+
+	return nil
+}
+
+// fetchExchangeRates queries all the providers and aggregates their prices.
+// The providers failing are left out.
+func (f *Feeder) fetchExchangeRates(ctx context.Context) map[string]sdk.Dec {
+	providerPrices := make(map[string]map[string]sdk.Dec, len(f.providers))
+	for _, p := range f.providers {
+		prices, err := p.Prices(ctx)
+		if err != nil {
+			f.logger.Error("failed to fetch prices", "provider", p.Name(), "err", err)
+			continue
+		}
+		providerPrices[p.Name()] = prices
+	}
+
+	return AggregatePrices(providerPrices, f.maxDeviation, f.minProviders)
+}
+
+// FormatExchangeRates formats the exchange rates of the vote targets as expected by the oracle votes.
+// The targets without exchange rate are abstained on, with a zero rate.
+func FormatExchangeRates(targets []string, exchangeRates map[string]sdk.Dec) string {
+	sorted := make([]string, len(targets))
+	copy(sorted, targets)
+	sort.Strings(sorted)
+
+	tuples := make([]string, len(sorted))
+	for i, denom := range sorted {
+		rate, ok := exchangeRates[denom]
+		if !ok {
+			rate = sdk.ZeroDec()
+		}
+		tuples[i] = rate.String() + denom
+	}
+
+	return strings.Join(tuples, ",")
+}
+
+// GenerateSalt returns a random salt of the maximum length accepted by the oracle votes.
+func GenerateSalt() (string, error) {
+	bz := make([]byte, 2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	return hex.EncodeToString(bz), nil
+}
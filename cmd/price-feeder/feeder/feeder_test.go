@@ -0,0 +1,185 @@
+package feeder_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/feeder"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/provider"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+var (
+	feederAddr    = sdk.AccAddress([]byte("feeder______________"))
+	validatorAddr = sdk.ValAddress([]byte("validator___________"))
+)
+
+type mockChain struct {
+	height       int64
+	votePeriod   uint64
+	voteTargets  []string
+	broadcastErr error
+	msgs         []sdk.Msg
+}
+
+var _ feeder.ChainClient = &mockChain{}
+
+func (c *mockChain) FeederAddress() sdk.AccAddress { return feederAddr }
+
+func (c *mockChain) LatestHeight(context.Context) (int64, error) { return c.height, nil }
+
+func (c *mockChain) VotePeriod(context.Context) (uint64, error) { return c.votePeriod, nil }
+
+func (c *mockChain) VoteTargets(context.Context) ([]string, error) { return c.voteTargets, nil }
+
+func (c *mockChain) BroadcastTx(_ context.Context, msgs ...sdk.Msg) error {
+	if c.broadcastErr != nil {
+		return c.broadcastErr
+	}
+	c.msgs = append(c.msgs, msgs...)
+	return nil
+}
+
+type mockProvider struct {
+	name   string
+	prices map[string]sdk.Dec
+	err    error
+}
+
+var _ provider.Provider = &mockProvider{}
+
+func (p *mockProvider) Name() string { return p.name }
+
+func (p *mockProvider) Prices(context.Context) (map[string]sdk.Dec, error) { return p.prices, p.err }
+
+func newFeeder(chain *mockChain, providers ...provider.Provider) *feeder.Feeder {
+	return feeder.NewFeeder(log.NewNopLogger(), chain, providers, validatorAddr, sdk.NewDecWithPrec(1, 1), 1, time.Second)
+}
+
+func TestFormatExchangeRates(t *testing.T) {
+	exchangeRates := map[string]sdk.Dec{
+		"uusd": sdk.MustNewDecFromStr("1.01"),
+		"usdr": sdk.MustNewDecFromStr("2"),
+		"ukrw": sdk.MustNewDecFromStr("0.0007"),
+	}
+
+	// sorted by denom, untargeted rates left out and missing rates abstained on
+	res := feeder.FormatExchangeRates([]string{"uusd", "usdr", "ueur"}, exchangeRates)
+	require.Equal(t, "0.000000000000000000ueur,2.000000000000000000usdr,1.010000000000000000uusd", res)
+
+	parsed, err := oracletypes.ParseExchangeRateTuples(res)
+	require.NoError(t, err)
+	require.Len(t, parsed, 3)
+}
+
+func TestGenerateSalt(t *testing.T) {
+	salt, err := feeder.GenerateSalt()
+	require.NoError(t, err)
+	require.Len(t, salt, 4)
+
+	hash := oracletypes.GetAggregateVoteHash(salt, "1.0uusd", validatorAddr)
+	require.NoError(t, oracletypes.NewMsgAggregateExchangeRatePrevote(hash, feederAddr, validatorAddr).ValidateBasic())
+	require.NoError(t, oracletypes.NewMsgAggregateExchangeRateVote(salt, "1.0uusd", feederAddr, validatorAddr).ValidateBasic())
+}
+
+func TestFeeder_Tick(t *testing.T) {
+	chain := &mockChain{votePeriod: 5, voteTargets: []string{"uusd", "usdr"}}
+	f := newFeeder(chain,
+		&mockProvider{name: "a", prices: map[string]sdk.Dec{"uusd": sdk.MustNewDecFromStr("1.01")}},
+		&mockProvider{name: "b", err: errors.New("unavailable")},
+	)
+
+	tick := func(height int64) {
+		chain.height = height
+		require.NoError(t, f.Tick(context.Background()))
+	}
+
+	// the next block is the last one of the period: wait for the next period
+	chain.height = 8
+	require.NoError(t, f.Tick(context.Background()))
+	require.Empty(t, chain.msgs)
+
+	// the first vote of the feeder is a prevote alone
+	tick(9)
+	require.Len(t, chain.msgs, 1)
+	prevote, ok := chain.msgs[0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+	require.Equal(t, feederAddr.String(), prevote.Feeder)
+	require.Equal(t, validatorAddr.String(), prevote.Validator)
+
+	// a single vote per period
+	tick(10)
+	tick(11)
+	require.Len(t, chain.msgs, 1)
+
+	// the prevote is revealed in the next period, along with the next prevote
+	tick(14)
+	require.Len(t, chain.msgs, 2)
+	voteAndPrevote, ok := chain.msgs[1].(*oracletypes.MsgAggregateExchangeRateVoteAndPrevote)
+	require.True(t, ok)
+	require.Equal(t, "0.000000000000000000usdr,1.010000000000000000uusd", voteAndPrevote.ExchangeRates)
+	require.Equal(t, prevote.Hash, oracletypes.GetAggregateVoteHash(voteAndPrevote.Salt, voteAndPrevote.ExchangeRates, validatorAddr).String())
+	require.NoError(t, voteAndPrevote.ValidateBasic())
+
+	// a period is missed: the last prevote can't be revealed anymore
+	tick(25)
+	require.Len(t, chain.msgs, 3)
+	_, ok = chain.msgs[2].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+}
+
+func TestFeeder_Tick_NoExchangeRate(t *testing.T) {
+	chain := &mockChain{votePeriod: 5, voteTargets: []string{"uusd"}}
+	p := &mockProvider{name: "a", prices: map[string]sdk.Dec{"uusd": sdk.MustNewDecFromStr("1.01")}}
+	f := newFeeder(chain, p)
+
+	chain.height = 4
+	require.NoError(t, f.Tick(context.Background()))
+	require.Len(t, chain.msgs, 1)
+
+	// the pending vote is still revealed, without any new prevote
+	p.prices, p.err = nil, errors.New("unavailable")
+	chain.height = 9
+	require.NoError(t, f.Tick(context.Background()))
+	require.Len(t, chain.msgs, 2)
+	vote, ok := chain.msgs[1].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Equal(t, "1.010000000000000000uusd", vote.ExchangeRates)
+
+	// nothing left to reveal
+	chain.height = 14
+	require.Error(t, f.Tick(context.Background()))
+	require.Len(t, chain.msgs, 2)
+}
+
+func TestFeeder_Tick_NoVoteTarget(t *testing.T) {
+	chain := &mockChain{votePeriod: 5}
+	f := newFeeder(chain, &mockProvider{name: "a", prices: map[string]sdk.Dec{"uusd": sdk.OneDec()}})
+
+	chain.height = 4
+	require.NoError(t, f.Tick(context.Background()))
+	require.Empty(t, chain.msgs)
+}
+
+func TestFeeder_Tick_BroadcastFailure(t *testing.T) {
+	chain := &mockChain{votePeriod: 5, voteTargets: []string{"uusd"}}
+	f := newFeeder(chain, &mockProvider{name: "a", prices: map[string]sdk.Dec{"uusd": sdk.OneDec()}})
+
+	chain.height = 4
+	chain.broadcastErr = errors.New("rejected")
+	require.Error(t, f.Tick(context.Background()))
+
+	// the failed prevote isn't revealed
+	chain.height = 9
+	chain.broadcastErr = nil
+	require.NoError(t, f.Tick(context.Background()))
+	require.Len(t, chain.msgs, 1)
+	_, ok := chain.msgs[0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+}
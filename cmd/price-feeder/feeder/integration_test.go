@@ -0,0 +1,173 @@
+package feeder_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v23/app"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/feeder"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/provider"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// TestFeeder_InProcessChain runs the feeder against a single validator chain and local mock providers,
+// voting with a feeder key delegated by the validator, until the oracle sets the aggregated exchange rates.
+func TestFeeder_InProcessChain(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process chain test in short mode")
+	}
+
+	cfg := app.DefaultConfig()
+
+	oracleGenesis := oracletypes.DefaultGenesisState()
+	oracleGenesis.Params.VotePeriod = 4
+	oracleGenesis.Params.MaxRateAge = 40
+	oracleGenesis.Params.Whitelist = oracletypes.DenomList{
+		{Name: "uusd", TobinTax: oracletypes.DefaultTobinTax},
+		{Name: "usdr", TobinTax: oracletypes.DefaultTobinTax},
+	}
+	require.NoError(t, oracleGenesis.Params.Validate())
+	cfg.GenesisState[oracletypes.ModuleName] = cfg.Codec.MustMarshalJSON(oracleGenesis)
+
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(net.Cleanup)
+
+	_, err = net.WaitForHeight(1)
+	require.NoError(t, err)
+
+	val := net.Validators[0]
+	encodingConfig := app.MakeEncodingConfig()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	feederRecord, _, err := val.ClientCtx.Keyring.NewMnemonic("feeder", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	feederAddr, err := feederRecord.GetAddress()
+	require.NoError(t, err)
+
+	chainConfig := func(keyName, gasPrices string) config.Config {
+		return config.Config{
+			ChainID:    cfg.ChainID,
+			RPCAddress: val.RPCAddress,
+			Validator:  val.ValAddress.String(),
+			Gas:        config.DefaultGas,
+			GasPrices:  gasPrices,
+			Keyring:    config.KeyringConfig{KeyName: keyName},
+		}
+	}
+
+	// the validator funds the feeder account and delegates its votes to it
+	validatorClient, err := feeder.NewRPCChainClient(chainConfig(val.Moniker, fmt.Sprintf("1%s", cfg.BondDenom)), encodingConfig, val.ClientCtx.Keyring)
+	require.NoError(t, err)
+	require.NoError(t, validatorClient.BroadcastTx(ctx,
+		banktypes.NewMsgSend(val.Address, feederAddr, sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 1000000))),
+		oracletypes.NewMsgDelegateFeedConsent(val.ValAddress, feederAddr),
+	))
+
+	queryClient := oracletypes.NewQueryClient(val.ClientCtx)
+	require.Eventually(t, func() bool {
+		res, err := queryClient.FeederDelegation(ctx, &oracletypes.QueryFeederDelegationRequest{ValidatorAddr: val.ValAddress.String()})
+		return err == nil && res.FeederAddr == feederAddr.String()
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// the oracle votes are free: the feeder doesn't pay any fee
+	chain, err := feeder.NewRPCChainClient(chainConfig("feeder", ""), encodingConfig, val.ClientCtx.Keyring)
+	require.NoError(t, err)
+	require.Equal(t, feederAddr, chain.FeederAddress())
+
+	providers := []provider.Provider{
+		provider.NewHTTPProvider(config.ProviderConfig{
+			Name:    "http-a",
+			URL:     newMockHTTPProvider(t, `{"USD":"0.99","SDR":"2.0"}`),
+			Denoms:  map[string]string{"USD": "uusd", "SDR": "usdr"},
+			Timeout: time.Second,
+		}),
+		provider.NewHTTPProvider(config.ProviderConfig{
+			Name:    "http-b",
+			URL:     newMockHTTPProvider(t, `{"uusd":1.01,"usdr":2.0}`),
+			Timeout: time.Second,
+		}),
+		// the usdr price is an outlier, dropped from the median
+		provider.NewWebsocketProvider(config.ProviderConfig{
+			Name:        "ws",
+			URL:         newMockWebsocketProvider(t, `{"uusd":"1.0","usdr":"3.0"}`),
+			MaxPriceAge: time.Minute,
+		}),
+	}
+
+	f := feeder.NewFeeder(log.NewNopLogger(), chain, providers, val.ValAddress, sdk.NewDecWithPrec(1, 1), 2, 100*time.Millisecond)
+	go func() {
+		_ = f.Run(ctx)
+	}()
+
+	expRates := map[string]sdk.Dec{
+		"uusd": sdk.OneDec(),
+		"usdr": sdk.NewDec(2),
+	}
+	require.Eventually(t, func() bool {
+		for denom, expRate := range expRates {
+			res, err := queryClient.ExchangeRate(ctx, &oracletypes.QueryExchangeRateRequest{Denom: denom})
+			if err != nil || !res.ExchangeRate.Equal(expRate) {
+				return false
+			}
+		}
+		return true
+	}, 60*time.Second, 250*time.Millisecond)
+
+	// the validator keeps voting every period, without missing any
+	startHeight, err := net.LatestHeight()
+	require.NoError(t, err)
+	_, err = net.WaitForHeight(startHeight + 3*int64(oracleGenesis.Params.VotePeriod))
+	require.NoError(t, err)
+
+	res, err := queryClient.MissCounter(ctx, &oracletypes.QueryMissCounterRequest{ValidatorAddr: val.ValAddress.String()})
+	require.NoError(t, err)
+	require.Zero(t, res.MissCounter)
+}
+
+// newMockHTTPProvider serves the given prices over HTTP and returns the endpoint.
+func newMockHTTPProvider(t *testing.T, prices string) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(prices))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// newMockWebsocketProvider streams the given prices over a WebSocket and returns the endpoint.
+func newMockWebsocketProvider(t *testing.T, prices string) string {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(prices)); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
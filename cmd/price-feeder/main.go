@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v23/app"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/feeder"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/provider"
+)
+
+// keyringServiceName is the keyring service of symphonyd, so that both share the keys of the os backend.
+const keyringServiceName = "symphony"
+
+func main() {
+	if err := NewRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd returns the price-feeder command.
+func NewRootCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-feeder [config-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the oracle votes of a Symphony validator",
+		Long: `Submit the oracle votes of a Symphony validator.
+
+The exchange rates are fetched from the configured providers, filtered and aggregated into their median,
+then voted once per vote period with the feeder key, delegated by the validator through set-feeder.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.LoadConfig(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			return run(ctx, cfg)
+		},
+	}
+}
+
+func run(ctx context.Context, cfg config.Config) error {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	encodingConfig := app.MakeEncodingConfig()
+
+	kr, err := keyring.New(keyringServiceName, cfg.Keyring.Backend, cfg.Keyring.Dir, os.Stdin, encodingConfig.Marshaler)
+	if err != nil {
+		return fmt.Errorf("failed to open the keyring: %w", err)
+	}
+
+	chain, err := feeder.NewRPCChainClient(cfg, encodingConfig, kr)
+	if err != nil {
+		return err
+	}
+
+	providers := make([]provider.Provider, len(cfg.Providers))
+	for i, providerCfg := range cfg.Providers {
+		if providers[i], err = provider.NewProvider(providerCfg); err != nil {
+			return err
+		}
+	}
+
+	validator, err := sdk.ValAddressFromBech32(cfg.Validator)
+	if err != nil {
+		return err
+	}
+
+	maxDeviation, err := cfg.MaxDeviationDec()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("starting price feeder", "validator", validator, "feeder", chain.FeederAddress(), "providers", len(providers))
+
+	return feeder.NewFeeder(logger, chain, providers, validator, maxDeviation, cfg.MinProviders, cfg.PollInterval).Run(ctx)
+}
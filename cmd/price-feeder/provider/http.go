@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+)
+
+// maxResponseSize bounds the size of the price responses read from the providers.
+const maxResponseSize = 1 << 20
+
+var _ Provider = &HTTPProvider{}
+
+// HTTPProvider fetches the prices from an HTTP endpoint serving a JSON object of prices.
+type HTTPProvider struct {
+	cfg    config.ProviderConfig
+	client *http.Client
+}
+
+// NewHTTPProvider returns a new HTTPProvider.
+func NewHTTPProvider(cfg config.ProviderConfig) *HTTPProvider {
+	cfg.Denoms = normalizeDenoms(cfg.Denoms)
+
+	return &HTTPProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Name implements Provider.
+func (p *HTTPProvider) Name() string {
+	return p.cfg.Name
+}
+
+// Prices implements Provider, requesting the prices from the endpoint.
+func (p *HTTPProvider) Prices(ctx context.Context) (map[string]sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", p.cfg.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, p.cfg.Name)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of %s: %w", p.cfg.Name, err)
+	}
+
+	return parsePrices(body, p.cfg.Denoms)
+}
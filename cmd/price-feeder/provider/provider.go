@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+)
+
+// Provider is a source of exchange rates.
+// The rates are expressed the way the oracle votes them, keyed by chain denom.
+type Provider interface {
+	// Name returns the name of the provider, as configured.
+	Name() string
+	// Prices returns the latest exchange rates known to the provider.
+	Prices(ctx context.Context) (map[string]sdk.Dec, error)
+}
+
+// Streamer is implemented by the providers keeping a connection open to receive the prices.
+type Streamer interface {
+	// Start connects the provider in the background, until the context is done.
+	Start(ctx context.Context)
+}
+
+// Start starts the streaming providers among the given ones.
+func Start(ctx context.Context, providers []Provider) {
+	for _, provider := range providers {
+		if streamer, ok := provider.(Streamer); ok {
+			streamer.Start(ctx)
+		}
+	}
+}
+
+// NewProvider creates the provider described by the given configuration.
+func NewProvider(cfg config.ProviderConfig) (Provider, error) {
+	switch cfg.Type {
+	case config.ProviderTypeHTTP:
+		return NewHTTPProvider(cfg), nil
+	case config.ProviderTypeWebsocket:
+		return NewWebsocketProvider(cfg), nil
+	default:
+		return nil, fmt.Errorf("provider %s has unknown type %q", cfg.Name, cfg.Type)
+	}
+}
+
+// parsePrices decodes a JSON object mapping the provider symbols to their prices,
+// given either as strings or numbers, into exchange rates keyed by chain denom.
+// The symbols are looked up in lowercase in a non-empty denoms mapping, and ignored when missing from it.
+func parsePrices(data []byte, denoms map[string]string) (map[string]sdk.Dec, error) {
+	var raw map[string]json.Number
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode prices: %w", err)
+	}
+
+	prices := make(map[string]sdk.Dec, len(raw))
+	for symbol, value := range raw {
+		denom := symbol
+		if len(denoms) > 0 {
+			var ok bool
+			if denom, ok = denoms[strings.ToLower(symbol)]; !ok {
+				continue
+			}
+		}
+
+		price, err := sdk.NewDecFromStr(value.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid price %q for %s: %w", value, symbol, err)
+		}
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("non-positive price %s for %s", price, symbol)
+		}
+
+		prices[denom] = price
+	}
+
+	return prices, nil
+}
+
+// normalizeDenoms lowercases the symbols of a denoms mapping, as done by the config loading.
+func normalizeDenoms(denoms map[string]string) map[string]string {
+	normalized := make(map[string]string, len(denoms))
+	for symbol, denom := range denoms {
+		normalized[strings.ToLower(symbol)] = denom
+	}
+
+	return normalized
+}
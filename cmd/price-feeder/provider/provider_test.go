@@ -0,0 +1,223 @@
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/provider"
+)
+
+func newHTTPServer(t *testing.T, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newWebsocketServer(t *testing.T, subscribe string, messages ...string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if subscribe != "" {
+			_, data, err := conn.ReadMessage()
+			if err != nil || string(data) != subscribe {
+				return
+			}
+		}
+
+		for _, message := range messages {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+
+		// keep the connection open until the client leaves
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewProvider(t *testing.T) {
+	p, err := provider.NewProvider(config.ProviderConfig{Name: "http", Type: config.ProviderTypeHTTP})
+	require.NoError(t, err)
+	require.IsType(t, &provider.HTTPProvider{}, p)
+	require.Equal(t, "http", p.Name())
+
+	p, err = provider.NewProvider(config.ProviderConfig{Name: "ws", Type: config.ProviderTypeWebsocket})
+	require.NoError(t, err)
+	require.IsType(t, &provider.WebsocketProvider{}, p)
+	require.Implements(t, (*provider.Streamer)(nil), p)
+
+	_, err = provider.NewProvider(config.ProviderConfig{Name: "grpc", Type: "grpc"})
+	require.Error(t, err)
+}
+
+func TestHTTPProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		denoms    map[string]string
+		expPrices map[string]sdk.Dec
+		expErr    bool
+	}{
+		{
+			name:   "string and number prices",
+			status: http.StatusOK,
+			body:   `{"uusd":"1.01","usdr":2}`,
+			expPrices: map[string]sdk.Dec{
+				"uusd": sdk.NewDecWithPrec(101, 2),
+				"usdr": sdk.NewDec(2),
+			},
+		},
+		{
+			name:   "mapped symbols",
+			status: http.StatusOK,
+			body:   `{"USD":"1.01","SDR":"2","BTC":"60000"}`,
+			denoms: map[string]string{"usd": "uusd", "Sdr": "usdr"},
+			expPrices: map[string]sdk.Dec{
+				"uusd": sdk.NewDecWithPrec(101, 2),
+				"usdr": sdk.NewDec(2),
+			},
+		},
+		{
+			name:   "error status",
+			status: http.StatusInternalServerError,
+			body:   `{"uusd":"1.01"}`,
+			expErr: true,
+		},
+		{
+			name:   "invalid json",
+			status: http.StatusOK,
+			body:   `["1.01"]`,
+			expErr: true,
+		},
+		{
+			name:   "invalid price",
+			status: http.StatusOK,
+			body:   `{"uusd":"one"}`,
+			expErr: true,
+		},
+		{
+			name:   "non-positive price",
+			status: http.StatusOK,
+			body:   `{"uusd":"0"}`,
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newHTTPServer(t, tc.status, tc.body)
+			p := provider.NewHTTPProvider(config.ProviderConfig{
+				Name:    "http",
+				Type:    config.ProviderTypeHTTP,
+				URL:     server.URL,
+				Denoms:  tc.denoms,
+				Timeout: time.Second,
+			})
+
+			prices, err := p.Prices(context.Background())
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPrices, prices)
+		})
+	}
+}
+
+func TestHTTPProvider_Unreachable(t *testing.T) {
+	server := newHTTPServer(t, http.StatusOK, `{}`)
+	server.Close()
+
+	p := provider.NewHTTPProvider(config.ProviderConfig{Name: "http", URL: server.URL, Timeout: time.Second})
+	_, err := p.Prices(context.Background())
+	require.Error(t, err)
+}
+
+func TestWebsocketProvider(t *testing.T) {
+	server := newWebsocketServer(t, `{"subscribe":"prices"}`,
+		`{"result":"subscribed"}`,
+		`{"USD":"1.00","SDR":"2.5"}`,
+		`{"USD":"1.02"}`,
+	)
+
+	p := provider.NewWebsocketProvider(config.ProviderConfig{
+		Name:        "ws",
+		Type:        config.ProviderTypeWebsocket,
+		URL:         "ws" + strings.TrimPrefix(server.URL, "http"),
+		Subscribe:   `{"subscribe":"prices"}`,
+		Denoms:      map[string]string{"USD": "uusd", "SDR": "usdr"},
+		MaxPriceAge: time.Minute,
+	})
+
+	// no price before the provider is started
+	_, err := p.Prices(context.Background())
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p.Start(ctx)
+
+	expPrices := map[string]sdk.Dec{
+		"uusd": sdk.NewDecWithPrec(102, 2),
+		"usdr": sdk.NewDecWithPrec(25, 1),
+	}
+	require.Eventually(t, func() bool {
+		prices, err := p.Prices(ctx)
+		return err == nil && len(prices) == len(expPrices) && prices["uusd"].Equal(expPrices["uusd"])
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.Prices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expPrices, prices)
+}
+
+func TestWebsocketProvider_StalePrices(t *testing.T) {
+	server := newWebsocketServer(t, "", `{"uusd":"1.00"}`)
+
+	p := provider.NewWebsocketProvider(config.ProviderConfig{
+		Name:        "ws",
+		Type:        config.ProviderTypeWebsocket,
+		URL:         "ws" + strings.TrimPrefix(server.URL, "http"),
+		MaxPriceAge: 50 * time.Millisecond,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		_, err := p.Prices(ctx)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the price is never updated again, and ends up ignored
+	require.Eventually(t, func() bool {
+		_, err := p.Prices(ctx)
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
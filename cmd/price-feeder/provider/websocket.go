@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+
+	"github.com/osmosis-labs/osmosis/v23/cmd/price-feeder/config"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+var _ Provider = &WebsocketProvider{}
+
+// WebsocketProvider streams the prices from a WebSocket endpoint, each message being a JSON object of prices.
+// The latest price of each denom is kept until it gets older than the configured max price age.
+type WebsocketProvider struct {
+	cfg config.ProviderConfig
+
+	mu      sync.RWMutex
+	prices  map[string]timedPrice
+	lastErr error
+}
+
+type timedPrice struct {
+	price     sdk.Dec
+	updatedAt time.Time
+}
+
+// NewWebsocketProvider returns a new WebsocketProvider. It must be started to receive prices.
+func NewWebsocketProvider(cfg config.ProviderConfig) *WebsocketProvider {
+	cfg.Denoms = normalizeDenoms(cfg.Denoms)
+
+	return &WebsocketProvider{
+		cfg:    cfg,
+		prices: make(map[string]timedPrice),
+	}
+}
+
+// Name implements Provider.
+func (p *WebsocketProvider) Name() string {
+	return p.cfg.Name
+}
+
+// Start implements Streamer, keeping a connection open until the context is done.
+func (p *WebsocketProvider) Start(ctx context.Context) {
+	go func() {
+		delay := minReconnectDelay
+		for {
+			connected, err := p.stream(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			p.setErr(err)
+
+			if connected {
+				delay = minReconnectDelay
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			if delay *= 2; delay > maxReconnectDelay {
+				delay = maxReconnectDelay
+			}
+		}
+	}()
+}
+
+// Prices implements Provider, returning the prices received within the max price age.
+func (p *WebsocketProvider) Prices(_ context.Context) (map[string]sdk.Dec, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	prices := make(map[string]sdk.Dec, len(p.prices))
+	for denom, price := range p.prices {
+		if time.Since(price.updatedAt) <= p.cfg.MaxPriceAge {
+			prices[denom] = price.price
+		}
+	}
+
+	if len(prices) == 0 {
+		if p.lastErr != nil {
+			return nil, fmt.Errorf("no fresh prices from %s: %w", p.cfg.Name, p.lastErr)
+		}
+		return nil, fmt.Errorf("no fresh prices from %s", p.cfg.Name)
+	}
+
+	return prices, nil
+}
+
+// stream reads the prices from a new connection until it fails, and reports whether it connected at all.
+func (p *WebsocketProvider) stream(ctx context.Context) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, p.cfg.URL, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to %s: %w", p.cfg.Name, err)
+	}
+	defer conn.Close()
+
+	// unblock the read below once the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if p.cfg.Subscribe != "" {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(p.cfg.Subscribe)); err != nil {
+			return true, fmt.Errorf("failed to subscribe to %s: %w", p.cfg.Name, err)
+		}
+	}
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return true, fmt.Errorf("connection to %s lost: %w", p.cfg.Name, err)
+		}
+
+		// the messages which aren't prices, e.g. subscription acknowledgements, are skipped
+		prices, err := parsePrices(data, p.cfg.Denoms)
+		if err != nil {
+			continue
+		}
+		p.update(prices)
+	}
+}
+
+func (p *WebsocketProvider) update(prices map[string]sdk.Dec) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for denom, price := range prices {
+		p.prices[denom] = timedPrice{price: price, updatedAt: now}
+	}
+	p.lastErr = nil
+}
+
+func (p *WebsocketProvider) setErr(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastErr = err
+}
@@ -0,0 +1,15 @@
+package osmoutils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// SetParamIfMissing sets the param stored under key to value unless the subspace already has it.
+// Store migrations use it to give the params a module added since its last version their default value,
+// without overwriting a value governance has already set.
+func SetParamIfMissing(ctx sdk.Context, paramSpace paramstypes.Subspace, key []byte, value interface{}) {
+	if !paramSpace.Has(ctx, key) {
+		paramSpace.Set(ctx, key, value)
+	}
+}
@@ -0,0 +1,40 @@
+package osmoutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/noapptest"
+)
+
+func TestSetParamIfMissing(t *testing.T) {
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewKVStoreKey(paramstypes.TStoreKey)
+	ctx := noapptest.DefaultCtxWithStoreKeys([]storetypes.StoreKey{paramsKey, paramsTKey})
+	encConfig := noapptest.MakeTestEncodingConfig(params.AppModuleBasic{})
+	paramsKeeper := paramskeeper.NewKeeper(encConfig.Codec, encConfig.Amino, paramsKey, paramsTKey)
+
+	key := []byte("Param")
+	var value uint64
+	paramSpace := paramsKeeper.Subspace("test").WithKeyTable(paramstypes.NewKeyTable(
+		paramstypes.NewParamSetPair(key, &value, func(interface{}) error { return nil }),
+	))
+
+	// a missing param is set
+	osmoutils.SetParamIfMissing(ctx, paramSpace, key, uint64(1))
+	paramSpace.Get(ctx, key, &value)
+	require.Equal(t, uint64(1), value)
+
+	// an existing param is kept
+	osmoutils.SetParamIfMissing(ctx, paramSpace, key, uint64(2))
+	paramSpace.Get(ctx, key, &value)
+	require.Equal(t, uint64(1), value)
+}
@@ -16,6 +16,7 @@ build-help:
 	@echo "  dev-install                      Install development build"
 	@echo "  install-with-autocomplete        Install with autocomplete support"
 	@echo "  linux                            Build for Linux"
+	@echo "  price-feeder                     Build the oracle price feeder"
 	@echo "  reproducible                     Build reproducible binaries"
 	@echo "  reproducible-amd64               Build reproducible amd64 binary"
 	@echo "  reproducible-arm64               Build reproducible arm64 binary"
@@ -137,3 +138,7 @@ build-linux: go.sum
 build-contract-tests-hooks:
 	mkdir -p $(BUILDDIR)
 	go build -mod=readonly $(BUILD_FLAGS) -o $(BUILDDIR)/ ./cmd/contract_tests
+
+build-price-feeder: build-check-version go.sum
+	mkdir -p $(BUILDDIR)/
+	GOWORK=off go build -mod=readonly $(BUILD_FLAGS) -o $(BUILDDIR)/ $(GO_MODULE)/cmd/price-feeder
@@ -23,6 +23,7 @@ import (
 	gammtypes "github.com/osmosis-labs/osmosis/v23/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v23/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v23/x/lockup/types"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
 	minttypes "github.com/osmosis-labs/osmosis/v23/x/mint/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v23/x/pool-incentives/types"
 	poolmanagerqueryproto "github.com/osmosis-labs/osmosis/v23/x/poolmanager/client/queryproto"
@@ -186,7 +187,7 @@ func init() {
 
 	// market
 	//setWhitelistedQuery("/osmosis.market.v1beta1.Query/Swap", &marketqueryproto.QuerySwapResponse{})
-	//setWhitelistedQuery("/osmosis.market.v1beta1.Query/OsmosisPoolDelta", &marketqueryproto.QueryTerraPoolDeltaResponse{})
+	setWhitelistedQuery("/osmosis.market.v1beta1.Query/PoolDelta", &markettypes.QueryPoolDeltaResponse{})
 }
 
 // IsWhitelistedQuery returns if the query is not whitelisted.
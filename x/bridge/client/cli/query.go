@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdInboundTransfer)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPendingInboundTransfers)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdRemainingCapacity)
+
+	cmd.AddCommand(
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
+
+	return cmd
+}
+
+func GetCmdInboundTransfer() (*osmocli.QueryDescriptor, *types.QueryInboundTransferRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "inbound-transfer",
+		Short: "Get the inbound transfer with its vote tally by the external id",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} <external-id>`,
+	}, &types.QueryInboundTransferRequest{}
+}
+
+func GetCmdPendingInboundTransfers() (*osmocli.QueryDescriptor, *types.QueryPendingInboundTransfersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pending-inbound-transfers",
+		Short: "Get all the non-finalized inbound transfers",
+	}, &types.QueryPendingInboundTransfersRequest{}
+}
+
+func GetCmdRemainingCapacity() (*osmocli.QueryDescriptor, *types.QueryRemainingCapacityRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "remaining-capacity",
+		Short: "Get the volume of the asset which can still be transferred under its rate limits",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} <source-chain> <denom>`,
+	}, &types.QueryRemainingCapacityRequest{}
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := osmocli.TxIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		NewInboundTransferCmd(),
+		NewOutboundTransferCmd(),
+	)
+
+	return cmd
+}
+
+// NewInboundTransferCmd broadcasts MsgInboundTransfer
+func NewInboundTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inbound-transfer [external-id] [dest-addr] [source-chain] [denom] [precision] [amount] [flags]",
+		Short: "Vote to mint the bridged asset to the destination address. Must be a bridge signer to do so.",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asset, amount, err := parseAssetAndAmount(args[2], args[3], args[4], args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInboundTransfer(clientCtx.GetFromAddress().String(), args[0], args[1], asset, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewOutboundTransferCmd broadcasts MsgOutboundTransfer
+func NewOutboundTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-transfer [dest-addr] [source-chain] [denom] [precision] [amount] [flags]",
+		Short: "Burn the bridged asset to release it on the destination chain.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asset, amount, err := parseAssetAndAmount(args[1], args[2], args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOutboundTransfer(clientCtx.GetFromAddress().String(), args[0], asset, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseAssetAndAmount(sourceChain, denom, precisionStr, amountStr string) (types.Asset, math.Int, error) {
+	precision, err := strconv.ParseUint(precisionStr, 10, 64)
+	if err != nil {
+		return types.Asset{}, math.Int{}, fmt.Errorf("invalid precision %s: %w", precisionStr, err)
+	}
+
+	amount, ok := math.NewIntFromString(amountStr)
+	if !ok {
+		return types.Asset{}, math.Int{}, fmt.Errorf("invalid amount %s", amountStr)
+	}
+
+	asset := types.Asset{
+		SourceChain: sourceChain,
+		Denom:       denom,
+		Precision:   precision,
+	}
+
+	return asset, amount, nil
+}
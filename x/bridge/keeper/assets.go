@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// createAssets creates a x/tokenfactory denom for every provided asset.
+// The x/bridge module account becomes the admin of the created denoms.
+// Denoms that already exist (e.g. after a genesis import or when a removed
+// asset is added back) are left untouched.
+func (k Keeper) createAssets(ctx sdk.Context, assets []types.AssetWithStatus) error {
+	for _, asset := range assets {
+		if err := k.createAsset(ctx, asset.Asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) createAsset(ctx sdk.Context, asset types.Asset) error {
+	denom, err := k.GetAssetDenom(asset)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrCantCreateAsset, "asset %s: %s", asset.Name(), err)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return nil
+	}
+
+	_, err = k.tokenFactoryKeeper.CreateDenom(ctx, k.GetModuleAddress().String(), asset.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrCantCreateAsset, "asset %s: %s", asset.Name(), err)
+	}
+
+	// x/tokenfactory only registers the base unit, so add the display unit
+	// using the asset precision
+	metadata, _ := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if asset.Precision > 0 {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    asset.Denom,
+			Exponent: uint32(asset.Precision),
+		})
+		metadata.Display = asset.Denom
+	}
+	metadata.Name = asset.Name()
+	metadata.Symbol = asset.Denom
+	metadata.Description = "Bridged asset from " + asset.SourceChain
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
+
+// GetAssetDenom returns the x/tokenfactory denom of the asset.
+func (k Keeper) GetAssetDenom(asset types.Asset) (string, error) {
+	return asset.TokenFactoryDenom(k.GetModuleAddress().String())
+}
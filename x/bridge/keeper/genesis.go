@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// InitGenesis initializes the x/bridge module's state from a provided genesis
+// state. The x/tokenfactory denoms are created for all genesis assets.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if err := k.createAssets(ctx, genState.Params.Assets); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, transfer := range genState.InboundTransfers {
+		k.SetInboundTransfer(ctx, transfer)
+	}
+
+	for _, assetFlows := range genState.AssetFlows {
+		k.SetAssetFlows(ctx, assetFlows)
+	}
+}
+
+// ExportGenesis returns the x/bridge module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:           k.GetParams(ctx),
+		InboundTransfers: k.GetAllInboundTransfers(ctx),
+		AssetFlows:       k.GetAllAssetFlows(ctx),
+	}
+}
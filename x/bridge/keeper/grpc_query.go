@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the bridge QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = querier{}
+
+func (q querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := q.GetParams(sdkCtx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q querier) InboundTransfer(ctx context.Context, req *types.QueryInboundTransferRequest) (*types.QueryInboundTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ExternalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty external id")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := q.GetParams(sdkCtx)
+
+	transfer, found := q.GetInboundTransfer(sdkCtx, req.ExternalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "inbound transfer %s not found", req.ExternalId)
+	}
+
+	return &types.QueryInboundTransferResponse{
+		Transfer:    transfer,
+		Tallies:     transfer.Tally(params.IsSigner),
+		VotesNeeded: params.VotesNeeded,
+	}, nil
+}
+
+func (q querier) PendingInboundTransfers(ctx context.Context, req *types.QueryPendingInboundTransfersRequest) (*types.QueryPendingInboundTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(sdkCtx.KVStore(q.storeKey), types.PendingInboundTransferPrefix)
+
+	transfers := make([]types.InboundTransfer, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		transfer, found := q.GetInboundTransfer(sdkCtx, string(value))
+		if !found {
+			return status.Errorf(codes.Internal, "inbound transfer %s not found", string(value))
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingInboundTransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q querier) RemainingCapacity(ctx context.Context, req *types.QueryRemainingCapacityRequest) (*types.QueryRemainingCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	asset := types.Asset{SourceChain: req.SourceChain, Denom: req.Denom}
+	if _, found := q.GetParams(sdkCtx).GetAsset(asset); !found {
+		return nil, status.Errorf(codes.NotFound, "asset %s not found", asset.Name())
+	}
+
+	return &types.QueryRemainingCapacityResponse{
+		Capacities: q.GetRemainingCapacity(sdkCtx, asset),
+	}, nil
+}
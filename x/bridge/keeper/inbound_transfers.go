@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// GetInboundTransfer returns the inbound transfer by its external id.
+func (k Keeper) GetInboundTransfer(ctx sdk.Context, externalID string) (types.InboundTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInboundTransferKey(externalID))
+	if bz == nil {
+		return types.InboundTransfer{}, false
+	}
+
+	var transfer types.InboundTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// SetInboundTransfer stores the inbound transfer and keeps the pending
+// transfer index in sync with its finalization status.
+func (k Keeper) SetInboundTransfer(ctx sdk.Context, transfer types.InboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInboundTransferKey(transfer.ExternalId), k.cdc.MustMarshal(&transfer))
+
+	pendingKey := types.GetPendingInboundTransferKey(transfer.CreatedHeight, transfer.ExternalId)
+	if transfer.Finalized {
+		store.Delete(pendingKey)
+	} else {
+		store.Set(pendingKey, []byte(transfer.ExternalId))
+	}
+}
+
+// deleteInboundTransfer removes the inbound transfer along with its index.
+func (k Keeper) deleteInboundTransfer(ctx sdk.Context, transfer types.InboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetInboundTransferKey(transfer.ExternalId))
+	store.Delete(types.GetPendingInboundTransferKey(transfer.CreatedHeight, transfer.ExternalId))
+}
+
+// GetAllInboundTransfers returns both pending and finalized inbound transfers.
+func (k Keeper) GetAllInboundTransfers(ctx sdk.Context) []types.InboundTransfer {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InboundTransferPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	transfers := make([]types.InboundTransfer, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.InboundTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// ExpireInboundTransfers removes all the pending inbound transfers created
+// at least Params.VoteExpiry blocks ago. Finalized transfers are kept forever,
+// so the same external transfer can't be minted twice.
+func (k Keeper) ExpireInboundTransfers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	// the transfer created at expiryHeight expires at the current height
+	expiryHeight := ctx.BlockHeight() - int64(params.VoteExpiry)
+	if expiryHeight < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.PendingInboundTransferPrefix,
+		types.GetPendingInboundTransferPrefixByHeight(expiryHeight+1),
+	)
+
+	var expired []types.InboundTransfer
+	for ; iterator.Valid(); iterator.Next() {
+		transfer, found := k.GetInboundTransfer(ctx, string(iterator.Value()))
+		if found {
+			expired = append(expired, transfer)
+		}
+	}
+	iterator.Close()
+
+	for _, transfer := range expired {
+		k.deleteInboundTransfer(ctx, transfer)
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventInboundTransferExpired{
+			ExternalId: transfer.ExternalId,
+			Votes:      uint64(len(transfer.Votes)),
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to emit inbound transfer expiry event", "external_id", transfer.ExternalId, "error", err)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// setupSigners makes TestAccs[0:3] signers with the provided vote threshold
+// and expiry.
+func (s *KeeperTestSuite) setupSigners(votesNeeded, voteExpiry uint64) {
+	params := s.App.BridgeKeeper.GetParams(s.Ctx)
+	params.Signers = []string{s.TestAccs[0].String(), s.TestAccs[1].String(), s.TestAccs[2].String()}
+	params.VotesNeeded = votesNeeded
+	params.VoteExpiry = voteExpiry
+
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.govAddr, params))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestInboundTransferVoting() {
+	denom := s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupSigners(2, types.DefaultVoteExpiry)
+
+	accs := apptesting.CreateRandomAccounts(2)
+	dest := accs[0].String()
+	other := accs[1].String()
+	amount := osmomath.NewInt(1000)
+
+	// the first vote is recorded, nothing is minted
+	res, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+	s.Require().False(res.Finalized)
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount.IsZero())
+	s.AssertEventEmitted(s.Ctx, "osmosis.bridge.v1beta1.EventInboundVote", 1)
+
+	// the same signer can't vote twice
+	_, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().ErrorIs(err, types.ErrAlreadyVoted)
+
+	// a vote for the different data doesn't count towards the first one
+	res, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[1].String(), "tx1", other, defaultAsset, amount))
+	s.Require().NoError(err)
+	s.Require().False(res.Finalized)
+
+	querier := keeper.NewQuerier(*s.App.BridgeKeeper)
+	queryRes, err := querier.InboundTransfer(sdk.WrapSDKContext(s.Ctx), &types.QueryInboundTransferRequest{ExternalId: "tx1"})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Transfer.Votes, 2)
+	s.Require().Equal(uint64(2), queryRes.VotesNeeded)
+	s.Require().Equal([]types.InboundVoteTally{
+		{DestAddr: dest, Asset: defaultAsset, Amount: amount, Votes: 1},
+		{DestAddr: other, Asset: defaultAsset, Amount: amount, Votes: 1},
+	}, queryRes.Tallies)
+
+	pendingRes, err := querier.PendingInboundTransfers(sdk.WrapSDKContext(s.Ctx), &types.QueryPendingInboundTransfersRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(pendingRes.Transfers, 1)
+
+	// the matching vote reaches the threshold and mints the coins
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[2].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+	s.Require().True(res.Finalized)
+	s.Require().Equal(amount, s.App.BankKeeper.GetBalance(s.Ctx, accs[0], denom).Amount)
+	s.Require().Equal(amount, s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+	s.AssertEventEmitted(s.Ctx, "osmosis.bridge.v1beta1.EventInboundTransfer", 1)
+
+	pendingRes, err = querier.PendingInboundTransfers(sdk.WrapSDKContext(s.Ctx), &types.QueryPendingInboundTransfersRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(pendingRes.Transfers)
+
+	// the finalized transfer can't be voted for anymore
+	_, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[1].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().ErrorIs(err, types.ErrTransferFinalized)
+	s.Require().Equal(amount, s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+}
+
+func (s *KeeperTestSuite) TestInboundTransferRemovedSigner() {
+	denom := s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupSigners(2, types.DefaultVoteExpiry)
+
+	dest := apptesting.CreateRandomAccounts(1)[0].String()
+	amount := osmomath.NewInt(1000)
+
+	_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+
+	// TestAccs[0] is removed from the signers, so its vote is not counted
+	params := s.App.BridgeKeeper.GetParams(s.Ctx)
+	params.Signers = []string{s.TestAccs[1].String(), s.TestAccs[2].String()}
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.govAddr, params))
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[1].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+	s.Require().False(res.Finalized)
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount.IsZero())
+
+	res, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[2].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+	s.Require().True(res.Finalized)
+	s.Require().Equal(amount, s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+}
+
+func (s *KeeperTestSuite) TestExpireInboundTransfers() {
+	s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupSigners(2, 10)
+
+	dest := apptesting.CreateRandomAccounts(1)[0].String()
+	amount := osmomath.NewInt(1000)
+
+	_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+
+	// finalized transfers never expire
+	_, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx2", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[1].String(), "tx2", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+
+	createdHeight := s.Ctx.BlockHeight()
+
+	// not expired yet
+	s.Ctx = s.Ctx.WithBlockHeight(createdHeight + 9)
+	s.App.BridgeKeeper.ExpireInboundTransfers(s.Ctx)
+	_, found := s.App.BridgeKeeper.GetInboundTransfer(s.Ctx, "tx1")
+	s.Require().True(found)
+
+	s.Ctx = s.Ctx.WithBlockHeight(createdHeight + 10).WithEventManager(sdk.NewEventManager())
+	s.App.BridgeKeeper.ExpireInboundTransfers(s.Ctx)
+	_, found = s.App.BridgeKeeper.GetInboundTransfer(s.Ctx, "tx1")
+	s.Require().False(found)
+	s.AssertEventEmitted(s.Ctx, "osmosis.bridge.v1beta1.EventInboundTransferExpired", 1)
+
+	transfer, found := s.App.BridgeKeeper.GetInboundTransfer(s.Ctx, "tx2")
+	s.Require().True(found)
+	s.Require().True(transfer.Finalized)
+
+	// the expired transfer can be voted for from scratch
+	res, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+	s.Require().False(res.Finalized)
+}
+
+func (s *KeeperTestSuite) TestInboundTransfersGenesis() {
+	s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupSigners(2, 10)
+
+	dest := apptesting.CreateRandomAccounts(1)[0].String()
+	amount := osmomath.NewInt(1000)
+
+	_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.TestAccs[0].String(), "tx1", dest, defaultAsset, amount))
+	s.Require().NoError(err)
+
+	genesis := s.App.BridgeKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Len(genesis.InboundTransfers, 1)
+
+	s.SetupTest()
+	s.App.BridgeKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(genesis, s.App.BridgeKeeper.ExportGenesis(s.Ctx))
+
+	// the pending index is restored, so the transfer still expires
+	s.Ctx = s.Ctx.WithBlockHeight(genesis.InboundTransfers[0].CreatedHeight + 10)
+	s.App.BridgeKeeper.ExpireInboundTransfers(s.Ctx)
+	_, found := s.App.BridgeKeeper.GetInboundTransfer(s.Ctx, "tx1")
+	s.Require().False(found)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	accountKeeper         types.AccountKeeper
+	bankKeeper            types.BankKeeper
+	tokenFactoryKeeper    types.TokenFactoryKeeper
+	tokenFactoryMsgServer types.TokenFactoryMsgServer
+
+	// govModuleAddr is the only address allowed to update params
+	// and change asset statuses
+	govModuleAddr string
+}
+
+// NewKeeper returns a new instance of the x/bridge keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	tokenFactoryKeeper types.TokenFactoryKeeper,
+	tokenFactoryMsgServer types.TokenFactoryMsgServer,
+	govModuleAddr string,
+) Keeper {
+	// ensure bridge module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:                   cdc,
+		storeKey:              storeKey,
+		paramSpace:            paramSpace,
+		accountKeeper:         accountKeeper,
+		bankKeeper:            bankKeeper,
+		tokenFactoryKeeper:    tokenFactoryKeeper,
+		tokenFactoryMsgServer: tokenFactoryMsgServer,
+		govModuleAddr:         govModuleAddr,
+	}
+}
+
+// Logger returns a logger for the x/bridge module.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetModuleAddress returns the address of the x/bridge module account. The
+// module account is the x/tokenfactory admin of every bridged asset.
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// GetGovModuleAddress returns the address allowed to manage the module.
+func (k Keeper) GetGovModuleAddress() string {
+	return k.govModuleAddr
+}
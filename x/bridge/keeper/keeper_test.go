@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+var defaultAsset = types.Asset{
+	SourceChain: "bitcoin",
+	Denom:       "btc",
+	Precision:   8,
+}
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	msgServer types.MsgServer
+	govAddr   string
+	signer    string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+
+	s.msgServer = keeper.NewMsgServerImpl(*s.App.BridgeKeeper)
+	s.govAddr = s.App.BridgeKeeper.GetGovModuleAddress()
+	s.signer = s.TestAccs[0].String()
+}
+
+// setupAsset registers the default asset with the provided status and
+// makes TestAccs[0] the only signer.
+func (s *KeeperTestSuite) setupAsset(status types.AssetStatus) string {
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(
+		s.govAddr,
+		types.NewParams(
+			[]string{s.signer},
+			[]types.AssetWithStatus{{Asset: defaultAsset, AssetStatus: types.AssetStatus_ASSET_STATUS_OK}},
+			1,
+			types.DefaultVoteExpiry,
+			nil,
+		),
+	))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ChangeAssetStatus(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAssetStatus(
+		s.govAddr,
+		types.AssetWithStatus{Asset: defaultAsset, AssetStatus: status},
+	))
+	s.Require().NoError(err)
+
+	denom, err := s.App.BridgeKeeper.GetAssetDenom(defaultAsset)
+	s.Require().NoError(err)
+	return denom
+}
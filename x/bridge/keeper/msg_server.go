@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) InboundTransfer(goCtx context.Context, msg *types.MsgInboundTransfer) (*types.MsgInboundTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := server.Keeper.GetParams(ctx)
+	if !params.IsSigner(msg.Sender) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a bridge signer", msg.Sender)
+	}
+
+	finalized, err := server.Keeper.InboundTransfer(ctx, msg.Sender, msg.ExternalId, msg.DestAddr, msg.Asset, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventInboundVote{
+		Signer:     msg.Sender,
+		ExternalId: msg.ExternalId,
+		DestAddr:   msg.DestAddr,
+		Asset:      msg.Asset,
+		Amount:     msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if finalized {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventInboundTransfer{
+			Sender:     msg.Sender,
+			DestAddr:   msg.DestAddr,
+			Asset:      msg.Asset,
+			Amount:     msg.Amount,
+			ExternalId: msg.ExternalId,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgInboundTransferResponse{Finalized: finalized}, nil
+}
+
+func (server msgServer) OutboundTransfer(goCtx context.Context, msg *types.MsgOutboundTransfer) (*types.MsgOutboundTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.Keeper.OutboundTransfer(ctx, msg.Sender, msg.Asset, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventOutboundTransfer{
+		Sender:   msg.Sender,
+		DestAddr: msg.DestAddr,
+		Asset:    msg.Asset,
+		Amount:   msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOutboundTransferResponse{}, nil
+}
+
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Sender != server.Keeper.govModuleAddr {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only %s can update params", server.Keeper.govModuleAddr)
+	}
+
+	result, err := server.Keeper.UpdateParams(ctx, msg.NewParams)
+	if err != nil {
+		return nil, err
+	}
+
+	newParams := server.Keeper.GetParams(ctx)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
+		NewSigners:     newParams.Signers,
+		CreatedSigners: result.CreatedSigners,
+		DeletedSigners: result.DeletedSigners,
+		NewAssets:      newParams.Assets,
+		CreatedAssets:  result.CreatedAssets,
+		DeletedAssets:  result.DeletedAssets,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (server msgServer) ChangeAssetStatus(goCtx context.Context, msg *types.MsgChangeAssetStatus) (*types.MsgChangeAssetStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Sender != server.Keeper.govModuleAddr {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only %s can change asset status", server.Keeper.govModuleAddr)
+	}
+
+	oldAssetStatus, err := server.Keeper.ChangeAssetStatus(ctx, msg.NewAssetStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventChangeAssetStatus{
+		Sender:         msg.Sender,
+		OldAssetStatus: oldAssetStatus,
+		NewAssetStatus: msg.NewAssetStatus,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAssetStatusResponse{}, nil
+}
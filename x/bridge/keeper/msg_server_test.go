@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	// only the gov module can update params
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.signer, types.DefaultParams()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// new assets are always added with blocked status
+	newParams := types.NewParams(
+		[]string{s.signer},
+		[]types.AssetWithStatus{{Asset: defaultAsset, AssetStatus: types.AssetStatus_ASSET_STATUS_OK}},
+		1,
+		types.DefaultVoteExpiry,
+		nil,
+	)
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.govAddr, newParams))
+	s.Require().NoError(err)
+
+	params := s.App.BridgeKeeper.GetParams(s.Ctx)
+	s.Require().Equal([]string{s.signer}, params.Signers)
+	s.Require().Len(params.Assets, 1)
+	s.Require().Equal(types.AssetStatus_ASSET_STATUS_BLOCKED_BOTH, params.Assets[0].AssetStatus)
+
+	// the tokenfactory denom is created and administered by the bridge
+	denom, err := s.App.BridgeKeeper.GetAssetDenom(defaultAsset)
+	s.Require().NoError(err)
+	authority, err := s.App.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(s.App.BridgeKeeper.GetModuleAddress().String(), authority.Admin)
+	metadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(defaultAsset.Denom, metadata.Display)
+
+	// statuses of the known assets are preserved
+	_, err = s.msgServer.ChangeAssetStatus(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAssetStatus(
+		s.govAddr,
+		types.AssetWithStatus{Asset: defaultAsset, AssetStatus: types.AssetStatus_ASSET_STATUS_OK},
+	))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.govAddr, newParams))
+	s.Require().NoError(err)
+	params = s.App.BridgeKeeper.GetParams(s.Ctx)
+	s.Require().Equal(types.AssetStatus_ASSET_STATUS_OK, params.Assets[0].AssetStatus)
+
+	// assets and signers can be removed
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.govAddr, types.DefaultParams()))
+	s.Require().NoError(err)
+	params = s.App.BridgeKeeper.GetParams(s.Ctx)
+	s.Require().Empty(params.Signers)
+	s.Require().Empty(params.Assets)
+}
+
+func (s *KeeperTestSuite) TestChangeAssetStatus() {
+	// unknown asset
+	_, err := s.msgServer.ChangeAssetStatus(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAssetStatus(
+		s.govAddr,
+		types.AssetWithStatus{Asset: defaultAsset, AssetStatus: types.AssetStatus_ASSET_STATUS_OK},
+	))
+	s.Require().ErrorIs(err, types.ErrAssetNotFound)
+
+	s.setupAsset(types.AssetStatus_ASSET_STATUS_BLOCKED_BOTH)
+
+	// only the gov module can change statuses
+	_, err = s.msgServer.ChangeAssetStatus(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAssetStatus(
+		s.signer,
+		types.AssetWithStatus{Asset: defaultAsset, AssetStatus: types.AssetStatus_ASSET_STATUS_OK},
+	))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAssetStatus(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAssetStatus(
+		s.govAddr,
+		types.AssetWithStatus{Asset: defaultAsset, AssetStatus: types.AssetStatus_ASSET_STATUS_BLOCKED_INBOUND},
+	))
+	s.Require().NoError(err)
+
+	asset, found := s.App.BridgeKeeper.GetParams(s.Ctx).GetAsset(defaultAsset)
+	s.Require().True(found)
+	s.Require().Equal(types.AssetStatus_ASSET_STATUS_BLOCKED_INBOUND, asset.AssetStatus)
+}
+
+func (s *KeeperTestSuite) TestInboundTransfer() {
+	tests := map[string]struct {
+		status      types.AssetStatus
+		sender      func() string
+		asset       types.Asset
+		expectedErr error
+	}{
+		"happy path": {
+			status: types.AssetStatus_ASSET_STATUS_OK,
+			sender: func() string { return s.signer },
+			asset:  defaultAsset,
+		},
+		"outbound blocked": {
+			status: types.AssetStatus_ASSET_STATUS_BLOCKED_OUTBOUND,
+			sender: func() string { return s.signer },
+			asset:  defaultAsset,
+		},
+		"inbound blocked": {
+			status:      types.AssetStatus_ASSET_STATUS_BLOCKED_INBOUND,
+			sender:      func() string { return s.signer },
+			asset:       defaultAsset,
+			expectedErr: types.ErrInboundDisabled,
+		},
+		"both blocked": {
+			status:      types.AssetStatus_ASSET_STATUS_BLOCKED_BOTH,
+			sender:      func() string { return s.signer },
+			asset:       defaultAsset,
+			expectedErr: types.ErrInboundDisabled,
+		},
+		"not a signer": {
+			status:      types.AssetStatus_ASSET_STATUS_OK,
+			sender:      func() string { return s.TestAccs[1].String() },
+			asset:       defaultAsset,
+			expectedErr: types.ErrUnauthorized,
+		},
+		"unknown asset": {
+			status:      types.AssetStatus_ASSET_STATUS_OK,
+			sender:      func() string { return s.signer },
+			asset:       types.Asset{SourceChain: "ethereum", Denom: "eth", Precision: 18},
+			expectedErr: types.ErrAssetNotFound,
+		},
+		"precision mismatch": {
+			status:      types.AssetStatus_ASSET_STATUS_OK,
+			sender:      func() string { return s.signer },
+			asset:       types.Asset{SourceChain: defaultAsset.SourceChain, Denom: defaultAsset.Denom, Precision: 6},
+			expectedErr: types.ErrInvalidAsset,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			denom := s.setupAsset(tc.status)
+
+			dest := s.TestAccs[2]
+			amount := osmomath.NewInt(1000)
+
+			res, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(tc.sender(), "tx1", dest.String(), tc.asset, amount))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, dest, denom).IsZero())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(res.Finalized)
+			s.Require().Equal(amount, s.App.BankKeeper.GetBalance(s.Ctx, dest, denom).Amount)
+			s.Require().Equal(amount, s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+			s.AssertEventEmitted(s.Ctx, "osmosis.bridge.v1beta1.EventInboundTransfer", 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOutboundTransfer() {
+	tests := map[string]struct {
+		status      types.AssetStatus
+		amount      osmomath.Int
+		expectedErr error
+	}{
+		"happy path": {
+			status: types.AssetStatus_ASSET_STATUS_OK,
+			amount: osmomath.NewInt(400),
+		},
+		"inbound blocked": {
+			status: types.AssetStatus_ASSET_STATUS_BLOCKED_INBOUND,
+			amount: osmomath.NewInt(400),
+		},
+		"outbound blocked": {
+			status:      types.AssetStatus_ASSET_STATUS_BLOCKED_OUTBOUND,
+			amount:      osmomath.NewInt(400),
+			expectedErr: types.ErrOutboundDisabled,
+		},
+		"both blocked": {
+			status:      types.AssetStatus_ASSET_STATUS_BLOCKED_BOTH,
+			amount:      osmomath.NewInt(400),
+			expectedErr: types.ErrOutboundDisabled,
+		},
+		"insufficient funds": {
+			status:      types.AssetStatus_ASSET_STATUS_OK,
+			amount:      osmomath.NewInt(1001),
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			denom := s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+
+			user := s.TestAccs[2]
+			initial := osmomath.NewInt(1000)
+			_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, "tx1", user.String(), defaultAsset, initial))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.ChangeAssetStatus(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAssetStatus(
+				s.govAddr,
+				types.AssetWithStatus{Asset: defaultAsset, AssetStatus: tc.status},
+			))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.OutboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgOutboundTransfer(user.String(), "bc1qdestination", defaultAsset, tc.amount))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(initial, s.App.BankKeeper.GetBalance(s.Ctx, user, denom).Amount)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(initial.Sub(tc.amount), s.App.BankKeeper.GetBalance(s.Ctx, user, denom).Amount)
+			s.Require().Equal(initial.Sub(tc.amount), s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+			s.AssertEventEmitted(s.Ctx, "osmosis.bridge.v1beta1.EventOutboundTransfer", 1)
+		})
+	}
+}
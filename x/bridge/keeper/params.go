@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// GetParams returns the total set params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// UpdateParamsResult describes how the params changed after UpdateParams.
+type UpdateParamsResult struct {
+	CreatedSigners []string
+	DeletedSigners []string
+	CreatedAssets  []types.AssetWithStatus
+	DeletedAssets  []types.AssetWithStatus
+}
+
+// UpdateParams replaces the module params with the new ones. Statuses of the
+// already known assets are preserved, and all new assets get the
+// ASSET_STATUS_BLOCKED_BOTH status; statuses are only changed through
+// ChangeAssetStatus. A x/tokenfactory denom is created for every new asset.
+func (k Keeper) UpdateParams(ctx sdk.Context, newParams types.Params) (UpdateParamsResult, error) {
+	oldParams := k.GetParams(ctx)
+
+	var result UpdateParamsResult
+
+	for _, signer := range newParams.Signers {
+		if !oldParams.IsSigner(signer) {
+			result.CreatedSigners = append(result.CreatedSigners, signer)
+		}
+	}
+	for _, signer := range oldParams.Signers {
+		if !newParams.IsSigner(signer) {
+			result.DeletedSigners = append(result.DeletedSigners, signer)
+		}
+	}
+
+	assets := make([]types.AssetWithStatus, 0, len(newParams.Assets))
+	for _, asset := range newParams.Assets {
+		oldAsset, found := oldParams.GetAsset(asset.Asset)
+		if found {
+			asset.AssetStatus = oldAsset.AssetStatus
+		} else {
+			asset.AssetStatus = types.AssetStatus_ASSET_STATUS_BLOCKED_BOTH
+			result.CreatedAssets = append(result.CreatedAssets, asset)
+		}
+		assets = append(assets, asset)
+	}
+	for _, asset := range oldParams.Assets {
+		if _, found := newParams.GetAsset(asset.Asset); !found {
+			result.DeletedAssets = append(result.DeletedAssets, asset)
+		}
+	}
+
+	newParams.Assets = assets
+	if err := newParams.Validate(); err != nil {
+		return UpdateParamsResult{}, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.createAssets(ctx, result.CreatedAssets); err != nil {
+		return UpdateParamsResult{}, err
+	}
+
+	k.SetParams(ctx, newParams)
+
+	return result, nil
+}
+
+// ChangeAssetStatus changes the status of the known asset and returns the
+// asset with its previous status.
+func (k Keeper) ChangeAssetStatus(ctx sdk.Context, newAssetStatus types.AssetWithStatus) (types.AssetWithStatus, error) {
+	if err := newAssetStatus.Validate(); err != nil {
+		return types.AssetWithStatus{}, err
+	}
+
+	params := k.GetParams(ctx)
+
+	for i, asset := range params.Assets {
+		if asset.Asset.SameAs(newAssetStatus.Asset) {
+			params.Assets[i].AssetStatus = newAssetStatus.AssetStatus
+			k.SetParams(ctx, params)
+			return asset, nil
+		}
+	}
+
+	return types.AssetWithStatus{}, errorsmod.Wrapf(types.ErrAssetNotFound, "asset %s", newAssetStatus.Asset.Name())
+}
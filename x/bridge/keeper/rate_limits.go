@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// GetAssetFlows returns the rate limit flows of the asset.
+func (k Keeper) GetAssetFlows(ctx sdk.Context, asset types.Asset) (types.AssetFlows, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAssetFlowsKey(asset.Denom))
+	if bz == nil {
+		return types.AssetFlows{}, false
+	}
+
+	var assetFlows types.AssetFlows
+	k.cdc.MustUnmarshal(bz, &assetFlows)
+	return assetFlows, true
+}
+
+// SetAssetFlows stores the rate limit flows of the asset.
+func (k Keeper) SetAssetFlows(ctx sdk.Context, assetFlows types.AssetFlows) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAssetFlowsKey(assetFlows.Asset.Denom), k.cdc.MustMarshal(&assetFlows))
+}
+
+// GetAllAssetFlows returns the rate limit flows of all the assets.
+func (k Keeper) GetAllAssetFlows(ctx sdk.Context) []types.AssetFlows {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AssetFlowsPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allFlows := make([]types.AssetFlows, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var assetFlows types.AssetFlows
+		k.cdc.MustUnmarshal(iterator.Value(), &assetFlows)
+		allFlows = append(allFlows, assetFlows)
+	}
+	return allFlows
+}
+
+// updateFlows adds the inbound and outbound amounts to the flows of all the
+// asset rate limits. The windows slide with the block time: the buckets which
+// left the rate limit window are dropped first. Returns an error without
+// updating the flows if any of the rate limits is exceeded.
+func (k Keeper) updateFlows(ctx sdk.Context, asset types.Asset, inbound, outbound math.Int) error {
+	rateLimits, found := k.GetParams(ctx).GetRateLimits(asset)
+	if !found {
+		return nil
+	}
+
+	now := ctx.BlockTime()
+	assetFlows, _ := k.GetAssetFlows(ctx, asset)
+
+	flows := make([]types.Flow, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		flow, found := assetFlows.GetFlow(rateLimit.Name)
+		if !found {
+			flow = types.NewFlow(rateLimit.Name)
+		}
+		flow = flow.Prune(now, rateLimit.Duration).Add(now, rateLimit.Duration, inbound, outbound)
+
+		if flowInbound := flow.Inbound(); flowInbound.GT(rateLimit.MaxInbound) {
+			return errorsmod.Wrapf(types.ErrRateLimitExceeded,
+				"asset %s: inbound %s rate limit is %s, remaining %s",
+				asset.Name(), rateLimit.Name, rateLimit.MaxInbound, math.MaxInt(rateLimit.MaxInbound.Sub(flowInbound.Sub(inbound)), math.ZeroInt()))
+		}
+
+		if flowOutbound := flow.Outbound(); flowOutbound.GT(rateLimit.MaxOutbound) {
+			return errorsmod.Wrapf(types.ErrRateLimitExceeded,
+				"asset %s: outbound %s rate limit is %s, remaining %s",
+				asset.Name(), rateLimit.Name, rateLimit.MaxOutbound, math.MaxInt(rateLimit.MaxOutbound.Sub(flowOutbound.Sub(outbound)), math.ZeroInt()))
+		}
+
+		flows = append(flows, flow)
+	}
+
+	// flows of the removed rate limits are dropped here as well
+	k.SetAssetFlows(ctx, types.AssetFlows{Asset: asset, Flows: flows})
+
+	return nil
+}
+
+// GetRemainingCapacity returns the volume of the asset which can still be
+// transferred in each direction under each of the asset rate limits.
+func (k Keeper) GetRemainingCapacity(ctx sdk.Context, asset types.Asset) []types.RateLimitCapacity {
+	rateLimits, found := k.GetParams(ctx).GetRateLimits(asset)
+	if !found {
+		return []types.RateLimitCapacity{}
+	}
+
+	now := ctx.BlockTime()
+	assetFlows, _ := k.GetAssetFlows(ctx, asset)
+
+	capacities := make([]types.RateLimitCapacity, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		capacity := types.RateLimitCapacity{
+			RateLimit:         rateLimit,
+			InboundRemaining:  rateLimit.MaxInbound,
+			OutboundRemaining: rateLimit.MaxOutbound,
+		}
+
+		flow, _ := assetFlows.GetFlow(rateLimit.Name)
+		flow = flow.Prune(now, rateLimit.Duration)
+		if periodEnd, found := flow.PeriodEnd(rateLimit.Duration); found {
+			capacity.InboundRemaining = math.MaxInt(rateLimit.MaxInbound.Sub(flow.Inbound()), math.ZeroInt())
+			capacity.OutboundRemaining = math.MaxInt(rateLimit.MaxOutbound.Sub(flow.Outbound()), math.ZeroInt())
+			capacity.PeriodEnd = &periodEnd
+		}
+
+		capacities = append(capacities, capacity)
+	}
+	return capacities
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+// setupRateLimits sets the rate limits of the default asset.
+func (s *KeeperTestSuite) setupRateLimits(rateLimits ...types.RateLimit) {
+	params := s.App.BridgeKeeper.GetParams(s.Ctx)
+	params.AssetRateLimits = []types.AssetRateLimits{{Asset: defaultAsset, RateLimits: rateLimits}}
+
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(s.govAddr, params))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestInboundRateLimits() {
+	denom := s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupRateLimits(
+		types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(1000), osmomath.NewInt(1000)),
+		types.NewRateLimit("daily", 24*time.Hour, osmomath.NewInt(1500), osmomath.NewInt(1500)),
+	)
+	dest := s.TestAccs[1]
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Truncate(time.Hour))
+
+	inbound := func(externalID string, amount int64) error {
+		_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, externalID, dest.String(), defaultAsset, osmomath.NewInt(amount)))
+		return err
+	}
+
+	s.Require().NoError(inbound("tx1", 600))
+
+	// the hourly limit is exceeded
+	err := inbound("tx2", 600)
+	s.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	s.Require().Equal(osmomath.NewInt(600), s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+
+	// the failed vote is not recorded, so it can be submitted again later
+	_, found := s.App.BridgeKeeper.GetInboundTransfer(s.Ctx, "tx2")
+	s.Require().False(found)
+
+	querier := keeper.NewQuerier(*s.App.BridgeKeeper)
+	res, err := querier.RemainingCapacity(sdk.WrapSDKContext(s.Ctx), &types.QueryRemainingCapacityRequest{
+		SourceChain: defaultAsset.SourceChain,
+		Denom:       defaultAsset.Denom,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Capacities, 2)
+	s.Require().Equal(osmomath.NewInt(400), res.Capacities[0].InboundRemaining)
+	s.Require().Equal(osmomath.NewInt(1000), res.Capacities[0].OutboundRemaining)
+	s.Require().Equal(s.Ctx.BlockTime().Add(time.Hour+time.Minute), *res.Capacities[0].PeriodEnd)
+	s.Require().Equal(osmomath.NewInt(900), res.Capacities[1].InboundRemaining)
+
+	// the bucket of the first transfer leaves the hourly window, but the daily limit is exceeded
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour + time.Minute))
+	s.Require().NoError(inbound("tx2", 600))
+	s.Require().ErrorIs(inbound("tx3", 600), types.ErrRateLimitExceeded)
+
+	// all the transfers leave both windows
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(25 * time.Hour))
+	res, err = querier.RemainingCapacity(sdk.WrapSDKContext(s.Ctx), &types.QueryRemainingCapacityRequest{
+		SourceChain: defaultAsset.SourceChain,
+		Denom:       defaultAsset.Denom,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1000), res.Capacities[0].InboundRemaining)
+	s.Require().Nil(res.Capacities[0].PeriodEnd)
+
+	s.Require().NoError(inbound("tx3", 600))
+	s.Require().Equal(osmomath.NewInt(1800), s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+}
+
+func (s *KeeperTestSuite) TestRateLimitsSlidingWindow() {
+	s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupRateLimits(types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(1000), osmomath.NewInt(1000)))
+	dest := s.TestAccs[1]
+	start := s.Ctx.BlockTime().Truncate(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(start)
+
+	inbound := func(externalID string, amount int64) error {
+		_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, externalID, dest.String(), defaultAsset, osmomath.NewInt(amount)))
+		return err
+	}
+
+	s.Require().NoError(inbound("tx1", 600))
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(59 * time.Minute))
+	s.Require().NoError(inbound("tx2", 400))
+
+	// the capacity is freed as the buckets leave the window, there is no
+	// window boundary after which the whole capacity is available at once
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(61 * time.Minute))
+	capacities := s.App.BridgeKeeper.GetRemainingCapacity(s.Ctx, defaultAsset)
+	s.Require().Equal(osmomath.NewInt(600), capacities[0].InboundRemaining)
+	s.Require().Equal(start.Add(120*time.Minute), *capacities[0].PeriodEnd)
+	s.Require().ErrorIs(inbound("tx3", 1000), types.ErrRateLimitExceeded)
+	s.Require().NoError(inbound("tx3", 300))
+	s.Require().NoError(inbound("tx4", 300))
+
+	// the first transfer is dropped, and the transfers of the same period share a bucket
+	flows, found := s.App.BridgeKeeper.GetAssetFlows(s.Ctx, defaultAsset)
+	s.Require().True(found)
+	s.Require().Len(flows.Flows[0].Buckets, 2)
+	s.Require().Equal(osmomath.NewInt(1000), flows.Flows[0].Inbound())
+}
+
+func (s *KeeperTestSuite) TestRateLimitsBucketCount() {
+	s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupRateLimits(types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(1_000_000), osmomath.NewInt(1_000_000)))
+	dest := s.TestAccs[1]
+	start := s.Ctx.BlockTime().Truncate(time.Hour)
+
+	// a transfer every 10 seconds for two windows
+	for i := 0; i < 720; i++ {
+		s.Ctx = s.Ctx.WithBlockTime(start.Add(time.Duration(i) * 10 * time.Second))
+		_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, fmt.Sprintf("tx%d", i), dest.String(), defaultAsset, osmomath.OneInt()))
+		s.Require().NoError(err)
+
+		// the transfers are merged into the buckets of their period of the window
+		flows, found := s.App.BridgeKeeper.GetAssetFlows(s.Ctx, defaultAsset)
+		s.Require().True(found)
+		s.Require().LessOrEqual(len(flows.Flows[0].Buckets), types.FlowBucketsPerWindow+1)
+	}
+
+	// the flow counts the transfers of the buckets partly in the window
+	flows, _ := s.App.BridgeKeeper.GetAssetFlows(s.Ctx, defaultAsset)
+	s.Require().Len(flows.Flows[0].Buckets, types.FlowBucketsPerWindow+1)
+	s.Require().Equal(osmomath.NewInt(366), flows.Flows[0].Inbound())
+}
+
+func (s *KeeperTestSuite) TestRateLimitsLoweredBelowFlow() {
+	s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	s.setupRateLimits(types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(1000), osmomath.NewInt(1000)))
+	dest := s.TestAccs[1]
+
+	_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, "tx1", dest.String(), defaultAsset, osmomath.NewInt(900)))
+	s.Require().NoError(err)
+
+	// the window is already over the lowered limit, so nothing remains
+	s.setupRateLimits(types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(500), osmomath.NewInt(1000)))
+	_, err = s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, "tx2", dest.String(), defaultAsset, osmomath.NewInt(100)))
+	s.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	s.Require().ErrorContains(err, "remaining 0")
+
+	capacities := s.App.BridgeKeeper.GetRemainingCapacity(s.Ctx, defaultAsset)
+	s.Require().True(capacities[0].InboundRemaining.IsZero())
+}
+
+func (s *KeeperTestSuite) TestOutboundRateLimits() {
+	denom := s.setupAsset(types.AssetStatus_ASSET_STATUS_OK)
+	user := s.TestAccs[1]
+
+	_, err := s.msgServer.InboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgInboundTransfer(s.signer, "tx1", user.String(), defaultAsset, osmomath.NewInt(1000)))
+	s.Require().NoError(err)
+
+	s.setupRateLimits(types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(1000), osmomath.NewInt(500)))
+
+	outbound := func(amount int64) error {
+		_, err := s.msgServer.OutboundTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgOutboundTransfer(user.String(), "bc1qdestination", defaultAsset, osmomath.NewInt(amount)))
+		return err
+	}
+
+	s.Require().NoError(outbound(300))
+	s.Require().ErrorIs(outbound(300), types.ErrRateLimitExceeded)
+	s.Require().Equal(osmomath.NewInt(700), s.App.BankKeeper.GetBalance(s.Ctx, user, denom).Amount)
+
+	// the outbound volume doesn't affect the inbound capacity
+	capacities := s.App.BridgeKeeper.GetRemainingCapacity(s.Ctx, defaultAsset)
+	s.Require().Equal(osmomath.NewInt(1000), capacities[0].InboundRemaining)
+	s.Require().Equal(osmomath.NewInt(200), capacities[0].OutboundRemaining)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour + types.FlowBucketDuration(time.Hour)))
+	s.Require().NoError(outbound(300))
+	s.Require().Equal(osmomath.NewInt(400), s.App.BankKeeper.GetBalance(s.Ctx, user, denom).Amount)
+
+	// flows are exported and imported with the genesis
+	genesis := s.App.BridgeKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Len(genesis.AssetFlows, 1)
+}
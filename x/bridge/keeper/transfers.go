@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v23/x/tokenfactory/types"
+)
+
+// InboundTransfer records the signer's vote for the inbound transfer
+// identified by the external id. Once Params.VotesNeeded current signers vote
+// for the same destination address, asset and amount, the amount of the asset
+// is minted to the destination address and the transfer is finalized.
+// The asset must be known and its status must allow inbound transfers.
+// The minted amount counts towards the asset rate limits, and the vote
+// finalizing the transfer is rejected if any of them is exceeded.
+// Returns true if the vote finalized the transfer.
+func (k Keeper) InboundTransfer(
+	ctx sdk.Context,
+	signer string,
+	externalID string,
+	destAddr string,
+	asset types.Asset,
+	amount math.Int,
+) (bool, error) {
+	params := k.GetParams(ctx)
+
+	if err := checkInboundAsset(params, asset); err != nil {
+		return false, err
+	}
+
+	transfer, found := k.GetInboundTransfer(ctx, externalID)
+	if !found {
+		transfer = types.InboundTransfer{
+			ExternalId:    externalID,
+			Votes:         []types.InboundVote{},
+			Finalized:     false,
+			CreatedHeight: ctx.BlockHeight(),
+		}
+	}
+	if transfer.Finalized {
+		return false, errorsmod.Wrapf(types.ErrTransferFinalized, "transfer %s", externalID)
+	}
+	if transfer.HasVoted(signer) {
+		return false, errorsmod.Wrapf(types.ErrAlreadyVoted, "signer %s, transfer %s", signer, externalID)
+	}
+
+	vote := types.NewInboundVote(signer, destAddr, asset, amount)
+	transfer.Votes = append(transfer.Votes, vote)
+
+	for _, tally := range transfer.Tally(params.IsSigner) {
+		if tally.DestAddr != destAddr || tally.Asset != asset || !tally.Amount.Equal(amount) {
+			continue
+		}
+		if tally.Votes >= params.VotesNeeded {
+			if err := k.mintInbound(ctx, destAddr, asset, amount); err != nil {
+				return false, err
+			}
+			transfer.Finalized = true
+		}
+		break
+	}
+
+	k.SetInboundTransfer(ctx, transfer)
+
+	return transfer.Finalized, nil
+}
+
+// mintInbound mints the amount of the asset to the destination address.
+func (k Keeper) mintInbound(ctx sdk.Context, destAddr string, asset types.Asset, amount math.Int) error {
+	denom, err := k.GetAssetDenom(asset)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAsset, "asset %s: %s", asset.Name(), err)
+	}
+
+	if err = k.updateFlows(ctx, asset, amount, math.ZeroInt()); err != nil {
+		return err
+	}
+
+	_, err = k.tokenFactoryMsgServer.Mint(
+		sdk.WrapSDKContext(ctx),
+		tokenfactorytypes.NewMsgMintTo(k.GetModuleAddress().String(), sdk.NewCoin(denom, amount), destAddr),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "can't mint %s%s to %s", amount, denom, destAddr)
+	}
+
+	return nil
+}
+
+// checkInboundAsset checks that the asset is known and its status allows
+// inbound transfers.
+func checkInboundAsset(params types.Params, asset types.Asset) error {
+	assetWithStatus, found := params.GetAsset(asset)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAssetNotFound, "asset %s", asset.Name())
+	}
+	if assetWithStatus.Asset != asset {
+		return errorsmod.Wrapf(types.ErrInvalidAsset, "asset %s has precision %d, got %d", asset.Name(), assetWithStatus.Asset.Precision, asset.Precision)
+	}
+	if !assetWithStatus.AssetStatus.InboundActive() {
+		return errorsmod.Wrapf(types.ErrInboundDisabled, "asset %s has status %s", asset.Name(), assetWithStatus.AssetStatus)
+	}
+	return nil
+}
+
+// OutboundTransfer burns the amount of the asset from the sender address.
+// The asset must be known and its status must allow outbound transfers.
+// The burned amount counts towards the asset rate limits.
+func (k Keeper) OutboundTransfer(ctx sdk.Context, sender string, asset types.Asset, amount math.Int) error {
+	params := k.GetParams(ctx)
+
+	assetWithStatus, found := params.GetAsset(asset)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAssetNotFound, "asset %s", asset.Name())
+	}
+	if assetWithStatus.Asset != asset {
+		return errorsmod.Wrapf(types.ErrInvalidAsset, "asset %s has precision %d, got %d", asset.Name(), assetWithStatus.Asset.Precision, asset.Precision)
+	}
+	if !assetWithStatus.AssetStatus.OutboundActive() {
+		return errorsmod.Wrapf(types.ErrOutboundDisabled, "asset %s has status %s", asset.Name(), assetWithStatus.AssetStatus)
+	}
+
+	denom, err := k.GetAssetDenom(asset)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAsset, "asset %s: %s", asset.Name(), err)
+	}
+
+	if err = k.updateFlows(ctx, asset, math.ZeroInt(), amount); err != nil {
+		return err
+	}
+
+	_, err = k.tokenFactoryMsgServer.Burn(
+		sdk.WrapSDKContext(ctx),
+		tokenfactorytypes.NewMsgBurnFrom(k.GetModuleAddress().String(), sdk.NewCoin(denom, amount), sender),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "can't burn %s%s from %s", amount, denom, sender)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v23/x/tokenfactory/types"
+)
+
+// MaxPrecision is the maximum precision of an asset. It is large enough for
+// all common chains and keeps the display exponent within uint32.
+const MaxPrecision = 18
+
+// DefaultAssets returns the list of assets supported by default.
+// New assets are introduced through governance, so the list is empty.
+func DefaultAssets() []AssetWithStatus {
+	return []AssetWithStatus{}
+}
+
+// Validate performs stateless validation of the asset.
+func (a Asset) Validate() error {
+	if a.SourceChain == "" {
+		return fmt.Errorf("source chain must not be empty")
+	}
+
+	if a.Denom == "" {
+		return fmt.Errorf("denom must not be empty")
+	}
+
+	if len(a.Denom) > tokenfactorytypes.MaxSubdenomLength {
+		return tokenfactorytypes.ErrSubdenomTooLong
+	}
+
+	if a.Precision > MaxPrecision {
+		return fmt.Errorf("precision must not exceed %d, got %d", MaxPrecision, a.Precision)
+	}
+
+	return nil
+}
+
+// SameAs returns true if both assets represent the same token of the same
+// source chain. The precision is not part of the asset identity.
+func (a Asset) SameAs(other Asset) bool {
+	return a.SourceChain == other.SourceChain && a.Denom == other.Denom
+}
+
+// Name returns a human-readable name of the asset.
+func (a Asset) Name() string {
+	return fmt.Sprintf("%s/%s", a.SourceChain, a.Denom)
+}
+
+// TokenFactoryDenom returns the x/tokenfactory denom representing the asset
+// on chain. The creator is expected to be the x/bridge module address.
+func (a Asset) TokenFactoryDenom(creator string) (string, error) {
+	return tokenfactorytypes.GetTokenDenom(creator, a.Denom)
+}
+
+// Validate performs stateless validation of the asset with its status.
+func (a AssetWithStatus) Validate() error {
+	if err := a.Asset.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAsset, err.Error())
+	}
+
+	return a.AssetStatus.Validate()
+}
+
+// Validate checks that the status is a known non-default value.
+func (s AssetStatus) Validate() error {
+	switch s {
+	case AssetStatus_ASSET_STATUS_OK,
+		AssetStatus_ASSET_STATUS_BLOCKED_INBOUND,
+		AssetStatus_ASSET_STATUS_BLOCKED_OUTBOUND,
+		AssetStatus_ASSET_STATUS_BLOCKED_BOTH:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidAssetStatus, "unknown asset status %s", s)
+	}
+}
+
+// InboundActive returns true if inbound transfers are allowed for the status.
+func (s AssetStatus) InboundActive() bool {
+	return s == AssetStatus_ASSET_STATUS_OK || s == AssetStatus_ASSET_STATUS_BLOCKED_OUTBOUND
+}
+
+// OutboundActive returns true if outbound transfers are allowed for the status.
+func (s AssetStatus) OutboundActive() bool {
+	return s == AssetStatus_ASSET_STATUS_OK || s == AssetStatus_ASSET_STATUS_BLOCKED_INBOUND
+}
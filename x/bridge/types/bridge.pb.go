@@ -4,18 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,6 +70,15 @@ type Params struct {
 	// Assets is a list used to create tokenfactory denoms
 	// for corresponding trading pairs
 	Assets []AssetWithStatus `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets" yaml:"assets"`
+	// VotesNeeded is the number of matching signer votes needed to finalize
+	// an inbound transfer
+	VotesNeeded uint64 `protobuf:"varint,3,opt,name=votes_needed,json=votesNeeded,proto3" json:"votes_needed,omitempty" yaml:"votes_needed"`
+	// VoteExpiry is the number of blocks after which a non-finalized inbound
+	// transfer and all its votes are removed
+	VoteExpiry uint64 `protobuf:"varint,4,opt,name=vote_expiry,json=voteExpiry,proto3" json:"vote_expiry,omitempty" yaml:"vote_expiry"`
+	// AssetRateLimits is a list of volume limits of the known assets. Assets
+	// without rate limits are not limited.
+	AssetRateLimits []AssetRateLimits `protobuf:"bytes,5,rep,name=asset_rate_limits,json=assetRateLimits,proto3" json:"asset_rate_limits" yaml:"asset_rate_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -113,6 +128,27 @@ func (m *Params) GetAssets() []AssetWithStatus {
 	return nil
 }
 
+func (m *Params) GetVotesNeeded() uint64 {
+	if m != nil {
+		return m.VotesNeeded
+	}
+	return 0
+}
+
+func (m *Params) GetVoteExpiry() uint64 {
+	if m != nil {
+		return m.VoteExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetAssetRateLimits() []AssetRateLimits {
+	if m != nil {
+		return m.AssetRateLimits
+	}
+	return nil
+}
+
 // AssetWithStatus defines a pair of the asset and its current status.
 type AssetWithStatus struct {
 	Asset       Asset       `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset" yaml:"asset"`
@@ -231,251 +267,2766 @@ func (m *Asset) GetPrecision() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("osmosis.bridge.v1beta1.AssetStatus", AssetStatus_name, AssetStatus_value)
-	proto.RegisterType((*Params)(nil), "osmosis.bridge.v1beta1.Params")
-	proto.RegisterType((*AssetWithStatus)(nil), "osmosis.bridge.v1beta1.AssetWithStatus")
-	proto.RegisterType((*Asset)(nil), "osmosis.bridge.v1beta1.Asset")
+// InboundVote is a vote of a single signer for an inbound transfer.
+type InboundVote struct {
+	// Signer is a signer's address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	// DestAddr is a destination Osmosis address
+	DestAddr string `protobuf:"bytes,2,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty" yaml:"dest_addr"`
+	// Asset contains a source chain and a target denom
+	Asset Asset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset" yaml:"asset"`
+	// Amount of coins to transfer
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/bridge/v1beta1/bridge.proto", fileDescriptor_f999ddf08452f1f3)
+func (m *InboundVote) Reset()         { *m = InboundVote{} }
+func (m *InboundVote) String() string { return proto.CompactTextString(m) }
+func (*InboundVote) ProtoMessage()    {}
+func (*InboundVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{3}
 }
-
-var fileDescriptor_f999ddf08452f1f3 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xda, 0x4c,
-	0x14, 0xc7, 0x19, 0x08, 0x7c, 0x62, 0xe0, 0x4b, 0xac, 0x09, 0x6d, 0xdd, 0x2a, 0xd8, 0xee, 0x44,
-	0x6a, 0xad, 0xaa, 0xb5, 0x15, 0x67, 0x97, 0x1d, 0x06, 0xaa, 0xa2, 0x44, 0x38, 0xb2, 0x4d, 0x2b,
-	0x75, 0x63, 0xd9, 0xc4, 0x32, 0x96, 0x62, 0x06, 0x79, 0x4c, 0xd4, 0x5c, 0xa2, 0xea, 0x0d, 0xba,
-	0xe9, 0x35, 0xba, 0xcf, 0x32, 0xcb, 0xae, 0xac, 0x0a, 0x6e, 0xc0, 0x09, 0x2a, 0xec, 0xa1, 0x38,
-	0x15, 0xca, 0x6e, 0xde, 0xfb, 0xff, 0xe6, 0xf7, 0xde, 0x08, 0x0c, 0x8f, 0x09, 0x8d, 0x08, 0x0d,
-	0xa9, 0xea, 0xc5, 0xe1, 0x55, 0xe0, 0xab, 0x37, 0x27, 0x9e, 0x9f, 0xb8, 0x27, 0xac, 0x54, 0x66,
-	0x31, 0x49, 0x08, 0x7a, 0xca, 0x20, 0x85, 0x75, 0x19, 0xf4, 0xa2, 0x15, 0x90, 0x80, 0x64, 0x88,
-	0xba, 0x3e, 0xe5, 0x34, 0xfe, 0x0a, 0x60, 0xed, 0xd2, 0x8d, 0xdd, 0x88, 0xa2, 0xb7, 0xf0, 0x3f,
-	0x1a, 0x06, 0x53, 0x3f, 0xa6, 0x3c, 0x90, 0x2a, 0x72, 0x5d, 0x47, 0xab, 0x54, 0xdc, 0xbf, 0x75,
-	0xa3, 0xeb, 0x33, 0xcc, 0x02, 0x6c, 0x6e, 0x10, 0xf4, 0x11, 0xd6, 0x5c, 0x4a, 0xfd, 0x84, 0xf2,
-	0x65, 0xa9, 0x22, 0x37, 0xb4, 0xd7, 0xca, 0xee, 0xb9, 0x4a, 0x67, 0x4d, 0x7d, 0x0a, 0x93, 0x89,
-	0x95, 0xb8, 0xc9, 0x9c, 0xea, 0x4f, 0xee, 0x52, 0xb1, 0xb4, 0x4a, 0xc5, 0xff, 0x73, 0x73, 0x2e,
-	0xc1, 0x26, 0xb3, 0xe1, 0x9f, 0x00, 0x1e, 0xfc, 0x73, 0x05, 0x0d, 0x60, 0x35, 0x4b, 0x79, 0x20,
-	0x01, 0xb9, 0xa1, 0xb5, 0x1f, 0x1d, 0xa5, 0xb7, 0xd8, 0x80, 0x66, 0x61, 0x00, 0x36, 0x73, 0x03,
-	0x72, 0x60, 0x33, 0x3b, 0x38, 0x34, 0x53, 0xf3, 0x65, 0x09, 0xc8, 0xfb, 0xda, 0xf1, 0xa3, 0x46,
-	0xb6, 0xf8, 0xb3, 0x55, 0x2a, 0x1e, 0x16, 0x9c, 0x4c, 0x81, 0xcd, 0x86, 0xbb, 0xa5, 0xf0, 0x77,
-	0x00, 0xab, 0xd9, 0x2d, 0x74, 0x06, 0x9b, 0x94, 0xcc, 0xe3, 0xb1, 0xef, 0x8c, 0x27, 0x6e, 0x38,
-	0xcd, 0x96, 0xaf, 0x17, 0x2d, 0xc5, 0x14, 0x9b, 0x8d, 0xbc, 0xec, 0xae, 0x2b, 0xf4, 0x0a, 0x56,
-	0xaf, 0xfc, 0x29, 0x89, 0xb2, 0xfd, 0xea, 0x3a, 0xb7, 0x7d, 0x4e, 0xd6, 0xc6, 0x66, 0x1e, 0x23,
-	0x0d, 0xd6, 0x67, 0xb1, 0x3f, 0x0e, 0x69, 0x48, 0xa6, 0x7c, 0x45, 0x02, 0xf2, 0x9e, 0xde, 0x5a,
-	0xa5, 0x22, 0x97, 0xb3, 0x7f, 0x23, 0x6c, 0x6e, 0xb1, 0x37, 0x3f, 0x00, 0x6c, 0x14, 0xde, 0x85,
-	0x8e, 0x20, 0xdf, 0xb1, 0xac, 0xbe, 0xed, 0x58, 0x76, 0xc7, 0x1e, 0x59, 0xce, 0x68, 0x68, 0x5d,
-	0xf6, 0xbb, 0x83, 0xf7, 0x83, 0x7e, 0x8f, 0x2b, 0xa1, 0x43, 0x78, 0xf0, 0x20, 0x35, 0xce, 0x39,
-	0x80, 0x24, 0x78, 0xf4, 0xa0, 0xa9, 0x5f, 0x18, 0xdd, 0xf3, 0x7e, 0xcf, 0x19, 0x0c, 0x75, 0x63,
-	0x34, 0xec, 0x71, 0x65, 0xf4, 0x12, 0xb6, 0x77, 0x12, 0xc6, 0xc8, 0xce, 0x91, 0x0a, 0x6a, 0xc3,
-	0xe7, 0x3b, 0x11, 0xdd, 0xb0, 0x3f, 0x70, 0x7b, 0xfa, 0xc5, 0xdd, 0x42, 0x00, 0xf7, 0x0b, 0x01,
-	0xfc, 0x5e, 0x08, 0xe0, 0xdb, 0x52, 0x28, 0xdd, 0x2f, 0x85, 0xd2, 0xaf, 0xa5, 0x50, 0xfa, 0xac,
-	0x05, 0x61, 0x32, 0x99, 0x7b, 0xca, 0x98, 0x44, 0x2a, 0xfb, 0xdd, 0xde, 0x5d, 0xbb, 0x1e, 0xdd,
-	0x14, 0xea, 0x8d, 0x76, 0xaa, 0x7e, 0xd9, 0x7c, 0x24, 0xc9, 0xed, 0xcc, 0xa7, 0x5e, 0x2d, 0xfb,
-	0xbb, 0x9f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x39, 0x7b, 0x24, 0x48, 0x43, 0x03, 0x00, 0x00,
+func (m *InboundVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InboundVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InboundVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundVote.Merge(m, src)
+}
+func (m *InboundVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundVote.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBridge(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+var xxx_messageInfo_InboundVote proto.InternalMessageInfo
+
+func (m *InboundVote) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintBridge(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *InboundVote) GetDestAddr() string {
+	if m != nil {
+		return m.DestAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AssetWithStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InboundVote) GetAsset() Asset {
+	if m != nil {
+		return m.Asset
 	}
-	return dAtA[:n], nil
+	return Asset{}
 }
 
-func (m *AssetWithStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InboundTransfer collects signer votes for the transfer identified by
+// the external transaction id. The transfer is finalized, i.e., the coins are
+// minted, once Params.VotesNeeded signers submit matching votes.
+type InboundTransfer struct {
+	// ExternalId is a unique transfer id on the source chain
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty" yaml:"external_id"`
+	// Votes is a list of all the submitted votes
+	Votes []InboundVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes" yaml:"votes"`
+	// Finalized is true if the coins are already minted
+	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty" yaml:"finalized"`
+	// CreatedHeight is the block height of the first vote
+	CreatedHeight int64 `protobuf:"varint,4,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty" yaml:"created_height"`
 }
 
-func (m *AssetWithStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AssetStatus != 0 {
-		i = encodeVarintBridge(dAtA, i, uint64(m.AssetStatus))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+func (m *InboundTransfer) Reset()         { *m = InboundTransfer{} }
+func (m *InboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*InboundTransfer) ProtoMessage()    {}
+func (*InboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{4}
+}
+func (m *InboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintBridge(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *InboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundTransfer.Merge(m, src)
+}
+func (m *InboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundTransfer.DiscardUnknown(m)
 }
 
-func (m *Asset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InboundTransfer proto.InternalMessageInfo
+
+func (m *InboundTransfer) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InboundTransfer) GetVotes() []InboundVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Precision != 0 {
-		i = encodeVarintBridge(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x18
+func (m *InboundTransfer) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *InboundTransfer) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	if len(m.SourceChain) > 0 {
-		i -= len(m.SourceChain)
-		copy(dAtA[i:], m.SourceChain)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.SourceChain)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// InboundVoteTally is a number of signer votes for the same transfer data.
+type InboundVoteTally struct {
+	// DestAddr is a destination Osmosis address
+	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty" yaml:"dest_addr"`
+	// Asset contains a source chain and a target denom
+	Asset Asset `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset" yaml:"asset"`
+	// Amount of coins to transfer
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// Votes is a number of the current signers voted for the data
+	Votes uint64 `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+}
+
+func (m *InboundVoteTally) Reset()         { *m = InboundVoteTally{} }
+func (m *InboundVoteTally) String() string { return proto.CompactTextString(m) }
+func (*InboundVoteTally) ProtoMessage()    {}
+func (*InboundVoteTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{5}
+}
+func (m *InboundVoteTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundVoteTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundVoteTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *InboundVoteTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundVoteTally.Merge(m, src)
+}
+func (m *InboundVoteTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundVoteTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundVoteTally.DiscardUnknown(m)
 }
 
-func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBridge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_InboundVoteTally proto.InternalMessageInfo
+
+func (m *InboundVoteTally) GetDestAddr() string {
+	if m != nil {
+		return m.DestAddr
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *InboundVoteTally) GetAsset() Asset {
+	if m != nil {
+		return m.Asset
 	}
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovBridge(uint64(l))
-		}
+	return Asset{}
+}
+
+func (m *InboundVoteTally) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
 	}
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovBridge(uint64(l))
+	return 0
+}
+
+// RateLimit limits the volume of the asset transferred in each direction
+// during any window of the given duration, sliding with the block time.
+type RateLimit struct {
+	// Name identifies the rate limit for the asset, e.g., "hourly" or "daily"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Duration is the window length
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// MaxInbound is the maximum amount minted during the window
+	MaxInbound cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_inbound,json=maxInbound,proto3,customtype=cosmossdk.io/math.Int" json:"max_inbound" yaml:"max_inbound"`
+	// MaxOutbound is the maximum amount burned during the window
+	MaxOutbound cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_outbound,json=maxOutbound,proto3,customtype=cosmossdk.io/math.Int" json:"max_outbound" yaml:"max_outbound"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{6}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
 }
 
-func (m *AssetWithStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Asset.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	if m.AssetStatus != 0 {
-		n += 1 + sovBridge(uint64(m.AssetStatus))
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *Asset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RateLimit) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// AssetRateLimits defines rate limits of the asset.
+type AssetRateLimits struct {
+	Asset      Asset       `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset" yaml:"asset"`
+	RateLimits []RateLimit `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+}
+
+func (m *AssetRateLimits) Reset()         { *m = AssetRateLimits{} }
+func (m *AssetRateLimits) String() string { return proto.CompactTextString(m) }
+func (*AssetRateLimits) ProtoMessage()    {}
+func (*AssetRateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{7}
+}
+func (m *AssetRateLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRateLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRateLimits.Merge(m, src)
+}
+func (m *AssetRateLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetRateLimits proto.InternalMessageInfo
+
+func (m *AssetRateLimits) GetAsset() Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return Asset{}
+}
+
+func (m *AssetRateLimits) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+// Flow is the volume of the asset transferred during the sliding window of
+// the rate limit with the same name.
+type Flow struct {
+	// Name is the name of the rate limit
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Buckets are the amounts transferred in the periods of the window, oldest
+	// first
+	Buckets []FlowBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets" yaml:"buckets"`
+}
+
+func (m *Flow) Reset()         { *m = Flow{} }
+func (m *Flow) String() string { return proto.CompactTextString(m) }
+func (*Flow) ProtoMessage()    {}
+func (*Flow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{8}
+}
+func (m *Flow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Flow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Flow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Flow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Flow.Merge(m, src)
+}
+func (m *Flow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Flow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Flow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Flow proto.InternalMessageInfo
+
+func (m *Flow) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Flow) GetBuckets() []FlowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// FlowBucket is the volume of the asset transferred during a period of the
+// window, which is FlowBucketsPerWindow times shorter than the window.
+type FlowBucket struct {
+	// Time is the start of the period
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// Inbound is the amount minted during the period
+	Inbound cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inbound,proto3,customtype=cosmossdk.io/math.Int" json:"inbound" yaml:"inbound"`
+	// Outbound is the amount burned during the period
+	Outbound cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outbound,proto3,customtype=cosmossdk.io/math.Int" json:"outbound" yaml:"outbound"`
+}
+
+func (m *FlowBucket) Reset()         { *m = FlowBucket{} }
+func (m *FlowBucket) String() string { return proto.CompactTextString(m) }
+func (*FlowBucket) ProtoMessage()    {}
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{9}
+}
+func (m *FlowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowBucket.Merge(m, src)
+}
+func (m *FlowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowBucket proto.InternalMessageInfo
+
+func (m *FlowBucket) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// AssetFlows defines the flows of the asset rate limits.
+type AssetFlows struct {
+	Asset Asset  `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset" yaml:"asset"`
+	Flows []Flow `protobuf:"bytes,2,rep,name=flows,proto3" json:"flows" yaml:"flows"`
+}
+
+func (m *AssetFlows) Reset()         { *m = AssetFlows{} }
+func (m *AssetFlows) String() string { return proto.CompactTextString(m) }
+func (*AssetFlows) ProtoMessage()    {}
+func (*AssetFlows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{10}
+}
+func (m *AssetFlows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetFlows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetFlows.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetFlows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetFlows.Merge(m, src)
+}
+func (m *AssetFlows) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetFlows) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetFlows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetFlows proto.InternalMessageInfo
+
+func (m *AssetFlows) GetAsset() Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return Asset{}
+}
+
+func (m *AssetFlows) GetFlows() []Flow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+// RateLimitCapacity is the volume of the asset which can still be
+// transferred under the rate limit.
+type RateLimitCapacity struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit" yaml:"rate_limit"`
+	// InboundRemaining is the amount which can still be minted
+	InboundRemaining cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inbound_remaining,json=inboundRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_remaining" yaml:"inbound_remaining"`
+	// OutboundRemaining is the amount which can still be burned
+	OutboundRemaining cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outbound_remaining,json=outboundRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_remaining" yaml:"outbound_remaining"`
+	// PeriodEnd is the time the oldest transfer of the window leaves it,
+	// freeing some capacity. It is not set if there is no transfer in the
+	// window, i.e., the full capacity is available.
+	PeriodEnd *time.Time `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end,omitempty" yaml:"period_end"`
+}
+
+func (m *RateLimitCapacity) Reset()         { *m = RateLimitCapacity{} }
+func (m *RateLimitCapacity) String() string { return proto.CompactTextString(m) }
+func (*RateLimitCapacity) ProtoMessage()    {}
+func (*RateLimitCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f999ddf08452f1f3, []int{11}
+}
+func (m *RateLimitCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitCapacity.Merge(m, src)
+}
+func (m *RateLimitCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitCapacity proto.InternalMessageInfo
+
+func (m *RateLimitCapacity) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+func (m *RateLimitCapacity) GetPeriodEnd() *time.Time {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.bridge.v1beta1.AssetStatus", AssetStatus_name, AssetStatus_value)
+	proto.RegisterType((*Params)(nil), "osmosis.bridge.v1beta1.Params")
+	proto.RegisterType((*AssetWithStatus)(nil), "osmosis.bridge.v1beta1.AssetWithStatus")
+	proto.RegisterType((*Asset)(nil), "osmosis.bridge.v1beta1.Asset")
+	proto.RegisterType((*InboundVote)(nil), "osmosis.bridge.v1beta1.InboundVote")
+	proto.RegisterType((*InboundTransfer)(nil), "osmosis.bridge.v1beta1.InboundTransfer")
+	proto.RegisterType((*InboundVoteTally)(nil), "osmosis.bridge.v1beta1.InboundVoteTally")
+	proto.RegisterType((*RateLimit)(nil), "osmosis.bridge.v1beta1.RateLimit")
+	proto.RegisterType((*AssetRateLimits)(nil), "osmosis.bridge.v1beta1.AssetRateLimits")
+	proto.RegisterType((*Flow)(nil), "osmosis.bridge.v1beta1.Flow")
+	proto.RegisterType((*FlowBucket)(nil), "osmosis.bridge.v1beta1.FlowBucket")
+	proto.RegisterType((*AssetFlows)(nil), "osmosis.bridge.v1beta1.AssetFlows")
+	proto.RegisterType((*RateLimitCapacity)(nil), "osmosis.bridge.v1beta1.RateLimitCapacity")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/bridge/v1beta1/bridge.proto", fileDescriptor_f999ddf08452f1f3)
+}
+
+var fileDescriptor_f999ddf08452f1f3 = []byte{
+	// 1259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x49, 0x1a, 0x3f, 0xa7, 0x8d, 0x33, 0xfd, 0x65, 0xe7, 0xdb, 0xda, 0xee, 0x54,
+	0xfa, 0x12, 0x10, 0xd8, 0xd4, 0x45, 0x42, 0xf4, 0x44, 0x36, 0x71, 0xa9, 0xd5, 0x28, 0xae, 0xc6,
+	0x4e, 0x2b, 0x81, 0xc4, 0x6a, 0xec, 0x9d, 0xd8, 0xa3, 0x7a, 0x77, 0xad, 0xdd, 0x71, 0x49, 0x38,
+	0xc1, 0x7f, 0x50, 0x6e, 0x70, 0x42, 0x48, 0xfc, 0x07, 0x5c, 0xe1, 0xde, 0x63, 0x8f, 0x88, 0x83,
+	0x41, 0xed, 0x9d, 0x83, 0x8f, 0x1c, 0x10, 0xda, 0x99, 0x59, 0xef, 0x3a, 0x34, 0x71, 0x51, 0x73,
+	0xf3, 0x9b, 0xf7, 0xde, 0x67, 0xde, 0x7b, 0x9f, 0xcf, 0xcc, 0xac, 0xe1, 0xa6, 0x17, 0x38, 0x5e,
+	0xc0, 0x83, 0x6a, 0xc7, 0xe7, 0x76, 0x8f, 0x55, 0x9f, 0xdc, 0xea, 0x30, 0x41, 0x6f, 0x69, 0xb3,
+	0x32, 0xf4, 0x3d, 0xe1, 0xa1, 0x2b, 0x3a, 0xa8, 0xa2, 0x57, 0x75, 0xd0, 0xc6, 0xa5, 0x9e, 0xd7,
+	0xf3, 0x64, 0x48, 0x35, 0xfc, 0xa5, 0xa2, 0x37, 0x8a, 0x3d, 0xcf, 0xeb, 0x0d, 0x58, 0x55, 0x5a,
+	0x9d, 0xd1, 0x41, 0xd5, 0x1e, 0xf9, 0x54, 0x70, 0xcf, 0xd5, 0xfe, 0xd2, 0x71, 0xbf, 0xe0, 0x0e,
+	0x0b, 0x04, 0x75, 0x86, 0x2a, 0x00, 0xff, 0x95, 0x82, 0xe5, 0x07, 0xd4, 0xa7, 0x4e, 0x80, 0xde,
+	0x85, 0x73, 0x01, 0xef, 0xb9, 0xcc, 0x0f, 0xf2, 0x46, 0x39, 0xbd, 0x99, 0x31, 0xd1, 0x64, 0x5c,
+	0xba, 0x70, 0x44, 0x9d, 0xc1, 0x1d, 0xac, 0x1d, 0x98, 0x44, 0x21, 0xe8, 0x21, 0x2c, 0xd3, 0x20,
+	0x60, 0x22, 0xc8, 0xa7, 0xca, 0xe9, 0xcd, 0x6c, 0xed, 0xad, 0xca, 0xab, 0x0b, 0xaf, 0x6c, 0x85,
+	0x51, 0x8f, 0xb8, 0xe8, 0xb7, 0x04, 0x15, 0xa3, 0xc0, 0xbc, 0xfc, 0x6c, 0x5c, 0x5a, 0x98, 0x8c,
+	0x4b, 0xe7, 0x15, 0xb2, 0x02, 0xc1, 0x44, 0xa3, 0xa1, 0x3b, 0xb0, 0xfa, 0xc4, 0x13, 0x2c, 0xb0,
+	0x5c, 0xc6, 0x6c, 0x66, 0xe7, 0xd3, 0x65, 0x63, 0x73, 0xd1, 0xbc, 0x3a, 0x19, 0x97, 0x2e, 0xaa,
+	0x84, 0xa4, 0x17, 0x93, 0xac, 0x34, 0xf7, 0xa4, 0x85, 0x3e, 0x04, 0x69, 0x5a, 0xec, 0x70, 0xc8,
+	0xfd, 0xa3, 0xfc, 0xa2, 0x4c, 0xbd, 0x32, 0x19, 0x97, 0x50, 0x9c, 0xaa, 0x9d, 0x98, 0x40, 0x68,
+	0xd5, 0xa5, 0x81, 0x46, 0xb0, 0x2e, 0xb7, 0xb7, 0x7c, 0x2a, 0x98, 0x35, 0xe0, 0x0e, 0x17, 0x41,
+	0x7e, 0xe9, 0x35, 0xfa, 0x22, 0x54, 0xb0, 0x5d, 0x19, 0x6e, 0x96, 0x75, 0x5f, 0xf9, 0x44, 0x5f,
+	0x49, 0x3c, 0x4c, 0xd6, 0xe8, 0x6c, 0x0a, 0xfe, 0xc5, 0x80, 0xb5, 0x63, 0xe3, 0x41, 0x0d, 0x58,
+	0x92, 0x61, 0x79, 0xa3, 0x6c, 0x6c, 0x66, 0x6b, 0xd7, 0x4f, 0xdd, 0xde, 0xbc, 0xa4, 0x37, 0x5d,
+	0x4d, 0x6c, 0x8a, 0x89, 0x42, 0x40, 0x16, 0xac, 0xaa, 0x2a, 0x02, 0x09, 0x9d, 0x4f, 0x95, 0x8d,
+	0xcd, 0x0b, 0xb5, 0x9b, 0xa7, 0x22, 0x6a, 0x92, 0x12, 0xf3, 0x4e, 0x42, 0x60, 0x92, 0xa5, 0x71,
+	0x14, 0xfe, 0xde, 0x80, 0x25, 0x99, 0x15, 0xb2, 0x16, 0x78, 0x23, 0xbf, 0xcb, 0xac, 0x6e, 0x9f,
+	0x72, 0x57, 0x16, 0x9f, 0x49, 0xa2, 0x24, 0xbd, 0x98, 0x64, 0x95, 0xb9, 0x1d, 0x5a, 0xe8, 0xff,
+	0xb0, 0x64, 0x33, 0xd7, 0x73, 0x64, 0x7d, 0x19, 0x33, 0x17, 0xb7, 0x23, 0x97, 0x31, 0x51, 0x6e,
+	0x54, 0x83, 0xcc, 0xd0, 0x67, 0x5d, 0x1e, 0x70, 0xcf, 0xd5, 0xb2, 0xb8, 0x34, 0x19, 0x97, 0x72,
+	0x2a, 0x76, 0xea, 0xc2, 0x24, 0x0e, 0xc3, 0x7f, 0x1b, 0x90, 0x6d, 0xb8, 0x1d, 0x6f, 0xe4, 0xda,
+	0x0f, 0x3d, 0xc1, 0xd0, 0xdb, 0xb0, 0xac, 0x04, 0xac, 0x2b, 0x5c, 0x8f, 0x85, 0xa8, 0xd6, 0x31,
+	0xd1, 0x01, 0xe8, 0x16, 0x64, 0x6c, 0x16, 0x08, 0x8b, 0xda, 0xb6, 0xaf, 0x4b, 0x4b, 0x6c, 0x37,
+	0x75, 0x61, 0xb2, 0x12, 0xfe, 0xde, 0xb2, 0x6d, 0x3f, 0xe6, 0x2e, 0xfd, 0xc6, 0xdc, 0xdd, 0x85,
+	0x65, 0xea, 0x78, 0x23, 0x57, 0x48, 0x15, 0x67, 0xcc, 0x4a, 0x18, 0xfc, 0xdb, 0xb8, 0x74, 0xb9,
+	0x2b, 0x31, 0x03, 0xfb, 0x71, 0x85, 0x7b, 0x55, 0x87, 0x8a, 0x7e, 0xa5, 0xe1, 0x8a, 0xc4, 0x71,
+	0x92, 0x49, 0xe1, 0x71, 0x52, 0x3f, 0xbe, 0x49, 0xc1, 0x9a, 0x1e, 0x40, 0xdb, 0xa7, 0x6e, 0x70,
+	0xc0, 0xfc, 0xf0, 0x98, 0xb0, 0x43, 0xc1, 0x7c, 0x97, 0x0e, 0x2c, 0x6e, 0xeb, 0x49, 0x24, 0x8e,
+	0x49, 0xc2, 0x89, 0x09, 0x44, 0x56, 0xc3, 0x46, 0x4d, 0x58, 0x92, 0xc7, 0x4d, 0x1f, 0xf9, 0x13,
+	0x95, 0x94, 0x98, 0xf8, 0xf1, 0x2e, 0x65, 0x3e, 0x26, 0x0a, 0x27, 0xa4, 0xf4, 0x80, 0xbb, 0x74,
+	0xc0, 0xbf, 0xd4, 0x27, 0x7d, 0x25, 0x39, 0xe3, 0xa9, 0x0b, 0x93, 0x38, 0x0c, 0x7d, 0x0c, 0x17,
+	0xba, 0x3e, 0xa3, 0x82, 0xd9, 0x56, 0x9f, 0xf1, 0x5e, 0x5f, 0x4d, 0x28, 0x6d, 0x16, 0x26, 0xe3,
+	0xd2, 0x65, 0x95, 0x38, 0xeb, 0xc7, 0xe4, 0xbc, 0x5e, 0xb8, 0xa7, 0xec, 0xaf, 0x52, 0x90, 0x4b,
+	0x94, 0xd8, 0xa6, 0x83, 0xc1, 0xd1, 0x2c, 0xdd, 0xc6, 0x7f, 0xa3, 0x3b, 0x75, 0x86, 0x74, 0xa7,
+	0xdf, 0x84, 0xee, 0xf0, 0x2c, 0x29, 0x86, 0xd4, 0xdd, 0x97, 0x3b, 0x61, 0xf0, 0xf8, 0xa7, 0x14,
+	0x64, 0xa6, 0x17, 0x11, 0xba, 0x09, 0x8b, 0x2e, 0x75, 0x98, 0x6e, 0x7b, 0x6d, 0x32, 0x2e, 0x65,
+	0x55, 0x52, 0xb8, 0x8a, 0x89, 0x74, 0x22, 0x02, 0x2b, 0xd1, 0xe3, 0xa2, 0x1b, 0x2e, 0x54, 0xd4,
+	0xeb, 0x52, 0x89, 0x5e, 0x97, 0xca, 0x8e, 0x0e, 0x30, 0xff, 0xa7, 0x9b, 0x5d, 0xd3, 0xe3, 0xd3,
+	0xeb, 0xf8, 0xdb, 0xdf, 0x4b, 0x06, 0x99, 0xe2, 0xa0, 0x36, 0x64, 0x1d, 0x7a, 0x68, 0x71, 0x45,
+	0x86, 0xee, 0xfd, 0xf6, 0xbc, 0xde, 0xb5, 0x4c, 0x13, 0x99, 0x98, 0x80, 0x43, 0x0f, 0x35, 0xa7,
+	0xe8, 0x11, 0xac, 0x86, 0x3e, 0x6f, 0x24, 0x14, 0xac, 0x3a, 0x41, 0x1f, 0xcc, 0x83, 0xbd, 0x18,
+	0xc3, 0x46, 0xa9, 0x98, 0x84, 0xf5, 0x35, 0x23, 0xeb, 0xe7, 0xe8, 0xbe, 0x8e, 0xef, 0xf0, 0xb3,
+	0xbc, 0xaf, 0x3f, 0x87, 0x6c, 0xf2, 0xfd, 0x51, 0x87, 0xec, 0xc6, 0x49, 0x80, 0xd3, 0x1a, 0xcc,
+	0x0d, 0x0d, 0xaa, 0xe7, 0x32, 0xf3, 0xe6, 0x80, 0x1f, 0x3f, 0x37, 0x5f, 0x1b, 0xb0, 0x78, 0x77,
+	0xe0, 0x7d, 0xf1, 0x7a, 0x7c, 0xb7, 0xe1, 0x5c, 0x67, 0xd4, 0x7d, 0x1c, 0xbf, 0xf0, 0xf8, 0xa4,
+	0x4a, 0x42, 0x4c, 0x53, 0x86, 0x9a, 0x57, 0x74, 0x29, 0xfa, 0xb3, 0x41, 0x03, 0x60, 0x12, 0x41,
+	0xe1, 0x3f, 0x0d, 0x80, 0x38, 0x1e, 0x7d, 0x02, 0x8b, 0x82, 0xeb, 0x4a, 0xb2, 0xb5, 0x8d, 0x7f,
+	0x09, 0xaa, 0x1d, 0x7d, 0xae, 0x98, 0x57, 0x35, 0xb2, 0xae, 0x34, 0xcc, 0xc2, 0x4f, 0x43, 0x35,
+	0x49, 0x00, 0xd4, 0x80, 0x73, 0x91, 0x8a, 0xd4, 0x5d, 0x5d, 0x9d, 0x47, 0xb7, 0x2e, 0x71, 0xaa,
+	0xa0, 0x28, 0x1f, 0xed, 0xc2, 0xca, 0x54, 0x3a, 0x4a, 0x91, 0xef, 0xcf, 0xc3, 0xd2, 0x32, 0x8f,
+	0x65, 0x33, 0x45, 0xc0, 0x3f, 0x18, 0x00, 0x92, 0xfb, 0xb0, 0xeb, 0x33, 0x95, 0xcb, 0x3d, 0x58,
+	0x3a, 0x08, 0x31, 0x35, 0x3d, 0xd7, 0x4e, 0xa5, 0xe7, 0x18, 0x92, 0x4c, 0xc4, 0x44, 0x01, 0xe0,
+	0xef, 0xd2, 0xb0, 0x3e, 0x95, 0xd3, 0x36, 0x1d, 0xd2, 0x2e, 0x17, 0x47, 0xe8, 0x33, 0x80, 0x58,
+	0x4a, 0xba, 0xde, 0xd7, 0x50, 0x63, 0x41, 0xef, 0xb4, 0x7e, 0x5c, 0x8d, 0x98, 0x64, 0xa6, 0x62,
+	0x44, 0x07, 0xb0, 0xae, 0xe7, 0x6d, 0xf9, 0xcc, 0xa1, 0xdc, 0xe5, 0x6e, 0x4f, 0x33, 0xf7, 0xd1,
+	0xbc, 0x69, 0xe7, 0x67, 0x98, 0x8b, 0xf3, 0x31, 0xc9, 0xe9, 0x35, 0x12, 0x2d, 0x21, 0x0e, 0x28,
+	0xa2, 0x22, 0xb1, 0x91, 0xa2, 0xf5, 0xce, 0xbc, 0x8d, 0x0a, 0xb3, 0xb4, 0x26, 0x77, 0x5a, 0x8f,
+	0x16, 0xe3, 0xad, 0xda, 0x00, 0x43, 0xe6, 0x73, 0xcf, 0xb6, 0x98, 0xbe, 0x74, 0x4e, 0x57, 0x74,
+	0x21, 0x1e, 0x52, 0x9c, 0xa7, 0x34, 0x9d, 0x51, 0x0b, 0x75, 0xd7, 0x7e, 0xe7, 0x47, 0x03, 0xb2,
+	0x89, 0x2f, 0x33, 0x74, 0x0d, 0xf2, 0x5b, 0xad, 0x56, 0xbd, 0x6d, 0xb5, 0xda, 0x5b, 0xed, 0xfd,
+	0x96, 0xb5, 0xbf, 0xd7, 0x7a, 0x50, 0xdf, 0x6e, 0xdc, 0x6d, 0xd4, 0x77, 0x72, 0x0b, 0xe8, 0x22,
+	0xac, 0xcd, 0x78, 0x9b, 0xf7, 0x73, 0x06, 0x2a, 0xc3, 0xb5, 0x99, 0x45, 0x73, 0xb7, 0xb9, 0x7d,
+	0xbf, 0xbe, 0x63, 0x35, 0xf6, 0xcc, 0xe6, 0xfe, 0xde, 0x4e, 0x2e, 0x85, 0x6e, 0xc0, 0xf5, 0x57,
+	0x46, 0x34, 0xf7, 0xdb, 0x2a, 0x24, 0x8d, 0xae, 0x43, 0xe1, 0x95, 0x21, 0x66, 0xb3, 0x7d, 0x2f,
+	0xb7, 0x68, 0xee, 0x3e, 0x7b, 0x51, 0x34, 0x9e, 0xbf, 0x28, 0x1a, 0x7f, 0xbc, 0x28, 0x1a, 0x4f,
+	0x5f, 0x16, 0x17, 0x9e, 0xbf, 0x2c, 0x2e, 0xfc, 0xfa, 0xb2, 0xb8, 0xf0, 0x69, 0xad, 0xc7, 0x45,
+	0x7f, 0xd4, 0xa9, 0x74, 0x3d, 0xa7, 0xaa, 0xc5, 0xf3, 0xde, 0x80, 0x76, 0x82, 0xc8, 0xa8, 0x3e,
+	0xa9, 0xdd, 0xae, 0x1e, 0x46, 0xff, 0x89, 0xc4, 0xd1, 0x90, 0x05, 0x9d, 0x65, 0x39, 0xae, 0xdb,
+	0xff, 0x0c, 0x00, 0x3b, 0x76, 0xd0, 0xc2, 0x32, 0x0d, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourceChain)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	if len(m.AssetRateLimits) > 0 {
+		for iNdEx := len(m.AssetRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	if m.VoteExpiry != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.VoteExpiry))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Precision != 0 {
-		n += 1 + sovBridge(uint64(m.Precision))
+	if m.VotesNeeded != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.VotesNeeded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintBridge(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetWithStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetWithStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetWithStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AssetStatus != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.AssetStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Asset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Precision != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DestAddr) > 0 {
+		i -= len(m.DestAddr)
+		copy(dAtA[i:], m.DestAddr)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.DestAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundVoteTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundVoteTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundVoteTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Votes != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DestAddr) > 0 {
+		i -= len(m.DestAddr)
+		copy(dAtA[i:], m.DestAddr)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.DestAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxOutbound.Size()
+		i -= size
+		if _, err := m.MaxOutbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxInbound.Size()
+		i -= size
+		if _, err := m.MaxInbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBridge(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRateLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRateLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRateLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Flow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Flow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outbound.Size()
+		i -= size
+		if _, err := m.Outbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inbound.Size()
+		i -= size
+		if _, err := m.Inbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBridge(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetFlows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetFlows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetFlows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodEnd != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodEnd):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintBridge(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.OutboundRemaining.Size()
+		i -= size
+		if _, err := m.OutboundRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InboundRemaining.Size()
+		i -= size
+		if _, err := m.InboundRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	if m.VotesNeeded != 0 {
+		n += 1 + sovBridge(uint64(m.VotesNeeded))
+	}
+	if m.VoteExpiry != 0 {
+		n += 1 + sovBridge(uint64(m.VoteExpiry))
+	}
+	if len(m.AssetRateLimits) > 0 {
+		for _, e := range m.AssetRateLimits {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetWithStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.AssetStatus != 0 {
+		n += 1 + sovBridge(uint64(m.AssetStatus))
+	}
+	return n
+}
+
+func (m *Asset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovBridge(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *InboundVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.DestAddr)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *InboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovBridge(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *InboundVoteTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestAddr)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.Votes != 0 {
+		n += 1 + sovBridge(uint64(m.Votes))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.MaxInbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.MaxOutbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *AssetRateLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Flow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.Inbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.Outbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *AssetFlows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.InboundRemaining.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.OutboundRemaining.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.PeriodEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodEnd)
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func sovBridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridge(x uint64) (n int) {
+	return sovBridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, AssetWithStatus{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesNeeded", wireType)
+			}
+			m.VotesNeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesNeeded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExpiry", wireType)
+			}
+			m.VoteExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRateLimits = append(m.AssetRateLimits, AssetRateLimits{})
+			if err := m.AssetRateLimits[len(m.AssetRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetWithStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetWithStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetWithStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetStatus", wireType)
+			}
+			m.AssetStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetStatus |= AssetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Asset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, InboundVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundVoteTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundVoteTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundVoteTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRateLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRateLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRateLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovBridge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBridge(x uint64) (n int) {
-	return sovBridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Flow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -498,15 +3049,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -534,11 +3085,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -565,8 +3116,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, AssetWithStatus{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, FlowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -591,7 +3142,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetWithStatus) Unmarshal(dAtA []byte) error {
+func (m *FlowBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -614,10 +3165,161 @@ func (m *AssetWithStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetWithStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlowBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetWithStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetFlows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetFlows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetFlows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -654,10 +3356,10 @@ func (m *AssetWithStatus) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
 			}
-			m.AssetStatus = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -667,11 +3369,26 @@ func (m *AssetWithStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetStatus |= AssetStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, Flow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -693,7 +3410,7 @@ func (m *AssetWithStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Asset) Unmarshal(dAtA []byte) error {
+func (m *RateLimitCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -716,15 +3433,48 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRemaining", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -752,11 +3502,13 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			if err := m.InboundRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRemaining", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -784,13 +3536,15 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.OutboundRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
 			}
-			m.Precision = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -800,11 +3554,28 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Precision |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodEnd == nil {
+				m.PeriodEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -0,0 +1,26 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/bridge module sentinel errors
+var (
+	ErrInvalidAsset       = errorsmod.Register(ModuleName, 2, "invalid asset")
+	ErrInvalidAssetStatus = errorsmod.Register(ModuleName, 3, "invalid asset status")
+	ErrInvalidSigners     = errorsmod.Register(ModuleName, 4, "invalid signers")
+	ErrInvalidParams      = errorsmod.Register(ModuleName, 5, "invalid params")
+	ErrAssetNotFound      = errorsmod.Register(ModuleName, 6, "asset not found")
+	ErrInboundDisabled    = errorsmod.Register(ModuleName, 7, "inbound transfers are disabled for the asset")
+	ErrOutboundDisabled   = errorsmod.Register(ModuleName, 8, "outbound transfers are disabled for the asset")
+	ErrUnauthorized       = errorsmod.Register(ModuleName, 9, "unauthorized account")
+	ErrCantCreateAsset    = errorsmod.Register(ModuleName, 10, "can't create asset")
+	ErrAlreadyVoted       = errorsmod.Register(ModuleName, 11, "signer already voted for the inbound transfer")
+	ErrTransferFinalized  = errorsmod.Register(ModuleName, 12, "inbound transfer is already finalized")
+	ErrTransferNotFound   = errorsmod.Register(ModuleName, 13, "inbound transfer not found")
+	ErrInvalidTransfer    = errorsmod.Register(ModuleName, 14, "invalid inbound transfer")
+	ErrInvalidRateLimits  = errorsmod.Register(ModuleName, 15, "invalid rate limits")
+	ErrRateLimitExceeded  = errorsmod.Register(ModuleName, 16, "rate limit exceeded")
+)
@@ -25,7 +25,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type EventInboundTransfer struct {
-	// Sender is a sender's address
+	// Sender is the address of the signer which finalized the transfer
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// DestAddr is a destination Osmosis address
 	DestAddr string `protobuf:"bytes,2,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
@@ -33,6 +33,8 @@ type EventInboundTransfer struct {
 	Asset Asset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset"`
 	// Amount of coins to transfer
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// ExternalId is a unique transfer id on the source chain
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
 func (m *EventInboundTransfer) Reset()         { *m = EventInboundTransfer{} }
@@ -89,6 +91,141 @@ func (m *EventInboundTransfer) GetAsset() Asset {
 	return Asset{}
 }
 
+func (m *EventInboundTransfer) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+type EventInboundVote struct {
+	// Signer is a signer's address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ExternalId is a unique transfer id on the source chain
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// DestAddr is a destination Osmosis address
+	DestAddr string `protobuf:"bytes,3,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	// Asset contains a source chain and a target denom
+	Asset Asset `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset"`
+	// Amount of coins to transfer
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EventInboundVote) Reset()         { *m = EventInboundVote{} }
+func (m *EventInboundVote) String() string { return proto.CompactTextString(m) }
+func (*EventInboundVote) ProtoMessage()    {}
+func (*EventInboundVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b63bd2a1c2ae24, []int{1}
+}
+func (m *EventInboundVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInboundVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInboundVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInboundVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInboundVote.Merge(m, src)
+}
+func (m *EventInboundVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInboundVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInboundVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInboundVote proto.InternalMessageInfo
+
+func (m *EventInboundVote) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventInboundVote) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventInboundVote) GetDestAddr() string {
+	if m != nil {
+		return m.DestAddr
+	}
+	return ""
+}
+
+func (m *EventInboundVote) GetAsset() Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return Asset{}
+}
+
+type EventInboundTransferExpired struct {
+	// ExternalId is a unique transfer id on the source chain
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// Votes is a number of votes submitted before the expiry
+	Votes uint64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *EventInboundTransferExpired) Reset()         { *m = EventInboundTransferExpired{} }
+func (m *EventInboundTransferExpired) String() string { return proto.CompactTextString(m) }
+func (*EventInboundTransferExpired) ProtoMessage()    {}
+func (*EventInboundTransferExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61b63bd2a1c2ae24, []int{2}
+}
+func (m *EventInboundTransferExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInboundTransferExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInboundTransferExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInboundTransferExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInboundTransferExpired.Merge(m, src)
+}
+func (m *EventInboundTransferExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInboundTransferExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInboundTransferExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInboundTransferExpired proto.InternalMessageInfo
+
+func (m *EventInboundTransferExpired) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventInboundTransferExpired) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
 type EventOutboundTransfer struct {
 	// Sender is a sender's address
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -104,7 +241,7 @@ func (m *EventOutboundTransfer) Reset()         { *m = EventOutboundTransfer{} }
 func (m *EventOutboundTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventOutboundTransfer) ProtoMessage()    {}
 func (*EventOutboundTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_61b63bd2a1c2ae24, []int{1}
+	return fileDescriptor_61b63bd2a1c2ae24, []int{3}
 }
 func (m *EventOutboundTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +304,7 @@ func (m *EventUpdateParams) Reset()         { *m = EventUpdateParams{} }
 func (m *EventUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateParams) ProtoMessage()    {}
 func (*EventUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_61b63bd2a1c2ae24, []int{2}
+	return fileDescriptor_61b63bd2a1c2ae24, []int{4}
 }
 func (m *EventUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +387,7 @@ func (m *EventChangeAssetStatus) Reset()         { *m = EventChangeAssetStatus{}
 func (m *EventChangeAssetStatus) String() string { return proto.CompactTextString(m) }
 func (*EventChangeAssetStatus) ProtoMessage()    {}
 func (*EventChangeAssetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_61b63bd2a1c2ae24, []int{3}
+	return fileDescriptor_61b63bd2a1c2ae24, []int{5}
 }
 func (m *EventChangeAssetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,6 +439,8 @@ func (m *EventChangeAssetStatus) GetNewAssetStatus() AssetWithStatus {
 
 func init() {
 	proto.RegisterType((*EventInboundTransfer)(nil), "osmosis.bridge.v1beta1.EventInboundTransfer")
+	proto.RegisterType((*EventInboundVote)(nil), "osmosis.bridge.v1beta1.EventInboundVote")
+	proto.RegisterType((*EventInboundTransferExpired)(nil), "osmosis.bridge.v1beta1.EventInboundTransferExpired")
 	proto.RegisterType((*EventOutboundTransfer)(nil), "osmosis.bridge.v1beta1.EventOutboundTransfer")
 	proto.RegisterType((*EventUpdateParams)(nil), "osmosis.bridge.v1beta1.EventUpdateParams")
 	proto.RegisterType((*EventChangeAssetStatus)(nil), "osmosis.bridge.v1beta1.EventChangeAssetStatus")
@@ -312,38 +451,43 @@ func init() {
 }
 
 var fileDescriptor_61b63bd2a1c2ae24 = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0xa5, 0xab, 0xa8, 0x2b, 0xc6, 0x88, 0xb6, 0x2a, 0x1a, 0x5a, 0x5a, 0x95, 0xc3,
-	0x7a, 0x21, 0xd1, 0x3a, 0x71, 0xe0, 0xb8, 0x21, 0x0e, 0x93, 0x26, 0x81, 0xba, 0xa1, 0x4a, 0x5c,
-	0x2a, 0xa7, 0x7e, 0xa4, 0x11, 0x8d, 0x5d, 0xf9, 0x39, 0x2d, 0xdc, 0xf8, 0x08, 0x7c, 0x21, 0x38,
-	0xef, 0xb8, 0x23, 0xe2, 0x30, 0xa1, 0xf4, 0x8b, 0xa0, 0x38, 0xce, 0xe8, 0x81, 0x21, 0xd1, 0x1b,
-	0xb7, 0xf8, 0xef, 0x9f, 0x7f, 0x7e, 0x2f, 0xb6, 0x4c, 0x9f, 0x4a, 0x4c, 0x25, 0x26, 0x18, 0x46,
-	0x2a, 0xe1, 0x31, 0x84, 0x8b, 0xe3, 0x08, 0x34, 0x3b, 0x0e, 0x61, 0x01, 0x42, 0x63, 0x30, 0x57,
-	0x52, 0x4b, 0xb7, 0x6d, 0xa1, 0xa0, 0x84, 0x02, 0x0b, 0x1d, 0xec, 0xc5, 0x32, 0x96, 0x06, 0x09,
-	0x8b, 0xaf, 0x92, 0x3e, 0xb8, 0x4f, 0x69, 0x17, 0x1b, 0xa8, 0xf7, 0x95, 0xd0, 0xbd, 0x57, 0xc5,
-	0x1e, 0xe7, 0x22, 0x92, 0x99, 0xe0, 0x57, 0x8a, 0x09, 0x7c, 0x0f, 0xca, 0x6d, 0xd3, 0x06, 0x82,
-	0xe0, 0xa0, 0x3c, 0xd2, 0x25, 0xfd, 0xe6, 0xd0, 0x8e, 0xdc, 0x27, 0xb4, 0xc9, 0x01, 0xf5, 0x98,
-	0x71, 0xae, 0xbc, 0x2d, 0x33, 0xf5, 0xa0, 0x08, 0x4e, 0x39, 0x57, 0xee, 0x0b, 0xba, 0xcd, 0x10,
-	0x41, 0x7b, 0x4e, 0x97, 0xf4, 0x5b, 0x83, 0xc3, 0xe0, 0xcf, 0x05, 0x07, 0xa7, 0x05, 0x74, 0x56,
-	0xbf, 0xbe, 0xed, 0xd4, 0x86, 0xe5, 0x0a, 0xf7, 0x39, 0x6d, 0xb0, 0x54, 0x66, 0x42, 0x7b, 0xf5,
-	0x42, 0x7a, 0x76, 0x58, 0x4c, 0xfe, 0xb8, 0xed, 0xec, 0x4f, 0x8c, 0x03, 0xf9, 0x87, 0x20, 0x91,
-	0x61, 0xca, 0xf4, 0x34, 0x38, 0x17, 0x7a, 0x68, 0xe1, 0xde, 0x37, 0x42, 0xf7, 0x4d, 0xfd, 0xaf,
-	0x33, 0xfd, 0x5f, 0x36, 0xf0, 0xd9, 0xa1, 0x8f, 0x4d, 0x03, 0x6f, 0xe7, 0x9c, 0x69, 0x78, 0xc3,
-	0x14, 0x4b, 0xd1, 0xed, 0xd0, 0x96, 0x80, 0xe5, 0x18, 0x93, 0x58, 0x80, 0x42, 0x8f, 0x74, 0x9d,
-	0x7e, 0x73, 0x48, 0x05, 0x2c, 0x2f, 0xcb, 0xc4, 0x3d, 0xa2, 0x8f, 0x26, 0x0a, 0x98, 0x06, 0x7e,
-	0x07, 0x6d, 0x19, 0x68, 0xc7, 0xc6, 0x6b, 0x20, 0x87, 0x19, 0xac, 0x83, 0x4e, 0x09, 0xda, 0xb8,
-	0x02, 0x2f, 0x68, 0xe1, 0x1f, 0x9b, 0x66, 0xd0, 0xab, 0x77, 0x9d, 0x7e, 0x6b, 0x70, 0xf4, 0xd7,
-	0xfe, 0x47, 0x89, 0x9e, 0x5e, 0x6a, 0xa6, 0x33, 0xb4, 0x7f, 0xa2, 0x29, 0x60, 0x69, 0x66, 0xd0,
-	0xbd, 0xa2, 0x55, 0x21, 0x95, 0x71, 0x7b, 0x13, 0xe3, 0x43, 0x2b, 0xf9, 0x6d, 0xad, 0x9a, 0xb1,
-	0xd6, 0xc6, 0x46, 0x56, 0x2b, 0x29, 0xad, 0xbd, 0x9c, 0xd0, 0xb6, 0x39, 0x82, 0x97, 0x53, 0x26,
-	0x62, 0x30, 0x69, 0xc9, 0xdf, 0x7b, 0x89, 0x46, 0x74, 0x57, 0xce, 0x6c, 0x11, 0x63, 0x34, 0xac,
-	0xb9, 0x4b, 0xff, 0x5c, 0xca, 0x8e, 0x9c, 0xf1, 0xf5, 0x0d, 0x47, 0x74, 0xf7, 0xee, 0x14, 0x2a,
-	0xb1, 0xb3, 0x91, 0xb8, 0x3a, 0x0b, 0x9b, 0x5e, 0x5c, 0xe7, 0x3e, 0xb9, 0xc9, 0x7d, 0xf2, 0x33,
-	0xf7, 0xc9, 0x97, 0x95, 0x5f, 0xbb, 0x59, 0xf9, 0xb5, 0xef, 0x2b, 0xbf, 0xf6, 0x6e, 0x10, 0x27,
-	0x7a, 0x9a, 0x45, 0xc1, 0x44, 0xa6, 0xa1, 0xdd, 0xe2, 0xd9, 0x8c, 0x45, 0x58, 0x0d, 0xc2, 0xc5,
-	0xe0, 0x24, 0xfc, 0x58, 0xbd, 0x22, 0xfa, 0xd3, 0x1c, 0x30, 0x6a, 0x98, 0xd7, 0xe3, 0xe4, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x46, 0x44, 0x09, 0xb7, 0x04, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x4f, 0x6f, 0xda, 0x30,
+	0x18, 0x87, 0x31, 0x01, 0x34, 0x8c, 0xd6, 0xb1, 0x88, 0xa2, 0xa8, 0x55, 0x03, 0xca, 0x0e, 0xe5,
+	0xb2, 0x44, 0xa5, 0xda, 0x61, 0xc7, 0x76, 0xea, 0x01, 0xa9, 0xd2, 0x26, 0xca, 0x86, 0xb4, 0x0b,
+	0x72, 0xf0, 0xbb, 0x10, 0x0d, 0x6c, 0x14, 0x9b, 0x3f, 0xbb, 0xed, 0x23, 0xec, 0x13, 0xed, 0xdc,
+	0x63, 0x8f, 0x53, 0x0f, 0x68, 0x82, 0x2f, 0x32, 0xc5, 0x71, 0x3a, 0xc6, 0xca, 0xa4, 0xb2, 0x53,
+	0x6f, 0xf8, 0xf5, 0xc3, 0xe3, 0xf7, 0xe7, 0xd7, 0x0a, 0x7e, 0xc1, 0xc5, 0x88, 0x8b, 0x50, 0x78,
+	0x7e, 0x14, 0xd2, 0x00, 0xbc, 0xe9, 0x89, 0x0f, 0x92, 0x9c, 0x78, 0x30, 0x05, 0x26, 0x85, 0x3b,
+	0x8e, 0xb8, 0xe4, 0x66, 0x55, 0x43, 0x6e, 0x02, 0xb9, 0x1a, 0x3a, 0xa8, 0x04, 0x3c, 0xe0, 0x0a,
+	0xf1, 0xe2, 0x5f, 0x09, 0x7d, 0xb0, 0x4d, 0xa9, 0xff, 0xac, 0x20, 0x67, 0x81, 0x70, 0xe5, 0x22,
+	0x3e, 0xa3, 0xc5, 0x7c, 0x3e, 0x61, 0xb4, 0x13, 0x11, 0x26, 0x3e, 0x41, 0x64, 0x56, 0x71, 0x41,
+	0x00, 0xa3, 0x10, 0x59, 0xa8, 0x8e, 0x1a, 0xc5, 0xb6, 0x5e, 0x99, 0x87, 0xb8, 0x48, 0x41, 0xc8,
+	0x1e, 0xa1, 0x34, 0xb2, 0xb2, 0x6a, 0xeb, 0x49, 0x5c, 0x38, 0xa3, 0x34, 0x32, 0x5f, 0xe3, 0x3c,
+	0x11, 0x02, 0xa4, 0x65, 0xd4, 0x51, 0xa3, 0xd4, 0x3c, 0x72, 0xef, 0x6f, 0xd8, 0x3d, 0x8b, 0xa1,
+	0xf3, 0xdc, 0xf5, 0xa2, 0x96, 0x69, 0x27, 0xff, 0x30, 0x5f, 0xe1, 0x02, 0x19, 0xf1, 0x09, 0x93,
+	0x56, 0x2e, 0x96, 0x9e, 0x1f, 0xc5, 0x9b, 0xb7, 0x8b, 0xda, 0x7e, 0x5f, 0x39, 0x04, 0xfd, 0xec,
+	0x86, 0xdc, 0x1b, 0x11, 0x39, 0x70, 0x5b, 0x4c, 0xb6, 0x35, 0x6c, 0xd6, 0x70, 0x09, 0xe6, 0x12,
+	0x22, 0x46, 0x86, 0xbd, 0x90, 0x5a, 0x79, 0xd5, 0x10, 0x4e, 0x4b, 0x2d, 0xea, 0xdc, 0x22, 0x5c,
+	0x5e, 0x0f, 0xf8, 0x81, 0x4b, 0x50, 0xe1, 0xc2, 0x80, 0xad, 0x85, 0x53, 0xab, 0x4d, 0x5b, 0x76,
+	0xd3, 0xf6, 0x67, 0x7a, 0x63, 0x5b, 0xfa, 0xdc, 0x7f, 0xa4, 0xcf, 0x3f, 0x20, 0xbd, 0xd3, 0xc1,
+	0x87, 0xf7, 0x0d, 0xef, 0x62, 0x3e, 0x0e, 0x23, 0xa0, 0x9b, 0x71, 0xd0, 0x5f, 0x71, 0x2a, 0x38,
+	0x3f, 0xe5, 0x12, 0x84, 0x4a, 0x9a, 0x6b, 0x27, 0x0b, 0xe7, 0x3b, 0xc2, 0xfb, 0x4a, 0xfb, 0x76,
+	0x22, 0x1f, 0xe3, 0xa3, 0x70, 0xbe, 0x1a, 0xf8, 0xb9, 0x0a, 0xf0, 0x7e, 0x4c, 0x89, 0x84, 0x77,
+	0x24, 0x22, 0x23, 0x11, 0xdf, 0x06, 0x83, 0x59, 0x2f, 0x19, 0xb5, 0xb0, 0x50, 0xdd, 0x88, 0x6f,
+	0x83, 0xc1, 0xec, 0x2a, 0xa9, 0x98, 0xc7, 0xf8, 0x59, 0x3f, 0x02, 0x22, 0x81, 0xde, 0x41, 0x59,
+	0x05, 0xed, 0xe9, 0xf2, 0x1a, 0x48, 0x61, 0x08, 0xeb, 0xa0, 0x91, 0x80, 0xba, 0x9c, 0x82, 0x97,
+	0x38, 0xf6, 0xf7, 0x54, 0x18, 0x61, 0xe5, 0xea, 0x46, 0xa3, 0xd4, 0x3c, 0xfe, 0x67, 0xfe, 0x6e,
+	0x28, 0x07, 0x57, 0x92, 0xc8, 0x89, 0xd0, 0x37, 0x51, 0x64, 0x30, 0x53, 0x3b, 0xc2, 0xec, 0xe0,
+	0xb4, 0x91, 0xd4, 0x98, 0xdf, 0xc5, 0xf8, 0x54, 0x4b, 0x7e, 0x5b, 0xd3, 0x30, 0xda, 0x5a, 0xd8,
+	0xc9, 0xaa, 0x25, 0x89, 0xd5, 0x59, 0x22, 0x5c, 0x55, 0x23, 0x78, 0x33, 0x20, 0x2c, 0x00, 0x55,
+	0x4d, 0xf8, 0xad, 0x8f, 0xa8, 0x8b, 0xcb, 0x7c, 0xa8, 0x9b, 0xe8, 0x09, 0xc5, 0xaa, 0xb7, 0xf4,
+	0xe0, 0x56, 0xf6, 0xf8, 0x90, 0xae, 0x1f, 0xd8, 0xc5, 0xe5, 0xbb, 0x29, 0xa4, 0x62, 0x63, 0x27,
+	0x71, 0x3a, 0x0b, 0x5d, 0xbd, 0xbc, 0x5e, 0xda, 0xe8, 0x66, 0x69, 0xa3, 0x9f, 0x4b, 0x1b, 0x7d,
+	0x5b, 0xd9, 0x99, 0x9b, 0x95, 0x9d, 0xf9, 0xb1, 0xb2, 0x33, 0x1f, 0x9b, 0x41, 0x28, 0x07, 0x13,
+	0xdf, 0xed, 0xf3, 0x91, 0xa7, 0x8f, 0x78, 0x39, 0x24, 0xbe, 0x48, 0x17, 0xde, 0xb4, 0x79, 0xea,
+	0xcd, 0xd3, 0x2f, 0xb3, 0xfc, 0x32, 0x06, 0xe1, 0x17, 0xd4, 0x17, 0xf9, 0xf4, 0xd7, 0x00, 0xed,
+	0xfc, 0xde, 0x83, 0x0b, 0x06, 0x00, 0x00,
 }
 
 func (m *EventInboundTransfer) Marshal() (dAtA []byte, err error) {
@@ -366,6 +510,13 @@ func (m *EventInboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -403,6 +554,105 @@ func (m *EventInboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventInboundVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInboundVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInboundVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DestAddr) > 0 {
+		i -= len(m.DestAddr)
+		copy(dAtA[i:], m.DestAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventInboundTransferExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInboundTransferExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInboundTransferExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Votes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventOutboundTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -631,6 +881,51 @@ func (m *EventInboundTransfer) Size() (n int) {
 	n += 1 + l + sovEvents(uint64(l))
 	l = m.Amount.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventInboundVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventInboundTransferExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovEvents(uint64(m.Votes))
+	}
 	return n
 }
 
@@ -883,6 +1178,352 @@ func (m *EventInboundTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInboundVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInboundVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInboundVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInboundTransferExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInboundTransferExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInboundTransferExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
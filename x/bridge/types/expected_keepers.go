@@ -0,0 +1,37 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v23/x/tokenfactory/types"
+)
+
+// AccountKeeper defines the account contract that must be fulfilled when
+// creating a x/bridge keeper.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the banking contract that must be fulfilled when
+// creating a x/bridge keeper.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+}
+
+// TokenFactoryKeeper defines the x/tokenfactory contract that must be fulfilled
+// when creating a x/bridge keeper.
+type TokenFactoryKeeper interface {
+	CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error)
+}
+
+// TokenFactoryMsgServer defines the x/tokenfactory messages x/bridge relies on
+// to mint and burn bridged assets. Tokens are always minted and burned through
+// the message server, so the x/tokenfactory admin checks stay in place.
+type TokenFactoryMsgServer interface {
+	Mint(goCtx context.Context, msg *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error)
+	Burn(goCtx context.Context, msg *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error)
+}
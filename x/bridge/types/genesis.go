@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default x/bridge genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:           DefaultParams(),
+		InboundTransfers: []InboundTransfer{},
+		AssetFlows:       []AssetFlows{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.InboundTransfers))
+	for _, transfer := range gs.InboundTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seen[transfer.ExternalId]; found {
+			return errorsmod.Wrapf(ErrInvalidTransfer, "duplicated transfer %s", transfer.ExternalId)
+		}
+		seen[transfer.ExternalId] = struct{}{}
+	}
+
+	seenDenoms := make(map[string]struct{}, len(gs.AssetFlows))
+	for _, assetFlows := range gs.AssetFlows {
+		if err := assetFlows.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seenDenoms[assetFlows.Asset.Denom]; found {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "duplicated flows for asset %s", assetFlows.Asset.Name())
+		}
+		seenDenoms[assetFlows.Asset.Denom] = struct{}{}
+	}
+
+	return nil
+}
@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params defines params for x/bridge module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// InboundTransfers are both pending and finalized inbound transfers
+	InboundTransfers []InboundTransfer `protobuf:"bytes,2,rep,name=inbound_transfers,json=inboundTransfers,proto3" json:"inbound_transfers"`
+	// AssetFlows are the current rate limit flows of the assets
+	AssetFlows []AssetFlows `protobuf:"bytes,3,rep,name=asset_flows,json=assetFlows,proto3" json:"asset_flows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +73,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetInboundTransfers() []InboundTransfer {
+	if m != nil {
+		return m.InboundTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAssetFlows() []AssetFlows {
+	if m != nil {
+		return m.AssetFlows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.bridge.v1beta1.GenesisState")
 }
@@ -78,20 +96,25 @@ func init() {
 }
 
 var fileDescriptor_597144059d669411 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x71, 0x98, 0x09, 0xd5, 0x0c, 0x56, 0xa4,
-	0xe4, 0xc3, 0xc5, 0xe3, 0x0e, 0xb1, 0x23, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x86, 0x8b, 0xad,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0xbb,
-	0x9d, 0x7a, 0x01, 0x60, 0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xf5, 0x38, 0xf9,
-	0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x44, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47,
-	0xbf, 0xcc, 0xc8, 0x58, 0xbf, 0x02, 0xe6, 0xd4, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0,
-	0x13, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xab, 0xd8, 0xd8, 0x14, 0x1d, 0x01, 0x00, 0x00,
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4a, 0x03, 0x31,
+	0x1c, 0xc6, 0x2f, 0x56, 0x3a, 0xa4, 0x0e, 0x7a, 0x88, 0x1c, 0x1d, 0x62, 0xa9, 0x82, 0x5d, 0x4c,
+	0xe8, 0x75, 0x75, 0xd1, 0x41, 0x29, 0x38, 0x88, 0x3a, 0x75, 0x29, 0x89, 0x4d, 0xcf, 0x40, 0xef,
+	0x72, 0xe4, 0x9f, 0x56, 0x7d, 0x0b, 0x1f, 0xab, 0x63, 0x47, 0x27, 0x91, 0xbb, 0x47, 0xf0, 0x05,
+	0xe4, 0x72, 0x29, 0x22, 0x78, 0x5b, 0xf2, 0xe7, 0xf7, 0xfd, 0x3e, 0xf8, 0xf0, 0xa9, 0x86, 0x54,
+	0x83, 0x02, 0x26, 0x8c, 0x9a, 0x25, 0x92, 0xad, 0x86, 0x42, 0x5a, 0x3e, 0x64, 0x89, 0xcc, 0x24,
+	0x28, 0xa0, 0xb9, 0xd1, 0x56, 0x87, 0x47, 0x9e, 0xa2, 0x35, 0x45, 0x3d, 0xd5, 0x3d, 0x4c, 0x74,
+	0xa2, 0x1d, 0xc2, 0xaa, 0x57, 0x4d, 0x77, 0x4f, 0x1a, 0x9c, 0x3e, 0xec, 0xa0, 0xfe, 0x37, 0xc2,
+	0x7b, 0x37, 0x75, 0xc9, 0x83, 0xe5, 0x56, 0x86, 0x17, 0xb8, 0x9d, 0x73, 0xc3, 0x53, 0x88, 0x50,
+	0x0f, 0x0d, 0x3a, 0x31, 0xa1, 0xff, 0x97, 0xd2, 0x3b, 0x47, 0x5d, 0xed, 0xae, 0x3f, 0x8f, 0x83,
+	0x7b, 0x9f, 0x09, 0x27, 0xf8, 0x40, 0x65, 0x42, 0x2f, 0xb3, 0xd9, 0xd4, 0x1a, 0x9e, 0xc1, 0x5c,
+	0x1a, 0x88, 0x76, 0x7a, 0xad, 0x41, 0x27, 0x3e, 0x6b, 0x12, 0x8d, 0xeb, 0xc0, 0xa3, 0xe7, 0xbd,
+	0x71, 0x5f, 0xfd, 0x3d, 0x43, 0x38, 0xc6, 0x1d, 0x0e, 0x20, 0xed, 0x74, 0xbe, 0xd0, 0x2f, 0x10,
+	0xb5, 0x9c, 0xb5, 0xdf, 0x64, 0xbd, 0xac, 0xd0, 0xeb, 0x8a, 0xf4, 0x42, 0xcc, 0x7f, 0x2f, 0xb7,
+	0xeb, 0x82, 0xa0, 0x4d, 0x41, 0xd0, 0x57, 0x41, 0xd0, 0x7b, 0x49, 0x82, 0x4d, 0x49, 0x82, 0x8f,
+	0x92, 0x04, 0x93, 0x38, 0x51, 0xf6, 0x79, 0x29, 0xe8, 0x93, 0x4e, 0x99, 0x37, 0x9f, 0x2f, 0xb8,
+	0x80, 0xed, 0x87, 0xad, 0xe2, 0x11, 0x7b, 0xdd, 0x4e, 0x6a, 0xdf, 0x72, 0x09, 0xa2, 0xed, 0xa6,
+	0x1c, 0xfd, 0x0c, 0x00, 0xe6, 0xf1, 0xb0, 0x9d, 0xc5, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +137,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetFlows) > 0 {
+		for iNdEx := len(m.AssetFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InboundTransfers) > 0 {
+		for iNdEx := len(m.InboundTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +197,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InboundTransfers) > 0 {
+		for _, e := range m.InboundTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AssetFlows) > 0 {
+		for _, e := range m.AssetFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +280,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundTransfers = append(m.InboundTransfers, InboundTransfer{})
+			if err := m.InboundTransfers[len(m.InboundTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetFlows = append(m.AssetFlows, AssetFlows{})
+			if err := m.AssetFlows[len(m.AssetFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
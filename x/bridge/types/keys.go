@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "bridge"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the bridge module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// InboundTransferPrefix stores inbound transfers by their external ids
+	InboundTransferPrefix = []byte{0x01}
+
+	// PendingInboundTransferPrefix indexes non-finalized inbound transfers by
+	// the height of their creation, so the expired ones can be pruned in order
+	PendingInboundTransferPrefix = []byte{0x02}
+
+	// AssetFlowsPrefix stores rate limit flows by asset denoms
+	AssetFlowsPrefix = []byte{0x03}
+)
+
+// GetInboundTransferKey returns the key of the inbound transfer.
+func GetInboundTransferKey(externalID string) []byte {
+	return append(InboundTransferPrefix, []byte(externalID)...)
+}
+
+// GetAssetFlowsKey returns the key of the asset rate limit flows.
+func GetAssetFlowsKey(denom string) []byte {
+	return append(AssetFlowsPrefix, []byte(denom)...)
+}
+
+// GetPendingInboundTransferPrefixByHeight returns the pending inbound transfer
+// index prefix for the creation height.
+func GetPendingInboundTransferPrefixByHeight(height int64) []byte {
+	return append(PendingInboundTransferPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPendingInboundTransferKey returns the pending inbound transfer index key.
+func GetPendingInboundTransferKey(height int64, externalID string) []byte {
+	return append(GetPendingInboundTransferPrefixByHeight(height), []byte(externalID)...)
+}
@@ -0,0 +1,179 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgInboundTransfer   = "inbound_transfer"
+	TypeMsgOutboundTransfer  = "outbound_transfer"
+	TypeMsgUpdateParams      = "update_params"
+	TypeMsgChangeAssetStatus = "change_asset_status"
+)
+
+var _ sdk.Msg = &MsgInboundTransfer{}
+
+// NewMsgInboundTransfer creates a signer's vote to mint the asset coming from the source chain
+func NewMsgInboundTransfer(sender, externalID, destAddr string, asset Asset, amount math.Int) *MsgInboundTransfer {
+	return &MsgInboundTransfer{
+		Sender:     sender,
+		DestAddr:   destAddr,
+		Asset:      asset,
+		Amount:     amount,
+		ExternalId: externalID,
+	}
+}
+
+func (m MsgInboundTransfer) Route() string { return RouterKey }
+func (m MsgInboundTransfer) Type() string  { return TypeMsgInboundTransfer }
+func (m MsgInboundTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.DestAddr)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid destination address (%s)", err)
+	}
+
+	if err = m.Asset.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAsset, err.Error())
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "Amount should be positive: %s", m.Amount)
+	}
+
+	if m.ExternalId == "" {
+		return errorsmod.Wrap(ErrInvalidTransfer, "Empty external id")
+	}
+
+	return nil
+}
+
+func (m MsgInboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgInboundTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgOutboundTransfer{}
+
+// NewMsgOutboundTransfer creates a msg to burn the asset and release it on the destination chain
+func NewMsgOutboundTransfer(sender, destAddr string, asset Asset, amount math.Int) *MsgOutboundTransfer {
+	return &MsgOutboundTransfer{
+		Sender:   sender,
+		DestAddr: destAddr,
+		Asset:    asset,
+		Amount:   amount,
+	}
+}
+
+func (m MsgOutboundTransfer) Route() string { return RouterKey }
+func (m MsgOutboundTransfer) Type() string  { return TypeMsgOutboundTransfer }
+func (m MsgOutboundTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// the destination address belongs to the external chain, so only check it is set
+	if m.DestAddr == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "Empty destination address")
+	}
+
+	if err = m.Asset.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAsset, err.Error())
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "Amount should be positive: %s", m.Amount)
+	}
+
+	return nil
+}
+
+func (m MsgOutboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgOutboundTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a msg to update the module params
+func NewMsgUpdateParams(sender string, newParams Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Sender:    sender,
+		NewParams: newParams,
+	}
+}
+
+func (m MsgUpdateParams) Route() string { return RouterKey }
+func (m MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (m MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err = m.NewParams.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgChangeAssetStatus{}
+
+// NewMsgChangeAssetStatus creates a msg to change the status of the known asset
+func NewMsgChangeAssetStatus(sender string, newAssetStatus AssetWithStatus) *MsgChangeAssetStatus {
+	return &MsgChangeAssetStatus{
+		Sender:         sender,
+		NewAssetStatus: newAssetStatus,
+	}
+}
+
+func (m MsgChangeAssetStatus) Route() string { return RouterKey }
+func (m MsgChangeAssetStatus) Type() string  { return TypeMsgChangeAssetStatus }
+func (m MsgChangeAssetStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err = m.NewAssetStatus.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgChangeAssetStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgChangeAssetStatus) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
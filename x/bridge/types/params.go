@@ -0,0 +1,237 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+)
+
+// Parameter store keys.
+var (
+	KeySigners     = []byte("Signers")
+	KeyAssets      = []byte("Assets")
+	KeyVotesNeeded = []byte("VotesNeeded")
+	KeyVoteExpiry  = []byte("VoteExpiry")
+	KeyRateLimits  = []byte("AssetRateLimits")
+)
+
+// Default parameter values.
+const (
+	// DefaultVotesNeeded is the majority of a three signer set, so that no single signer can mint
+	DefaultVotesNeeded = uint64(2)
+	DefaultVoteExpiry  = uint64(appparams.BlocksPerDay) // 14,400
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for the x/bridge module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	signers []string,
+	assets []AssetWithStatus,
+	votesNeeded uint64,
+	voteExpiry uint64,
+	assetRateLimits []AssetRateLimits,
+) Params {
+	return Params{
+		Signers:         signers,
+		Assets:          assets,
+		VotesNeeded:     votesNeeded,
+		VoteExpiry:      voteExpiry,
+		AssetRateLimits: assetRateLimits,
+	}
+}
+
+// DefaultParams returns the default x/bridge module params.
+func DefaultParams() Params {
+	return Params{
+		Signers:         []string{},
+		Assets:          DefaultAssets(),
+		VotesNeeded:     DefaultVotesNeeded,
+		VoteExpiry:      DefaultVoteExpiry,
+		AssetRateLimits: []AssetRateLimits{},
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateSigners(p.Signers); err != nil {
+		return err
+	}
+
+	if err := validateAssets(p.Assets); err != nil {
+		return err
+	}
+
+	if err := validateVotesNeeded(p.VotesNeeded); err != nil {
+		return err
+	}
+
+	if err := validateVoteExpiry(p.VoteExpiry); err != nil {
+		return err
+	}
+
+	if err := validateAssetRateLimits(p.AssetRateLimits); err != nil {
+		return err
+	}
+
+	// rate limits can only be set for the known assets
+	for _, rateLimits := range p.AssetRateLimits {
+		asset, found := p.GetAsset(rateLimits.Asset)
+		if !found || asset.Asset != rateLimits.Asset {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "rate limits for unknown asset %s", rateLimits.Asset.Name())
+		}
+	}
+
+	// the empty signer set is allowed, it just disables inbound transfers;
+	// otherwise a transfer needs the votes of a majority of the signers
+	if len(p.Signers) > 0 {
+		if p.VotesNeeded > uint64(len(p.Signers)) {
+			return errorsmod.Wrapf(ErrInvalidParams, "votes needed %d is greater than the number of signers %d", p.VotesNeeded, len(p.Signers))
+		}
+		if p.VotesNeeded <= uint64(len(p.Signers))/2 {
+			return errorsmod.Wrapf(ErrInvalidParams, "votes needed %d is not a majority of the %d signers", p.VotesNeeded, len(p.Signers))
+		}
+	}
+
+	return nil
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySigners, &p.Signers, validateSigners),
+		paramtypes.NewParamSetPair(KeyAssets, &p.Assets, validateAssets),
+		paramtypes.NewParamSetPair(KeyVotesNeeded, &p.VotesNeeded, validateVotesNeeded),
+		paramtypes.NewParamSetPair(KeyVoteExpiry, &p.VoteExpiry, validateVoteExpiry),
+		paramtypes.NewParamSetPair(KeyRateLimits, &p.AssetRateLimits, validateAssetRateLimits),
+	}
+}
+
+// GetAsset returns the asset with its status if the asset is known.
+func (p Params) GetAsset(asset Asset) (AssetWithStatus, bool) {
+	for _, a := range p.Assets {
+		if a.Asset.SameAs(asset) {
+			return a, true
+		}
+	}
+	return AssetWithStatus{}, false
+}
+
+// GetRateLimits returns the rate limits of the asset if there are any.
+func (p Params) GetRateLimits(asset Asset) ([]RateLimit, bool) {
+	for _, a := range p.AssetRateLimits {
+		if a.Asset.SameAs(asset) {
+			return a.RateLimits, len(a.RateLimits) > 0
+		}
+	}
+	return nil, false
+}
+
+// IsSigner returns true if the address is in the signer set.
+func (p Params) IsSigner(address string) bool {
+	for _, signer := range p.Signers {
+		if signer == address {
+			return true
+		}
+	}
+	return false
+}
+
+func validateSigners(i interface{}) error {
+	signers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSigners, "invalid signer address %s: %s", signer, err)
+		}
+
+		if _, found := seen[signer]; found {
+			return errorsmod.Wrapf(ErrInvalidSigners, "duplicated signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateAssets(i interface{}) error {
+	assets, ok := i.([]AssetWithStatus)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// denoms are used as tokenfactory subdenoms, so they must be unique
+	// across source chains as well
+	seenDenoms := make(map[string]struct{}, len(assets))
+	for _, asset := range assets {
+		if err := asset.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seenDenoms[asset.Asset.Denom]; found {
+			return errorsmod.Wrapf(ErrInvalidAsset, "duplicated asset denom %s", asset.Asset.Denom)
+		}
+		seenDenoms[asset.Asset.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateVotesNeeded(i interface{}) error {
+	votesNeeded, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if votesNeeded == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "votes needed must be positive")
+	}
+
+	return nil
+}
+
+func validateVoteExpiry(i interface{}) error {
+	voteExpiry, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if voteExpiry == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "vote expiry must be positive")
+	}
+
+	return nil
+}
+
+func validateAssetRateLimits(i interface{}) error {
+	assetRateLimits, ok := i.([]AssetRateLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]struct{}, len(assetRateLimits))
+	for _, rateLimits := range assetRateLimits {
+		if err := rateLimits.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seenDenoms[rateLimits.Asset.Denom]; found {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "duplicated rate limits for asset %s", rateLimits.Asset.Name())
+		}
+		seenDenoms[rateLimits.Asset.Denom] = struct{}{}
+	}
+
+	return nil
+}
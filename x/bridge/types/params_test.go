@@ -0,0 +1,161 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v23/x/bridge/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	signers := apptesting.CreateRandomAccounts(3)
+	signer := signers[0].String()
+	threeSigners := []string{signer, signers[1].String(), signers[2].String()}
+	asset := types.AssetWithStatus{
+		Asset:       types.Asset{SourceChain: "bitcoin", Denom: "btc", Precision: 8},
+		AssetStatus: types.AssetStatus_ASSET_STATUS_OK,
+	}
+
+	daily := types.NewRateLimit("daily", 24*time.Hour, osmomath.NewInt(1000), osmomath.NewInt(1000))
+
+	tests := map[string]struct {
+		params    types.Params
+		expectErr bool
+	}{
+		"default params": {
+			params: types.DefaultParams(),
+		},
+		"valid params": {
+			params: types.NewParams([]string{signer}, []types.AssetWithStatus{asset}, 1, 100, nil),
+		},
+		"invalid signer": {
+			params:    types.NewParams([]string{"invalid"}, nil, 1, 100, nil),
+			expectErr: true,
+		},
+		"duplicated signer": {
+			params:    types.NewParams([]string{signer, signer}, nil, 1, 100, nil),
+			expectErr: true,
+		},
+		"empty source chain": {
+			params: types.NewParams(nil, []types.AssetWithStatus{{
+				Asset:       types.Asset{Denom: "btc", Precision: 8},
+				AssetStatus: types.AssetStatus_ASSET_STATUS_OK,
+			}}, 1, 100, nil),
+			expectErr: true,
+		},
+		"too large precision": {
+			params: types.NewParams(nil, []types.AssetWithStatus{{
+				Asset:       types.Asset{SourceChain: "bitcoin", Denom: "btc", Precision: types.MaxPrecision + 1},
+				AssetStatus: types.AssetStatus_ASSET_STATUS_OK,
+			}}, 1, 100, nil),
+			expectErr: true,
+		},
+		"unspecified status": {
+			params: types.NewParams(nil, []types.AssetWithStatus{{
+				Asset:       asset.Asset,
+				AssetStatus: types.AssetStatus_ASSET_STATUS_UNSPECIFIED,
+			}}, 1, 100, nil),
+			expectErr: true,
+		},
+		"zero votes needed": {
+			params:    types.NewParams([]string{signer}, nil, 0, 100, nil),
+			expectErr: true,
+		},
+		"votes needed exceed signers": {
+			params:    types.NewParams([]string{signer}, nil, 2, 100, nil),
+			expectErr: true,
+		},
+		"majority of signers": {
+			params: types.NewParams(threeSigners, nil, 2, 100, nil),
+		},
+		"votes needed below majority": {
+			params:    types.NewParams(threeSigners, nil, 1, 100, nil),
+			expectErr: true,
+		},
+		"half of signers": {
+			params:    types.NewParams(threeSigners[:2], nil, 1, 100, nil),
+			expectErr: true,
+		},
+		"zero vote expiry": {
+			params:    types.NewParams([]string{signer}, nil, 1, 0, nil),
+			expectErr: true,
+		},
+		"valid rate limits": {
+			params: types.NewParams([]string{signer}, []types.AssetWithStatus{asset}, 1, 100, []types.AssetRateLimits{{
+				Asset:      asset.Asset,
+				RateLimits: []types.RateLimit{daily, types.NewRateLimit("hourly", time.Hour, osmomath.NewInt(100), osmomath.NewInt(100))},
+			}}),
+		},
+		"rate limits for unknown asset": {
+			params: types.NewParams([]string{signer}, nil, 1, 100, []types.AssetRateLimits{{
+				Asset:      asset.Asset,
+				RateLimits: []types.RateLimit{daily},
+			}}),
+			expectErr: true,
+		},
+		"duplicated rate limit name": {
+			params: types.NewParams([]string{signer}, []types.AssetWithStatus{asset}, 1, 100, []types.AssetRateLimits{{
+				Asset:      asset.Asset,
+				RateLimits: []types.RateLimit{daily, daily},
+			}}),
+			expectErr: true,
+		},
+		"zero rate limit duration": {
+			params: types.NewParams([]string{signer}, []types.AssetWithStatus{asset}, 1, 100, []types.AssetRateLimits{{
+				Asset:      asset.Asset,
+				RateLimits: []types.RateLimit{types.NewRateLimit("daily", 0, osmomath.NewInt(1000), osmomath.NewInt(1000))},
+			}}),
+			expectErr: true,
+		},
+		"zero max inbound": {
+			params: types.NewParams([]string{signer}, []types.AssetWithStatus{asset}, 1, 100, []types.AssetRateLimits{{
+				Asset:      asset.Asset,
+				RateLimits: []types.RateLimit{types.NewRateLimit("daily", time.Hour, osmomath.ZeroInt(), osmomath.NewInt(1000))},
+			}}),
+			expectErr: true,
+		},
+		"duplicated denom": {
+			params: types.NewParams(nil, []types.AssetWithStatus{asset, {
+				Asset:       types.Asset{SourceChain: "other", Denom: "btc", Precision: 8},
+				AssetStatus: types.AssetStatus_ASSET_STATUS_OK,
+			}}, 1, 100, nil),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssetStatus(t *testing.T) {
+	tests := []struct {
+		status   types.AssetStatus
+		inbound  bool
+		outbound bool
+	}{
+		{types.AssetStatus_ASSET_STATUS_UNSPECIFIED, false, false},
+		{types.AssetStatus_ASSET_STATUS_OK, true, true},
+		{types.AssetStatus_ASSET_STATUS_BLOCKED_INBOUND, false, true},
+		{types.AssetStatus_ASSET_STATUS_BLOCKED_OUTBOUND, true, false},
+		{types.AssetStatus_ASSET_STATUS_BLOCKED_BOTH, false, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			require.Equal(t, tc.inbound, tc.status.InboundActive())
+			require.Equal(t, tc.outbound, tc.status.OutboundActive())
+		})
+	}
+}
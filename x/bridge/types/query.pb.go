@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -111,9 +112,326 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryInboundTransferRequest is the request type for the
+// Query/InboundTransfer RPC method.
+type QueryInboundTransferRequest struct {
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty" yaml:"external_id"`
+}
+
+func (m *QueryInboundTransferRequest) Reset()         { *m = QueryInboundTransferRequest{} }
+func (m *QueryInboundTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInboundTransferRequest) ProtoMessage()    {}
+func (*QueryInboundTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05fd16bccc7396b4, []int{2}
+}
+func (m *QueryInboundTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInboundTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInboundTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInboundTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInboundTransferRequest.Merge(m, src)
+}
+func (m *QueryInboundTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInboundTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInboundTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInboundTransferRequest proto.InternalMessageInfo
+
+func (m *QueryInboundTransferRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// QueryInboundTransferResponse is the response type for the
+// Query/InboundTransfer RPC method.
+type QueryInboundTransferResponse struct {
+	Transfer InboundTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer" yaml:"transfer"`
+	// Tallies contains votes of the current signers grouped by transfer data
+	Tallies []InboundVoteTally `protobuf:"bytes,2,rep,name=tallies,proto3" json:"tallies" yaml:"tallies"`
+	// VotesNeeded is the number of matching votes needed to finalize
+	// the transfer
+	VotesNeeded uint64 `protobuf:"varint,3,opt,name=votes_needed,json=votesNeeded,proto3" json:"votes_needed,omitempty" yaml:"votes_needed"`
+}
+
+func (m *QueryInboundTransferResponse) Reset()         { *m = QueryInboundTransferResponse{} }
+func (m *QueryInboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInboundTransferResponse) ProtoMessage()    {}
+func (*QueryInboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05fd16bccc7396b4, []int{3}
+}
+func (m *QueryInboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInboundTransferResponse.Merge(m, src)
+}
+func (m *QueryInboundTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInboundTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInboundTransferResponse proto.InternalMessageInfo
+
+func (m *QueryInboundTransferResponse) GetTransfer() InboundTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return InboundTransfer{}
+}
+
+func (m *QueryInboundTransferResponse) GetTallies() []InboundVoteTally {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
+}
+
+func (m *QueryInboundTransferResponse) GetVotesNeeded() uint64 {
+	if m != nil {
+		return m.VotesNeeded
+	}
+	return 0
+}
+
+// QueryPendingInboundTransfersRequest is the request type for the
+// Query/PendingInboundTransfers RPC method.
+type QueryPendingInboundTransfersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingInboundTransfersRequest) Reset()         { *m = QueryPendingInboundTransfersRequest{} }
+func (m *QueryPendingInboundTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingInboundTransfersRequest) ProtoMessage()    {}
+func (*QueryPendingInboundTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05fd16bccc7396b4, []int{4}
+}
+func (m *QueryPendingInboundTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingInboundTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingInboundTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingInboundTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingInboundTransfersRequest.Merge(m, src)
+}
+func (m *QueryPendingInboundTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingInboundTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingInboundTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingInboundTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryPendingInboundTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingInboundTransfersResponse is the response type for the
+// Query/PendingInboundTransfers RPC method.
+type QueryPendingInboundTransfersResponse struct {
+	Transfers  []InboundTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers" yaml:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingInboundTransfersResponse) Reset()         { *m = QueryPendingInboundTransfersResponse{} }
+func (m *QueryPendingInboundTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingInboundTransfersResponse) ProtoMessage()    {}
+func (*QueryPendingInboundTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05fd16bccc7396b4, []int{5}
+}
+func (m *QueryPendingInboundTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingInboundTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingInboundTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingInboundTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingInboundTransfersResponse.Merge(m, src)
+}
+func (m *QueryPendingInboundTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingInboundTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingInboundTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingInboundTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryPendingInboundTransfersResponse) GetTransfers() []InboundTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *QueryPendingInboundTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRemainingCapacityRequest is the request type for the
+// Query/RemainingCapacity RPC method.
+type QueryRemainingCapacityRequest struct {
+	SourceChain string `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty" yaml:"source_chain"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryRemainingCapacityRequest) Reset()         { *m = QueryRemainingCapacityRequest{} }
+func (m *QueryRemainingCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingCapacityRequest) ProtoMessage()    {}
+func (*QueryRemainingCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05fd16bccc7396b4, []int{6}
+}
+func (m *QueryRemainingCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingCapacityRequest.Merge(m, src)
+}
+func (m *QueryRemainingCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryRemainingCapacityRequest) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *QueryRemainingCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryRemainingCapacityResponse is the response type for the
+// Query/RemainingCapacity RPC method.
+type QueryRemainingCapacityResponse struct {
+	Capacities []RateLimitCapacity `protobuf:"bytes,1,rep,name=capacities,proto3" json:"capacities" yaml:"capacities"`
+}
+
+func (m *QueryRemainingCapacityResponse) Reset()         { *m = QueryRemainingCapacityResponse{} }
+func (m *QueryRemainingCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingCapacityResponse) ProtoMessage()    {}
+func (*QueryRemainingCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05fd16bccc7396b4, []int{7}
+}
+func (m *QueryRemainingCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingCapacityResponse.Merge(m, src)
+}
+func (m *QueryRemainingCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingCapacityResponse) GetCapacities() []RateLimitCapacity {
+	if m != nil {
+		return m.Capacities
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.bridge.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.bridge.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryInboundTransferRequest)(nil), "osmosis.bridge.v1beta1.QueryInboundTransferRequest")
+	proto.RegisterType((*QueryInboundTransferResponse)(nil), "osmosis.bridge.v1beta1.QueryInboundTransferResponse")
+	proto.RegisterType((*QueryPendingInboundTransfersRequest)(nil), "osmosis.bridge.v1beta1.QueryPendingInboundTransfersRequest")
+	proto.RegisterType((*QueryPendingInboundTransfersResponse)(nil), "osmosis.bridge.v1beta1.QueryPendingInboundTransfersResponse")
+	proto.RegisterType((*QueryRemainingCapacityRequest)(nil), "osmosis.bridge.v1beta1.QueryRemainingCapacityRequest")
+	proto.RegisterType((*QueryRemainingCapacityResponse)(nil), "osmosis.bridge.v1beta1.QueryRemainingCapacityResponse")
 }
 
 func init() {
@@ -121,26 +439,58 @@ func init() {
 }
 
 var fileDescriptor_05fd16bccc7396b4 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x83, 0xaa, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b,
-	0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0xca,
-	0x38, 0xec, 0x83, 0x1a, 0x0d, 0x56, 0xa4, 0x24, 0xc2, 0x25, 0x14, 0x08, 0xb2, 0x3f, 0x20, 0xb1,
-	0x28, 0x31, 0xb7, 0x38, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x29, 0x85, 0x4b, 0x18, 0x45,
-	0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x97, 0x8b, 0xad, 0x00, 0x2c, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa7, 0x87, 0xdd, 0xb9, 0x7a, 0x10, 0x7d, 0x4e, 0xa2, 0x27, 0xee,
-	0xc9, 0x33, 0x7c, 0xba, 0x27, 0xcf, 0x5b, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x04, 0xd1, 0xab, 0x14,
-	0x04, 0x35, 0xc4, 0x68, 0x32, 0x23, 0x17, 0x2b, 0xd8, 0x1a, 0xa1, 0x4e, 0x46, 0x2e, 0x36, 0x88,
-	0x1e, 0x21, 0x2d, 0x5c, 0x66, 0x62, 0x3a, 0x53, 0x4a, 0x9b, 0x28, 0xb5, 0x10, 0xc7, 0x2b, 0xa9,
-	0x35, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x41, 0x48, 0x4e, 0x1f, 0x47, 0xb8, 0x40, 0x5c, 0xe5, 0xe4,
-	0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x30, 0x33, 0x74, 0x73, 0x12, 0x93, 0x8a, 0xe1, 0x06, 0x96,
-	0x19, 0x19, 0xeb, 0x57, 0xc0, 0x8c, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0xb3,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x43, 0x48, 0x0e, 0xfd, 0x01, 0x00, 0x00,
+	// 804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5f, 0x4f, 0x2b, 0x45,
+	0x14, 0xef, 0x16, 0x41, 0x99, 0xa2, 0xc0, 0x80, 0x50, 0x2b, 0x6e, 0x9b, 0xc1, 0x60, 0xc5, 0xb8,
+	0x1b, 0x5a, 0x95, 0x80, 0x26, 0xc6, 0x25, 0xd1, 0x90, 0xa0, 0xd1, 0x0d, 0xe1, 0x81, 0x98, 0x34,
+	0xd3, 0xee, 0xb8, 0x4c, 0xb2, 0x3b, 0x53, 0x76, 0xb6, 0x84, 0x86, 0xf0, 0xa2, 0x89, 0x89, 0x6f,
+	0x26, 0xfa, 0x75, 0x7c, 0xe7, 0xc5, 0x48, 0xe2, 0x8b, 0x4f, 0x8d, 0x81, 0xfb, 0x09, 0x9a, 0xdc,
+	0xf7, 0x9b, 0xce, 0xcc, 0xb6, 0x5b, 0x60, 0xcb, 0xe5, 0xbe, 0xed, 0xcc, 0x9c, 0xf3, 0xfb, 0x73,
+	0xce, 0x99, 0x59, 0x80, 0xb8, 0x08, 0xb9, 0xa0, 0xc2, 0x6e, 0x46, 0xd4, 0xf3, 0x89, 0x7d, 0xb6,
+	0xd5, 0x24, 0x31, 0xde, 0xb2, 0x4f, 0x3b, 0x24, 0xea, 0x5a, 0xed, 0x88, 0xc7, 0x1c, 0xae, 0xe8,
+	0x18, 0x4b, 0xc5, 0x58, 0x3a, 0xa6, 0xb4, 0xec, 0x73, 0x9f, 0xcb, 0x10, 0x7b, 0xf0, 0xa5, 0xa2,
+	0x4b, 0x6b, 0x3e, 0xe7, 0x7e, 0x40, 0x6c, 0xdc, 0xa6, 0x36, 0x66, 0x8c, 0xc7, 0x38, 0xa6, 0x9c,
+	0x09, 0x7d, 0xba, 0xd9, 0x92, 0x60, 0x76, 0x13, 0x0b, 0xa2, 0x48, 0x86, 0x94, 0x6d, 0xec, 0x53,
+	0x26, 0x83, 0x75, 0xec, 0x7a, 0x86, 0x36, 0x2d, 0x43, 0x06, 0xa1, 0x65, 0x00, 0x7f, 0x18, 0xc0,
+	0x7c, 0x8f, 0x23, 0x1c, 0x0a, 0x97, 0x9c, 0x76, 0x88, 0x88, 0x91, 0x07, 0x96, 0xc6, 0x76, 0x45,
+	0x9b, 0x33, 0x41, 0xe0, 0xb7, 0x60, 0xa6, 0x2d, 0x77, 0x8a, 0x46, 0xc5, 0xa8, 0x16, 0x6a, 0xa6,
+	0xf5, 0xb0, 0x35, 0x4b, 0xe5, 0x39, 0x6f, 0x5f, 0xf5, 0xca, 0xb9, 0x7e, 0xaf, 0xfc, 0x66, 0x17,
+	0x87, 0xc1, 0x2e, 0x52, 0xb9, 0xc8, 0xd5, 0x20, 0xe8, 0x08, 0xbc, 0x2b, 0x59, 0xf6, 0x59, 0x93,
+	0x77, 0x98, 0x77, 0x18, 0x61, 0x26, 0x7e, 0x22, 0x91, 0x16, 0x01, 0xb7, 0x41, 0x81, 0x9c, 0xc7,
+	0x24, 0x62, 0x38, 0x68, 0x50, 0x4f, 0x52, 0xce, 0x3a, 0x2b, 0xfd, 0x5e, 0x19, 0x2a, 0xb8, 0xd4,
+	0x21, 0x72, 0x41, 0xb2, 0xda, 0xf7, 0xd0, 0x9f, 0x79, 0xb0, 0xf6, 0x30, 0xb0, 0xf6, 0xf1, 0x23,
+	0x78, 0x23, 0xd6, 0x7b, 0xda, 0xc9, 0x07, 0x59, 0x4e, 0xee, 0x40, 0x38, 0xab, 0xda, 0xd2, 0xbc,
+	0xd2, 0x90, 0xc0, 0x20, 0x77, 0x88, 0x08, 0x8f, 0xc1, 0xeb, 0x31, 0x0e, 0x02, 0x4a, 0x44, 0x31,
+	0x5f, 0x99, 0xaa, 0x16, 0x6a, 0xd5, 0x47, 0xc0, 0x8f, 0x78, 0x4c, 0x0e, 0x71, 0x10, 0x74, 0x9d,
+	0x15, 0x8d, 0xfe, 0x96, 0x46, 0x57, 0x30, 0xc8, 0x4d, 0x00, 0xe1, 0x2e, 0x98, 0x3b, 0xe3, 0x31,
+	0x11, 0x0d, 0x46, 0x88, 0x47, 0xbc, 0xe2, 0x54, 0xc5, 0xa8, 0xbe, 0xe6, 0xac, 0xf6, 0x7b, 0xe5,
+	0x25, 0x95, 0x92, 0x3e, 0x45, 0x6e, 0x41, 0x2e, 0xbf, 0x53, 0xab, 0x10, 0xac, 0xab, 0xa6, 0x12,
+	0xe6, 0x51, 0xe6, 0xdf, 0x71, 0x96, 0xf4, 0x1e, 0x7e, 0x0d, 0xc0, 0x68, 0x94, 0x74, 0x79, 0x36,
+	0x2c, 0x35, 0x77, 0xd6, 0x60, 0xee, 0x2c, 0x35, 0xdc, 0xa3, 0x5e, 0xfb, 0x44, 0xe7, 0xba, 0xa9,
+	0x4c, 0x74, 0x6d, 0x80, 0xf7, 0x27, 0xf3, 0xe9, 0x6e, 0x34, 0xc0, 0x6c, 0x52, 0xbb, 0xc1, 0x60,
+	0x4d, 0x3d, 0xa5, 0x1d, 0x45, 0x5d, 0xb0, 0x85, 0xf1, 0x76, 0x08, 0xe4, 0x8e, 0x30, 0xe1, 0x37,
+	0x63, 0x8e, 0xf2, 0xba, 0xe1, 0x8f, 0x39, 0x52, 0xea, 0xc6, 0x2c, 0xfd, 0x62, 0x80, 0xf7, 0xa4,
+	0x25, 0x97, 0x84, 0x98, 0x32, 0xca, 0xfc, 0x3d, 0xdc, 0xc6, 0x2d, 0x1a, 0x77, 0x93, 0xe2, 0xed,
+	0x82, 0x39, 0xc1, 0x3b, 0x51, 0x8b, 0x34, 0x5a, 0x27, 0x98, 0x32, 0x3d, 0xb4, 0xa9, 0xfe, 0xa4,
+	0x4f, 0x91, 0x5b, 0x50, 0xcb, 0xbd, 0xc1, 0x0a, 0x6e, 0x80, 0x69, 0x8f, 0x30, 0x1e, 0x4a, 0x85,
+	0xb3, 0xce, 0x42, 0xbf, 0x57, 0x9e, 0x53, 0x49, 0x72, 0x1b, 0xb9, 0xea, 0x18, 0xfd, 0x6a, 0x00,
+	0x33, 0x4b, 0x85, 0x2e, 0xa9, 0x07, 0x40, 0x4b, 0xed, 0x51, 0x92, 0xd4, 0xf4, 0xc3, 0xac, 0x9a,
+	0xba, 0x38, 0x26, 0x07, 0x34, 0xa4, 0x71, 0x02, 0xe3, 0xbc, 0xa3, 0xab, 0xba, 0xa8, 0xe8, 0x47,
+	0x50, 0xc8, 0x4d, 0xe1, 0xd6, 0x9e, 0x4f, 0x83, 0x69, 0x29, 0x04, 0xfe, 0x66, 0x80, 0x19, 0x75,
+	0xe7, 0xe1, 0x66, 0x16, 0xcd, 0xfd, 0x67, 0xa6, 0xf4, 0xd1, 0x4b, 0xc5, 0x2a, 0x4f, 0x68, 0xe3,
+	0xe7, 0x7f, 0x9f, 0xfd, 0x91, 0xaf, 0x40, 0xd3, 0xce, 0x78, 0xd7, 0xd4, 0xab, 0x02, 0xff, 0x32,
+	0xc0, 0xfc, 0x9d, 0x31, 0x81, 0xf5, 0x89, 0x44, 0x0f, 0xbf, 0x3f, 0xa5, 0x4f, 0x9e, 0x96, 0xa4,
+	0x65, 0x7e, 0x29, 0x65, 0xee, 0xc0, 0xed, 0x2c, 0x99, 0x54, 0x25, 0x36, 0x86, 0xf3, 0x69, 0x5f,
+	0xa4, 0x5e, 0xb2, 0x4b, 0xf8, 0x8f, 0x01, 0x56, 0x33, 0xae, 0x0c, 0xfc, 0x7c, 0x72, 0xc1, 0x26,
+	0x5e, 0xec, 0xd2, 0x17, 0xaf, 0x96, 0xac, 0x7d, 0xed, 0x48, 0x5f, 0x75, 0xb8, 0x95, 0x59, 0x7e,
+	0x05, 0xd0, 0xb8, 0xe7, 0x0f, 0xfe, 0x6d, 0x80, 0xc5, 0x7b, 0xb3, 0x0a, 0x3f, 0x9d, 0x28, 0x27,
+	0xeb, 0x86, 0x95, 0x3e, 0x7b, 0x6a, 0x9a, 0xd6, 0xbf, 0x2f, 0xf5, 0xef, 0xc1, 0xaf, 0xb2, 0xf4,
+	0x47, 0x49, 0x6a, 0x43, 0x8f, 0x78, 0xd7, 0xbe, 0x48, 0xdf, 0xd6, 0x4b, 0xfb, 0x42, 0xde, 0xbf,
+	0x4b, 0xe7, 0xe0, 0xea, 0xc6, 0x34, 0xae, 0x6f, 0x4c, 0xe3, 0xff, 0x1b, 0xd3, 0xf8, 0xfd, 0xd6,
+	0xcc, 0x5d, 0xdf, 0x9a, 0xb9, 0xff, 0x6e, 0xcd, 0xdc, 0x71, 0xcd, 0xa7, 0xf1, 0x49, 0xa7, 0x69,
+	0xb5, 0x78, 0x98, 0xd0, 0x7c, 0x1c, 0xe0, 0xa6, 0x18, 0x72, 0x9e, 0xd5, 0xea, 0xf6, 0x79, 0xc2,
+	0x1c, 0x77, 0xdb, 0x44, 0x34, 0x67, 0xe4, 0x8f, 0xb8, 0xfe, 0x62, 0x00, 0x6b, 0x12, 0xfb, 0x2c,
+	0x4b, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +507,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params returns x/bridge module params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InboundTransfer returns the inbound transfer with its vote tally.
+	InboundTransfer(ctx context.Context, in *QueryInboundTransferRequest, opts ...grpc.CallOption) (*QueryInboundTransferResponse, error)
+	// PendingInboundTransfers returns all the non-finalized inbound transfers.
+	PendingInboundTransfers(ctx context.Context, in *QueryPendingInboundTransfersRequest, opts ...grpc.CallOption) (*QueryPendingInboundTransfersResponse, error)
+	// RemainingCapacity returns the volume of the asset which can still be
+	// transferred under each of its rate limits.
+	RemainingCapacity(ctx context.Context, in *QueryRemainingCapacityRequest, opts ...grpc.CallOption) (*QueryRemainingCapacityResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +533,44 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InboundTransfer(ctx context.Context, in *QueryInboundTransferRequest, opts ...grpc.CallOption) (*QueryInboundTransferResponse, error) {
+	out := new(QueryInboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.bridge.v1beta1.Query/InboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingInboundTransfers(ctx context.Context, in *QueryPendingInboundTransfersRequest, opts ...grpc.CallOption) (*QueryPendingInboundTransfersResponse, error) {
+	out := new(QueryPendingInboundTransfersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.bridge.v1beta1.Query/PendingInboundTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingCapacity(ctx context.Context, in *QueryRemainingCapacityRequest, opts ...grpc.CallOption) (*QueryRemainingCapacityResponse, error) {
+	out := new(QueryRemainingCapacityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.bridge.v1beta1.Query/RemainingCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns x/bridge module params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InboundTransfer returns the inbound transfer with its vote tally.
+	InboundTransfer(context.Context, *QueryInboundTransferRequest) (*QueryInboundTransferResponse, error)
+	// PendingInboundTransfers returns all the non-finalized inbound transfers.
+	PendingInboundTransfers(context.Context, *QueryPendingInboundTransfersRequest) (*QueryPendingInboundTransfersResponse, error)
+	// RemainingCapacity returns the volume of the asset which can still be
+	// transferred under each of its rate limits.
+	RemainingCapacity(context.Context, *QueryRemainingCapacityRequest) (*QueryRemainingCapacityResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +580,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) InboundTransfer(ctx context.Context, req *QueryInboundTransferRequest) (*QueryInboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundTransfer not implemented")
+}
+func (*UnimplementedQueryServer) PendingInboundTransfers(ctx context.Context, req *QueryPendingInboundTransfersRequest) (*QueryPendingInboundTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingInboundTransfers not implemented")
+}
+func (*UnimplementedQueryServer) RemainingCapacity(ctx context.Context, req *QueryRemainingCapacityRequest) (*QueryRemainingCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingCapacity not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +612,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInboundTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InboundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.bridge.v1beta1.Query/InboundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InboundTransfer(ctx, req.(*QueryInboundTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingInboundTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingInboundTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingInboundTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.bridge.v1beta1.Query/PendingInboundTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingInboundTransfers(ctx, req.(*QueryPendingInboundTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.bridge.v1beta1.Query/RemainingCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingCapacity(ctx, req.(*QueryRemainingCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.bridge.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +674,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "InboundTransfer",
+			Handler:    _Query_InboundTransfer_Handler,
+		},
+		{
+			MethodName: "PendingInboundTransfers",
+			Handler:    _Query_PendingInboundTransfers_Handler,
+		},
+		{
+			MethodName: "RemainingCapacity",
+			Handler:    _Query_RemainingCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/bridge/v1beta1/query.proto",
@@ -281,21 +747,261 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryInboundTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryInboundTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInboundTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotesNeeded != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotesNeeded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingInboundTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingInboundTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingInboundTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingInboundTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingInboundTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingInboundTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for iNdEx := len(m.Capacities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capacities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -312,6 +1018,103 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInboundTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.VotesNeeded != 0 {
+		n += 1 + sovQuery(uint64(m.VotesNeeded))
+	}
+	return n
+}
+
+func (m *QueryPendingInboundTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingInboundTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainingCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainingCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for _, e := range m.Capacities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -451,6 +1254,628 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInboundTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInboundTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInboundTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInboundTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInboundTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tallies = append(m.Tallies, InboundVoteTally{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesNeeded", wireType)
+			}
+			m.VotesNeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesNeeded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingInboundTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingInboundTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingInboundTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingInboundTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingInboundTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingInboundTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, InboundTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capacities = append(m.Capacities, RateLimitCapacity{})
+			if err := m.Capacities[len(m.Capacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
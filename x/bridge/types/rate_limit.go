@@ -0,0 +1,194 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewRateLimit creates a rate limit with the given window duration.
+func NewRateLimit(name string, duration time.Duration, maxInbound, maxOutbound math.Int) RateLimit {
+	return RateLimit{
+		Name:        name,
+		Duration:    duration,
+		MaxInbound:  maxInbound,
+		MaxOutbound: maxOutbound,
+	}
+}
+
+// Validate performs basic validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if r.Name == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimits, "empty rate limit name")
+	}
+
+	if r.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimits, "rate limit %s: duration must be positive", r.Name)
+	}
+
+	if r.MaxInbound.IsNil() || !r.MaxInbound.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidRateLimits, "rate limit %s: max inbound must be positive", r.Name)
+	}
+
+	if r.MaxOutbound.IsNil() || !r.MaxOutbound.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidRateLimits, "rate limit %s: max outbound must be positive", r.Name)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the asset rate limits.
+func (a AssetRateLimits) Validate() error {
+	if err := a.Asset.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimits, err.Error())
+	}
+
+	seen := make(map[string]struct{}, len(a.RateLimits))
+	for _, rateLimit := range a.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "asset %s", a.Asset.Name())
+		}
+
+		if _, found := seen[rateLimit.Name]; found {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "asset %s: duplicated rate limit %s", a.Asset.Name(), rateLimit.Name)
+		}
+		seen[rateLimit.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// FlowBucketsPerWindow is the number of buckets the sliding window of a rate
+// limit is split into. The transfers are added to the bucket of their block
+// time, so a flow holds at most FlowBucketsPerWindow+1 buckets however many
+// transfers there are.
+const FlowBucketsPerWindow = 60
+
+// FlowBucketDuration returns the duration covered by each bucket of the flow
+// of a rate limit with the given window duration.
+func FlowBucketDuration(duration time.Duration) time.Duration {
+	if bucketDuration := duration / FlowBucketsPerWindow; bucketDuration > 0 {
+		return bucketDuration
+	}
+	return 1
+}
+
+// NewFlow creates a flow without any transfer.
+func NewFlow(name string) Flow {
+	return Flow{
+		Name:    name,
+		Buckets: []FlowBucket{},
+	}
+}
+
+// Prune drops the buckets which are entirely out of the window of the given
+// duration by now. A bucket is kept as long as part of it is in the window,
+// so a transfer counts for at most one bucket duration more than the window.
+func (f Flow) Prune(now time.Time, duration time.Duration) Flow {
+	windowStart := now.Add(-duration)
+	bucketDuration := FlowBucketDuration(duration)
+	for len(f.Buckets) > 0 && !f.Buckets[0].Time.Add(bucketDuration).After(windowStart) {
+		f.Buckets = f.Buckets[1:]
+	}
+	return f
+}
+
+// Add records the amounts transferred at the given time in the bucket of the
+// window of the given duration it falls in.
+func (f Flow) Add(now time.Time, duration time.Duration, inbound, outbound math.Int) Flow {
+	buckets := make([]FlowBucket, len(f.Buckets), len(f.Buckets)+1)
+	copy(buckets, f.Buckets)
+
+	bucketStart := now.Truncate(FlowBucketDuration(duration))
+	if last := len(buckets) - 1; last >= 0 && !buckets[last].Time.Before(bucketStart) {
+		buckets[last].Inbound = buckets[last].Inbound.Add(inbound)
+		buckets[last].Outbound = buckets[last].Outbound.Add(outbound)
+	} else {
+		buckets = append(buckets, FlowBucket{Time: bucketStart, Inbound: inbound, Outbound: outbound})
+	}
+
+	f.Buckets = buckets
+	return f
+}
+
+// PeriodEnd returns the time at which the oldest bucket of the flow leaves the
+// window of the given duration.
+func (f Flow) PeriodEnd(duration time.Duration) (time.Time, bool) {
+	if len(f.Buckets) == 0 {
+		return time.Time{}, false
+	}
+	return f.Buckets[0].Time.Add(FlowBucketDuration(duration)).Add(duration), true
+}
+
+// Inbound returns the amount minted in all the buckets of the flow.
+func (f Flow) Inbound() math.Int {
+	total := math.ZeroInt()
+	for _, bucket := range f.Buckets {
+		total = total.Add(bucket.Inbound)
+	}
+	return total
+}
+
+// Outbound returns the amount burned in all the buckets of the flow.
+func (f Flow) Outbound() math.Int {
+	total := math.ZeroInt()
+	for _, bucket := range f.Buckets {
+		total = total.Add(bucket.Outbound)
+	}
+	return total
+}
+
+// Validate performs basic validation of the flow.
+func (f Flow) Validate() error {
+	if f.Name == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimits, "empty flow name")
+	}
+
+	for i, bucket := range f.Buckets {
+		if bucket.Inbound.IsNil() || bucket.Inbound.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "flow %s: inbound must be non-negative", f.Name)
+		}
+
+		if bucket.Outbound.IsNil() || bucket.Outbound.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "flow %s: outbound must be non-negative", f.Name)
+		}
+
+		if i > 0 && !bucket.Time.After(f.Buckets[i-1].Time) {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "flow %s: buckets must be sorted by time", f.Name)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the asset flows.
+func (a AssetFlows) Validate() error {
+	if err := a.Asset.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimits, err.Error())
+	}
+
+	seen := make(map[string]struct{}, len(a.Flows))
+	for _, flow := range a.Flows {
+		if err := flow.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "asset %s", a.Asset.Name())
+		}
+
+		if _, found := seen[flow.Name]; found {
+			return errorsmod.Wrapf(ErrInvalidRateLimits, "asset %s: duplicated flow %s", a.Asset.Name(), flow.Name)
+		}
+		seen[flow.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetFlow returns the flow of the rate limit with the given name.
+func (a AssetFlows) GetFlow(name string) (Flow, bool) {
+	for _, flow := range a.Flows {
+		if flow.Name == name {
+			return flow, true
+		}
+	}
+	return Flow{}, false
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewInboundVote creates a vote of the signer for the inbound transfer data.
+func NewInboundVote(signer, destAddr string, asset Asset, amount math.Int) InboundVote {
+	return InboundVote{
+		Signer:   signer,
+		DestAddr: destAddr,
+		Asset:    asset,
+		Amount:   amount,
+	}
+}
+
+// Validate performs basic validation of the vote.
+func (v InboundVote) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid signer address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v.DestAddr); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid destination address (%s)", err)
+	}
+
+	if err := v.Asset.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAsset, err.Error())
+	}
+
+	if v.Amount.IsNil() || !v.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "Amount should be positive: %s", v.Amount)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the inbound transfer.
+func (t InboundTransfer) Validate() error {
+	if t.ExternalId == "" {
+		return errorsmod.Wrap(ErrInvalidTransfer, "empty external id")
+	}
+
+	if len(t.Votes) == 0 {
+		return errorsmod.Wrapf(ErrInvalidTransfer, "transfer %s has no votes", t.ExternalId)
+	}
+
+	seen := make(map[string]struct{}, len(t.Votes))
+	for _, vote := range t.Votes {
+		if err := vote.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTransfer, "transfer %s: %s", t.ExternalId, err)
+		}
+
+		if _, found := seen[vote.Signer]; found {
+			return errorsmod.Wrapf(ErrInvalidTransfer, "transfer %s: duplicated vote of %s", t.ExternalId, vote.Signer)
+		}
+		seen[vote.Signer] = struct{}{}
+	}
+
+	return nil
+}
+
+// HasVoted returns true if the signer has already voted for the transfer.
+func (t InboundTransfer) HasVoted(signer string) bool {
+	for _, vote := range t.Votes {
+		if vote.Signer == signer {
+			return true
+		}
+	}
+	return false
+}
+
+// Tally groups the votes by the transfer data and counts them. Only votes of
+// the addresses accepted by isSigner are counted, so votes of the removed
+// signers don't contribute to the tally. Tallies are ordered by the first vote
+// for the data.
+func (t InboundTransfer) Tally(isSigner func(string) bool) []InboundVoteTally {
+	tallies := make([]InboundVoteTally, 0)
+	for _, vote := range t.Votes {
+		if !isSigner(vote.Signer) {
+			continue
+		}
+
+		found := false
+		for i, tally := range tallies {
+			if tally.DestAddr == vote.DestAddr && tally.Asset == vote.Asset && tally.Amount.Equal(vote.Amount) {
+				tallies[i].Votes++
+				found = true
+				break
+			}
+		}
+		if !found {
+			tallies = append(tallies, InboundVoteTally{
+				DestAddr: vote.DestAddr,
+				Asset:    vote.Asset,
+				Amount:   vote.Amount,
+				Votes:    1,
+			})
+		}
+	}
+	return tallies
+}
@@ -35,8 +35,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // service method. It allows a sender to perform an inbound cross-chain
 // transfer, i.e., to transfer their tokens from the source chain to Osmosis and
 // get the equivalent amount of the corresponding token (specified in subdenom)
-// on Osmosis in return. The tokens are minted through the x/tokenfactory module
-// to the destination address.
+// on Osmosis in return. The message is a signer's vote for the transfer
+// identified by the external id. The tokens are minted through the
+// x/tokenfactory module to the destination address once enough signers submit
+// matching votes.
 type MsgInboundTransfer struct {
 	// Sender is a sender's address
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -46,6 +48,8 @@ type MsgInboundTransfer struct {
 	Asset Asset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset" yaml:"asset"`
 	// Amount of coins to transfer
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// ExternalId is a unique transfer id on the source chain
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty" yaml:"external_id"`
 }
 
 func (m *MsgInboundTransfer) Reset()         { *m = MsgInboundTransfer{} }
@@ -102,7 +106,16 @@ func (m *MsgInboundTransfer) GetAsset() Asset {
 	return Asset{}
 }
 
+func (m *MsgInboundTransfer) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
 type MsgInboundTransferResponse struct {
+	// Finalized is true if the vote finalized the transfer
+	Finalized bool `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
 }
 
 func (m *MsgInboundTransferResponse) Reset()         { *m = MsgInboundTransferResponse{} }
@@ -138,6 +151,13 @@ func (m *MsgInboundTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInboundTransferResponse proto.InternalMessageInfo
 
+func (m *MsgInboundTransferResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
 // MsgOutboundTransfer defines the message structure for the OutboundTransfer
 // gRPC service method. It allows a sender to perform an outbound cross-chain
 // transfer, i.e., to transfer their tokens from Osmosis to the destination
@@ -444,48 +464,51 @@ func init() {
 func init() { proto.RegisterFile("osmosis/bridge/v1beta1/tx.proto", fileDescriptor_8e478e3238c885a8) }
 
 var fileDescriptor_8e478e3238c885a8 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcd, 0x6e, 0xd3, 0x4e,
-	0x14, 0xc5, 0xe3, 0xf6, 0xff, 0xaf, 0xe8, 0xb4, 0xd0, 0xc6, 0x04, 0x9a, 0x9a, 0xd6, 0x2e, 0x06,
-	0xd4, 0x52, 0x88, 0xad, 0xba, 0xac, 0xb2, 0x6b, 0x90, 0x90, 0x2a, 0x11, 0x81, 0x0c, 0x08, 0xc4,
-	0xa6, 0x1a, 0xc7, 0x83, 0x63, 0x51, 0xcf, 0x04, 0xcf, 0xb8, 0x69, 0x5f, 0x81, 0x15, 0xef, 0x81,
-	0x90, 0xd8, 0xf2, 0x06, 0x5d, 0x96, 0x1d, 0x62, 0x61, 0xa1, 0x76, 0xc1, 0x3e, 0x4f, 0x80, 0x3c,
-	0x33, 0xf9, 0xc0, 0xf9, 0x50, 0xb3, 0x66, 0x13, 0x39, 0xf6, 0xef, 0x9e, 0x7b, 0xcf, 0xb9, 0xe3,
-	0x04, 0x18, 0x84, 0x46, 0x84, 0x86, 0xd4, 0xf6, 0xe2, 0xd0, 0x0f, 0x90, 0x7d, 0xb4, 0xe3, 0x21,
-	0x06, 0x77, 0x6c, 0x76, 0x6c, 0xb5, 0x62, 0xc2, 0x88, 0x7a, 0x53, 0x02, 0x96, 0x00, 0x2c, 0x09,
-	0x68, 0xa5, 0x80, 0x04, 0x84, 0x23, 0x76, 0x76, 0x25, 0x68, 0xad, 0x08, 0xa3, 0x10, 0x13, 0x9b,
-	0x7f, 0xca, 0x5b, 0x7a, 0x83, 0x2b, 0xd8, 0x1e, 0xa4, 0x7d, 0xf9, 0x06, 0x09, 0xb1, 0x7c, 0x7e,
-	0x67, 0xcc, 0x04, 0xb2, 0x1f, 0x87, 0xcc, 0xcf, 0x33, 0x40, 0xad, 0xd3, 0x60, 0x1f, 0x7b, 0x24,
-	0xc1, 0xfe, 0xcb, 0x18, 0x62, 0xfa, 0x0e, 0xc5, 0xea, 0x7d, 0x30, 0x47, 0x11, 0xf6, 0x51, 0x5c,
-	0x56, 0x36, 0x94, 0xad, 0xf9, 0x5a, 0xb1, 0x93, 0x1a, 0x57, 0x4f, 0x60, 0x74, 0x58, 0x35, 0xc5,
-	0x7d, 0xd3, 0x95, 0x80, 0xba, 0x03, 0xe6, 0x7d, 0x44, 0xd9, 0x01, 0xf4, 0xfd, 0xb8, 0x3c, 0xc3,
-	0xe9, 0x52, 0x27, 0x35, 0x96, 0x05, 0xdd, 0x7b, 0x64, 0xba, 0x57, 0xb2, 0xeb, 0x3d, 0xdf, 0x8f,
-	0xd5, 0x7d, 0xf0, 0x3f, 0xa4, 0x14, 0xb1, 0xf2, 0xec, 0x86, 0xb2, 0xb5, 0xe0, 0xac, 0x5b, 0xa3,
-	0xa3, 0xb0, 0xf6, 0x32, 0xa8, 0x56, 0x3a, 0x4d, 0x8d, 0x42, 0x27, 0x35, 0x16, 0x85, 0x22, 0xaf,
-	0x34, 0x5d, 0xa1, 0xa0, 0x3e, 0x01, 0x73, 0x30, 0x22, 0x09, 0x66, 0xe5, 0xff, 0x78, 0x6b, 0x2b,
-	0x83, 0x7f, 0xa6, 0xc6, 0x0d, 0x11, 0x0e, 0xf5, 0xdf, 0x5b, 0x21, 0xb1, 0x23, 0xc8, 0x9a, 0xd6,
-	0x3e, 0x66, 0x7d, 0x17, 0xa2, 0xc8, 0x74, 0x65, 0x75, 0xf5, 0xee, 0xc7, 0xdf, 0x5f, 0xb7, 0xf3,
-	0x3b, 0x0b, 0x45, 0x2a, 0x15, 0x26, 0x63, 0x31, 0xd7, 0x80, 0x36, 0x1c, 0x96, 0x8b, 0x68, 0x8b,
-	0x60, 0x8a, 0xcc, 0x2f, 0x33, 0xe0, 0x7a, 0x9d, 0x06, 0xcf, 0x12, 0xf6, 0x8f, 0x87, 0x79, 0x2f,
-	0x0b, 0x73, 0x23, 0x17, 0x26, 0x91, 0xb1, 0xf4, 0xd3, 0x5c, 0x07, 0xb7, 0x46, 0xc4, 0xd5, 0x8b,
-	0xf3, 0x9b, 0x02, 0x96, 0xea, 0x34, 0x78, 0xd5, 0xf2, 0x21, 0x43, 0xcf, 0x61, 0x0c, 0x23, 0x3a,
-	0x4d, 0x94, 0x6f, 0x00, 0xc0, 0xa8, 0x7d, 0xd0, 0xe2, 0x85, 0x3c, 0xcb, 0x05, 0x47, 0x1f, 0x17,
-	0x8e, 0x90, 0xaf, 0xad, 0xca, 0x74, 0x8a, 0x42, 0xb2, 0x5f, 0x6f, 0xba, 0xf3, 0x18, 0xb5, 0x05,
-	0x55, 0xbd, 0x9d, 0xd9, 0x5b, 0xcb, 0xd9, 0x4b, 0xf8, 0x98, 0x15, 0x89, 0xaf, 0x82, 0x95, 0xdc,
-	0xe8, 0x3d, 0x5b, 0xa9, 0x02, 0x4a, 0x75, 0x1a, 0x3c, 0x6e, 0x42, 0x1c, 0x20, 0xbe, 0x94, 0x17,
-	0x0c, 0xb2, 0x64, 0x2a, 0x6f, 0x31, 0x58, 0xce, 0x66, 0xe3, 0x5b, 0x3b, 0xa0, 0xbc, 0x5c, 0x3a,
-	0xdc, 0x9c, 0xb8, 0xfe, 0xd7, 0x21, 0x6b, 0x8a, 0x6e, 0x35, 0x43, 0x5a, 0x5d, 0xe9, 0x5b, 0x1d,
-	0x94, 0x33, 0xdd, 0x6b, 0x18, 0xb5, 0x07, 0xc6, 0xab, 0x6e, 0x66, 0xae, 0xcd, 0x9c, 0xeb, 0x06,
-	0x77, 0x51, 0xe1, 0x95, 0x15, 0x59, 0xa9, 0x83, 0xb5, 0x51, 0xfe, 0xba, 0x01, 0x38, 0xdf, 0x67,
-	0xc1, 0x6c, 0x9d, 0x06, 0xea, 0x07, 0xb0, 0x94, 0xff, 0xd9, 0xd9, 0x1e, 0x37, 0xfd, 0xf0, 0x5b,
-	0xa7, 0x39, 0x97, 0x67, 0xbb, 0xad, 0x55, 0x06, 0x96, 0x87, 0xde, 0xce, 0x07, 0x13, 0x74, 0xf2,
-	0xb0, 0xb6, 0x3b, 0x05, 0xdc, 0xeb, 0xda, 0x04, 0x8b, 0x7f, 0x1d, 0xe2, 0xcd, 0x09, 0x22, 0x83,
-	0xa0, 0x66, 0x5f, 0x12, 0xec, 0x75, 0x6a, 0x83, 0xe2, 0xf0, 0xb9, 0x7a, 0x38, 0x41, 0x65, 0x88,
-	0xd6, 0x1e, 0x4d, 0x43, 0x77, 0x1b, 0xd7, 0x9e, 0x9e, 0x9e, 0xeb, 0xca, 0xd9, 0xb9, 0xae, 0xfc,
-	0x3a, 0xd7, 0x95, 0x4f, 0x17, 0x7a, 0xe1, 0xec, 0x42, 0x2f, 0xfc, 0xb8, 0xd0, 0x0b, 0x6f, 0x9d,
-	0x20, 0x64, 0xcd, 0xc4, 0xb3, 0x1a, 0x24, 0xb2, 0xa5, 0x72, 0xe5, 0x10, 0x7a, 0xb4, 0xfb, 0xc5,
-	0x3e, 0x72, 0x76, 0xed, 0xe3, 0xee, 0x79, 0x62, 0x27, 0x2d, 0x44, 0xbd, 0x39, 0xfe, 0xdf, 0xb4,
-	0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0x72, 0x89, 0xa4, 0xf5, 0x44, 0x07, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0xf6, 0xb6, 0x6a, 0xa6, 0xbd, 0xb7, 0x8d, 0x6f, 0x6e, 0x9b, 0xe6, 0xb6, 0x76,
+	0x31, 0xa0, 0x96, 0x42, 0x6c, 0x35, 0x45, 0x42, 0xca, 0xae, 0x41, 0x42, 0x8a, 0x44, 0x04, 0x32,
+	0x20, 0x10, 0x9b, 0x68, 0x1c, 0x4f, 0x1d, 0x8b, 0x78, 0x26, 0x78, 0xc6, 0x4d, 0xcb, 0x23, 0xb0,
+	0xe2, 0x45, 0x90, 0xd8, 0xf2, 0x06, 0x5d, 0x96, 0x1d, 0x62, 0x61, 0xa1, 0x16, 0x89, 0x7d, 0x9e,
+	0x00, 0x79, 0x66, 0xf2, 0x07, 0xa7, 0x8d, 0x9a, 0x35, 0x9b, 0xca, 0xf6, 0xfc, 0xce, 0x77, 0xce,
+	0xf7, 0xcd, 0x4c, 0x03, 0x74, 0x42, 0x03, 0x42, 0x7d, 0x6a, 0x39, 0xa1, 0xef, 0x7a, 0xc8, 0x3a,
+	0xda, 0x73, 0x10, 0x83, 0x7b, 0x16, 0x3b, 0x36, 0x3b, 0x21, 0x61, 0x44, 0x5d, 0x95, 0x80, 0x29,
+	0x00, 0x53, 0x02, 0xc5, 0xbc, 0x47, 0x3c, 0xc2, 0x11, 0x2b, 0x79, 0x12, 0x74, 0x31, 0x07, 0x03,
+	0x1f, 0x13, 0x8b, 0xff, 0x95, 0x9f, 0xb4, 0x26, 0x57, 0xb0, 0x1c, 0x48, 0x87, 0xf2, 0x4d, 0xe2,
+	0x63, 0xb9, 0x7e, 0xf3, 0x8a, 0x09, 0x64, 0x3f, 0x0e, 0x19, 0x3f, 0x66, 0x80, 0x5a, 0xa7, 0x5e,
+	0x0d, 0x3b, 0x24, 0xc2, 0xee, 0xf3, 0x10, 0x62, 0x7a, 0x88, 0x42, 0xf5, 0x0e, 0x98, 0xa7, 0x08,
+	0xbb, 0x28, 0x2c, 0x28, 0x5b, 0xca, 0x4e, 0xb6, 0x9a, 0xeb, 0xc5, 0xfa, 0xdf, 0x27, 0x30, 0x68,
+	0x57, 0x0c, 0xf1, 0xdd, 0xb0, 0x25, 0xa0, 0xee, 0x81, 0xac, 0x8b, 0x28, 0x6b, 0x40, 0xd7, 0x0d,
+	0x0b, 0x33, 0x9c, 0xce, 0xf7, 0x62, 0x7d, 0x45, 0xd0, 0x83, 0x25, 0xc3, 0x5e, 0x48, 0x9e, 0x0f,
+	0x5c, 0x37, 0x54, 0x6b, 0x60, 0x0e, 0x52, 0x8a, 0x58, 0x61, 0x76, 0x4b, 0xd9, 0x59, 0x2c, 0x6f,
+	0x9a, 0x97, 0x47, 0x61, 0x1e, 0x24, 0x50, 0x35, 0x7f, 0x1a, 0xeb, 0x99, 0x5e, 0xac, 0x2f, 0x09,
+	0x45, 0x5e, 0x69, 0xd8, 0x42, 0x41, 0x7d, 0x04, 0xe6, 0x61, 0x40, 0x22, 0xcc, 0x0a, 0x7f, 0xf1,
+	0xd6, 0x66, 0x02, 0x7f, 0x8b, 0xf5, 0xff, 0x44, 0x38, 0xd4, 0x7d, 0x63, 0xfa, 0xc4, 0x0a, 0x20,
+	0x6b, 0x99, 0x35, 0xcc, 0x86, 0x2e, 0x44, 0x91, 0x61, 0xcb, 0x6a, 0xf5, 0x01, 0x58, 0x44, 0xc7,
+	0x0c, 0x85, 0x18, 0xb6, 0x1b, 0xbe, 0x5b, 0x98, 0xe3, 0x62, 0xab, 0xbd, 0x58, 0x57, 0x05, 0x3f,
+	0xb2, 0x68, 0xd8, 0xa0, 0xff, 0x56, 0x73, 0x2b, 0xb7, 0xde, 0xff, 0xfc, 0xb4, 0x9b, 0xde, 0x6c,
+	0x5f, 0xc4, 0x59, 0x62, 0x32, 0x4f, 0xa3, 0x02, 0x8a, 0xe3, 0x29, 0xdb, 0x88, 0x76, 0x08, 0xa6,
+	0x48, 0xdd, 0x00, 0xd9, 0x43, 0x1f, 0xc3, 0xb6, 0xff, 0x0e, 0xb9, 0x3c, 0xf0, 0x05, 0x7b, 0xf8,
+	0xc1, 0xf8, 0x38, 0x03, 0xfe, 0xad, 0x53, 0xef, 0x49, 0xc4, 0xfe, 0xec, 0x3d, 0xaa, 0xdc, 0x4e,
+	0xa2, 0xde, 0x4a, 0x45, 0x4d, 0x22, 0x96, 0xca, 0x7a, 0x13, 0xfc, 0x7f, 0x49, 0x5c, 0xfd, 0xb0,
+	0x8d, 0xcf, 0x0a, 0x58, 0xae, 0x53, 0xef, 0x45, 0xc7, 0x85, 0x0c, 0x3d, 0x85, 0x21, 0x0c, 0xe8,
+	0x34, 0x51, 0xbe, 0x02, 0x00, 0xa3, 0x6e, 0xa3, 0xc3, 0x0b, 0x79, 0x96, 0x8b, 0x65, 0xed, 0xaa,
+	0x70, 0x84, 0x7c, 0x75, 0x5d, 0xa6, 0x93, 0x13, 0x92, 0xc3, 0x7a, 0xc3, 0xce, 0x62, 0xd4, 0x15,
+	0x54, 0xe5, 0x46, 0x62, 0x6f, 0x23, 0x65, 0x2f, 0xe2, 0x63, 0x96, 0x24, 0xbe, 0x0e, 0xd6, 0x52,
+	0xa3, 0x0f, 0x6c, 0xc5, 0x0a, 0xc8, 0xd7, 0xa9, 0xf7, 0xb0, 0x05, 0xb1, 0x87, 0xf8, 0xa6, 0x3c,
+	0x63, 0x90, 0x45, 0x53, 0x79, 0x0b, 0xc1, 0x4a, 0x32, 0x1b, 0xdf, 0xb5, 0x06, 0xe5, 0xe5, 0xd2,
+	0xe1, 0xf6, 0xc4, 0xed, 0x7f, 0xe9, 0xb3, 0x96, 0xe8, 0x56, 0xd5, 0xa5, 0xd5, 0xb5, 0xa1, 0xd5,
+	0x51, 0x39, 0xc3, 0xfe, 0x07, 0xa3, 0xee, 0xc8, 0x78, 0x95, 0xed, 0xc4, 0xb5, 0x91, 0x72, 0xdd,
+	0xe4, 0x2e, 0x4a, 0xbc, 0xb2, 0x24, 0x2b, 0x35, 0xb0, 0x71, 0x99, 0xbf, 0x7e, 0x00, 0xe5, 0x2f,
+	0xb3, 0x60, 0xb6, 0x4e, 0x3d, 0xf5, 0x2d, 0x58, 0x4e, 0xff, 0x37, 0xdb, 0xbd, 0x6a, 0xfa, 0xf1,
+	0x3b, 0x59, 0x2c, 0x5f, 0x9f, 0x1d, 0xdc, 0x5f, 0x06, 0x56, 0xc6, 0x6e, 0xe7, 0xdd, 0x09, 0x3a,
+	0x69, 0xb8, 0xb8, 0x3f, 0x05, 0x3c, 0xe8, 0xda, 0x02, 0x4b, 0xbf, 0x1d, 0xe2, 0xed, 0x09, 0x22,
+	0xa3, 0x60, 0xd1, 0xba, 0x26, 0x38, 0xe8, 0xd4, 0x05, 0xb9, 0xf1, 0x73, 0x75, 0x6f, 0x82, 0xca,
+	0x18, 0x5d, 0xbc, 0x3f, 0x0d, 0xdd, 0x6f, 0x5c, 0x7d, 0x7c, 0x7a, 0xae, 0x29, 0x67, 0xe7, 0x9a,
+	0xf2, 0xfd, 0x5c, 0x53, 0x3e, 0x5c, 0x68, 0x99, 0xb3, 0x0b, 0x2d, 0xf3, 0xf5, 0x42, 0xcb, 0xbc,
+	0x2e, 0x7b, 0x3e, 0x6b, 0x45, 0x8e, 0xd9, 0x24, 0x81, 0x25, 0x95, 0x4b, 0x6d, 0xe8, 0xd0, 0xfe,
+	0x8b, 0x75, 0x54, 0xde, 0xb7, 0x8e, 0xfb, 0xe7, 0x89, 0x9d, 0x74, 0x10, 0x75, 0xe6, 0xf9, 0x4f,
+	0xde, 0xfe, 0xaf, 0x01, 0x00, 0x74, 0x66, 0x31, 0x1a, 0x9b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -704,6 +727,13 @@ func (m *MsgInboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -761,6 +791,16 @@ func (m *MsgInboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -999,6 +1039,10 @@ func (m *MsgInboundTransfer) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1008,6 +1052,9 @@ func (m *MsgInboundTransferResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Finalized {
+		n += 2
+	}
 	return n
 }
 
@@ -1255,6 +1302,38 @@ func (m *MsgInboundTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1305,6 +1384,26 @@ func (m *MsgInboundTransferResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgInboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,26 @@
+package market
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/market/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+)
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// Replenishes pools towards the base pool
+	k.ReplenishPools(ctx)
+
+	// Reset the mint caps usage of the block, and of the epoch at its last block
+	k.ClearBlockNetMints(ctx)
+	if appparams.IsPeriodLastBlock(ctx, k.MintCapEpoch(ctx)) {
+		k.ClearEpochNetMints(ctx)
+	}
+}
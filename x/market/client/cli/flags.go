@@ -0,0 +1,16 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// FlagMinReceive is the minimum amount of ask denom coins to receive from a swap.
+	FlagMinReceive = "min-receive"
+)
+
+func FlagSetSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMinReceive, "", "Minimum amount of ask-denom coins to receive, the swap fails if it would return less (e.g. 1000)")
+	return fs
+}
@@ -25,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 
 	marketQueryCmd.AddCommand(
 		GetCmdQuerySwap(),
+		GetCmdQueryPoolDelta(),
+		GetCmdQueryMintCapacity(),
+		GetCmdQueryCircuitBreaker(),
 		GetCmdQueryParams(),
 		GetCmdQueryExchangeRequirements(),
 	)
@@ -74,6 +77,99 @@ $ symphonyd query swap 5000000note usdr
 	return cmd
 }
 
+// GetCmdQueryPoolDelta implements the query pool delta command.
+func GetCmdQueryPoolDelta() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-delta",
+		Args:  cobra.NoArgs,
+		Short: "Query the gap between the stable pool and the base pool",
+		Long: strings.TrimSpace(`
+Query the gap between the stable pool and the base pool, in melody units. The spread of melody and stable swaps grows with the delta.
+
+$ symphonyd query market pool-delta
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolDelta(context.Background(), &types.QueryPoolDeltaRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMintCapacity implements the query mint capacity command.
+func GetCmdQueryMintCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-capacity [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the remaining net amount of a denom swaps can issue in the current block and epoch",
+		Long: strings.TrimSpace(`
+Query the mint cap of a denom, the net amount issued by swaps in the current block and epoch, and the remaining capacity.
+
+$ symphonyd query market mint-capacity usdr
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintCapacity(context.Background(), &types.QueryMintCapacityRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCircuitBreaker implements the query circuit-breaker command.
+func GetCmdQueryCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker",
+		Args:  cobra.NoArgs,
+		Short: "Query the state of the market circuit breaker",
+		Long: strings.TrimSpace(`
+Query which swaps the market accepts, and whether the state is overridden by governance.
+
+$ symphonyd query market circuit-breaker
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreaker(context.Background(), &types.QueryCircuitBreakerRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
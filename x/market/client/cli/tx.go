@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -35,8 +36,13 @@ func NewSwapCmd() (*osmocli.TxCliDesc, *types.MsgSwap) {
    The to-address can be specified. A default to-address is trader.
 
    $ symphonyd market swap "symphony1..." "1000stake" "note"
+
+   The swap fails if it would return less than the optional --min-receive amount of ask-denom.
+
+   $ symphonyd market swap "symphony1..." "1000usdr" "note" --min-receive 1900
    `),
 		ParseAndBuildMsg: NewSwapMsg,
+		Flags:            osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetSwap()}},
 	}, &types.MsgSwap{}
 }
 
@@ -50,6 +56,19 @@ func NewSwapMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.
 	askDenom := args[2]
 	fromAddress := clientCtx.GetFromAddress()
 
+	minAskAmount := sdk.ZeroInt()
+	minReceiveStr, err := fs.GetString(FlagMinReceive)
+	if err != nil {
+		return nil, err
+	}
+	if minReceiveStr != "" {
+		var ok bool
+		minAskAmount, ok = sdk.NewIntFromString(minReceiveStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s amount: %s", FlagMinReceive, minReceiveStr)
+		}
+	}
+
 	var msg sdk.Msg
 	if len(args) == 3 {
 		toAddress, err := sdk.AccAddressFromBech32(args[0])
@@ -57,15 +76,17 @@ func NewSwapMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.
 			return nil, err
 		}
 
-		msg = types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom)
-		if err = msg.ValidateBasic(); err != nil {
-			return nil, err
-		}
+		swapSendMsg := types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom)
+		swapSendMsg.MinAskAmount = minAskAmount
+		msg = swapSendMsg
 	} else {
-		msg = types.NewMsgSwap(fromAddress, offerCoin, askDenom)
-		if err = msg.ValidateBasic(); err != nil {
-			return nil, err
-		}
+		swapMsg := types.NewMsgSwap(fromAddress, offerCoin, askDenom)
+		swapMsg.MinAskAmount = minAskAmount
+		msg = swapMsg
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
 	}
 	return msg, nil
 }
@@ -13,6 +13,8 @@ import (
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetOsmosisPoolDelta(ctx, data.OsmosisPoolDelta)
+	keeper.SetCircuitBreaker(ctx, data.CircuitBreaker)
 
 	// check if the module account exists
 	moduleAcc := keeper.GetMarketAccount(ctx)
@@ -26,5 +28,7 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisState) {
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(params)
+	osmosisPoolDelta := keeper.GetOsmosisPoolDelta(ctx)
+	circuitBreaker := keeper.GetCircuitBreaker(ctx)
+	return types.NewGenesisState(params, osmosisPoolDelta, circuitBreaker)
 }
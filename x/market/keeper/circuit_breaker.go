@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+)
+
+// GetCircuitBreaker returns the circuit breaker, which accepts all swaps until it is first set
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context) types.CircuitBreaker {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CircuitBreakerKey)
+	if bz == nil {
+		return types.DefaultCircuitBreaker()
+	}
+
+	var circuitBreaker types.CircuitBreaker
+	k.cdc.MustUnmarshal(bz, &circuitBreaker)
+	return circuitBreaker
+}
+
+// SetCircuitBreaker stores the circuit breaker
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&circuitBreaker)
+	store.Set(types.CircuitBreakerKey, bz)
+}
+
+// UpdateCircuitBreakerState moves the circuit breaker to the state required by the collateralization
+// of the stable supply. It is a no-op while governance overrides the circuit breaker.
+func (k Keeper) UpdateCircuitBreakerState(ctx sdk.Context, state types.CircuitBreakerState) {
+	circuitBreaker := k.GetCircuitBreaker(ctx)
+	if circuitBreaker.Overridden {
+		return
+	}
+
+	k.transitionCircuitBreaker(ctx, circuitBreaker, types.NewCircuitBreaker(state, false))
+}
+
+// OverrideCircuitBreaker pins the circuit breaker to the state until the next override.
+// CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED lifts the override, keeping the current state
+// until UpdateCircuitBreakerState is next called.
+func (k Keeper) OverrideCircuitBreaker(ctx sdk.Context, state types.CircuitBreakerState) error {
+	circuitBreaker := k.GetCircuitBreaker(ctx)
+	if state == types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED {
+		k.transitionCircuitBreaker(ctx, circuitBreaker, types.NewCircuitBreaker(circuitBreaker.State, false))
+		return nil
+	}
+
+	if err := state.Validate(); err != nil {
+		return err
+	}
+
+	k.transitionCircuitBreaker(ctx, circuitBreaker, types.NewCircuitBreaker(state, true))
+	return nil
+}
+
+func (k Keeper) transitionCircuitBreaker(ctx sdk.Context, oldCircuitBreaker types.CircuitBreaker, newCircuitBreaker types.CircuitBreaker) {
+	if oldCircuitBreaker.Equal(newCircuitBreaker) {
+		return
+	}
+
+	k.SetCircuitBreaker(ctx, newCircuitBreaker)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventCircuitBreaker,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOldState, oldCircuitBreaker.State.String()),
+			sdk.NewAttribute(types.AttributeKeyNewState, newCircuitBreaker.State.String()),
+			sdk.NewAttribute(types.AttributeKeyOverride, strconv.FormatBool(newCircuitBreaker.Overridden)),
+		),
+	)
+}
+
+// ValidateSwapAllowed returns ErrCircuitBreakerTripped if the circuit breaker refuses swaps into the ask denom.
+// Swaps into melody are redemptions, swaps into any other denom mint stable coins.
+func (k Keeper) ValidateSwapAllowed(ctx sdk.Context, askDenom string) error {
+	state := k.GetCircuitBreaker(ctx).State
+	if askDenom == appparams.BaseCoinUnit {
+		if !state.RedemptionActive() {
+			return errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "redemptions are paused in state %s", state)
+		}
+		return nil
+	}
+
+	if !state.MintActive() {
+		return errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "swaps into %s are paused in state %s", askDenom, state)
+	}
+	return nil
+}
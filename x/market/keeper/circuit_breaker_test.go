@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/market/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+)
+
+func (s *KeeperTestSuite) TestCircuitBreakerTransitions() {
+	s.Require().Equal(types.DefaultCircuitBreaker(), s.App.MarketKeeper.GetCircuitBreaker(s.Ctx))
+
+	// every transition emits an event, staying in the same state doesn't
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.MarketKeeper.UpdateCircuitBreakerState(ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY)
+	s.App.MarketKeeper.UpdateCircuitBreakerState(ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY)
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().Equal(types.EventCircuitBreaker, ctx.EventManager().Events()[0].Type)
+
+	// redemptions are accepted, minting is not
+	s.Require().NoError(s.App.MarketKeeper.ValidateSwapAllowed(ctx, appparams.BaseCoinUnit))
+	s.Require().ErrorIs(s.App.MarketKeeper.ValidateSwapAllowed(ctx, assets.MicroSDRDenom), types.ErrCircuitBreakerTripped)
+
+	// an override pins the state until it is lifted
+	s.Require().NoError(s.App.MarketKeeper.OverrideCircuitBreaker(ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED))
+	s.App.MarketKeeper.UpdateCircuitBreakerState(ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL)
+	s.Require().Equal(types.NewCircuitBreaker(types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED, true), s.App.MarketKeeper.GetCircuitBreaker(ctx))
+	s.Require().ErrorIs(s.App.MarketKeeper.ValidateSwapAllowed(ctx, appparams.BaseCoinUnit), types.ErrCircuitBreakerTripped)
+
+	s.Require().NoError(s.App.MarketKeeper.OverrideCircuitBreaker(ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED))
+	s.App.MarketKeeper.UpdateCircuitBreakerState(ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL)
+	s.Require().Equal(types.DefaultCircuitBreaker(), s.App.MarketKeeper.GetCircuitBreaker(ctx))
+	s.Require().NoError(s.App.MarketKeeper.ValidateSwapAllowed(ctx, assets.MicroSDRDenom))
+}
+
+func (s *KeeperTestSuite) TestMsgServer_OverrideCircuitBreaker() {
+	msgServer := keeper.NewMsgServerImpl(*s.App.MarketKeeper)
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	// only the gov module account can override the circuit breaker
+	msg := types.NewMsgOverrideCircuitBreaker(Addr.String(), types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED)
+	_, err := msgServer.OverrideCircuitBreaker(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	msg.Sender = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = msgServer.OverrideCircuitBreaker(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewCircuitBreaker(types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED, true), s.App.MarketKeeper.GetCircuitBreaker(s.Ctx))
+
+	// swaps are refused while halted
+	swapMsg := types.NewMsgSwap(Addr, sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000), assets.MicroSDRDenom)
+	_, err = msgServer.Swap(ctx, swapMsg)
+	s.Require().ErrorIs(err, types.ErrCircuitBreakerTripped)
+}
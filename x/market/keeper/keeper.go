@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -21,6 +22,9 @@ type Keeper struct {
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	OracleKeeper  types.OracleKeeper
+
+	// the address capable of overriding the circuit breaker, usually the gov module account
+	authority string
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -31,6 +35,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	oracleKeeper types.OracleKeeper,
+	authority string,
 ) Keeper {
 	// ensure market module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -49,7 +54,40 @@ func NewKeeper(
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
 		OracleKeeper:  oracleKeeper,
+		authority:     authority,
+	}
+}
+
+// GetOsmosisPoolDelta returns the gap between the stable pool and the BasePool
+func (k Keeper) GetOsmosisPoolDelta(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OsmosisPoolDeltaKey)
+	if bz == nil {
+		return sdk.ZeroDec()
 	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec
+}
+
+// SetOsmosisPoolDelta updates the gap between the stable pool and the BasePool
+func (k Keeper) SetOsmosisPoolDelta(ctx sdk.Context, delta sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: delta})
+	store.Set(types.OsmosisPoolDeltaKey, bz)
+}
+
+// ReplenishPools replenishes the stable and melody pools towards the BasePool
+func (k Keeper) ReplenishPools(ctx sdk.Context) {
+	delta := k.GetOsmosisPoolDelta(ctx)
+	regressionAmt := delta.QuoInt64(int64(k.PoolRecoveryPeriod(ctx)))
+
+	// Replenish stable pool towards base pool
+	// regressionAmt cannot make delta zero
+	delta = delta.Sub(regressionAmt)
+
+	k.SetOsmosisPoolDelta(ctx, delta)
 }
 
 func (k Keeper) GetExchangePoolBalance(ctx sdk.Context) sdk.Coin {
@@ -64,21 +102,72 @@ func (k Keeper) GetExchangePoolBalance(ctx sdk.Context) sdk.Coin {
 // GetExchangeRequirement calculates the total amount of Melody asset required to back the assets in the oracle module.
 func (k Keeper) GetExchangeRequirement(ctx sdk.Context) sdk.Dec {
 	total := sdk.ZeroDec()
-	for _, req := range k.getExchangeRates(ctx) {
+	for _, req := range k.GetExchangeRequirements(ctx) {
 		total = total.Add(req.BaseCurrency.Amount.ToLegacyDec().Mul(req.ExchangeRate))
 	}
 	return total
 }
 
-func (k Keeper) getExchangeRates(ctx sdk.Context) []types.ExchangeRequirement {
-	var result []types.ExchangeRequirement
+// GetExchangeRequirements returns the supply and the note exchange rate of every stable denom, i.e. every vote
+// target and every denom priced by the oracle module, except the denoms whose wind-down completed. A denom without a
+// current exchange rate is valued at its last recorded one, or at zero once that has been pruned.
+func (k Keeper) GetExchangeRequirements(ctx sdk.Context) []types.ExchangeRequirement {
+	requirements, _ := k.exchangeRequirements(ctx)
+	return requirements
+}
+
+// GetUnpricedDenoms returns the stable denoms with an outstanding supply but without a current exchange rate.
+func (k Keeper) GetUnpricedDenoms(ctx sdk.Context) []string {
+	_, unpriced := k.exchangeRequirements(ctx)
+	return unpriced
+}
+
+func (k Keeper) exchangeRequirements(ctx sdk.Context) (requirements []types.ExchangeRequirement, unpriced []string) {
+	exchangeRates := map[string]sdk.Dec{}
 	k.OracleKeeper.IterateNoteExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) (stop bool) {
+		exchangeRates[denom] = exchangeRate
+		return false
+	})
+
+	denoms := map[string]struct{}{}
+	for denom := range exchangeRates {
+		denoms[denom] = struct{}{}
+	}
+	k.OracleKeeper.IterateTobinTaxes(ctx, func(denom string, _ sdk.Dec) (stop bool) {
+		denoms[denom] = struct{}{}
+		return false
+	})
+	delete(denoms, appparams.BaseCoinUnit)
+	for denom := range denoms {
+		if k.OracleKeeper.IsWindDownComplete(ctx, denom) {
+			delete(denoms, denom)
+		}
+	}
+
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+
+	for _, denom := range sorted {
 		supply := k.BankKeeper.GetSupply(ctx, denom)
-		result = append(result, types.ExchangeRequirement{
+		exchangeRate, ok := exchangeRates[denom]
+		if !ok {
+			if supply.IsPositive() {
+				unpriced = append(unpriced, denom)
+			}
+
+			var err error
+			if exchangeRate, err = k.OracleKeeper.GetLastMelodyExchangeRate(ctx, denom); err != nil {
+				exchangeRate = sdk.ZeroDec()
+			}
+		}
+
+		requirements = append(requirements, types.ExchangeRequirement{
 			BaseCurrency: supply,
 			ExchangeRate: exchangeRate,
 		})
-		return false
-	})
-	return result
+	}
+	return requirements, unpriced
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/market"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v23/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -54,3 +55,26 @@ func (s *KeeperTestSuite) SetupTest() {
 	err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, FaucetAccountName, Addr, InitBaseCoins)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestOsmosisPoolDelta() {
+	s.Require().Equal(sdk.ZeroDec(), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+
+	delta := sdk.NewDecWithPrec(-314, 2)
+	s.App.MarketKeeper.SetOsmosisPoolDelta(s.Ctx, delta)
+	s.Require().Equal(delta, s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestReplenishPools() {
+	params := s.App.MarketKeeper.GetParams(s.Ctx)
+	params.PoolRecoveryPeriod = 10
+	s.App.MarketKeeper.SetParams(s.Ctx, params)
+
+	// the delta recovers by 1/PoolRecoveryPeriod of itself every block, in both directions
+	s.App.MarketKeeper.SetOsmosisPoolDelta(s.Ctx, sdk.NewDec(1000))
+	market.EndBlocker(s.Ctx, *s.App.MarketKeeper)
+	s.Require().Equal(sdk.NewDec(900), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+
+	s.App.MarketKeeper.SetOsmosisPoolDelta(s.Ctx, sdk.NewDec(-1000))
+	s.App.MarketKeeper.ReplenishPools(s.Ctx)
+	s.Require().Equal(sdk.NewDec(-900), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params registered since version 1, BasePool, PoolRecoveryPeriod, MinStabilitySpread,
+// MintCaps and MintCapEpoch, to their default values.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyBasePool, defaults.BasePool)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyPoolRecoveryPeriod, defaults.PoolRecoveryPeriod)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMinStabilitySpread, defaults.MinStabilitySpread)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMintCaps, defaults.MintCaps)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMintCapEpoch, defaults.MintCapEpoch)
+	return nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+)
+
+// GetBlockNetMint returns the net amount of the denom issued by swaps in the current block
+func (k Keeper) GetBlockNetMint(ctx sdk.Context, denom string) sdk.Int {
+	return k.getNetMint(ctx, types.GetBlockNetMintKey(denom))
+}
+
+// GetEpochNetMint returns the net amount of the denom issued by swaps in the current mint cap epoch
+func (k Keeper) GetEpochNetMint(ctx sdk.Context, denom string) sdk.Int {
+	return k.getNetMint(ctx, types.GetEpochNetMintKey(denom))
+}
+
+func (k Keeper) getNetMint(ctx sdk.Context, key []byte) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+func (k Keeper) setNetMint(ctx sdk.Context, key []byte, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(key, bz)
+}
+
+// ClearBlockNetMints resets the net amounts issued in the block for all denoms
+func (k Keeper) ClearBlockNetMints(ctx sdk.Context) {
+	k.clearNetMints(ctx, types.BlockNetMintKey)
+}
+
+// ClearEpochNetMints resets the net amounts issued in the mint cap epoch for all denoms
+func (k Keeper) ClearEpochNetMints(ctx sdk.Context) {
+	k.clearNetMints(ctx, types.EpochNetMintKey)
+}
+
+func (k Keeper) clearNetMints(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// ApplySwapToMintCaps records the coins taken in and issued by a swap, and returns ErrMintCapExceeded
+// if the net amount of askCoin issued in the block or epoch would exceed its MintCap.
+// Coins taken in reduce the net amount of their denom, so they are never refused.
+func (k Keeper) ApplySwapToMintCaps(ctx sdk.Context, offerCoin sdk.Coin, askCoin sdk.Coin) error {
+	k.addNetMint(ctx, offerCoin.Denom, offerCoin.Amount.Neg())
+
+	blockNetMint, epochNetMint := k.addNetMint(ctx, askCoin.Denom, askCoin.Amount)
+	mintCap, found := k.MintCaps(ctx).Get(askCoin.Denom)
+	if !found {
+		return nil
+	}
+
+	if blockNetMint.GT(mintCap.PerBlock) {
+		return errorsmod.Wrapf(types.ErrMintCapExceeded, "net %s issued in this block would be %s, per block cap is %s",
+			askCoin.Denom, blockNetMint, mintCap.PerBlock)
+	}
+	if epochNetMint.GT(mintCap.PerEpoch) {
+		return errorsmod.Wrapf(types.ErrMintCapExceeded, "net %s issued in this epoch would be %s, per epoch cap is %s",
+			askCoin.Denom, epochNetMint, mintCap.PerEpoch)
+	}
+
+	return nil
+}
+
+func (k Keeper) addNetMint(ctx sdk.Context, denom string, amount sdk.Int) (blockNetMint sdk.Int, epochNetMint sdk.Int) {
+	blockNetMint = k.GetBlockNetMint(ctx, denom).Add(amount)
+	epochNetMint = k.GetEpochNetMint(ctx, denom).Add(amount)
+	k.setNetMint(ctx, types.GetBlockNetMintKey(denom), blockNetMint)
+	k.setNetMint(ctx, types.GetEpochNetMintKey(denom), epochNetMint)
+	return blockNetMint, epochNetMint
+}
+
+// GetRemainingMintCapacity returns the net amount of the denom that can still be issued in the
+// current block and epoch. Returns false if the denom has no MintCap.
+func (k Keeper) GetRemainingMintCapacity(ctx sdk.Context, denom string) (blockRemaining sdk.Int, epochRemaining sdk.Int, found bool) {
+	mintCap, found := k.MintCaps(ctx).Get(denom)
+	if !found {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+
+	blockRemaining = sdk.MaxInt(mintCap.PerBlock.Sub(k.GetBlockNetMint(ctx, denom)), sdk.ZeroInt())
+	epochRemaining = sdk.MaxInt(mintCap.PerEpoch.Sub(k.GetEpochNetMint(ctx, denom)), sdk.ZeroInt())
+	return blockRemaining, epochRemaining, true
+}
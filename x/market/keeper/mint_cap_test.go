@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/market"
+	"github.com/osmosis-labs/osmosis/v23/x/market/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+)
+
+func (s *KeeperTestSuite) setMintCaps(epoch uint64, mintCaps ...types.MintCap) {
+	params := s.App.MarketKeeper.GetParams(s.Ctx)
+	params.MintCaps = mintCaps
+	params.MintCapEpoch = epoch
+	s.App.MarketKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestApplySwapToMintCaps() {
+	s.setMintCaps(10, types.NewMintCap(assets.MicroSDRDenom, sdk.NewInt(100), sdk.NewInt(250)))
+	melody := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(appparams.BaseCoinUnit, amount) }
+	sdr := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(assets.MicroSDRDenom, amount) }
+
+	// issuing up to the block cap is allowed
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, melody(10), sdr(60)))
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, melody(10), sdr(40)))
+	s.Require().Equal(sdk.NewInt(100), s.App.MarketKeeper.GetBlockNetMint(s.Ctx, assets.MicroSDRDenom))
+	s.Require().Equal(sdk.NewInt(-20), s.App.MarketKeeper.GetBlockNetMint(s.Ctx, appparams.BaseCoinUnit))
+
+	// past the block cap the swap is refused
+	cacheCtx, _ := s.Ctx.CacheContext()
+	err := s.App.MarketKeeper.ApplySwapToMintCaps(cacheCtx, melody(10), sdr(1))
+	s.Require().ErrorIs(err, types.ErrMintCapExceeded)
+
+	// burning the capped denom frees capacity, and is never refused
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, sdr(30), melody(10)))
+	blockRemaining, epochRemaining, found := s.App.MarketKeeper.GetRemainingMintCapacity(s.Ctx, assets.MicroSDRDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(30), blockRemaining)
+	s.Require().Equal(sdk.NewInt(180), epochRemaining)
+
+	// the block usage is reset at the end of the block, the epoch usage is kept
+	market.EndBlocker(s.Ctx.WithBlockHeight(1), *s.App.MarketKeeper)
+	s.Require().True(s.App.MarketKeeper.GetBlockNetMint(s.Ctx, assets.MicroSDRDenom).IsZero())
+	s.Require().Equal(sdk.NewInt(70), s.App.MarketKeeper.GetEpochNetMint(s.Ctx, assets.MicroSDRDenom))
+
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, melody(10), sdr(100)))
+	market.EndBlocker(s.Ctx.WithBlockHeight(2), *s.App.MarketKeeper)
+	cacheCtx, _ = s.Ctx.CacheContext()
+	err = s.App.MarketKeeper.ApplySwapToMintCaps(cacheCtx, melody(10), sdr(81))
+	s.Require().ErrorIs(err, types.ErrMintCapExceeded)
+
+	// the epoch usage is reset at the last block of the epoch
+	market.EndBlocker(s.Ctx.WithBlockHeight(9), *s.App.MarketKeeper)
+	s.Require().True(s.App.MarketKeeper.GetEpochNetMint(s.Ctx, assets.MicroSDRDenom).IsZero())
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, melody(10), sdr(100)))
+
+	// denoms without a cap are not limited
+	_, _, found = s.App.MarketKeeper.GetRemainingMintCapacity(s.Ctx, appparams.BaseCoinUnit)
+	s.Require().False(found)
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, sdr(10), melody(1000000)))
+}
+
+func (s *KeeperTestSuite) TestQueryMintCapacity() {
+	s.setMintCaps(10, types.NewMintCap(assets.MicroSDRDenom, sdk.NewInt(100), sdk.NewInt(250)))
+	querier := keeper.NewQuerier(*s.App.MarketKeeper)
+
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToMintCaps(s.Ctx, sdk.NewInt64Coin(appparams.BaseCoinUnit, 10), sdk.NewInt64Coin(assets.MicroSDRDenom, 40)))
+
+	res, err := querier.MintCapacity(sdk.WrapSDKContext(s.Ctx), &types.QueryMintCapacityRequest{Denom: assets.MicroSDRDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.QueryMintCapacityResponse{
+		MintCap:        types.NewMintCap(assets.MicroSDRDenom, sdk.NewInt(100), sdk.NewInt(250)),
+		BlockNetMint:   sdk.NewInt(40),
+		EpochNetMint:   sdk.NewInt(40),
+		BlockRemaining: sdk.NewInt(60),
+		EpochRemaining: sdk.NewInt(210),
+	}, *res)
+
+	_, err = querier.MintCapacity(sdk.WrapSDKContext(s.Ctx), &types.QueryMintCapacityRequest{Denom: assets.MicroMNTDenom})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = querier.MintCapacity(sdk.WrapSDKContext(s.Ctx), &types.QueryMintCapacityRequest{})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -7,7 +7,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"github.com/osmosis-labs/osmosis/v23/x/market/types"
 )
 
@@ -30,7 +29,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		return nil, err
 	}
 
-	return k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, msg.AskDenom)
+	return k.handleSwapRequest(ctx, addr, addr, msg.OfferCoin, msg.AskDenom, msg.MinAskAmount)
 }
 
 func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*types.MsgSwapSendResponse, error) {
@@ -45,7 +44,7 @@ func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*typ
 		return nil, err
 	}
 
-	res, err := k.handleSwapRequest(ctx, fromAddr, toAddr, msg.OfferCoin, msg.AskDenom)
+	res, err := k.handleSwapRequest(ctx, fromAddr, toAddr, msg.OfferCoin, msg.AskDenom, msg.MinAskAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -56,102 +55,38 @@ func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*typ
 	}, nil
 }
 
+func (k msgServer) OverrideCircuitBreaker(goCtx context.Context, msg *types.MsgOverrideCircuitBreaker) (*types.MsgOverrideCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Sender != k.authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only %s can override the circuit breaker", k.authority)
+	}
+
+	err := k.Keeper.OverrideCircuitBreaker(ctx, msg.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOverrideCircuitBreakerResponse{}, nil
+}
+
 // handleMsgSwap handles the logic of a MsgSwap
 // This function does not repeat checks that have already been performed in msg.ValidateBasic()
 // Ex) assert(offerCoin.Denom != askDenom)
 func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	trader sdk.AccAddress, receiver sdk.AccAddress,
-	offerCoin sdk.Coin, askDenom string,
+	offerCoin sdk.Coin, askDenom string, minAskAmount sdk.Int,
 ) (*types.MsgSwapResponse, error) {
-	// Compute exchange rates between the ask and offer
-	swapDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
+	swapCoin, feeCoin, err := k.swap(ctx, trader, receiver, offerCoin, askDenom, minAskAmount)
 	if err != nil {
 		return nil, err
 	}
 
-	// Charge a spread if applicable; the spread is burned
-	var feeDecCoin sdk.DecCoin
-	if spread.IsPositive() {
-		feeDecCoin = sdk.NewDecCoinFromDec(swapDecCoin.Denom, spread.Mul(swapDecCoin.Amount))
-	} else {
-		feeDecCoin = sdk.NewDecCoin(swapDecCoin.Denom, sdk.ZeroInt())
-	}
-
-	// Subtract fee from the swap coin
-	swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
-
-	// Send offer coins to module account
-	offerCoins := sdk.NewCoins(offerCoin)
-	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, offerCoins)
+	// Send swap coin to the trader
+	err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(swapCoin))
 	if err != nil {
-		return nil, err
-	}
-
-	if offerCoin.Denom != appparams.BaseCoinUnit {
-		// Burn offered coins and subtract from the trader's account
-		err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, offerCoins)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Mint asked coins and credit Trader's account
-	swapCoin, decimalCoin := swapDecCoin.TruncateDecimal()
-
-	// Ensure to fail the swap tx when zero swap coin
-	if !swapCoin.IsPositive() {
-		return nil, types.ErrZeroSwapCoin
+		return nil, fmt.Errorf("could not send swap coin to recipient: %w", err)
 	}
 
-	feeDecCoin = feeDecCoin.Add(decimalCoin) // add truncated decimalCoin to swapFee
-	feeCoin, _ := feeDecCoin.TruncateDecimal()
-
-	mintCoins := sdk.NewCoins(swapCoin.Add(feeCoin))
-
-	// mint only stable coin
-	if askDenom != appparams.BaseCoinUnit {
-		err = k.BankKeeper.MintCoins(ctx, types.ModuleName, mintCoins)
-		if err != nil {
-			return nil, err
-		}
-
-		// Send swap coin to the trader
-		swapCoins := sdk.NewCoins(swapCoin)
-		err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, swapCoins)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		// native coin transfer using exchange vault
-		calculatedAskCoin := swapCoin.Add(feeCoin)
-
-		marketVaultBalance := k.GetExchangePoolBalance(ctx)
-		if marketVaultBalance.Amount.LT(calculatedAskCoin.Amount) {
-			return nil, errorsmod.Wrapf(types.ErrNotEnoughBalanceOnMarketVaults, "Market vaults do not have enough coins to swap. Available amount: (main: %v), needed amount: %v",
-				marketVaultBalance.Amount, calculatedAskCoin.Amount)
-		}
-
-		err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(swapCoin))
-		if err != nil {
-			return nil, fmt.Errorf("could not send from exchange vault to recipient: %w", err)
-		}
-	}
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventSwap,
-			sdk.NewAttribute(types.AttributeKeyOffer, offerCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
-			sdk.NewAttribute(types.AttributeKeyRecipient, receiver.String()),
-			sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
-			sdk.NewAttribute(types.AttributeKeySwapFee, feeCoin.String()),
-		),
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-		),
-	})
-
 	return &types.MsgSwapResponse{
 		SwapCoin: swapCoin,
 		SwapFee:  feeCoin,
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v23/x/market/keeper"
 	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	treasurytypes "github.com/osmosis-labs/osmosis/v23/x/treasury/types"
 )
 
 func (s *KeeperTestSuite) setupServer() types.MsgServer {
@@ -43,14 +46,14 @@ func (s *KeeperTestSuite) TestMsgServer_SwapToNativeCoins() {
 
 	s.Require().Error(err)
 	s.Require().ErrorIs(err, types.ErrNotEnoughBalanceOnMarketVaults)
-	s.Require().ErrorContains(err, "Market vaults do not have enough coins to swap. Available amount: (main: 0), (reserve: 0)")
+	s.Require().ErrorContains(err, "Market vaults do not have enough coins to swap. Available amount: (main: 0)")
 
 	// 2) Happy case when exchange vault has enough balance
 	err = s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(30000))))
 	s.Require().NoError(err)
 
 	exchangeVaultBalanceBefore := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceBefore := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
+	reserveVaultBalanceBefore := s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx)
 	userBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
 	sdrSupplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, assets.MicroSDRDenom)
 
@@ -58,7 +61,7 @@ func (s *KeeperTestSuite) TestMsgServer_SwapToNativeCoins() {
 	s.Require().NoError(err)
 
 	exchangeVaultBalanceAfter := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceAfter := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
+	reserveVaultBalanceAfter := s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx)
 	userBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
 	sdrSupplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, assets.MicroSDRDenom)
 
@@ -67,11 +70,10 @@ func (s *KeeperTestSuite) TestMsgServer_SwapToNativeCoins() {
 	s.Require().Equal(resp.SwapCoin.Amount.Add(resp.SwapFee.Amount), exchangeVaultBalanceBefore.Amount.Sub(exchangeVaultBalanceAfter.Amount), "all asked amount should be deducted from exchange pool")
 	s.Require().Equal(sdrSupplyBefore.Amount.Sub(sdrSupplyAfter.Amount), swapAmountInSDR, "supply should decrease by swap amount since we burn stable coin")
 	s.Require().Equal(reserveVaultBalanceBefore.Amount, reserveVaultBalanceAfter.Amount, "reserve pool balance should not change")
-	s.Require().True(reserveVaultBalanceBefore.IsZero())
 }
 
 // TestMsgServer_SwapToNativeBalancePool tests the case when the user wants to swap from a stable coin to a native coin and vica verse.
-// In this case, the balance should be the same.
+// In this case, the user's melody balance should be the same.
 func (s *KeeperTestSuite) TestMsgServer_SwapToNativeBalancePool() {
 	msgServer := s.setupServer()
 
@@ -88,11 +90,12 @@ func (s *KeeperTestSuite) TestMsgServer_SwapToNativeBalancePool() {
 	s.Require().NoError(err)
 
 	exchangeVaultBalanceBefore := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceBefore := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
+	reserveVaultBalanceBefore := s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx)
 	userBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
 
 	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
 	s.Require().NoError(err)
+	redemptionFee := resp.SwapFee
 
 	offerCoin = resp.SwapCoin
 	swapMsg = types.NewMsgSwap(Addr, offerCoin, assets.MicroSDRDenom)
@@ -101,11 +104,12 @@ func (s *KeeperTestSuite) TestMsgServer_SwapToNativeBalancePool() {
 	s.Require().NoError(err)
 
 	exchangeVaultBalanceAfter := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceAfter := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
+	reserveVaultBalanceAfter := s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx)
 	userBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
 
 	s.Require().Equal(userBalanceBefore.Amount, userBalanceAfter.Amount, "user balance should not change")
-	s.Require().Equal(exchangeVaultBalanceBefore.Amount, exchangeVaultBalanceAfter.Amount, "exchange pool balance should not change")
+	s.Require().Equal(exchangeVaultBalanceBefore.Amount.Sub(redemptionFee.Amount), exchangeVaultBalanceAfter.Amount,
+		"exchange pool balance should only pay the spread of the redemption to the oracle reward pool")
 	s.Require().Equal(reserveVaultBalanceBefore.Amount, reserveVaultBalanceAfter.Amount, "reserve pool balance should not change")
 }
 
@@ -118,11 +122,15 @@ func (s *KeeperTestSuite) TestMsgServer_SwapStableToStable() {
 	usdPriceInMelody := sdk.NewDecWithPrec(13, 1) // 1 USD -> 1.3 Melody
 	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdrPriceInMelody)
 	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroUSDDenom, usdPriceInMelody)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, oracletypes.DefaultTobinTax)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroUSDDenom, oracletypes.DefaultTobinTax)
 
-	swapAmountInSDR := sdrPriceInMelody.MulInt64(rand.Int63()%10000 + 2).TruncateInt()
+	// large enough for the tobin tax not to truncate to zero
+	swapAmountInSDR := sdrPriceInMelody.MulInt64(rand.Int63()%10000 + 1000).TruncateInt()
 	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, swapAmountInSDR)
 
 	userBalanceSDRBefore := s.App.BankKeeper.GetBalance(s.Ctx, Addr, assets.MicroSDRDenom)
+	usdSupplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, assets.MicroUSDDenom)
 
 	swapMsg := types.NewMsgSwap(Addr, offerCoin, assets.MicroUSDDenom)
 	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
@@ -132,9 +140,140 @@ func (s *KeeperTestSuite) TestMsgServer_SwapStableToStable() {
 	userBalanceUSDAfter := s.App.BankKeeper.GetBalance(s.Ctx, Addr, assets.MicroUSDDenom)
 	s.Require().Equal(resp.SwapCoin, userBalanceUSDAfter, "user balance should increase by swap amount")
 	s.Require().Equal(userBalanceSDRBefore.Amount.Sub(userBalanceSDRAfter.Amount), swapAmountInSDR, "user balance should decrease by swap amount")
+
+	// the tobin tax is withheld from the swap coin and sent to the oracle reward pool
+	askAmount := swapAmountInSDR.ToLegacyDec().Mul(sdrPriceInMelody).Quo(usdPriceInMelody)
+	s.Require().True(resp.SwapFee.IsPositive())
+	s.Require().Equal(askAmount.Sub(askAmount.Mul(oracletypes.DefaultTobinTax)).TruncateInt(), resp.SwapCoin.Amount)
+	s.Require().Equal(askAmount.TruncateInt(), resp.SwapCoin.Amount.Add(resp.SwapFee.Amount))
+	s.Require().Equal(resp.SwapFee, s.App.OracleKeeper.GetRewardPool(s.Ctx, assets.MicroUSDDenom))
+
+	usdSupplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, assets.MicroUSDDenom)
+	s.Require().Equal(resp.SwapCoin.Amount.Add(resp.SwapFee.Amount), usdSupplyAfter.Amount.Sub(usdSupplyBefore.Amount), "swap coin and fee should be minted")
+}
+
+// TestMsgServer_SwapUpdatesPoolDelta tests that swaps between a stable coin and a native coin move the pool delta.
+func (s *KeeperTestSuite) TestMsgServer_SwapUpdatesPoolDelta() {
+	msgServer := s.setupServer()
+
+	// Set Oracle Price
+	sdrPriceInMelody := sdk.NewDecWithPrec(17, 1) // 1 SDR -> 1.7 Melody
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdrPriceInMelody)
+
+	err := s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(30000))))
+	s.Require().NoError(err)
+
+	// Stable -> Melody increases the delta by the value of the offer
+	swapMsg := types.NewMsgSwap(Addr, sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(10000)), appparams.BaseCoinUnit)
+	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
+	s.Require().NoError(err)
+	s.Require().True(resp.SwapFee.IsPositive(), "min stability spread should be charged")
+	s.Require().Equal(sdk.NewDec(17000), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+
+	// Melody -> Stable decreases the delta by the value of the swap coin
+	swapMsg = types.NewMsgSwap(Addr, resp.SwapCoin, assets.MicroSDRDenom)
+	resp, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
+	s.Require().NoError(err)
+	expectedDelta := sdk.NewDec(17000).Sub(sdk.NewDecFromInt(resp.SwapCoin.Amount).Mul(sdrPriceInMelody))
+	s.Require().Equal(expectedDelta, s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+}
+
+// TestMsgServer_SwapLargerThanBasePool tests that a Melody -> Stable swap of the whole base pool
+// keeps the stable pool positive, so the following swaps are still priced on the curve.
+func (s *KeeperTestSuite) TestMsgServer_SwapLargerThanBasePool() {
+	msgServer := s.setupServer()
+
+	sdrPriceInMelody := sdk.NewDecWithPrec(17, 1) // 1 SDR -> 1.7 Melody
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdrPriceInMelody)
+
+	basePool := s.App.MarketKeeper.BasePool(s.Ctx)
+	offerCoin := sdk.NewCoin(appparams.BaseCoinUnit, basePool.TruncateInt())
+	s.FundAcc(Addr, sdk.NewCoins(offerCoin))
+
+	// the curve pays out half of the stable pool for an offer of the size of the melody pool
+	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), types.NewMsgSwap(Addr, offerCoin, assets.MicroSDRDenom))
+	s.Require().NoError(err)
+	stablePool := basePool.Add(s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+	s.Require().True(stablePool.IsPositive())
+	s.Require().True(stablePool.GTE(basePool.QuoInt64(2)))
+
+	// a second swap is still priced on the positive pools
+	resp, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), types.NewMsgSwap(Addr, resp.SwapCoin, appparams.BaseCoinUnit))
+	s.Require().NoError(err)
+	s.Require().True(resp.SwapCoin.IsPositive())
+	s.Require().True(basePool.Add(s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx)).IsPositive())
+
+	// a depleted stable pool refuses the swaps instead of pricing them on a negative pool
+	s.App.MarketKeeper.SetOsmosisPoolDelta(s.Ctx, basePool.Neg())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), types.NewMsgSwap(Addr, sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000), assets.MicroSDRDenom))
+	s.Require().ErrorIs(err, types.ErrPoolDepleted)
+}
+
+// TestMsgServer_SwapMinAskAmount tests that the swap fails when it would return less than the min ask amount.
+func (s *KeeperTestSuite) TestMsgServer_SwapMinAskAmount() {
+	msgServer := s.setupServer()
+
+	// Set Oracle Price
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroUSDDenom, sdk.NewDecWithPrec(13, 1))
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, oracletypes.DefaultTobinTax)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroUSDDenom, oracletypes.DefaultTobinTax)
+
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(10000))
+	quote, err := keeper.NewQuerier(*s.App.MarketKeeper).Swap(sdk.WrapSDKContext(s.Ctx), &types.QuerySwapRequest{
+		OfferCoin: offerCoin.String(),
+		AskDenom:  assets.MicroUSDDenom,
+	})
+	s.Require().NoError(err)
+
+	swapMsg := types.NewMsgSwap(Addr, offerCoin, assets.MicroUSDDenom)
+	swapMsg.MinAskAmount = quote.ReturnCoin.Amount.AddRaw(1)
+	_, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
+	s.Require().ErrorIs(err, types.ErrMinAskAmountNotMet)
+
+	swapSendMsg := types.NewMsgSwapSend(Addr, Addr, offerCoin, assets.MicroUSDDenom)
+	swapSendMsg.MinAskAmount = quote.ReturnCoin.Amount.AddRaw(1)
+	_, err = msgServer.SwapSend(sdk.WrapSDKContext(s.Ctx), swapSendMsg)
+	s.Require().ErrorIs(err, types.ErrMinAskAmountNotMet)
+
+	swapMsg.MinAskAmount = quote.ReturnCoin.Amount
+	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(quote.ReturnCoin, resp.SwapCoin)
+	s.Require().Equal(quote.SwapFee, resp.SwapFee)
+}
+
+// TestMsgServer_SwapMintCapExceeded tests that the swap fails when it would issue more of the ask denom than its mint cap allows.
+func (s *KeeperTestSuite) TestMsgServer_SwapMintCapExceeded() {
+	msgServer := s.setupServer()
+
+	// Set Oracle Price
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroUSDDenom, sdk.NewDecWithPrec(13, 1))
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, oracletypes.DefaultTobinTax)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroUSDDenom, oracletypes.DefaultTobinTax)
+
+	params := s.App.MarketKeeper.GetParams(s.Ctx)
+	params.MintCaps = types.MintCaps{types.NewMintCap(assets.MicroUSDDenom, sdk.NewInt(10000), sdk.NewInt(100000))}
+	s.App.MarketKeeper.SetParams(s.Ctx, params)
+
+	// a failed tx is rolled back, so run the rejected swap on a cached context
+	cacheCtx, _ := s.Ctx.CacheContext()
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(10000))
+	_, err := msgServer.Swap(sdk.WrapSDKContext(cacheCtx), types.NewMsgSwap(Addr, offerCoin, assets.MicroUSDDenom))
+	s.Require().ErrorIs(err, types.ErrMintCapExceeded)
+
+	offerCoin = sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(5000))
+	_, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), types.NewMsgSwap(Addr, offerCoin, assets.MicroUSDDenom))
+	s.Require().NoError(err)
+
+	blockRemaining, _, found := s.App.MarketKeeper.GetRemainingMintCapacity(s.Ctx, assets.MicroUSDDenom)
+	s.Require().True(found)
+	s.Require().True(blockRemaining.LT(sdk.NewInt(10000)))
 }
 
-// TestMsgServe_SwapNotEnoughInMainPool tests the case when there is not enough balance in the main pool but enough in the reserve pool and swap should be successful.
+// TestMsgServe_SwapNotEnoughInMainPool tests the case when there is not enough balance in the main pool but enough in the reserve pool.
+// The reserve only backs swaps through the treasury refilling the main pool, so the swap should fail.
 func (s *KeeperTestSuite) TestMsgServe_SwapNotEnoughInMainPool() {
 	msgServer := s.setupServer()
 
@@ -150,31 +289,18 @@ func (s *KeeperTestSuite) TestMsgServe_SwapNotEnoughInMainPool() {
 	err := s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(appparams.MicroUnit/2))))
 	s.Require().NoError(err)
 
-	err = s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, types.ReserveModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(appparams.MicroUnit))))
+	err = s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, treasurytypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(appparams.MicroUnit))))
 	s.Require().NoError(err)
 
-	exchangeVaultBalanceBefore := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceBefore := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
-	userBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
+	reserveVaultBalanceBefore := s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx)
 
 	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
-	s.Require().NoError(err)
-
-	exchangeVaultBalanceAfter := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceAfter := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
-	userBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
-
-	exchangeVaultDiff := exchangeVaultBalanceBefore.Amount.Sub(exchangeVaultBalanceAfter.Amount)
-	reserveVaultDiff := reserveVaultBalanceBefore.Amount.Sub(reserveVaultBalanceAfter.Amount)
-	s.Require().Equal(swapAmountInSDR.ToLegacyDec(), userBalanceAfter.Amount.Sub(userBalanceBefore.Amount).ToLegacyDec(),
-		"user balance should increase by swap amount")
-	s.Require().Equal(resp.SwapCoin.Amount.ToLegacyDec(), exchangeVaultDiff.Add(reserveVaultDiff).ToLegacyDec(),
-		"all asked amount should be deducted from reserve pool and exchange pool")
-	s.Require().Equal(swapAmountInSDR.Sub(exchangeVaultBalanceBefore.Amount).ToLegacyDec(), reserveVaultDiff.ToLegacyDec(),
-		"difference that is not present in the main pool needs to be covered by the reserve pool")
+	s.Require().ErrorIs(err, types.ErrNotEnoughBalanceOnMarketVaults)
+	s.Require().Nil(resp)
+	s.Require().Equal(reserveVaultBalanceBefore, s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx), "reserve pool balance should not change")
 }
 
-// TestMsgServe_SwapMainPoolEmpty tests the case when the main pool is empty and swap should be successful since there is enough liquidity in the reserve pool.
+// TestMsgServe_SwapMainPoolEmpty tests the case when the main pool is empty and swap should fail even though there is enough liquidity in the reserve pool.
 func (s *KeeperTestSuite) TestMsgServe_SwapMainPoolEmpty() {
 	msgServer := s.setupServer()
 
@@ -187,25 +313,15 @@ func (s *KeeperTestSuite) TestMsgServe_SwapMainPoolEmpty() {
 
 	swapMsg := types.NewMsgSwap(Addr, offerCoin, appparams.BaseCoinUnit)
 
-	err := s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, types.ReserveModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(appparams.MicroUnit))))
+	err := s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, treasurytypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(appparams.MicroUnit))))
 	s.Require().NoError(err)
 
-	exchangeVaultBalanceBefore := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceBefore := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
-	userBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
+	reserveVaultBalanceBefore := s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx)
 
 	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
-	s.Require().NoError(err)
-
-	exchangeVaultBalanceAfter := s.App.MarketKeeper.GetExchangePoolBalance(s.Ctx)
-	reserveVaultBalanceAfter := s.App.MarketKeeper.GetReservePoolBalance(s.Ctx)
-	userBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, Addr, appparams.BaseCoinUnit)
-
-	exchangeVaultDiff := exchangeVaultBalanceBefore.Amount.Sub(exchangeVaultBalanceAfter.Amount)
-	reserveVaultDiff := reserveVaultBalanceBefore.Amount.Sub(reserveVaultBalanceAfter.Amount)
-	s.Require().True(exchangeVaultDiff.IsZero(), "exchange vault should not change")
-	s.Require().Equal(swapAmountInSDR.ToLegacyDec(), userBalanceAfter.Amount.Sub(userBalanceBefore.Amount).ToLegacyDec(), "user balance should increase by swap amount")
-	s.Require().Equal(resp.SwapCoin.Amount.ToLegacyDec(), reserveVaultDiff.ToLegacyDec(), "all asked amount should be deducted from reserve pool")
+	s.Require().ErrorIs(err, types.ErrNotEnoughBalanceOnMarketVaults)
+	s.Require().Nil(resp)
+	s.Require().Equal(reserveVaultBalanceBefore, s.App.TreasuryKeeper.GetReservePoolBalance(s.Ctx), "reserve pool balance should not change")
 }
 
 // TestMsgServe_SwapNotEnoughInReservePool tests the case when there is not enough balance in the reserve pool and swap should fail.
@@ -226,6 +342,34 @@ func (s *KeeperTestSuite) TestMsgServe_SwapNotEnoughInReservePool() {
 
 	resp, err := msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
 	s.Require().Error(err)
-	s.Require().ErrorContains(err, fmt.Sprintf("Market vaults do not have enough coins to swap. Available amount: (main: %v), (reserve: 0)", appparams.MicroUnit/2))
+	s.Require().ErrorContains(err, fmt.Sprintf("Market vaults do not have enough coins to swap. Available amount: (main: %v)", appparams.MicroUnit/2))
 	s.Require().Nil(resp)
 }
+
+// TestMsgServer_SwapWindingDownDenom tests that a denom winding down can neither be minted nor swapped away,
+// its holders redeeming it through the treasury instead.
+func (s *KeeperTestSuite) TestMsgServer_SwapWindingDownDenom() {
+	msgServer := s.setupServer()
+
+	oracleParams := s.App.OracleKeeper.GetParams(s.Ctx)
+	oracleParams.Whitelist = oracletypes.DenomList{{Name: assets.MicroSDRDenom, TobinTax: sdk.NewDecWithPrec(2, 2)}}
+	s.App.OracleKeeper.SetParams(s.Ctx, oracleParams)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(2, 2))
+
+	err := s.App.BankKeeper.SendCoinsFromModuleToModule(s.Ctx, FaucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(30000))))
+	s.Require().NoError(err)
+
+	settlementRate := sdk.NewDecWithPrec(17, 1)
+	windDown := oracletypes.NewWindDown(assets.MicroSDRDenom, settlementRate, settlementRate, s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.App.OracleKeeper.StartWindDown(s.Ctx, windDown))
+
+	// Swapping Melody -> SDR(winding down)
+	swapMsg := types.NewMsgSwap(Addr, sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000)), assets.MicroSDRDenom)
+	_, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
+	s.Require().ErrorIs(err, oracletypes.ErrDenomWindingDown)
+
+	// Swapping SDR(winding down) -> Melody
+	swapMsg = types.NewMsgSwap(Addr, sdk.NewCoin(assets.MicroSDRDenom, osmomath.NewInt(1000)), appparams.BaseCoinUnit)
+	_, err = msgServer.Swap(sdk.WrapSDKContext(s.Ctx), swapMsg)
+	s.Require().ErrorIs(err, oracletypes.ErrDenomWindingDown)
+}
@@ -6,6 +6,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// BasePool is the size of the virtual stable and melody pools at equilibrium, in melody units
+func (k Keeper) BasePool(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyBasePool, &res)
+	return
+}
+
 // MinStabilitySpread is the minimum spread applied to swaps to / from Note.
 // Intended to prevent swing trades exploiting oracle period delays
 func (k Keeper) MinStabilitySpread(ctx sdk.Context) (res sdk.Dec) {
@@ -13,12 +19,24 @@ func (k Keeper) MinStabilitySpread(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
-// PoolRecoveryPeriod is the period required to recover Symphony&Note Pools to the MintBasePool & BurnBasePool
+// PoolRecoveryPeriod is the period required to recover the stable and melody pools to the BasePool
 func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) (res uint64) {
 	k.paramSpace.Get(ctx, types.KeyPoolRecoveryPeriod, &res)
 	return
 }
 
+// MintCaps returns the caps on the net amount of each denom issued per block and per epoch
+func (k Keeper) MintCaps(ctx sdk.Context) (res types.MintCaps) {
+	k.paramSpace.Get(ctx, types.KeyMintCaps, &res)
+	return
+}
+
+// MintCapEpoch is the number of blocks in a mint cap epoch
+func (k Keeper) MintCapEpoch(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMintCapEpoch, &res)
+	return
+}
+
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSetIfExists(ctx, &params)
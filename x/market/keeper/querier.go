@@ -45,12 +45,50 @@ func (q querier) Swap(c context.Context, req *types.QuerySwapRequest) (*types.Qu
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	retCoin, err := q.simulateSwap(ctx, offerCoin, req.AskDenom)
+	retCoin, feeCoin, err := q.simulateSwap(ctx, offerCoin, req.AskDenom)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QuerySwapResponse{ReturnCoin: retCoin}, nil
+	return &types.QuerySwapResponse{ReturnCoin: retCoin, SwapFee: feeCoin}, nil
+}
+
+// PoolDelta queries the gap between the stable pool and the base pool
+func (q querier) PoolDelta(c context.Context, _ *types.QueryPoolDeltaRequest) (*types.QueryPoolDeltaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPoolDeltaResponse{PoolDelta: q.GetOsmosisPoolDelta(ctx)}, nil
+}
+
+// MintCapacity queries the remaining net amount of a denom the market can issue
+func (q querier) MintCapacity(c context.Context, req *types.QueryMintCapacityRequest) (*types.QueryMintCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	mintCap, found := q.MintCaps(ctx).Get(req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no mint cap for %s", req.Denom)
+	}
+
+	blockRemaining, epochRemaining, _ := q.GetRemainingMintCapacity(ctx, req.Denom)
+	return &types.QueryMintCapacityResponse{
+		MintCap:        mintCap,
+		BlockNetMint:   q.GetBlockNetMint(ctx, req.Denom),
+		EpochNetMint:   q.GetEpochNetMint(ctx, req.Denom),
+		BlockRemaining: blockRemaining,
+		EpochRemaining: epochRemaining,
+	}, nil
+}
+
+// CircuitBreaker queries the state of the market circuit breaker
+func (q querier) CircuitBreaker(c context.Context, _ *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryCircuitBreakerResponse{CircuitBreaker: q.GetCircuitBreaker(ctx)}, nil
 }
 
 // ExchangeRequirements returns the exchange requirements for the market module.
@@ -58,7 +96,7 @@ func (q querier) ExchangeRequirements(c context.Context, _ *types.QueryExchangeR
 	ctx := sdk.UnwrapSDKContext(c)
 	resp := &types.QueryExchangeRequirementsResponse{}
 
-	resp.ExchangeRequirements = q.getExchangeRates(ctx)
+	resp.ExchangeRequirements = q.GetExchangeRequirements(ctx)
 	total := sdk.ZeroDec()
 	for _, req := range resp.ExchangeRequirements {
 		total = total.Add(req.BaseCurrency.Amount.ToLegacyDec().Mul(req.ExchangeRate))
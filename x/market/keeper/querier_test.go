@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
 	appParams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"github.com/osmosis-labs/osmosis/v23/x/market/keeper"
 	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 )
 
 func (s *KeeperTestSuite) TestQueryParams() {
@@ -18,6 +21,17 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	s.Require().Equal(s.App.MarketKeeper.GetParams(s.Ctx), res.Params)
 }
 
+func (s *KeeperTestSuite) TestQueryPoolDelta() {
+	querier := keeper.NewQuerier(*s.App.MarketKeeper)
+
+	delta := sdk.NewDecWithPrec(17, 1)
+	s.App.MarketKeeper.SetOsmosisPoolDelta(s.Ctx, delta)
+
+	res, err := querier.PoolDelta(sdk.WrapSDKContext(s.Ctx), &types.QueryPoolDeltaRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(delta, res.PoolDelta)
+}
+
 func (s *KeeperTestSuite) TestQuerySwap() {
 	ctx := sdk.WrapSDKContext(s.Ctx)
 	querier := keeper.NewQuerier(*s.App.MarketKeeper)
@@ -57,4 +71,66 @@ func (s *KeeperTestSuite) TestQuerySwap() {
 	s.Require().Equal(assets.MicroSDRDenom, res.ReturnCoin.Denom)
 	s.Require().True(sdk.NewInt(17).GTE(res.ReturnCoin.Amount))
 	s.Require().True(res.ReturnCoin.Amount.IsPositive())
+	s.Require().Equal(assets.MicroSDRDenom, res.SwapFee.Denom)
+	s.Require().True(res.SwapFee.IsZero())
+}
+
+func (s *KeeperTestSuite) TestQuerySwapStableToStable() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+	querier := keeper.NewQuerier(*s.App.MarketKeeper)
+
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDec(2))
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroUSDDenom, sdk.OneDec())
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(1, 2))
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroUSDDenom, sdk.NewDecWithPrec(2, 2))
+
+	// 1000usdr -> 2000uusd, the 2% tobin tax of usd is withheld
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(1000)).String()
+	res, err := querier.Swap(ctx, &types.QuerySwapRequest{OfferCoin: offerCoin, AskDenom: assets.MicroUSDDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(assets.MicroUSDDenom, sdk.NewInt(1960)), res.ReturnCoin)
+	s.Require().Equal(sdk.NewCoin(assets.MicroUSDDenom, sdk.NewInt(40)), res.SwapFee)
+}
+
+func (s *KeeperTestSuite) TestQuerySwapCircuitBreaker() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+	querier := keeper.NewQuerier(*s.App.MarketKeeper)
+
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	mintRequest := &types.QuerySwapRequest{OfferCoin: sdk.NewInt64Coin(appParams.BaseCoinUnit, 10).String(), AskDenom: assets.MicroSDRDenom}
+	redeemRequest := &types.QuerySwapRequest{OfferCoin: sdk.NewInt64Coin(assets.MicroSDRDenom, 10).String(), AskDenom: appParams.BaseCoinUnit}
+
+	// only the redemptions are simulated while minting is paused
+	s.Require().NoError(s.App.MarketKeeper.OverrideCircuitBreaker(s.Ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY))
+	_, err := querier.Swap(ctx, mintRequest)
+	s.Require().ErrorContains(err, types.ErrCircuitBreakerTripped.Error())
+	_, err = querier.Swap(ctx, redeemRequest)
+	s.Require().NoError(err)
+
+	// no swap is simulated while the market is halted
+	s.Require().NoError(s.App.MarketKeeper.OverrideCircuitBreaker(s.Ctx, types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED))
+	_, err = querier.Swap(ctx, mintRequest)
+	s.Require().ErrorContains(err, types.ErrCircuitBreakerTripped.Error())
+	_, err = querier.Swap(ctx, redeemRequest)
+	s.Require().ErrorContains(err, types.ErrCircuitBreakerTripped.Error())
+}
+
+func (s *KeeperTestSuite) TestQuerySwapWindDown() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+	querier := keeper.NewQuerier(*s.App.MarketKeeper)
+
+	oracleParams := s.App.OracleKeeper.GetParams(s.Ctx)
+	oracleParams.Whitelist = oracletypes.DenomList{{Name: assets.MicroSDRDenom, TobinTax: sdk.NewDecWithPrec(2, 2)}}
+	s.App.OracleKeeper.SetParams(s.Ctx, oracleParams)
+
+	settlementRate := sdk.NewDecWithPrec(17, 1)
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, settlementRate)
+	windDown := oracletypes.NewWindDown(assets.MicroSDRDenom, settlementRate, settlementRate, s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.App.OracleKeeper.StartWindDown(s.Ctx, windDown))
+
+	// the denom winding down can't be asked for, nor offered
+	_, err := querier.Swap(ctx, &types.QuerySwapRequest{OfferCoin: sdk.NewInt64Coin(appParams.BaseCoinUnit, 10).String(), AskDenom: assets.MicroSDRDenom})
+	s.Require().ErrorContains(err, oracletypes.ErrDenomWindingDown.Error())
+	_, err = querier.Swap(ctx, &types.QuerySwapRequest{OfferCoin: sdk.NewInt64Coin(assets.MicroSDRDenom, 10).String(), AskDenom: appParams.BaseCoinUnit})
+	s.Require().ErrorContains(err, oracletypes.ErrDenomWindingDown.Error())
 }
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	appParams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -24,32 +25,108 @@ func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 		return sdk.DecCoin{}, sdk.Dec{}, err
 	}
 
-	// Symphony => Symphony swap
+	// Stable => Stable swap
 	// Apply only tobin tax without constant product spread
-	// TODO: yurii: revisit stable => stable swaps, apply tobix tax if needed.
-	//if offerCoin.Denom != appParams.BaseCoinUnit && askDenom != appParams.BaseCoinUnit {
-	//	var tobinTax sdk.Dec
-	//	offerTobinTax, err2 := k.OracleKeeper.GetTobinTax(ctx, offerCoin.Denom)
-	//	if err2 != nil {
-	//		return sdk.DecCoin{}, sdk.Dec{}, err2
-	//	}
-	//
-	//	askTobinTax, err2 := k.OracleKeeper.GetTobinTax(ctx, askDenom)
-	//	if err2 != nil {
-	//		return sdk.DecCoin{}, sdk.Dec{}, err2
-	//	}
-	//
-	//	// Apply highest tobin tax for the denoms in the swap operation
-	//	if askTobinTax.GT(offerTobinTax) {
-	//		tobinTax = askTobinTax
-	//	} else {
-	//		tobinTax = offerTobinTax
-	//	}
-	//
-	//	spread := tobinTax
-	//	return retDecCoin, spread, nil
-	//}
-	return retDecCoin, sdk.ZeroDec(), nil
+	if offerCoin.Denom != appParams.BaseCoinUnit && askDenom != appParams.BaseCoinUnit {
+		offerTobinTax, err := k.OracleKeeper.GetTobinTax(ctx, offerCoin.Denom)
+		if err != nil {
+			return sdk.DecCoin{}, sdk.Dec{}, err
+		}
+
+		askTobinTax, err := k.OracleKeeper.GetTobinTax(ctx, askDenom)
+		if err != nil {
+			return sdk.DecCoin{}, sdk.Dec{}, err
+		}
+
+		// Apply highest tobin tax for the denoms in the swap operation
+		spread := sdk.MaxDec(offerTobinTax, askTobinTax)
+		return retDecCoin, spread, nil
+	}
+
+	// Melody <=> Stable swap
+	// Apply the constant product spread of the virtual pools, with MinStabilitySpread as a floor
+	basePool := k.BasePool(ctx)
+	minSpread := k.MinStabilitySpread(ctx)
+
+	// constant-product, which by construction is square of base(equilibrium) pool
+	cp := basePool.Mul(basePool)
+	stablePool := basePool.Add(k.GetOsmosisPoolDelta(ctx))
+	if !stablePool.IsPositive() {
+		return sdk.DecCoin{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrPoolDepleted, "stable pool %s", stablePool)
+	}
+	melodyPool := cp.Quo(stablePool)
+
+	var offerPool sdk.Dec // melody unit
+	var askPool sdk.Dec   // melody unit
+	if offerCoin.Denom != appParams.BaseCoinUnit {
+		// Stable->Melody swap
+		offerPool = stablePool
+		askPool = melodyPool
+	} else {
+		// Melody->Stable swap
+		offerPool = melodyPool
+		askPool = stablePool
+	}
+
+	// Get the offer amount in melody unit
+	baseOfferDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), appParams.BaseCoinUnit)
+	if err != nil {
+		return sdk.DecCoin{}, sdk.Dec{}, err
+	}
+
+	// Get cp(constant-product) based swap amount
+	// askBaseAmount = askPool - cp / (offerPool + offerBaseAmount)
+	// askBaseAmount is melody unit
+	askBaseAmount := askPool.Sub(cp.Quo(offerPool.Add(baseOfferDecCoin.Amount)))
+
+	// Ensure the swap leaves both pools positive
+	if !askPool.Sub(askBaseAmount).IsPositive() {
+		return sdk.DecCoin{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrPoolDepleted, "ask pool %s, ask %s", askPool, askBaseAmount)
+	}
+
+	// Both baseOffer and baseAsk are melody units, so spread can be calculated by
+	// spread = (baseOfferAmt - baseAskAmt) / baseOfferAmt
+	baseOfferAmount := baseOfferDecCoin.Amount
+	spread := baseOfferAmount.Sub(askBaseAmount).Quo(baseOfferAmount)
+	if spread.LT(minSpread) {
+		spread = minSpread
+	}
+
+	return retDecCoin, spread, nil
+}
+
+// ApplySwapToPool updates the OsmosisPoolDelta to reflect a swap of offerCoin for askCoin.
+// Stable <=> Stable swaps don't change the delta as all stable coins share the same pool.
+func (k Keeper) ApplySwapToPool(ctx sdk.Context, offerCoin sdk.Coin, askCoin sdk.DecCoin) error {
+	// No delta update in case Stable to Stable swap
+	if offerCoin.Denom != appParams.BaseCoinUnit && askCoin.Denom != appParams.BaseCoinUnit {
+		return nil
+	}
+
+	delta := k.GetOsmosisPoolDelta(ctx)
+
+	// In case swapping Stable to Melody, the stable swap pool(offer) must be increased and the melody swap pool(ask) must be decreased
+	if offerCoin.Denom != appParams.BaseCoinUnit && askCoin.Denom == appParams.BaseCoinUnit {
+		offerBaseCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), appParams.BaseCoinUnit)
+		if err != nil {
+			return err
+		}
+
+		delta = delta.Add(offerBaseCoin.Amount)
+	}
+
+	// In case swapping Melody to Stable, the melody swap pool(offer) must be increased and the stable swap pool(ask) must be decreased
+	if offerCoin.Denom == appParams.BaseCoinUnit && askCoin.Denom != appParams.BaseCoinUnit {
+		askBaseCoin, err := k.ComputeInternalSwap(ctx, askCoin, appParams.BaseCoinUnit)
+		if err != nil {
+			return err
+		}
+
+		delta = delta.Sub(askBaseCoin.Amount)
+	}
+
+	k.SetOsmosisPoolDelta(ctx, delta)
+	return nil
 }
 
 // ComputeInternalSwap returns the amount of asked DecCoin should be returned for a given offerCoin at the effective
@@ -65,25 +142,25 @@ func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askD
 	if offerCoin.Denom == appParams.BaseCoinUnit { // melody -> stable
 		exchangeRatio, err := k.OracleKeeper.GetMelodyExchangeRate(ctx, askDenom)
 		if err != nil {
-			return sdk.DecCoin{}, errorsmod.Wrap(types.ErrNoEffectivePrice, askDenom)
+			return sdk.DecCoin{}, errorsmod.Wrapf(types.ErrNoEffectivePrice, "%s: %s", askDenom, err)
 		}
 		offerRate = exchangeRatio
 	} else if askDenom == appParams.BaseCoinUnit { // stable -> melody
 		exchangeRatio, err := k.OracleKeeper.GetMelodyExchangeRate(ctx, offerCoin.Denom)
 		if err != nil {
-			return sdk.DecCoin{}, errorsmod.Wrap(types.ErrNoEffectivePrice, offerCoin.Denom)
+			return sdk.DecCoin{}, errorsmod.Wrapf(types.ErrNoEffectivePrice, "%s: %s", offerCoin.Denom, err)
 		}
 		askRate = exchangeRatio
 	} else { // stable -> stable
 		var err error
 		askRate, err = k.OracleKeeper.GetMelodyExchangeRate(ctx, offerCoin.Denom)
 		if err != nil {
-			return sdk.DecCoin{}, errorsmod.Wrap(types.ErrNoEffectivePrice, offerCoin.Denom)
+			return sdk.DecCoin{}, errorsmod.Wrapf(types.ErrNoEffectivePrice, "%s: %s", offerCoin.Denom, err)
 		}
 
 		offerRate, err = k.OracleKeeper.GetMelodyExchangeRate(ctx, askDenom)
 		if err != nil {
-			return sdk.DecCoin{}, errorsmod.Wrap(types.ErrNoEffectivePrice, askDenom)
+			return sdk.DecCoin{}, errorsmod.Wrapf(types.ErrNoEffectivePrice, "%s: %s", askDenom, err)
 		}
 	}
 
@@ -95,29 +172,223 @@ func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askD
 	return sdk.NewDecCoinFromDec(askDenom, retAmount), nil
 }
 
-// simulateSwap interface for simulate swap
-func (k Keeper) simulateSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (sdk.Coin, error) {
+// simulateSwap interface for simulate swap. It returns the coin the trader would receive and
+// the swap fee withheld from it, computed the same way as the swap message handler. Like the
+// swap messages, it fails while the circuit breaker or a wind-down refuses the swap.
+func (k Keeper) simulateSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (sdk.Coin, sdk.Coin, error) {
 	if askDenom == offerCoin.Denom {
-		return sdk.Coin{}, errorsmod.Wrap(types.ErrRecursiveSwap, askDenom)
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrRecursiveSwap, askDenom)
+	}
+
+	err := k.validateSwap(ctx, offerCoin.Denom, askDenom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	if offerCoin.Amount.BigInt().BitLen() > 100 {
-		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, offerCoin.String())
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, offerCoin.String())
 	}
 
-	swapCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
+	swapDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
 	if err != nil {
-		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrPanic, err.Error())
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrPanic, err.Error())
 	}
 
+	swapCoin, feeCoin := applySwapFee(swapDecCoin, spread)
+	return swapCoin, feeCoin, nil
+}
+
+// applySwapFee withholds the spread from the swap coin. The decimal remainder truncated from the
+// swap coin is added to the fee, so the returned swap coin and fee never exceed the computed swap amount.
+func applySwapFee(swapDecCoin sdk.DecCoin, spread sdk.Dec) (swapCoin sdk.Coin, feeCoin sdk.Coin) {
+	feeDecCoin := sdk.NewDecCoin(swapDecCoin.Denom, sdk.ZeroInt())
 	if spread.IsPositive() {
-		swapFeeAmt := spread.Mul(swapCoin.Amount)
-		if swapFeeAmt.IsPositive() {
-			swapFee := sdk.NewDecCoinFromDec(swapCoin.Denom, swapFeeAmt)
-			swapCoin = swapCoin.Sub(swapFee)
+		feeDecCoin = sdk.NewDecCoinFromDec(swapDecCoin.Denom, spread.Mul(swapDecCoin.Amount))
+	}
+
+	// Subtract fee from the swap coin
+	swapDecCoin.Amount = swapDecCoin.Amount.Sub(feeDecCoin.Amount)
+
+	swapCoin, decimalCoin := swapDecCoin.TruncateDecimal()
+	feeCoin, _ = feeDecCoin.Add(decimalCoin).TruncateDecimal()
+	return swapCoin, feeCoin
+}
+
+// ConvertFromAccount converts the offer coin of the trader at the oracle rate, without spread, crediting the
+// converted coin back to the trader. It is meant for the modules converting the coins they collected, e.g. the
+// fees: unlike the swap messages, the trader may be a module account, and the conversion moves neither the
+// virtual pools nor the mint caps. The circuit breaker and the wind-downs still apply.
+func (k Keeper) ConvertFromAccount(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) (sdk.Coin, error) {
+	if offerCoin.Denom == askDenom {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrRecursiveSwap, askDenom)
+	}
+
+	err := k.validateSwap(ctx, offerCoin.Denom, askDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	askDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), askDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	askCoin, _ := askDecCoin.TruncateDecimal()
+	if !askCoin.IsPositive() {
+		return sdk.Coin{}, types.ErrZeroSwapCoin
+	}
+
+	offerCoins := sdk.NewCoins(offerCoin)
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, offerCoins)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if offerCoin.Denom != appParams.BaseCoinUnit {
+		err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, offerCoins)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if askDenom != appParams.BaseCoinUnit {
+		err = k.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(askCoin))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	} else {
+		marketVaultBalance := k.GetExchangePoolBalance(ctx)
+		if marketVaultBalance.Amount.LT(askCoin.Amount) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrNotEnoughBalanceOnMarketVaults, "Market vaults do not have enough coins to swap. Available amount: (main: %v), needed amount: %v",
+				marketVaultBalance.Amount, askCoin.Amount)
+		}
+	}
+
+	// Module accounts are blocked from receiving through the module send, so send directly
+	err = k.BankKeeper.SendCoins(ctx, k.GetMarketAccount(ctx).GetAddress(), trader, sdk.NewCoins(askCoin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return askCoin, nil
+}
+
+// validateSwap returns an error if the circuit breaker refuses swaps into the ask denom, or if either denom is
+// winding down.
+func (k Keeper) validateSwap(ctx sdk.Context, offerDenom string, askDenom string) error {
+	// Ensure the circuit breaker accepts swaps into the ask denom
+	err := k.ValidateSwapAllowed(ctx, askDenom)
+	if err != nil {
+		return err
+	}
+
+	// Denoms winding down can no longer be minted, and can only leave through the treasury redemption
+	if k.OracleKeeper.IsWindingDown(ctx, askDenom) {
+		return errorsmod.Wrapf(oracletypes.ErrDenomWindingDown, "swaps into %s are closed", askDenom)
+	}
+	if k.OracleKeeper.IsWindingDown(ctx, offerDenom) {
+		return errorsmod.Wrapf(oracletypes.ErrDenomWindingDown, "swaps from %s are closed, redeem it through the treasury instead", offerDenom)
+	}
+	return nil
+}
+
+// swap takes the offer coin from the trader and computes the swap at the oracle rate, charging a spread
+// if applicable. The swapped coin is left in the market module account for the caller to deliver,
+// while the spread is sent to the oracle reward pool.
+func (k Keeper) swap(ctx sdk.Context,
+	trader sdk.AccAddress, receiver sdk.AccAddress,
+	offerCoin sdk.Coin, askDenom string, minAskAmount sdk.Int,
+) (swapCoin sdk.Coin, feeCoin sdk.Coin, err error) {
+	err = k.validateSwap(ctx, offerCoin.Denom, askDenom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Compute exchange rates between the ask and offer
+	swapDecCoin, spread, err := k.ComputeSwap(ctx, offerCoin, askDenom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Send offer coins to module account
+	offerCoins := sdk.NewCoins(offerCoin)
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, trader, types.ModuleName, offerCoins)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if offerCoin.Denom != appParams.BaseCoinUnit {
+		// Burn offered coins and subtract from the trader's account
+		err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, offerCoins)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
 		}
 	}
 
-	retCoin, _ := swapCoin.TruncateDecimal()
-	return retCoin, nil
+	// Charge a spread if applicable; the fee is sent to the oracle reward pool
+	swapCoin, feeCoin = applySwapFee(swapDecCoin, spread)
+
+	// Ensure to fail the swap tx when zero swap coin
+	if !swapCoin.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrZeroSwapCoin
+	}
+
+	// Ensure to fail the swap tx when the rate moved against the trader
+	if !minAskAmount.IsNil() && swapCoin.Amount.LT(minAskAmount) {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrMinAskAmountNotMet, "swap coin %s, min ask amount %s", swapCoin, minAskAmount)
+	}
+
+	// Ensure the swap doesn't issue more than the mint caps allow
+	err = k.ApplySwapToMintCaps(ctx, offerCoin, swapCoin.Add(feeCoin))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Update pool delta
+	err = k.ApplySwapToPool(ctx, offerCoin, sdk.NewDecCoinFromCoin(swapCoin))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if askDenom != appParams.BaseCoinUnit {
+		// Mint asked coins; mint only stable coin
+		err = k.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(swapCoin.Add(feeCoin)))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	} else {
+		// native coin transfer using exchange vault
+		calculatedAskCoin := swapCoin.Add(feeCoin)
+
+		marketVaultBalance := k.GetExchangePoolBalance(ctx)
+		if marketVaultBalance.Amount.LT(calculatedAskCoin.Amount) {
+			return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrNotEnoughBalanceOnMarketVaults, "Market vaults do not have enough coins to swap. Available amount: (main: %v), needed amount: %v",
+				marketVaultBalance.Amount, calculatedAskCoin.Amount)
+		}
+	}
+
+	// Send the swap fee to the oracle module account, to be distributed to the oracle voters
+	if feeCoin.IsPositive() {
+		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(feeCoin))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventSwap,
+			sdk.NewAttribute(types.AttributeKeyOffer, offerCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFee, feeCoin.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return swapCoin, feeCoin, nil
 }
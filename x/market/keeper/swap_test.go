@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 )
 
 func (s *KeeperTestSuite) TestComputeSwap() {
@@ -17,9 +19,9 @@ func (s *KeeperTestSuite) TestComputeSwap() {
 		for i := 0; i < 100; i++ {
 			swapAmountInSDR := sdrPriceInMelody.MulInt64(rand.Int63()%10000 + 2).TruncateInt()
 			offerCoin := sdk.NewCoin(assets.MicroSDRDenom, swapAmountInSDR)
-			retCoin, _, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, appparams.BaseCoinUnit)
+			retCoin, spread, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, appparams.BaseCoinUnit)
 			s.Require().NoError(err)
-			//s.Require().True(spread.GTE(s.App.MarketKeeper.MinStabilitySpread(s.Ctx)))
+			s.Require().True(spread.GTE(s.App.MarketKeeper.MinStabilitySpread(s.Ctx)))
 			s.Require().Equal(sdk.NewDecFromInt(offerCoin.Amount).Mul(sdrPriceInMelody), retCoin.Amount)
 		}
 
@@ -36,9 +38,9 @@ func (s *KeeperTestSuite) TestComputeSwap() {
 		for i := 0; i < 100; i++ {
 			swapAmountInMelody := sdrPriceInMelody.MulInt64(rand.Int63()%10000 + 2).TruncateInt()
 			offerCoin := sdk.NewCoin(appparams.BaseCoinUnit, swapAmountInMelody)
-			retCoin, _, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, assets.MicroSDRDenom)
+			retCoin, spread, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, assets.MicroSDRDenom)
 			s.Require().NoError(err)
-			//s.Require().True(spread.GTE(s.App.MarketKeeper.MinStabilitySpread(s.Ctx)))
+			s.Require().True(spread.GTE(s.App.MarketKeeper.MinStabilitySpread(s.Ctx)))
 			s.Require().Equal(sdk.NewDecFromInt(offerCoin.Amount).Quo(sdrPriceInMelody), retCoin.Amount)
 		}
 
@@ -49,6 +51,104 @@ func (s *KeeperTestSuite) TestComputeSwap() {
 	})
 }
 
+func (s *KeeperTestSuite) TestComputeSwapSpread() {
+	// 1 SDR -> 2 Melody
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDec(2))
+
+	params := s.App.MarketKeeper.GetParams(s.Ctx)
+	params.BasePool = sdk.NewDec(1000000)
+	params.MinStabilitySpread = sdk.NewDecWithPrec(2, 2)
+	s.App.MarketKeeper.SetParams(s.Ctx, params)
+
+	tests := map[string]struct {
+		delta          sdk.Dec
+		offerCoin      sdk.Coin
+		askDenom       string
+		expectedSpread sdk.Dec
+	}{
+		"small swap is charged the min spread": {
+			delta:          sdk.ZeroDec(),
+			offerCoin:      sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000)),
+			askDenom:       assets.MicroSDRDenom,
+			expectedSpread: sdk.NewDecWithPrec(2, 2),
+		},
+		// melodyPool = 1000000, askBase = 1000000 - 1000000^2 / 2000000 = 500000
+		"melody to stable swap as large as the pool": {
+			delta:          sdk.ZeroDec(),
+			offerCoin:      sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000000)),
+			askDenom:       assets.MicroSDRDenom,
+			expectedSpread: sdk.NewDecWithPrec(5, 1),
+		},
+		// offerBase = 1000000, stablePool = 1000000, same as above
+		"stable to melody swap as large as the pool": {
+			delta:          sdk.ZeroDec(),
+			offerCoin:      sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(500000)),
+			askDenom:       appparams.BaseCoinUnit,
+			expectedSpread: sdk.NewDecWithPrec(5, 1),
+		},
+		// stablePool = 1500000, melodyPool = 666666.67, askBase = 666666.67 - 1000000^2 / 2500000 = 266666.67
+		"stable to melody swap when the stable pool is in excess": {
+			delta:          sdk.NewDec(500000),
+			offerCoin:      sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(500000)),
+			askDenom:       appparams.BaseCoinUnit,
+			expectedSpread: sdk.NewDec(11).QuoInt64(15),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.App.MarketKeeper.SetOsmosisPoolDelta(s.Ctx, tc.delta)
+
+			retCoin, spread, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, tc.offerCoin, tc.askDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.askDenom, retCoin.Denom)
+			s.Require().True(tc.expectedSpread.Sub(spread).Abs().LTE(sdk.NewDecWithPrec(1, 12)), "expected %s, got %s", tc.expectedSpread, spread)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestApplySwapToPool() {
+	// 1 SDR -> 2 Melody, 1 MNT -> 0.5 Melody
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDec(2))
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroMNTDenom, sdk.NewDecWithPrec(5, 1))
+
+	// Stable -> Melody increases the stable pool by the value of the offer
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(100))
+	askCoin := sdk.NewDecCoin(appparams.BaseCoinUnit, sdk.NewInt(196))
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToPool(s.Ctx, offerCoin, askCoin))
+	s.Require().Equal(sdk.NewDec(200), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+
+	// Melody -> Stable decreases the stable pool by the value of the ask
+	offerCoin = sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(100))
+	askCoin = sdk.NewDecCoin(assets.MicroMNTDenom, sdk.NewInt(196))
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToPool(s.Ctx, offerCoin, askCoin))
+	s.Require().Equal(sdk.NewDec(102), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+
+	// Stable -> Stable doesn't change the pools
+	offerCoin = sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(100))
+	askCoin = sdk.NewDecCoin(assets.MicroMNTDenom, sdk.NewInt(400))
+	s.Require().NoError(s.App.MarketKeeper.ApplySwapToPool(s.Ctx, offerCoin, askCoin))
+	s.Require().Equal(sdk.NewDec(102), s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestComputeSwapStaleRate() {
+	// Set Oracle Price
+	sdrPriceInMelody := sdk.NewDecWithPrec(17, 1)
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdrPriceInMelody)
+
+	maxRateAge := int64(s.App.OracleKeeper.MaxRateAge(s.Ctx))
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + maxRateAge + 1)
+
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(1000))
+	_, _, err := s.App.MarketKeeper.ComputeSwap(ctx, offerCoin, appparams.BaseCoinUnit)
+	s.Require().ErrorIs(err, types.ErrNoEffectivePrice)
+	s.Require().ErrorContains(err, oracletypes.ErrStaleExchangeRate.Error())
+
+	offerCoin = sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000))
+	_, _, err = s.App.MarketKeeper.ComputeSwap(ctx, offerCoin, assets.MicroSDRDenom)
+	s.Require().ErrorIs(err, types.ErrNoEffectivePrice)
+}
+
 func (s *KeeperTestSuite) TestComputeInternalSwap() {
 	// Set Oracle Price
 	sdrPriceInMelody := sdk.NewDecWithPrec(17, 1)
@@ -74,6 +174,8 @@ func (s *KeeperTestSuite) TestComputeInternalSwapStableAndStable() {
 	mntPriceInMelody := sdk.NewDecWithPrec(7652, 1)
 	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdrPriceInMelody)
 	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroMNTDenom, mntPriceInMelody)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, oracletypes.DefaultTobinTax)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroMNTDenom, oracletypes.DefaultTobinTax)
 
 	params := s.App.MarketKeeper.GetParams(s.Ctx)
 	s.App.MarketKeeper.SetParams(s.Ctx, params)
@@ -85,24 +187,46 @@ func (s *KeeperTestSuite) TestComputeInternalSwapStableAndStable() {
 	s.Require().Equal(swapAmountInSDR.ToLegacyDec().Mul(sdrPriceInMelody).Quo(mntPriceInMelody), swapCoin.Amount)
 }
 
-//func (s *KeeperTestSuite) TestIlliquidTobinTaxListParams() {
-//	// Set Oracle Price
-//	melodyPriceInSDR := sdk.NewDecWithPrec(17, 1)
-//	melodyPriceInMNT := sdk.NewDecWithPrec(7652, 1)
-//	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, melodyPriceInSDR)
-//	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroMNTDenom, melodyPriceInMNT)
-//
-//	tobinTax := sdk.NewDecWithPrec(25, 4)
-//	params := s.App.MarketKeeper.GetParams(s.Ctx)
-//	s.App.MarketKeeper.SetParams(s.Ctx, params)
-//
-//	illiquidFactor := sdk.NewDec(2)
-//	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, tobinTax)
-//	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroMNTDenom, tobinTax.Mul(illiquidFactor))
-//
-//	swapAmountInSDR := melodyPriceInSDR.MulInt64(rand.Int63()%10000 + 2).TruncateInt()
-//	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, swapAmountInSDR)
-//	_, spread, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, assets.MicroMNTDenom)
-//	s.Require().NoError(err)
-//	s.Require().Equal(tobinTax.Mul(illiquidFactor), spread)
-//}
+func (s *KeeperTestSuite) TestIlliquidTobinTaxListParams() {
+	// Set Oracle Price
+	melodyPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	melodyPriceInMNT := sdk.NewDecWithPrec(7652, 1)
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, melodyPriceInSDR)
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroMNTDenom, melodyPriceInMNT)
+
+	tobinTax := sdk.NewDecWithPrec(25, 4)
+	params := s.App.MarketKeeper.GetParams(s.Ctx)
+	s.App.MarketKeeper.SetParams(s.Ctx, params)
+
+	illiquidFactor := sdk.NewDec(2)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, tobinTax)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroMNTDenom, tobinTax.Mul(illiquidFactor))
+
+	swapAmountInSDR := melodyPriceInSDR.MulInt64(rand.Int63()%10000 + 2).TruncateInt()
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, swapAmountInSDR)
+	_, spread, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, assets.MicroMNTDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(tobinTax.Mul(illiquidFactor), spread)
+
+	// the highest tobin tax applies in both directions
+	offerCoin = sdk.NewCoin(assets.MicroMNTDenom, swapAmountInSDR)
+	_, spread, err = s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, assets.MicroSDRDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(tobinTax.Mul(illiquidFactor), spread)
+
+	// swaps with Melody are charged the stability spread instead of the tobin tax
+	_, spread, err = s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, appparams.BaseCoinUnit)
+	s.Require().NoError(err)
+	s.Require().Equal(s.App.MarketKeeper.MinStabilitySpread(s.Ctx), spread)
+}
+
+func (s *KeeperTestSuite) TestComputeSwapWithoutTobinTax() {
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroMNTDenom, sdk.NewDecWithPrec(7652, 1))
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, oracletypes.DefaultTobinTax)
+
+	// MNT is not whitelisted with a tobin tax, so the stable to stable swap is refused
+	offerCoin := sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(1000))
+	_, _, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, offerCoin, assets.MicroMNTDenom)
+	s.Require().ErrorIs(err, oracletypes.ErrNoTobinTax)
+}
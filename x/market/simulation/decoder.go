@@ -21,6 +21,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &deltaA)
 			cdc.MustUnmarshal(kvB.Value, &deltaB)
 			return fmt.Sprintf("%v\n%v", deltaA, deltaB)
+		case bytes.Equal(kvA.Key[:1], types.CircuitBreakerKey):
+			var circuitBreakerA, circuitBreakerB types.CircuitBreaker
+			cdc.MustUnmarshal(kvA.Value, &circuitBreakerA)
+			cdc.MustUnmarshal(kvB.Value, &circuitBreakerB)
+			return fmt.Sprintf("%v\n%v", circuitBreakerA, circuitBreakerB)
 		default:
 			panic(fmt.Sprintf("invalid market key prefix %X", kvA.Key[:1]))
 		}
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,16 +9,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	simapp "github.com/osmosis-labs/osmosis/v23/app"
-
-	"github.com/osmosis-labs/osmosis/v23/x/mint/simulation"
+	"github.com/osmosis-labs/osmosis/v23/x/market/types"
 )
 
 func TestDecodeDistributionStore(t *testing.T) {
 	cdc, _ := simapp.MakeCodecs()
-	dec := simulation.NewDecodeStore(cdc)
+	dec := NewDecodeStore(cdc)
+
+	circuitBreaker := types.NewCircuitBreaker(types.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY, true)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
+			{Key: types.CircuitBreakerKey, Value: cdc.MustMarshal(&circuitBreaker)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -26,6 +29,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
+		{"CircuitBreaker", fmt.Sprintf("%v\n%v", circuitBreaker, circuitBreaker)},
 		{"other", ""},
 	}
 
@@ -37,6 +37,12 @@ func GenMinSpread(r *rand.Rand) sdk.Dec {
 
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
+	var basePool sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, basePoolKey, &basePool, simState.Rand,
+		func(r *rand.Rand) { basePool = GenBasePool(r) },
+	)
+
 	var poolRecoveryPeriod uint64
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, poolRecoveryPeriodKey, &poolRecoveryPeriod, simState.Rand,
@@ -51,9 +57,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	marketGenesis := types.NewGenesisState(
 		types.Params{
+			ExchangePool:       sdk.ZeroDec(),
+			BasePool:           basePool,
 			PoolRecoveryPeriod: poolRecoveryPeriod,
 			MinStabilitySpread: minStabilitySpread,
+			MintCaps:           types.DefaultMintCaps,
+			MintCapEpoch:       types.DefaultMintCapEpoch,
 		},
+		sdk.ZeroDec(),
+		types.DefaultCircuitBreaker(),
 	)
 
 	bz, err := json.MarshalIndent(&marketGenesis.Params, "", " ")
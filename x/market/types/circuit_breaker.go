@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewCircuitBreaker creates a CircuitBreaker instance
+func NewCircuitBreaker(state CircuitBreakerState, overridden bool) CircuitBreaker {
+	return CircuitBreaker{
+		State:      state,
+		Overridden: overridden,
+	}
+}
+
+// DefaultCircuitBreaker returns a circuit breaker accepting all swaps
+func DefaultCircuitBreaker() CircuitBreaker {
+	return NewCircuitBreaker(CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL, false)
+}
+
+// Validate checks that the circuit breaker is in a known state
+func (cb CircuitBreaker) Validate() error {
+	return cb.State.Validate()
+}
+
+// Validate checks that the state is a known non-default value.
+func (s CircuitBreakerState) Validate() error {
+	switch s {
+	case CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL,
+		CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY,
+		CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidCircuitBreakerState, "unknown circuit breaker state %s", s)
+	}
+}
+
+// MintActive returns true if swaps into stable coins are allowed in the state.
+func (s CircuitBreakerState) MintActive() bool {
+	return s == CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL
+}
+
+// RedemptionActive returns true if swaps into melody are allowed in the state.
+// Redemptions are closed while HALTED: they pay the full oracle value, so once the backing is that short the
+// first holders to redeem would drain it at the expense of the others. Holders exit pro rata through the
+// treasury wind-down instead.
+func (s CircuitBreakerState) RedemptionActive() bool {
+	return s == CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL ||
+		s == CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY
+}
@@ -14,6 +14,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSwap{}, "market/MsgSwap")
 	legacy.RegisterAminoMsg(cdc, &MsgSwapSend{}, "market/MsgSwapSend")
+	legacy.RegisterAminoMsg(cdc, &MsgOverrideCircuitBreaker{}, "market/MsgOverrideCircuitBreaker")
 }
 
 // RegisterInterfaces registers the x/market interfaces types with the interface registry
@@ -21,6 +22,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSwap{},
 		&MsgSwapSend{},
+		&MsgOverrideCircuitBreaker{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -10,4 +10,10 @@ var (
 	ErrNoEffectivePrice               = errorsmod.Register(ModuleName, 3, "no price registered with oracle")
 	ErrZeroSwapCoin                   = errorsmod.Register(ModuleName, 4, "zero swap coin")
 	ErrNotEnoughBalanceOnMarketVaults = errorsmod.Register(ModuleName, 5, "not enough balance on market vaults")
+	ErrMinAskAmountNotMet             = errorsmod.Register(ModuleName, 6, "swap coin is below the min ask amount")
+	ErrMintCapExceeded                = errorsmod.Register(ModuleName, 7, "mint cap exceeded")
+	ErrInvalidCircuitBreakerState     = errorsmod.Register(ModuleName, 8, "invalid circuit breaker state")
+	ErrCircuitBreakerTripped          = errorsmod.Register(ModuleName, 9, "swap refused by the circuit breaker")
+	ErrUnauthorized                   = errorsmod.Register(ModuleName, 10, "unauthorized")
+	ErrPoolDepleted                   = errorsmod.Register(ModuleName, 11, "virtual pool depleted")
 )
@@ -2,13 +2,17 @@ package types
 
 // Market module event types
 const (
-	EventSwap = "swap"
+	EventSwap           = "swap"
+	EventCircuitBreaker = "circuit_breaker"
 
 	AttributeKeyOffer     = "offer"
 	AttributeKeyTrader    = "trader"
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySwapCoin  = "swap_coin"
 	AttributeKeySwapFee   = "swap_fee"
+	AttributeKeyOldState  = "old_state"
+	AttributeKeyNewState  = "new_state"
+	AttributeKeyOverride  = "overridden"
 
 	AttributeValueCategory = ModuleName
 )
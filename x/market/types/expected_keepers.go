@@ -17,6 +17,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
@@ -33,6 +34,13 @@ type OracleKeeper interface {
 	// GetMelodyExchangeRate returns the exchange rate of the given denom to melody. Returned value is in melody.
 	GetMelodyExchangeRate(ctx sdk.Context, denom string) (price sdk.Dec, err error)
 	GetTobinTax(ctx sdk.Context, denom string) (tobinTax sdk.Dec, err error)
+	// IsWindingDown returns true if the denom is winding down and can no longer be minted.
+	IsWindingDown(ctx sdk.Context, denom string) bool
+	// IsWindDownComplete returns true if the denom wound down, and its leftover supply is no longer backed.
+	IsWindDownComplete(ctx sdk.Context, denom string) bool
+	// GetLastMelodyExchangeRate returns the most recent exchange rate recorded for the denom, even if stale.
+	GetLastMelodyExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+	IterateTobinTaxes(ctx sdk.Context, handler func(denom string, tobinTax sdk.Dec) (stop bool))
 
 	// only used for simulation
 	IterateNoteExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate sdk.Dec) (stop bool))
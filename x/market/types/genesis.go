@@ -4,24 +4,33 @@ import (
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, osmosisPoolDelta sdk.Dec, circuitBreaker CircuitBreaker) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:           params,
+		OsmosisPoolDelta: osmosisPoolDelta,
+		CircuitBreaker:   circuitBreaker,
 	}
 }
 
 // DefaultGenesisState returns raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:           DefaultParams(),
+		OsmosisPoolDelta: sdk.ZeroDec(),
+		CircuitBreaker:   DefaultCircuitBreaker(),
 	}
 }
 
 // ValidateGenesis validates the provided market genesis state
 func ValidateGenesis(data *GenesisState) error {
+	if err := data.CircuitBreaker.Validate(); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +28,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// osmosis_pool_delta defines the gap between the stable pool and the base
+	// pool.
+	OsmosisPoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=osmosis_pool_delta,json=osmosisPoolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"osmosis_pool_delta"`
+	// circuit_breaker defines the state of the market circuit breaker.
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,3,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +75,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.market.v1beta1.GenesisState")
 }
@@ -78,20 +91,26 @@ func init() {
 }
 
 var fileDescriptor_3a9843ab068d8c85 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x71, 0x98, 0x09, 0xd5, 0x0c, 0x56, 0xa4,
-	0xe4, 0xc3, 0xc5, 0xe3, 0x0e, 0xb1, 0x23, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x86, 0x8b, 0xad,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0xbb,
-	0x9d, 0x7a, 0x01, 0x60, 0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xf5, 0x38, 0xf9,
-	0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x44, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47,
-	0xbf, 0xcc, 0xc8, 0x58, 0xbf, 0x02, 0xe6, 0xd4, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0,
-	0x13, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x38, 0xec, 0x2e, 0xc1, 0x1d, 0x01, 0x00, 0x00,
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd0, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0x07, 0xf0, 0x5c, 0x95, 0x0e, 0xb1, 0xa8, 0x04, 0x91, 0xd2, 0xe1, 0x5a, 0x54, 0x4a, 0x97,
+	0xde, 0xd1, 0x76, 0x75, 0x8a, 0x05, 0x17, 0x87, 0x52, 0x71, 0x11, 0x21, 0x5c, 0xae, 0x47, 0x0c,
+	0x49, 0x7c, 0xe1, 0xee, 0x5a, 0x74, 0xf4, 0x3f, 0xf0, 0xcf, 0xea, 0xd8, 0x51, 0x1c, 0x8a, 0x24,
+	0xff, 0x88, 0xe4, 0x72, 0x05, 0x05, 0x3b, 0xdd, 0x0f, 0x3e, 0xef, 0xbd, 0x2f, 0xcf, 0xbd, 0x02,
+	0x95, 0x81, 0x8a, 0x15, 0xcd, 0x98, 0x4c, 0x84, 0xa6, 0xab, 0x51, 0x28, 0x34, 0x1b, 0xd1, 0x48,
+	0xbc, 0x08, 0x15, 0x2b, 0x92, 0x4b, 0xd0, 0xe0, 0x9d, 0x5b, 0x45, 0x6a, 0x45, 0xac, 0xea, 0x9c,
+	0x45, 0x10, 0x81, 0x21, 0xb4, 0xba, 0xd5, 0xba, 0x73, 0xb9, 0xa7, 0xa7, 0x2d, 0x36, 0xe8, 0xe2,
+	0xbd, 0xe1, 0xb6, 0x6e, 0xeb, 0x21, 0xf7, 0x9a, 0x69, 0xe1, 0x5d, 0xbb, 0xcd, 0x9c, 0x49, 0x96,
+	0xa9, 0x36, 0xea, 0xa1, 0xc1, 0xd1, 0x18, 0x93, 0xff, 0x87, 0x92, 0x99, 0x51, 0xfe, 0xe1, 0x7a,
+	0xdb, 0x75, 0xe6, 0xb6, 0xc6, 0x7b, 0x72, 0x3d, 0xcb, 0x83, 0x1c, 0x20, 0x0d, 0x16, 0x22, 0xd5,
+	0xac, 0xdd, 0xe8, 0xa1, 0x41, 0xcb, 0x27, 0x95, 0xfc, 0xda, 0x76, 0xfb, 0x51, 0xac, 0x9f, 0x97,
+	0x21, 0xe1, 0x90, 0x51, 0x6e, 0xb4, 0x3d, 0x86, 0x6a, 0x91, 0x50, 0xfd, 0x96, 0x0b, 0x45, 0xa6,
+	0x82, 0xcf, 0x4f, 0x6d, 0xa7, 0x19, 0x40, 0x3a, 0xad, 0xfa, 0x78, 0x0f, 0xee, 0x09, 0x8f, 0x25,
+	0x5f, 0xc6, 0x3a, 0x08, 0xa5, 0x60, 0x89, 0x90, 0xed, 0x03, 0x13, 0xb2, 0xbf, 0x2f, 0xe4, 0x4d,
+	0xcd, 0xfd, 0x5a, 0xdb, 0xb0, 0xc7, 0xfc, 0xef, 0xef, 0xdd, 0xba, 0xc0, 0x68, 0x53, 0x60, 0xf4,
+	0x5d, 0x60, 0xf4, 0x51, 0x62, 0x67, 0x53, 0x62, 0xe7, 0xb3, 0xc4, 0xce, 0xe3, 0xf8, 0x57, 0x54,
+	0x3b, 0x61, 0x98, 0xb2, 0x50, 0xed, 0x1e, 0x74, 0x35, 0x9e, 0xd0, 0xd7, 0xdd, 0x82, 0x4d, 0xf4,
+	0xb0, 0x69, 0x16, 0x3b, 0xf9, 0x19, 0x00, 0x5a, 0xda, 0x4e, 0x89, 0xd3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +133,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OsmosisPoolDelta.Size()
+		i -= size
+		if _, err := m.OsmosisPoolDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +185,10 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OsmosisPoolDelta.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -217,6 +260,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmosisPoolDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmosisPoolDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,4 +22,8 @@ func TestGenesisValidation(t *testing.T) {
 	genState = DefaultGenesisState()
 	genState.Params.MinStabilitySpread = sdk.NewDec(-1)
 	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
+	genState.CircuitBreaker.State = CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+	require.Error(t, ValidateGenesis(genState))
 }
@@ -18,7 +18,26 @@ const (
 // Items are stored with the following key: values
 //
 // - 0x01: sdk.Dec
+//
+// - 0x02<denom_Bytes>: sdk.Int
+//
+// - 0x03<denom_Bytes>: sdk.Int
+//
+// - 0x04: CircuitBreaker
 var (
 	// Keys for store prefixed
 	OsmosisPoolDeltaKey = []byte{0x01} // key for symphony pool delta which gap between MintPool from BasePool
+	BlockNetMintKey     = []byte{0x02} // prefix for the net amount of each denom issued in the current block
+	EpochNetMintKey     = []byte{0x03} // prefix for the net amount of each denom issued in the current epoch
+	CircuitBreakerKey   = []byte{0x04} // key for the state of the circuit breaker
 )
+
+// GetBlockNetMintKey - stored by *denom*
+func GetBlockNetMintKey(denom string) []byte {
+	return append(BlockNetMintKey, []byte(denom)...)
+}
+
+// GetEpochNetMintKey - stored by *denom*
+func GetEpochNetMintKey(denom string) []byte {
+	return append(EpochNetMintKey, []byte(denom)...)
+}
@@ -24,9 +24,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CircuitBreakerState defines which swaps the market accepts.
+type CircuitBreakerState int32
+
+const (
+	// CIRCUIT_BREAKER_STATE_UNSPECIFIED is only used to lift an override
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED CircuitBreakerState = 0
+	// CIRCUIT_BREAKER_STATE_NORMAL accepts all swaps
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL CircuitBreakerState = 1
+	// CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY accepts only swaps into melody
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY CircuitBreakerState = 2
+	// CIRCUIT_BREAKER_STATE_HALTED refuses all swaps
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED CircuitBreakerState = 3
+)
+
+var CircuitBreakerState_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_STATE_UNSPECIFIED",
+	1: "CIRCUIT_BREAKER_STATE_NORMAL",
+	2: "CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY",
+	3: "CIRCUIT_BREAKER_STATE_HALTED",
+}
+
+var CircuitBreakerState_value = map[string]int32{
+	"CIRCUIT_BREAKER_STATE_UNSPECIFIED":     0,
+	"CIRCUIT_BREAKER_STATE_NORMAL":          1,
+	"CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY": 2,
+	"CIRCUIT_BREAKER_STATE_HALTED":          3,
+}
+
+func (x CircuitBreakerState) String() string {
+	return proto.EnumName(CircuitBreakerState_name, int32(x))
+}
+
+func (CircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1ff5b4d62e19a3b, []int{0}
+}
+
 // Params defines the parameters for the market module.
 type Params struct {
 	ExchangePool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_pool,json=exchangePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_pool" yaml:"exchange_pool"`
+	// base_pool is the size of the virtual stable and melody pools at
+	// equilibrium, in melody units.
+	BasePool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
+	// pool_recovery_period is the number of blocks over which the pool delta
+	// recovers towards zero.
+	PoolRecoveryPeriod uint64 `protobuf:"varint,3,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
+	// min_stability_spread is the minimum spread charged on melody and stable
+	// swaps.
+	MinStabilitySpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread" yaml:"min_stability_spread"`
+	// mint_caps limits the net amount of each denom the market can issue per
+	// block and per epoch. Denoms without a cap are not limited.
+	MintCaps MintCaps `protobuf:"bytes,5,rep,name=mint_caps,json=mintCaps,proto3,castrepeated=MintCaps" json:"mint_caps" yaml:"mint_caps"`
+	// mint_cap_epoch is the number of blocks in an epoch of the mint caps.
+	MintCapEpoch uint64 `protobuf:"varint,6,opt,name=mint_cap_epoch,json=mintCapEpoch,proto3" json:"mint_cap_epoch,omitempty" yaml:"mint_cap_epoch"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -61,8 +111,137 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetPoolRecoveryPeriod() uint64 {
+	if m != nil {
+		return m.PoolRecoveryPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMintCaps() MintCaps {
+	if m != nil {
+		return m.MintCaps
+	}
+	return nil
+}
+
+func (m *Params) GetMintCapEpoch() uint64 {
+	if m != nil {
+		return m.MintCapEpoch
+	}
+	return 0
+}
+
+// MintCap is the maximum net amount of a denom the market can issue through
+// swaps. For stable denoms this is minted minus burned supply, for melody it is
+// the outflow from the exchange vault.
+type MintCap struct {
+	Denom    string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	PerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=per_block,json=perBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"per_block" yaml:"per_block"`
+	PerEpoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=per_epoch,json=perEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"per_epoch" yaml:"per_epoch"`
+}
+
+func (m *MintCap) Reset()         { *m = MintCap{} }
+func (m *MintCap) String() string { return proto.CompactTextString(m) }
+func (*MintCap) ProtoMessage()    {}
+func (*MintCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1ff5b4d62e19a3b, []int{1}
+}
+func (m *MintCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintCap.Merge(m, src)
+}
+func (m *MintCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintCap proto.InternalMessageInfo
+
+func (m *MintCap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// CircuitBreaker is the current state of the market circuit breaker. The state
+// follows the collateralization of the stable supply, unless governance
+// overrides it.
+type CircuitBreaker struct {
+	State CircuitBreakerState `protobuf:"varint,1,opt,name=state,proto3,enum=osmosis.market.v1beta1.CircuitBreakerState" json:"state,omitempty" yaml:"state"`
+	// overridden is true while the state is pinned by governance
+	Overridden bool `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty" yaml:"overridden"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1ff5b4d62e19a3b, []int{2}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetState() CircuitBreakerState {
+	if m != nil {
+		return m.State
+	}
+	return CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+}
+
+func (m *CircuitBreaker) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.market.v1beta1.CircuitBreakerState", CircuitBreakerState_name, CircuitBreakerState_value)
 	proto.RegisterType((*Params)(nil), "osmosis.market.v1beta1.Params")
+	proto.RegisterType((*MintCap)(nil), "osmosis.market.v1beta1.MintCap")
+	proto.RegisterType((*CircuitBreaker)(nil), "osmosis.market.v1beta1.CircuitBreaker")
 }
 
 func init() {
@@ -70,22 +249,50 @@ func init() {
 }
 
 var fileDescriptor_d1ff5b4d62e19a3b = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0x84, 0x72, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xa0, 0x8a, 0xf4, 0xa0,
-	0xa2, 0x50, 0x45, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x25, 0xfa, 0x20, 0x16, 0x44, 0xb5,
-	0x52, 0x3d, 0x17, 0x5b, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x50, 0x36, 0x17, 0x6f, 0x6a, 0x45,
-	0x72, 0x46, 0x62, 0x5e, 0x7a, 0x6a, 0x7c, 0x41, 0x7e, 0x7e, 0x8e, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x8f, 0x93, 0xdb, 0x89, 0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa5, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0x83, 0xad, 0x80, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa,
-	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x2e, 0xa9, 0xc9, 0x9f, 0xee, 0xc9, 0x8b, 0x54, 0x26, 0xe6,
-	0xe6, 0x58, 0x29, 0xa1, 0x18, 0xa6, 0x14, 0xc4, 0x03, 0xe3, 0x07, 0xe4, 0xe7, 0xe7, 0x58, 0x71,
-	0xcc, 0x58, 0x20, 0xcf, 0xf0, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xcf, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0x21, 0xd9, 0x08, 0xf5, 0x93, 0x6e, 0x4e, 0x62, 0x52, 0x31,
-	0x8c, 0xa3, 0x5f, 0x66, 0x64, 0xac, 0x5f, 0x01, 0x0b, 0x0b, 0xb0, 0x0b, 0x92, 0xd8, 0xc0, 0xbe,
-	0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xeb, 0xbd, 0x1f, 0x2a, 0x01, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
+	0x1c, 0x8d, 0x9b, 0xb4, 0x24, 0x47, 0xa8, 0xc2, 0x91, 0xa2, 0xf0, 0xa1, 0x38, 0x18, 0x5a, 0x15,
+	0x50, 0x63, 0x35, 0x15, 0x4b, 0x17, 0x14, 0x27, 0xae, 0x88, 0x48, 0xd2, 0x70, 0x49, 0x07, 0x58,
+	0xac, 0xb3, 0x73, 0x4a, 0xad, 0xc4, 0x3e, 0xcb, 0xbe, 0x56, 0xcd, 0xc4, 0xbf, 0xc0, 0xc8, 0x58,
+	0x31, 0xc2, 0x3f, 0xd2, 0xb1, 0x23, 0x30, 0x18, 0xd4, 0x2e, 0xcc, 0xd9, 0xd8, 0x90, 0xcf, 0x97,
+	0x7e, 0x48, 0xe9, 0x10, 0x31, 0xf9, 0xee, 0xdd, 0xfb, 0xbd, 0xf7, 0xfb, 0x92, 0xc1, 0x53, 0x1a,
+	0x38, 0x34, 0xb0, 0x03, 0xd5, 0xc1, 0xfe, 0x90, 0x30, 0xf5, 0x70, 0xd3, 0x24, 0x0c, 0x6f, 0x8a,
+	0x6b, 0xd9, 0xf3, 0x29, 0xa3, 0xf0, 0xbe, 0x20, 0x95, 0x05, 0x2a, 0x48, 0x0f, 0xf3, 0x03, 0x3a,
+	0xa0, 0x9c, 0xa2, 0x46, 0xa7, 0x98, 0xad, 0xfc, 0x48, 0x81, 0xa5, 0x0e, 0xf6, 0xb1, 0x13, 0xc0,
+	0x21, 0xb8, 0x43, 0x8e, 0xac, 0x7d, 0xec, 0x0e, 0x88, 0xe1, 0x51, 0x3a, 0x2a, 0x48, 0x25, 0x69,
+	0x3d, 0xab, 0xed, 0x9c, 0x84, 0x72, 0xe2, 0x67, 0x28, 0xaf, 0x0d, 0x6c, 0xb6, 0x7f, 0x60, 0x96,
+	0x2d, 0xea, 0xa8, 0x16, 0xf7, 0x10, 0x9f, 0x8d, 0xa0, 0x3f, 0x54, 0xd9, 0xd8, 0x23, 0x41, 0xb9,
+	0x4e, 0xac, 0x49, 0x28, 0xe7, 0xc7, 0xd8, 0x19, 0x6d, 0x2b, 0xd7, 0xc4, 0x14, 0x94, 0x9d, 0xde,
+	0x3b, 0x94, 0x8e, 0xa0, 0x01, 0x32, 0x26, 0x0e, 0x84, 0xd1, 0x02, 0x37, 0xd2, 0xe6, 0x36, 0xca,
+	0xc5, 0x46, 0x17, 0x42, 0x0a, 0x4a, 0x47, 0x67, 0x6e, 0xf0, 0x0e, 0xe4, 0x23, 0xc8, 0xf0, 0x89,
+	0x45, 0x0f, 0x89, 0x3f, 0x36, 0x3c, 0xe2, 0xdb, 0xb4, 0x5f, 0x48, 0x96, 0xa4, 0xf5, 0x94, 0x26,
+	0x4f, 0x42, 0xf9, 0x51, 0x1c, 0x3d, 0x8b, 0xa5, 0x20, 0x18, 0xc1, 0x48, 0xa0, 0x1d, 0x0e, 0xc2,
+	0x8f, 0x20, 0xef, 0xd8, 0xae, 0x11, 0x30, 0x6c, 0xda, 0x23, 0x9b, 0x8d, 0x8d, 0xc0, 0xf3, 0x09,
+	0xee, 0x17, 0x52, 0x3c, 0xfd, 0xd6, 0xdc, 0xe9, 0x8b, 0x04, 0x66, 0x69, 0x2a, 0x08, 0x3a, 0xb6,
+	0xdb, 0x9d, 0xa2, 0x5d, 0x0e, 0x42, 0x13, 0x64, 0x1c, 0xdb, 0x65, 0x86, 0x85, 0xbd, 0xa0, 0xb0,
+	0x58, 0x4a, 0xae, 0xdf, 0xae, 0xc8, 0xe5, 0xd9, 0xe3, 0x2e, 0xb7, 0x6c, 0x97, 0xd5, 0xb0, 0xa7,
+	0x3d, 0x8b, 0xd2, 0xba, 0xec, 0xd5, 0x45, 0xbc, 0xf2, 0xf5, 0x97, 0x9c, 0x16, 0xa4, 0x00, 0xa5,
+	0x1d, 0x71, 0x82, 0xaf, 0xc1, 0xf2, 0x94, 0x63, 0x10, 0x8f, 0x5a, 0xfb, 0x85, 0x25, 0xde, 0xb1,
+	0x07, 0x93, 0x50, 0x5e, 0xb9, 0xae, 0x11, 0xbf, 0x2b, 0x28, 0x2b, 0x82, 0xf5, 0xe8, 0xba, 0x9d,
+	0xfe, 0x7c, 0x2c, 0x27, 0xfe, 0x1c, 0xcb, 0x92, 0xf2, 0x57, 0x02, 0xb7, 0x84, 0x03, 0x5c, 0x03,
+	0x8b, 0x7d, 0xe2, 0x52, 0x87, 0x2f, 0x55, 0x46, 0xcb, 0x4d, 0x42, 0x39, 0x1b, 0xab, 0x71, 0x58,
+	0x41, 0xf1, 0x73, 0xb4, 0x17, 0x1e, 0xf1, 0x0d, 0x73, 0x44, 0xad, 0x21, 0xdf, 0x8b, 0xcc, 0x5c,
+	0x7b, 0xd1, 0x70, 0xd9, 0x65, 0xad, 0x17, 0x42, 0x0a, 0x4a, 0x7b, 0xc4, 0xd7, 0xa2, 0xe3, 0xd4,
+	0x20, 0x2e, 0x2d, 0xf9, 0xff, 0x06, 0xa2, 0x07, 0x91, 0x41, 0x5c, 0x7f, 0x8a, 0xd7, 0xfe, 0x45,
+	0x02, 0xcb, 0x35, 0xdb, 0xb7, 0x0e, 0x6c, 0xa6, 0xf9, 0x04, 0x0f, 0x89, 0x0f, 0xbb, 0x60, 0x31,
+	0x60, 0x98, 0x11, 0xde, 0x82, 0xe5, 0xca, 0xcb, 0x9b, 0x26, 0x77, 0x3d, 0xac, 0x1b, 0x85, 0x5c,
+	0xed, 0x17, 0xd7, 0x50, 0x50, 0xac, 0x05, 0x5f, 0x01, 0x10, 0xad, 0xa8, 0x6f, 0xf7, 0xfb, 0xc4,
+	0xe5, 0x0d, 0x4b, 0x6b, 0x2b, 0x93, 0x50, 0xbe, 0x1b, 0x93, 0x2f, 0xdf, 0x14, 0x74, 0x85, 0x18,
+	0x27, 0xf9, 0xe2, 0x9b, 0x04, 0xee, 0xcd, 0x70, 0x83, 0xab, 0xe0, 0x49, 0xad, 0x81, 0x6a, 0x7b,
+	0x8d, 0x9e, 0xa1, 0x21, 0xbd, 0xfa, 0x56, 0x47, 0x46, 0xb7, 0x57, 0xed, 0xe9, 0xc6, 0x5e, 0xbb,
+	0xdb, 0xd1, 0x6b, 0x8d, 0x9d, 0x86, 0x5e, 0xcf, 0x25, 0x60, 0x09, 0x3c, 0x9e, 0x4d, 0x6b, 0xef,
+	0xa2, 0x56, 0xb5, 0x99, 0x93, 0xe0, 0x73, 0xb0, 0x3a, 0x9b, 0x81, 0xf4, 0xba, 0xde, 0xea, 0xf4,
+	0x1a, 0xbb, 0x6d, 0x63, 0xb7, 0xdd, 0x7c, 0x9f, 0x5b, 0xb8, 0x59, 0xec, 0x4d, 0xb5, 0xd9, 0xd3,
+	0xeb, 0xb9, 0xa4, 0xd6, 0x3c, 0x39, 0x2b, 0x4a, 0xa7, 0x67, 0x45, 0xe9, 0xf7, 0x59, 0x51, 0xfa,
+	0x74, 0x5e, 0x4c, 0x9c, 0x9e, 0x17, 0x13, 0xdf, 0xcf, 0x8b, 0x89, 0x0f, 0x95, 0x2b, 0x83, 0x13,
+	0x4d, 0xdd, 0x18, 0x61, 0x33, 0x98, 0x5e, 0xd4, 0xc3, 0xca, 0x96, 0x7a, 0x34, 0xfd, 0x6b, 0xf2,
+	0x41, 0x9a, 0x4b, 0xfc, 0xff, 0xb7, 0xf5, 0x6f, 0x00, 0xf3, 0x4e, 0x93, 0x66, 0x54, 0x05, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -110,6 +317,83 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ExchangePool.Equal(that1.ExchangePool) {
 		return false
 	}
+	if !this.BasePool.Equal(that1.BasePool) {
+		return false
+	}
+	if this.PoolRecoveryPeriod != that1.PoolRecoveryPeriod {
+		return false
+	}
+	if !this.MinStabilitySpread.Equal(that1.MinStabilitySpread) {
+		return false
+	}
+	if len(this.MintCaps) != len(that1.MintCaps) {
+		return false
+	}
+	for i := range this.MintCaps {
+		if !this.MintCaps[i].Equal(&that1.MintCaps[i]) {
+			return false
+		}
+	}
+	if this.MintCapEpoch != that1.MintCapEpoch {
+		return false
+	}
+	return true
+}
+func (this *MintCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintCap)
+	if !ok {
+		that2, ok := that.(MintCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.PerBlock.Equal(that1.PerBlock) {
+		return false
+	}
+	if !this.PerEpoch.Equal(that1.PerEpoch) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreaker)
+	if !ok {
+		that2, ok := that.(CircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Overridden != that1.Overridden {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -132,6 +416,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintCapEpoch != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MintCapEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MintCaps) > 0 {
+		for iNdEx := len(m.MintCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MinStabilitySpread.Size()
+		i -= size
+		if _, err := m.MinStabilitySpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolRecoveryPeriod != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PoolRecoveryPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BasePool.Size()
+		i -= size
+		if _, err := m.BasePool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.ExchangePool.Size()
 		i -= size
@@ -145,6 +473,94 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PerEpoch.Size()
+		i -= size
+		if _, err := m.PerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PerBlock.Size()
+		i -= size
+		if _, err := m.PerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -164,6 +580,54 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.ExchangePool.Size()
 	n += 1 + l + sovMarket(uint64(l))
+	l = m.BasePool.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.PoolRecoveryPeriod != 0 {
+		n += 1 + sovMarket(uint64(m.PoolRecoveryPeriod))
+	}
+	l = m.MinStabilitySpread.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if len(m.MintCaps) > 0 {
+		for _, e := range m.MintCaps {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.MintCapEpoch != 0 {
+		n += 1 + sovMarket(uint64(m.MintCapEpoch))
+	}
+	return n
+}
+
+func (m *MintCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.PerBlock.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.PerEpoch.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovMarket(uint64(m.State))
+	}
+	if m.Overridden {
+		n += 2
+	}
 	return n
 }
 
@@ -235,6 +699,383 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecoveryPeriod", wireType)
+			}
+			m.PoolRecoveryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolRecoveryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStabilitySpread", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStabilitySpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintCaps = append(m.MintCaps, MintCap{})
+			if err := m.MintCaps[len(m.MintCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCapEpoch", wireType)
+			}
+			m.MintCapEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintCapEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CircuitBreakerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintCap creates a MintCap instance
+func NewMintCap(denom string, perBlock sdk.Int, perEpoch sdk.Int) MintCap {
+	return MintCap{
+		Denom:    denom,
+		PerBlock: perBlock,
+		PerEpoch: perEpoch,
+	}
+}
+
+// Validate checks that the caps are positive and the block cap doesn't exceed the epoch cap
+func (c MintCap) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if c.PerBlock.IsNil() || !c.PerBlock.IsPositive() {
+		return fmt.Errorf("per block mint cap of %s must be positive: %s", c.Denom, c.PerBlock)
+	}
+	if c.PerEpoch.IsNil() || !c.PerEpoch.IsPositive() {
+		return fmt.Errorf("per epoch mint cap of %s must be positive: %s", c.Denom, c.PerEpoch)
+	}
+	if c.PerBlock.GT(c.PerEpoch) {
+		return fmt.Errorf("per block mint cap of %s must not exceed the per epoch mint cap: %s > %s", c.Denom, c.PerBlock, c.PerEpoch)
+	}
+	return nil
+}
+
+// MintCaps is array of MintCap
+type MintCaps []MintCap
+
+// String implements fmt.Stringer interface
+func (mc MintCaps) String() (out string) {
+	for _, c := range mc {
+		out += c.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Get returns the mint cap of the denom
+func (mc MintCaps) Get(denom string) (MintCap, bool) {
+	for _, c := range mc {
+		if c.Denom == denom {
+			return c, true
+		}
+	}
+	return MintCap{}, false
+}
+
+// Validate checks each cap and that no denom is capped twice
+func (mc MintCaps) Validate() error {
+	seen := make(map[string]bool, len(mc))
+	for _, c := range mc {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seen[c.Denom] {
+			return fmt.Errorf("duplicate mint cap for %s", c.Denom)
+		}
+		seen[c.Denom] = true
+	}
+	return nil
+}
@@ -10,12 +10,14 @@ import (
 var (
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgSwapSend{}
+	_ sdk.Msg = &MsgOverrideCircuitBreaker{}
 )
 
 // market message types
 const (
-	TypeMsgSwap     = "swap"
-	TypeMsgSwapSend = "swap_send"
+	TypeMsgSwap                   = "swap"
+	TypeMsgSwapSend               = "swap_send"
+	TypeMsgOverrideCircuitBreaker = "override_circuit_breaker"
 )
 
 //--------------------------------------------------------
@@ -24,9 +26,10 @@ const (
 // NewMsgSwap creates a MsgSwap instance
 func NewMsgSwap(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askCoin string) *MsgSwap {
 	return &MsgSwap{
-		Trader:    traderAddress.String(),
-		OfferCoin: offerCoin,
-		AskDenom:  askCoin,
+		Trader:       traderAddress.String(),
+		OfferCoin:    offerCoin,
+		AskDenom:     askCoin,
+		MinAskAmount: sdk.ZeroInt(),
 	}
 }
 
@@ -66,16 +69,21 @@ func (msg MsgSwap) ValidateBasic() error {
 		return errorsmod.Wrap(ErrRecursiveSwap, msg.AskDenom)
 	}
 
+	if !msg.MinAskAmount.IsNil() && msg.MinAskAmount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min ask amount must not be negative: %s", msg.MinAskAmount)
+	}
+
 	return nil
 }
 
 // NewMsgSwapSend conducts market swap and send all the result coins to recipient
 func NewMsgSwapSend(fromAddress sdk.AccAddress, toAddress sdk.AccAddress, offerCoin sdk.Coin, askCoin string) *MsgSwapSend {
 	return &MsgSwapSend{
-		FromAddress: fromAddress.String(),
-		ToAddress:   toAddress.String(),
-		OfferCoin:   offerCoin,
-		AskDenom:    askCoin,
+		FromAddress:  fromAddress.String(),
+		ToAddress:    toAddress.String(),
+		OfferCoin:    offerCoin,
+		AskDenom:     askCoin,
+		MinAskAmount: sdk.ZeroInt(),
 	}
 }
 
@@ -120,5 +128,53 @@ func (msg MsgSwapSend) ValidateBasic() error {
 		return errorsmod.Wrap(ErrRecursiveSwap, msg.AskDenom)
 	}
 
+	if !msg.MinAskAmount.IsNil() && msg.MinAskAmount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min ask amount must not be negative: %s", msg.MinAskAmount)
+	}
+
 	return nil
 }
+
+// NewMsgOverrideCircuitBreaker pins the state of the circuit breaker, or lifts the override with
+// CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+func NewMsgOverrideCircuitBreaker(sender string, state CircuitBreakerState) *MsgOverrideCircuitBreaker {
+	return &MsgOverrideCircuitBreaker{
+		Sender: sender,
+		State:  state,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgOverrideCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgOverrideCircuitBreaker) Type() string { return TypeMsgOverrideCircuitBreaker }
+
+// GetSignBytes Implements Msg
+func (msg MsgOverrideCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgOverrideCircuitBreaker) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgOverrideCircuitBreaker) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.State == CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED {
+		return nil
+	}
+
+	return msg.State.Validate()
+}
@@ -39,6 +39,11 @@ func TestMsgSwap(t *testing.T) {
 			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
 		}
 	}
+	msg := NewMsgSwap(addrs[0], sdk.NewCoin(appParams.BaseCoinUnit, sdk.OneInt()), assets.StakeDenom)
+	msg.MinAskAmount = sdk.NewInt(100)
+	require.Nil(t, msg.ValidateBasic())
+	msg.MinAskAmount = sdk.NewInt(-1)
+	require.EqualError(t, msg.ValidateBasic(), "min ask amount must not be negative: -1: invalid request")
 }
 
 func TestMsgSwapSend(t *testing.T) {
@@ -72,4 +77,31 @@ func TestMsgSwapSend(t *testing.T) {
 			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
 		}
 	}
+	msg := NewMsgSwapSend(addrs[0], addrs[1], sdk.NewCoin(appParams.BaseCoinUnit, sdk.OneInt()), assets.StakeDenom)
+	msg.MinAskAmount = sdk.NewInt(-1)
+	require.EqualError(t, msg.ValidateBasic(), "min ask amount must not be negative: -1: invalid request")
+}
+
+func TestMsgOverrideCircuitBreaker(t *testing.T) {
+	sender := sdk.AccAddress([]byte("addr1_______________")).String()
+
+	tests := []struct {
+		sender      string
+		state       CircuitBreakerState
+		expectedErr string
+	}{
+		{sender, CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED, ""},
+		{sender, CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED, ""},
+		{"", CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL, "Invalid sender address (empty address string is not allowed): invalid address"},
+		{sender, CircuitBreakerState(42), "unknown circuit breaker state 42: invalid circuit breaker state"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgOverrideCircuitBreaker(tc.sender, tc.state)
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+		}
+	}
 }
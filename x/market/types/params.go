@@ -13,24 +13,39 @@ import (
 
 // Parameter keys
 var (
+	// Size of the virtual liquidity pools at equilibrium (melody unit)
+	KeyBasePool = []byte("BasePool")
 	// The period required to recover BasePool
 	KeyPoolRecoveryPeriod = []byte("PoolRecoveryPeriod")
 	// Min spread
 	KeyMinStabilitySpread = []byte("MinStabilitySpread")
+	// Net mint caps per denom
+	KeyMintCaps = []byte("MintCaps")
+	// Number of blocks in a mint cap epoch
+	KeyMintCapEpoch = []byte("MintCapEpoch")
 )
 
 // Default parameter values
 var (
-	DefaultBasePool           = sdk.NewDec(1000000 * params.MicroUnit) // 1000,000sdr = 1000,000,000,000usdr
+	DefaultBasePool           = sdk.NewDec(1000000 * params.MicroUnit) // 1000,000melody = 1000,000,000,000note
 	DefaultPoolRecoveryPeriod = params.BlocksPerDay                    // 14,400
 	DefaultMinStabilitySpread = sdk.NewDecWithPrec(2, 2)               // 2%
+	DefaultMintCaps           = MintCaps{}
+	DefaultMintCapEpoch       = params.BlocksPerDay // 14,400
 )
 
 var _ paramstypes.ParamSet = &Params{}
 
 // DefaultParams creates default market module parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		ExchangePool:       sdk.ZeroDec(),
+		BasePool:           DefaultBasePool,
+		PoolRecoveryPeriod: DefaultPoolRecoveryPeriod,
+		MinStabilitySpread: DefaultMinStabilitySpread,
+		MintCaps:           DefaultMintCaps,
+		MintCapEpoch:       DefaultMintCapEpoch,
+	}
 }
 
 // ParamKeyTable returns the parameter key table.
@@ -47,7 +62,13 @@ func (p Params) String() string {
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of market module's parameters.
 func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
-	return paramstypes.ParamSetPairs{}
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyBasePool, &p.BasePool, validateBasePool),
+		paramstypes.NewParamSetPair(KeyPoolRecoveryPeriod, &p.PoolRecoveryPeriod, validatePoolRecoveryPeriod),
+		paramstypes.NewParamSetPair(KeyMinStabilitySpread, &p.MinStabilitySpread, validateMinStabilitySpread),
+		paramstypes.NewParamSetPair(KeyMintCaps, &p.MintCaps, validateMintCaps),
+		paramstypes.NewParamSetPair(KeyMintCapEpoch, &p.MintCapEpoch, validateMintCapEpoch),
+	}
 }
 
 // Validate a set of params
@@ -55,6 +76,34 @@ func (p Params) Validate() error {
 	if p.ExchangePool.IsNegative() {
 		return fmt.Errorf("exchange pool should be positive or zero, is %s", p.ExchangePool)
 	}
+	if !p.BasePool.IsPositive() {
+		return fmt.Errorf("base pool should be positive, is %s", p.BasePool)
+	}
+	if p.PoolRecoveryPeriod == 0 {
+		return fmt.Errorf("pool recovery period should be positive, is %d", p.PoolRecoveryPeriod)
+	}
+	if p.MinStabilitySpread.IsNegative() || p.MinStabilitySpread.GT(sdk.OneDec()) {
+		return fmt.Errorf("min spread should be between [0, 1], is %s", p.MinStabilitySpread)
+	}
+	if err := p.MintCaps.Validate(); err != nil {
+		return err
+	}
+	if p.MintCapEpoch == 0 {
+		return fmt.Errorf("mint cap epoch should be positive, is %d", p.MintCapEpoch)
+	}
+
+	return nil
+}
+
+func validateBasePool(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("base pool must be positive: %s", v)
+	}
 
 	return nil
 }
@@ -88,3 +137,25 @@ func validateMinStabilitySpread(i interface{}) error {
 
 	return nil
 }
+
+func validateMintCaps(i interface{}) error {
+	v, ok := i.(MintCaps)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateMintCapEpoch(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("mint cap epoch must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -30,6 +30,24 @@ func TestParamsEqual(t *testing.T) {
 	err = p4.Validate()
 	require.Error(t, err)
 
+	// invalid mint caps
+	p6 := DefaultParams()
+	p6.MintCaps = MintCaps{NewMintCap("usdr", sdk.NewInt(100), sdk.NewInt(1000))}
+	require.NoError(t, p6.Validate())
+	p6.MintCaps = MintCaps{NewMintCap("usdr", sdk.NewInt(100), sdk.NewInt(1000)), NewMintCap("usdr", sdk.NewInt(100), sdk.NewInt(1000))}
+	require.Error(t, p6.Validate())
+	p6.MintCaps = MintCaps{NewMintCap("usdr", sdk.ZeroInt(), sdk.NewInt(1000))}
+	require.Error(t, p6.Validate())
+	p6.MintCaps = MintCaps{NewMintCap("usdr", sdk.NewInt(1001), sdk.NewInt(1000))}
+	require.Error(t, p6.Validate())
+	p6.MintCaps = MintCaps{NewMintCap("", sdk.NewInt(100), sdk.NewInt(1000))}
+	require.Error(t, p6.Validate())
+
+	// invalid mint cap epoch
+	p7 := DefaultParams()
+	p7.MintCapEpoch = 0
+	require.Error(t, p7.Validate())
+
 	p5 := DefaultParams()
 	require.NotNil(t, p5.ParamSetPairs())
 	require.NotNil(t, p5.String())
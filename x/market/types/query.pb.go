@@ -76,6 +76,8 @@ var xxx_messageInfo_QuerySwapRequest proto.InternalMessageInfo
 type QuerySwapResponse struct {
 	// return_coin defines the coin returned as a result of the swap simulation.
 	ReturnCoin types.Coin `protobuf:"bytes,1,opt,name=return_coin,json=returnCoin,proto3" json:"return_coin"`
+	// swap_fee defines the spread fee withheld from the returned coin.
+	SwapFee types.Coin `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee"`
 }
 
 func (m *QuerySwapResponse) Reset()         { *m = QuerySwapResponse{} }
@@ -118,6 +120,280 @@ func (m *QuerySwapResponse) GetReturnCoin() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QuerySwapResponse) GetSwapFee() types.Coin {
+	if m != nil {
+		return m.SwapFee
+	}
+	return types.Coin{}
+}
+
+// QueryPoolDeltaRequest is the request type for the Query/PoolDelta RPC
+// method.
+type QueryPoolDeltaRequest struct {
+}
+
+func (m *QueryPoolDeltaRequest) Reset()         { *m = QueryPoolDeltaRequest{} }
+func (m *QueryPoolDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDeltaRequest) ProtoMessage()    {}
+func (*QueryPoolDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f495531fa36d269f, []int{2}
+}
+func (m *QueryPoolDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolDeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDeltaRequest.Merge(m, src)
+}
+func (m *QueryPoolDeltaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolDeltaRequest proto.InternalMessageInfo
+
+// QueryPoolDeltaResponse is the response type for the Query/PoolDelta RPC
+// method.
+type QueryPoolDeltaResponse struct {
+	// pool_delta defines the gap between the stable pool and the base pool, in
+	// melody units.
+	PoolDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=pool_delta,json=poolDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_delta"`
+}
+
+func (m *QueryPoolDeltaResponse) Reset()         { *m = QueryPoolDeltaResponse{} }
+func (m *QueryPoolDeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolDeltaResponse) ProtoMessage()    {}
+func (*QueryPoolDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f495531fa36d269f, []int{3}
+}
+func (m *QueryPoolDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolDeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolDeltaResponse.Merge(m, src)
+}
+func (m *QueryPoolDeltaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolDeltaResponse proto.InternalMessageInfo
+
+// QueryMintCapacityRequest is the request type for the Query/MintCapacity RPC
+// method.
+type QueryMintCapacityRequest struct {
+	// denom defines the denomination to query the mint capacity of.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryMintCapacityRequest) Reset()         { *m = QueryMintCapacityRequest{} }
+func (m *QueryMintCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintCapacityRequest) ProtoMessage()    {}
+func (*QueryMintCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f495531fa36d269f, []int{4}
+}
+func (m *QueryMintCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintCapacityRequest.Merge(m, src)
+}
+func (m *QueryMintCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryMintCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryMintCapacityResponse is the response type for the Query/MintCapacity
+// RPC method.
+type QueryMintCapacityResponse struct {
+	// mint_cap defines the configured cap of the denom.
+	MintCap MintCap `protobuf:"bytes,1,opt,name=mint_cap,json=mintCap,proto3" json:"mint_cap"`
+	// block_net_mint defines the net amount issued in the current block.
+	BlockNetMint github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=block_net_mint,json=blockNetMint,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"block_net_mint"`
+	// epoch_net_mint defines the net amount issued in the current epoch.
+	EpochNetMint github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=epoch_net_mint,json=epochNetMint,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_net_mint"`
+	// block_remaining defines the amount that can still be issued in the current
+	// block.
+	BlockRemaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=block_remaining,json=blockRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"block_remaining"`
+	// epoch_remaining defines the amount that can still be issued in the current
+	// epoch.
+	EpochRemaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=epoch_remaining,json=epochRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_remaining"`
+}
+
+func (m *QueryMintCapacityResponse) Reset()         { *m = QueryMintCapacityResponse{} }
+func (m *QueryMintCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintCapacityResponse) ProtoMessage()    {}
+func (*QueryMintCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f495531fa36d269f, []int{5}
+}
+func (m *QueryMintCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintCapacityResponse.Merge(m, src)
+}
+func (m *QueryMintCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryMintCapacityResponse) GetMintCap() MintCap {
+	if m != nil {
+		return m.MintCap
+	}
+	return MintCap{}
+}
+
+// QueryCircuitBreakerRequest is the request type for the Query/CircuitBreaker
+// RPC method.
+type QueryCircuitBreakerRequest struct {
+}
+
+func (m *QueryCircuitBreakerRequest) Reset()         { *m = QueryCircuitBreakerRequest{} }
+func (m *QueryCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f495531fa36d269f, []int{6}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerRequest proto.InternalMessageInfo
+
+// QueryCircuitBreakerResponse is the response type for the
+// Query/CircuitBreaker RPC method.
+type QueryCircuitBreakerResponse struct {
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f495531fa36d269f, []int{7}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -126,7 +402,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f495531fa36d269f, []int{2}
+	return fileDescriptor_f495531fa36d269f, []int{8}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +441,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f495531fa36d269f, []int{3}
+	return fileDescriptor_f495531fa36d269f, []int{9}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +486,7 @@ func (m *QueryExchangeRequirementsRequest) Reset()         { *m = QueryExchangeR
 func (m *QueryExchangeRequirementsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRequirementsRequest) ProtoMessage()    {}
 func (*QueryExchangeRequirementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f495531fa36d269f, []int{4}
+	return fileDescriptor_f495531fa36d269f, []int{10}
 }
 func (m *QueryExchangeRequirementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +526,7 @@ func (m *QueryExchangeRequirementsResponse) Reset()         { *m = QueryExchange
 func (m *QueryExchangeRequirementsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExchangeRequirementsResponse) ProtoMessage()    {}
 func (*QueryExchangeRequirementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f495531fa36d269f, []int{5}
+	return fileDescriptor_f495531fa36d269f, []int{11}
 }
 func (m *QueryExchangeRequirementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +578,7 @@ func (m *ExchangeRequirement) Reset()         { *m = ExchangeRequirement{} }
 func (m *ExchangeRequirement) String() string { return proto.CompactTextString(m) }
 func (*ExchangeRequirement) ProtoMessage()    {}
 func (*ExchangeRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f495531fa36d269f, []int{6}
+	return fileDescriptor_f495531fa36d269f, []int{12}
 }
 func (m *ExchangeRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,6 +617,12 @@ func (m *ExchangeRequirement) GetBaseCurrency() types.Coin {
 func init() {
 	proto.RegisterType((*QuerySwapRequest)(nil), "osmosis.market.v1beta1.QuerySwapRequest")
 	proto.RegisterType((*QuerySwapResponse)(nil), "osmosis.market.v1beta1.QuerySwapResponse")
+	proto.RegisterType((*QueryPoolDeltaRequest)(nil), "osmosis.market.v1beta1.QueryPoolDeltaRequest")
+	proto.RegisterType((*QueryPoolDeltaResponse)(nil), "osmosis.market.v1beta1.QueryPoolDeltaResponse")
+	proto.RegisterType((*QueryMintCapacityRequest)(nil), "osmosis.market.v1beta1.QueryMintCapacityRequest")
+	proto.RegisterType((*QueryMintCapacityResponse)(nil), "osmosis.market.v1beta1.QueryMintCapacityResponse")
+	proto.RegisterType((*QueryCircuitBreakerRequest)(nil), "osmosis.market.v1beta1.QueryCircuitBreakerRequest")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "osmosis.market.v1beta1.QueryCircuitBreakerResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.market.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.market.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryExchangeRequirementsRequest)(nil), "osmosis.market.v1beta1.QueryExchangeRequirementsRequest")
@@ -353,46 +635,67 @@ func init() {
 }
 
 var fileDescriptor_f495531fa36d269f = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0x13, 0x51,
-	0x14, 0x9d, 0xe9, 0x17, 0xed, 0x6b, 0x0b, 0xfa, 0x1a, 0xa5, 0xc6, 0x3a, 0x89, 0xa3, 0x94, 0x68,
-	0xc9, 0x3c, 0x9a, 0x52, 0xd0, 0xe2, 0x42, 0xd2, 0xe8, 0xca, 0x85, 0xa6, 0x08, 0xe2, 0x26, 0xbc,
-	0x4c, 0x5f, 0xa6, 0x43, 0x32, 0xf3, 0xa6, 0xf3, 0x5e, 0x6a, 0xb3, 0xd4, 0x95, 0xee, 0x04, 0xff,
-	0x40, 0x7f, 0x4b, 0x57, 0x59, 0x16, 0xdc, 0x88, 0x48, 0x90, 0xc4, 0x85, 0x6b, 0x7f, 0x81, 0xbc,
-	0x8f, 0xa6, 0x89, 0xcc, 0x84, 0xe0, 0x2a, 0xc9, 0xbd, 0xe7, 0x9e, 0x73, 0x72, 0xef, 0x99, 0x01,
-	0x36, 0x65, 0x01, 0x65, 0x3e, 0x43, 0x01, 0x8e, 0x9b, 0x84, 0xa3, 0x93, 0xed, 0x3a, 0xe1, 0x78,
-	0x1b, 0x1d, 0xb7, 0x49, 0xdc, 0x71, 0xa2, 0x98, 0x72, 0x0a, 0x6f, 0x6a, 0x8c, 0xa3, 0x30, 0x8e,
-	0xc6, 0x64, 0x33, 0x1e, 0xf5, 0xa8, 0x84, 0x20, 0xf1, 0x4d, 0xa1, 0xb3, 0x1b, 0x1e, 0xa5, 0x5e,
-	0x8b, 0x20, 0x1c, 0xf9, 0x08, 0x87, 0x21, 0xe5, 0x98, 0xfb, 0x34, 0x64, 0xba, 0x7b, 0x2f, 0x45,
-	0x4f, 0x53, 0x2b, 0x90, 0xe5, 0x4a, 0x14, 0xaa, 0x63, 0x46, 0x86, 0x08, 0x97, 0xfa, 0xa1, 0xea,
-	0xdb, 0x6f, 0xc0, 0xb5, 0x57, 0xc2, 0xdf, 0xc1, 0x3b, 0x1c, 0x55, 0xc9, 0x71, 0x9b, 0x30, 0x0e,
-	0xef, 0x00, 0x40, 0x1b, 0x0d, 0x12, 0xd7, 0x04, 0x6e, 0xdd, 0xcc, 0x9b, 0x85, 0xa5, 0xea, 0x92,
-	0xac, 0xec, 0x53, 0x3f, 0x84, 0xb7, 0xc1, 0x12, 0x66, 0xcd, 0xda, 0x21, 0x09, 0x69, 0xb0, 0x3e,
-	0x23, 0xbb, 0x8b, 0x98, 0x35, 0x2b, 0xe2, 0xf7, 0xde, 0xe2, 0xc7, 0xb3, 0x9c, 0xf1, 0xfb, 0x2c,
-	0x67, 0xd8, 0xaf, 0xc1, 0xf5, 0x11, 0x66, 0x16, 0xd1, 0x90, 0x11, 0xf8, 0x14, 0x2c, 0xc7, 0x84,
-	0xb7, 0xe3, 0xf0, 0x8a, 0x7b, 0xb9, 0x74, 0xcb, 0x51, 0x26, 0x1d, 0x61, 0xf2, 0x72, 0x25, 0x8e,
-	0xd0, 0x2a, 0xcf, 0x75, 0x7b, 0x39, 0xa3, 0x0a, 0xd4, 0x8c, 0xa8, 0xd8, 0x19, 0x00, 0x25, 0xed,
-	0x4b, 0x1c, 0xe3, 0x80, 0x69, 0xcb, 0xf6, 0x01, 0x58, 0x1b, 0xab, 0x6a, 0xb9, 0x27, 0x60, 0x21,
-	0x92, 0x15, 0xad, 0x64, 0x39, 0xc9, 0xfb, 0x77, 0xd4, 0x9c, 0x96, 0xd3, 0x33, 0xb6, 0x0d, 0xf2,
-	0x92, 0xf4, 0xd9, 0xa9, 0x7b, 0x84, 0x43, 0x8f, 0x08, 0x31, 0x3f, 0x26, 0x01, 0x09, 0xf9, 0x50,
-	0xf8, 0xdc, 0x04, 0x77, 0x27, 0x80, 0xb4, 0x8f, 0x06, 0xb8, 0x41, 0x74, 0xbf, 0x16, 0x8f, 0x00,
-	0xd6, 0xcd, 0xfc, 0x6c, 0x61, 0xb9, 0xb4, 0x95, 0x66, 0x2b, 0x81, 0x54, 0x7b, 0xcc, 0x90, 0x04,
-	0x3d, 0xb8, 0x0b, 0xe6, 0x39, 0xe5, 0xb8, 0x25, 0xcf, 0x32, 0xc5, 0x62, 0x15, 0xda, 0xee, 0x9a,
-	0x60, 0x2d, 0x41, 0x0a, 0x56, 0xc0, 0xaa, 0x98, 0xac, 0xb9, 0xed, 0x38, 0x26, 0xa1, 0xdb, 0x99,
-	0xf6, 0x5e, 0x2b, 0xa2, 0xb1, 0xaf, 0x87, 0x60, 0x13, 0xac, 0x5e, 0xfd, 0x79, 0xcc, 0x89, 0xca,
-	0x4c, 0xf9, 0xb9, 0x80, 0x7e, 0xef, 0xe5, 0x36, 0x3d, 0x9f, 0x1f, 0xb5, 0xeb, 0x8e, 0x4b, 0x03,
-	0xa4, 0xc3, 0xaa, 0x3e, 0x8a, 0xec, 0xb0, 0x89, 0x78, 0x27, 0x22, 0xcc, 0xa9, 0x10, 0xf7, 0x4f,
-	0x2f, 0x97, 0xe9, 0xe0, 0xa0, 0xb5, 0x67, 0x8f, 0x91, 0xd9, 0xd5, 0x95, 0xe1, 0x26, 0x30, 0x27,
-	0xa5, 0x1f, 0xb3, 0x60, 0x5e, 0xde, 0x03, 0xbe, 0x37, 0xc1, 0x9c, 0xc8, 0x1e, 0x2c, 0xa4, 0x6d,
-	0xf7, 0xdf, 0xe0, 0x67, 0x1f, 0x4c, 0x81, 0x54, 0x17, 0xb5, 0xef, 0x7f, 0xf8, 0xfa, 0xeb, 0xcb,
-	0x8c, 0x05, 0x37, 0x50, 0xca, 0x53, 0xc8, 0x84, 0xf4, 0x27, 0x13, 0x2c, 0xa8, 0x68, 0xc1, 0x87,
-	0x13, 0xb9, 0xc7, 0xd2, 0x9c, 0xdd, 0x9a, 0x0a, 0xab, 0x9d, 0x6c, 0x4a, 0x27, 0x79, 0x68, 0xa5,
-	0x39, 0x51, 0x69, 0x86, 0xe7, 0x26, 0xc8, 0x24, 0x85, 0x14, 0x3e, 0x9a, 0xa8, 0x36, 0x21, 0xfc,
-	0xd9, 0xc7, 0xff, 0x31, 0xa9, 0x5d, 0xef, 0x4a, 0xd7, 0x08, 0x16, 0xd3, 0x5c, 0x27, 0x3e, 0x2f,
-	0xe5, 0x17, 0xdd, 0xbe, 0x65, 0x5e, 0xf4, 0x2d, 0xf3, 0x67, 0xdf, 0x32, 0x3f, 0x0f, 0x2c, 0xe3,
-	0x62, 0x60, 0x19, 0xdf, 0x06, 0x96, 0xf1, 0xb6, 0x34, 0x12, 0x23, 0x4d, 0x59, 0x6c, 0xe1, 0x3a,
-	0x1b, 0xf2, 0x9f, 0x94, 0x76, 0xd0, 0xe9, 0xa5, 0x8a, 0x8c, 0x55, 0x7d, 0x41, 0xbe, 0x03, 0x77,
-	0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x4a, 0x69, 0x60, 0xba, 0x05, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0xe7, 0x57, 0xb3, 0x2f, 0xdb, 0x14, 0xa6, 0xdb, 0xb2, 0x75, 0x83, 0x37, 0x0c, 0x55,
+	0x48, 0x5b, 0xc5, 0x6e, 0x36, 0xaa, 0x04, 0x11, 0x07, 0x94, 0x84, 0x4a, 0x48, 0x14, 0xc1, 0x16,
+	0x04, 0xe2, 0x62, 0xcd, 0x3a, 0x93, 0x8d, 0xb5, 0xeb, 0x19, 0xd7, 0x9e, 0x6d, 0xbb, 0x42, 0x1c,
+	0xe0, 0x04, 0x37, 0x10, 0x12, 0xe7, 0x9e, 0xf9, 0x17, 0xb8, 0xf5, 0x94, 0x1b, 0x95, 0xb8, 0x20,
+	0x0e, 0x11, 0x4a, 0x38, 0x70, 0xe6, 0x2f, 0xa8, 0xe6, 0x87, 0xf7, 0x47, 0x64, 0xaf, 0x36, 0x39,
+	0x25, 0x9e, 0xf7, 0xbd, 0xef, 0xfb, 0xfc, 0x9e, 0xf3, 0x4d, 0x00, 0xf3, 0x34, 0xe2, 0x69, 0x98,
+	0x7a, 0x11, 0x49, 0x3a, 0x54, 0x78, 0x4f, 0x36, 0x5b, 0x54, 0x90, 0x4d, 0xef, 0x71, 0x8f, 0x26,
+	0x7d, 0x37, 0x4e, 0xb8, 0xe0, 0xe8, 0xba, 0xc1, 0xb8, 0x1a, 0xe3, 0x1a, 0x8c, 0x5d, 0x6d, 0xf3,
+	0x36, 0x57, 0x10, 0x4f, 0xfe, 0xa6, 0xd1, 0xf6, 0x4a, 0x9b, 0xf3, 0x76, 0x97, 0x7a, 0x24, 0x0e,
+	0x3d, 0xc2, 0x18, 0x17, 0x44, 0x84, 0x9c, 0xa5, 0xa6, 0xfa, 0x76, 0x81, 0x9e, 0xa1, 0xd6, 0x20,
+	0x27, 0x50, 0x28, 0xaf, 0x45, 0x52, 0x3a, 0x40, 0x04, 0x3c, 0x64, 0xba, 0x8e, 0xbf, 0x82, 0xd7,
+	0x3e, 0x93, 0xfe, 0x1e, 0x3d, 0x25, 0x71, 0x93, 0x3e, 0xee, 0xd1, 0x54, 0xa0, 0x37, 0x01, 0xf8,
+	0xc1, 0x01, 0x4d, 0x7c, 0x89, 0xab, 0x59, 0xab, 0xd6, 0x7a, 0xb9, 0x59, 0x56, 0x27, 0xbb, 0x3c,
+	0x64, 0xe8, 0x26, 0x94, 0x49, 0xda, 0xf1, 0xf7, 0x29, 0xe3, 0x51, 0x6d, 0x46, 0x55, 0x17, 0x49,
+	0xda, 0xd9, 0x93, 0xcf, 0xdb, 0x8b, 0x3f, 0x3c, 0xaf, 0x97, 0xfe, 0x7b, 0x5e, 0x2f, 0xe1, 0x9f,
+	0x2d, 0x78, 0x7d, 0x84, 0x3a, 0x8d, 0x39, 0x4b, 0x29, 0xfa, 0x00, 0x96, 0x12, 0x2a, 0x7a, 0x09,
+	0x1b, 0x92, 0x2f, 0x35, 0x6e, 0xb8, 0xda, 0xa5, 0x2b, 0x5d, 0x66, 0x33, 0x71, 0xa5, 0xd8, 0xce,
+	0xdc, 0xd1, 0x71, 0xbd, 0xd4, 0x04, 0xdd, 0xa3, 0xe4, 0xb7, 0x61, 0x31, 0x7d, 0x4a, 0x62, 0xff,
+	0x80, 0xd2, 0xda, 0xcc, 0x74, 0xed, 0x97, 0x64, 0xc3, 0x03, 0x4a, 0xf1, 0x1b, 0x70, 0x4d, 0x59,
+	0xfa, 0x94, 0xf3, 0xee, 0x1e, 0xed, 0x0a, 0x62, 0x5e, 0x19, 0xb7, 0xe1, 0xfa, 0xd9, 0x82, 0x31,
+	0xfc, 0x10, 0x20, 0xe6, 0xbc, 0xeb, 0xef, 0xcb, 0x53, 0xe5, 0xb7, 0xb2, 0xe3, 0x4a, 0xd6, 0xbf,
+	0x8f, 0xeb, 0x6b, 0xed, 0x50, 0x1c, 0xf6, 0x5a, 0x6e, 0xc0, 0x23, 0xcf, 0xcc, 0x59, 0xff, 0xd8,
+	0x48, 0xf7, 0x3b, 0x9e, 0xe8, 0xc7, 0x34, 0x75, 0xf7, 0x68, 0xd0, 0x2c, 0xc7, 0x19, 0x2d, 0xbe,
+	0x07, 0x35, 0x25, 0xf4, 0x30, 0x64, 0x62, 0x97, 0xc4, 0x24, 0x08, 0x45, 0x3f, 0x9b, 0x7b, 0x15,
+	0xe6, 0xf5, 0x50, 0xf5, 0xc8, 0xf5, 0x03, 0xfe, 0x7d, 0x16, 0x6e, 0xe4, 0xb4, 0x0c, 0xe6, 0xb9,
+	0x18, 0x85, 0x4c, 0xf8, 0x01, 0x89, 0xcd, 0x30, 0xeb, 0x6e, 0xfe, 0x37, 0xe6, 0x9a, 0xfe, 0x6c,
+	0x26, 0x91, 0x7e, 0x44, 0x9f, 0xc3, 0x72, 0xab, 0xcb, 0x83, 0x8e, 0xcf, 0xa8, 0xf0, 0xe5, 0xa1,
+	0xde, 0xe9, 0xb9, 0x5e, 0xf2, 0x23, 0x26, 0x9a, 0x15, 0xc5, 0xf2, 0x09, 0x15, 0x52, 0x47, 0xb2,
+	0xd2, 0x98, 0x07, 0x87, 0x43, 0xd6, 0xd9, 0x8b, 0xb1, 0x2a, 0x96, 0x8c, 0xf5, 0x4b, 0xb8, 0xa2,
+	0xbd, 0x26, 0x34, 0x22, 0x21, 0x0b, 0x59, 0xbb, 0x36, 0x77, 0x21, 0x5a, 0xfd, 0xca, 0xcd, 0x8c,
+	0x45, 0x12, 0x6b, 0xbb, 0x43, 0xe2, 0xf9, 0x8b, 0x11, 0x2b, 0x9a, 0x01, 0x31, 0x5e, 0x01, 0x5b,
+	0x2d, 0x6f, 0x37, 0x4c, 0x82, 0x5e, 0x28, 0x76, 0x12, 0x4a, 0x3a, 0x34, 0xc9, 0x3e, 0x3b, 0x01,
+	0x37, 0x73, 0xab, 0x66, 0xb9, 0x5f, 0xc0, 0x95, 0x40, 0x57, 0xfc, 0x96, 0x2e, 0x99, 0x1d, 0xaf,
+	0x15, 0xed, 0x78, 0x9c, 0xc8, 0xac, 0x7a, 0x39, 0x18, 0x3b, 0xc5, 0x55, 0x40, 0xfa, 0x63, 0x27,
+	0x09, 0x89, 0xd2, 0xcc, 0xcb, 0x23, 0xb8, 0x3a, 0x76, 0x6a, 0x3c, 0xbc, 0x0f, 0x0b, 0xb1, 0x3a,
+	0x31, 0xd2, 0x4e, 0x91, 0xb4, 0xee, 0x33, 0x92, 0xa6, 0x07, 0x63, 0x58, 0x55, 0xa4, 0x1f, 0x3e,
+	0x0b, 0x0e, 0x09, 0x6b, 0x53, 0x29, 0x16, 0x26, 0x34, 0xa2, 0x4c, 0x0c, 0x84, 0x5f, 0x58, 0xf0,
+	0xd6, 0x04, 0x90, 0xf1, 0x71, 0x00, 0xd7, 0xa8, 0xa9, 0xfb, 0xc9, 0x08, 0xa0, 0x66, 0xad, 0xce,
+	0xae, 0x2f, 0x35, 0xee, 0x16, 0xd9, 0xca, 0x21, 0x35, 0x1e, 0xab, 0x34, 0x47, 0x0f, 0xdd, 0x87,
+	0x79, 0xc1, 0x05, 0xe9, 0x4e, 0x9b, 0x2d, 0x1a, 0x8d, 0x8f, 0x2c, 0xb8, 0x9a, 0x23, 0x85, 0xf6,
+	0xe0, 0xb2, 0xec, 0xf4, 0x83, 0x5e, 0x92, 0x50, 0x16, 0xf4, 0xa7, 0x4d, 0xbc, 0x8a, 0x2c, 0xec,
+	0x9a, 0x26, 0xd4, 0x81, 0xcb, 0xc3, 0x97, 0x27, 0x82, 0x9a, 0x3f, 0xd1, 0x07, 0xe7, 0xcb, 0xa1,
+	0xff, 0x8f, 0xeb, 0xd5, 0x3e, 0x89, 0xba, 0xdb, 0x78, 0x8c, 0x0c, 0x37, 0x2b, 0x83, 0x49, 0x10,
+	0x41, 0x1b, 0x7f, 0x5c, 0x82, 0x79, 0xb5, 0x0f, 0xf4, 0x9d, 0x05, 0x73, 0x32, 0xbd, 0xd1, 0x7a,
+	0xd1, 0x74, 0xcf, 0xde, 0x1d, 0xf6, 0xed, 0x29, 0x90, 0x7a, 0xa3, 0xf8, 0xd6, 0xf7, 0x7f, 0xfe,
+	0xfb, 0xcb, 0x8c, 0x83, 0x56, 0xbc, 0x82, 0x8b, 0x4c, 0xa6, 0x36, 0xfa, 0xd5, 0x82, 0xf2, 0x20,
+	0x95, 0xd1, 0xc6, 0x44, 0xfa, 0xb3, 0xb1, 0x6e, 0xbb, 0xd3, 0xc2, 0x8d, 0xa5, 0x3b, 0xca, 0xd2,
+	0x2d, 0x84, 0x8b, 0x2c, 0x0d, 0xaf, 0x02, 0xf4, 0x9b, 0x05, 0x95, 0xd1, 0x48, 0x46, 0xf7, 0x26,
+	0x8a, 0xe5, 0x04, 0xbe, 0xbd, 0x79, 0x8e, 0x0e, 0xe3, 0xf0, 0xbe, 0x72, 0xe8, 0xa1, 0x8d, 0x22,
+	0x87, 0xd9, 0x6d, 0xa0, 0xda, 0xbc, 0x6f, 0xd4, 0x1d, 0xf2, 0xad, 0x34, 0xbb, 0x3c, 0x9e, 0x0d,
+	0xa8, 0x31, 0x51, 0x3c, 0x37, 0xaf, 0xec, 0xad, 0x73, 0xf5, 0x18, 0xcb, 0x9e, 0xb2, 0x7c, 0x1b,
+	0xbd, 0x53, 0x64, 0xf9, 0x4c, 0xc6, 0xa1, 0x1f, 0x2d, 0x58, 0xd0, 0x69, 0x82, 0xee, 0x4c, 0x5e,
+	0xe0, 0x68, 0x80, 0xd9, 0x77, 0xa7, 0xc2, 0x1a, 0x53, 0x6b, 0xca, 0xd4, 0x2a, 0x72, 0x0a, 0x37,
+	0xad, 0x0d, 0xbc, 0xb0, 0xa0, 0x9a, 0x97, 0x4b, 0xe8, 0xdd, 0x89, 0x6a, 0x13, 0xf2, 0xce, 0x7e,
+	0xef, 0x02, 0x9d, 0xd3, 0x6e, 0x3f, 0x37, 0x22, 0x77, 0x3e, 0x3e, 0x3a, 0x71, 0xac, 0x97, 0x27,
+	0x8e, 0xf5, 0xcf, 0x89, 0x63, 0xfd, 0x74, 0xea, 0x94, 0x5e, 0x9e, 0x3a, 0xa5, 0xbf, 0x4e, 0x9d,
+	0xd2, 0xd7, 0x8d, 0x91, 0xe4, 0x30, 0x94, 0x1b, 0x5d, 0xd2, 0x4a, 0x07, 0xfc, 0x4f, 0x1a, 0x5b,
+	0xde, 0xb3, 0x4c, 0x45, 0x25, 0x49, 0x6b, 0x41, 0xfd, 0xe7, 0xb8, 0xf5, 0x6a, 0x00, 0x05, 0x0c,
+	0xf5, 0x0c, 0xf0, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -409,6 +712,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Swap returns simulated swap amount.
 	Swap(ctx context.Context, in *QuerySwapRequest, opts ...grpc.CallOption) (*QuerySwapResponse, error)
+	// PoolDelta returns the gap between the stable pool and the base pool.
+	PoolDelta(ctx context.Context, in *QueryPoolDeltaRequest, opts ...grpc.CallOption) (*QueryPoolDeltaResponse, error)
+	// MintCapacity returns the remaining net amount of a denom the market can
+	// issue in the current block and epoch.
+	MintCapacity(ctx context.Context, in *QueryMintCapacityRequest, opts ...grpc.CallOption) (*QueryMintCapacityResponse, error)
+	// CircuitBreaker returns the state of the market circuit breaker.
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	ExchangeRequirements(ctx context.Context, in *QueryExchangeRequirementsRequest, opts ...grpc.CallOption) (*QueryExchangeRequirementsResponse, error)
@@ -431,6 +741,33 @@ func (c *queryClient) Swap(ctx context.Context, in *QuerySwapRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) PoolDelta(ctx context.Context, in *QueryPoolDeltaRequest, opts ...grpc.CallOption) (*QueryPoolDeltaResponse, error) {
+	out := new(QueryPoolDeltaResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.market.v1beta1.Query/PoolDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintCapacity(ctx context.Context, in *QueryMintCapacityRequest, opts ...grpc.CallOption) (*QueryMintCapacityResponse, error) {
+	out := new(QueryMintCapacityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.market.v1beta1.Query/MintCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.market.v1beta1.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.market.v1beta1.Query/Params", in, out, opts...)
@@ -453,6 +790,13 @@ func (c *queryClient) ExchangeRequirements(ctx context.Context, in *QueryExchang
 type QueryServer interface {
 	// Swap returns simulated swap amount.
 	Swap(context.Context, *QuerySwapRequest) (*QuerySwapResponse, error)
+	// PoolDelta returns the gap between the stable pool and the base pool.
+	PoolDelta(context.Context, *QueryPoolDeltaRequest) (*QueryPoolDeltaResponse, error)
+	// MintCapacity returns the remaining net amount of a denom the market can
+	// issue in the current block and epoch.
+	MintCapacity(context.Context, *QueryMintCapacityRequest) (*QueryMintCapacityResponse, error)
+	// CircuitBreaker returns the state of the market circuit breaker.
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	ExchangeRequirements(context.Context, *QueryExchangeRequirementsRequest) (*QueryExchangeRequirementsResponse, error)
@@ -465,6 +809,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Swap(ctx context.Context, req *QuerySwapRequest) (*QuerySwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
 }
+func (*UnimplementedQueryServer) PoolDelta(ctx context.Context, req *QueryPoolDeltaRequest) (*QueryPoolDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolDelta not implemented")
+}
+func (*UnimplementedQueryServer) MintCapacity(ctx context.Context, req *QueryMintCapacityRequest) (*QueryMintCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintCapacity not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -494,6 +847,60 @@ func _Query_Swap_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.market.v1beta1.Query/PoolDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolDelta(ctx, req.(*QueryPoolDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.market.v1beta1.Query/MintCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintCapacity(ctx, req.(*QueryMintCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.market.v1beta1.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -538,6 +945,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Swap",
 			Handler:    _Query_Swap_Handler,
 		},
+		{
+			MethodName: "PoolDelta",
+			Handler:    _Query_PoolDelta_Handler,
+		},
+		{
+			MethodName: "MintCapacity",
+			Handler:    _Query_MintCapacity_Handler,
+		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -608,6 +1027,16 @@ func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.ReturnCoin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -621,7 +1050,7 @@ func (m *QuerySwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolDeltaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -631,12 +1060,12 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -644,7 +1073,7 @@ func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolDeltaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -654,22 +1083,22 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.PoolDelta.Size()
+		i -= size
+		if _, err := m.PoolDelta.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -677,7 +1106,7 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRequirementsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMintCapacityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -687,20 +1116,27 @@ func (m *QueryExchangeRequirementsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRequirementsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMintCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRequirementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMintCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRequirementsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMintCapacityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -710,30 +1146,238 @@ func (m *QueryExchangeRequirementsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRequirementsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMintCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRequirementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMintCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.EpochRemaining.Size()
+		i -= size
+		if _, err := m.EpochRemaining.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BlockRemaining.Size()
 		i -= size
+		if _, err := m.BlockRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ExchangeRequirements) > 0 {
-		for iNdEx := len(m.ExchangeRequirements) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	dAtA[i] = 0x22
+	{
+		size := m.EpochNetMint.Size()
+		i -= size
+		if _, err := m.EpochNetMint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BlockNetMint.Size()
+		i -= size
+		if _, err := m.BlockNetMint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MintCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRequirementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRequirementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRequirementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRequirementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRequirementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRequirementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ExchangeRequirements) > 0 {
+		for iNdEx := len(m.ExchangeRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -826,6 +1470,80 @@ func (m *QuerySwapResponse) Size() (n int) {
 	_ = l
 	l = m.ReturnCoin.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockNetMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochNetMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1070,6 +1788,606 @@ func (m *QuerySwapResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolDeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolDeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolDeltaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolDeltaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDelta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNetMint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockNetMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNetMint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochNetMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -34,6 +35,9 @@ type MsgSwap struct {
 	Trader    string     `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty" yaml:"trader"`
 	OfferCoin types.Coin `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	AskDenom  string     `protobuf:"bytes,3,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_ask_amount is the minimum amount of ask_denom coins to receive. The
+	// swap fails if it returns less. Zero means no minimum.
+	MinAskAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_ask_amount,json=minAskAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_ask_amount" yaml:"min_ask_amount"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -129,6 +133,9 @@ type MsgSwapSend struct {
 	ToAddress   string     `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
 	OfferCoin   types.Coin `protobuf:"bytes,3,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	AskDenom    string     `protobuf:"bytes,4,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty" yaml:"ask_denom"`
+	// min_ask_amount is the minimum amount of ask_denom coins to receive. The
+	// swap fails if it returns less. Zero means no minimum.
+	MinAskAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_ask_amount,json=minAskAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_ask_amount" yaml:"min_ask_amount"`
 }
 
 func (m *MsgSwapSend) Reset()         { *m = MsgSwapSend{} }
@@ -217,49 +224,143 @@ func (m *MsgSwapSendResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+// MsgOverrideCircuitBreaker pins the state of the circuit breaker until the
+// next override. CIRCUIT_BREAKER_STATE_UNSPECIFIED lifts the override and
+// hands the state back to the collateralization checks. Only the gov module
+// account can send it.
+type MsgOverrideCircuitBreaker struct {
+	// sender is the gov module account address
+	Sender string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	State  CircuitBreakerState `protobuf:"varint,2,opt,name=state,proto3,enum=osmosis.market.v1beta1.CircuitBreakerState" json:"state,omitempty" yaml:"state"`
+}
+
+func (m *MsgOverrideCircuitBreaker) Reset()         { *m = MsgOverrideCircuitBreaker{} }
+func (m *MsgOverrideCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgOverrideCircuitBreaker) ProtoMessage()    {}
+func (*MsgOverrideCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b04bdc246eaa07, []int{4}
+}
+func (m *MsgOverrideCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOverrideCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOverrideCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOverrideCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOverrideCircuitBreaker.Merge(m, src)
+}
+func (m *MsgOverrideCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOverrideCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOverrideCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOverrideCircuitBreaker proto.InternalMessageInfo
+
+// MsgOverrideCircuitBreakerResponse defines the Msg/OverrideCircuitBreaker
+// response type.
+type MsgOverrideCircuitBreakerResponse struct {
+}
+
+func (m *MsgOverrideCircuitBreakerResponse) Reset()         { *m = MsgOverrideCircuitBreakerResponse{} }
+func (m *MsgOverrideCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOverrideCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgOverrideCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b04bdc246eaa07, []int{5}
+}
+func (m *MsgOverrideCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOverrideCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOverrideCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOverrideCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOverrideCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgOverrideCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOverrideCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOverrideCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOverrideCircuitBreakerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSwap)(nil), "osmosis.market.v1beta1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "osmosis.market.v1beta1.MsgSwapResponse")
 	proto.RegisterType((*MsgSwapSend)(nil), "osmosis.market.v1beta1.MsgSwapSend")
 	proto.RegisterType((*MsgSwapSendResponse)(nil), "osmosis.market.v1beta1.MsgSwapSendResponse")
+	proto.RegisterType((*MsgOverrideCircuitBreaker)(nil), "osmosis.market.v1beta1.MsgOverrideCircuitBreaker")
+	proto.RegisterType((*MsgOverrideCircuitBreakerResponse)(nil), "osmosis.market.v1beta1.MsgOverrideCircuitBreakerResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/market/v1beta1/tx.proto", fileDescriptor_91b04bdc246eaa07) }
 
 var fileDescriptor_91b04bdc246eaa07 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xf6, 0x26, 0x55, 0x1b, 0x6f, 0x40, 0xa5, 0x6e, 0xa1, 0x69, 0x0e, 0x76, 0xb5, 0x1c, 0x28,
-	0x42, 0xd8, 0x4a, 0xca, 0x29, 0x37, 0x02, 0xe2, 0x44, 0xa4, 0xca, 0xb9, 0x21, 0xa4, 0x68, 0x1d,
-	0xaf, 0x4d, 0x94, 0xda, 0x6b, 0x79, 0x97, 0xfe, 0xbc, 0x01, 0x47, 0x78, 0x83, 0x3e, 0x03, 0x12,
-	0x3c, 0x02, 0xea, 0xb1, 0x47, 0x4e, 0x16, 0x4a, 0x2e, 0x9c, 0xf3, 0x04, 0x68, 0x7f, 0xec, 0x04,
-	0x09, 0x29, 0x70, 0xe0, 0xd0, 0xdb, 0x8c, 0xe7, 0xfb, 0xbe, 0xf9, 0x66, 0xc7, 0xbb, 0xd0, 0xa1,
-	0x2c, 0xa1, 0x6c, 0xc2, 0xbc, 0x04, 0xe7, 0x53, 0xc2, 0xbd, 0xb3, 0x4e, 0x40, 0x38, 0xee, 0x78,
-	0xfc, 0xc2, 0xcd, 0x72, 0xca, 0xa9, 0xf5, 0x40, 0x03, 0x5c, 0x05, 0x70, 0x35, 0xa0, 0xbd, 0x17,
-	0xd3, 0x98, 0x4a, 0x88, 0x27, 0x22, 0x85, 0x6e, 0xdb, 0x63, 0x09, 0xf7, 0x02, 0xcc, 0x48, 0xa5,
-	0x35, 0xa6, 0x93, 0x54, 0xd5, 0xd1, 0x37, 0x00, 0xb7, 0x06, 0x2c, 0x1e, 0x9e, 0xe3, 0xcc, 0x7a,
-	0x0c, 0x37, 0x79, 0x8e, 0x43, 0x92, 0xb7, 0xc0, 0x21, 0x38, 0x32, 0xfb, 0x3b, 0x8b, 0xc2, 0xb9,
-	0x7b, 0x89, 0x93, 0xd3, 0x1e, 0x52, 0xdf, 0x91, 0xaf, 0x01, 0xd6, 0x10, 0x42, 0x1a, 0x45, 0x24,
-	0x1f, 0x09, 0xa9, 0x56, 0xed, 0x10, 0x1c, 0x35, 0xbb, 0x07, 0xae, 0xea, 0xe5, 0x8a, 0x5e, 0xa5,
-	0x2d, 0xf7, 0x05, 0x9d, 0xa4, 0xfd, 0x83, 0xeb, 0xc2, 0x31, 0x16, 0x85, 0xb3, 0xa3, 0xd4, 0x96,
-	0x54, 0xe4, 0x9b, 0x32, 0x11, 0x28, 0xab, 0x03, 0x4d, 0xcc, 0xa6, 0xa3, 0x90, 0xa4, 0x34, 0x69,
-	0xd5, 0xa5, 0x85, 0xbd, 0x45, 0xe1, 0xdc, 0x53, 0xa4, 0xaa, 0x84, 0xfc, 0x06, 0x66, 0xd3, 0x97,
-	0x22, 0xec, 0x35, 0x3e, 0x5c, 0x39, 0xc6, 0xcf, 0x2b, 0xc7, 0x40, 0x9f, 0x01, 0xdc, 0xd6, 0x83,
-	0xf8, 0x84, 0x65, 0x34, 0x65, 0xc4, 0x3a, 0x81, 0x26, 0x3b, 0xc7, 0x99, 0x32, 0x09, 0xd6, 0x99,
-	0x6c, 0x69, 0x93, 0xba, 0x5f, 0xc5, 0x44, 0x7e, 0x43, 0xc4, 0xd2, 0xe2, 0x00, 0xca, 0x78, 0x14,
-	0x11, 0xb2, 0x7e, 0xea, 0x7d, 0x2d, 0xb8, 0xbd, 0x22, 0x18, 0x11, 0x82, 0xfc, 0x2d, 0x11, 0xbe,
-	0x22, 0x04, 0x7d, 0xaa, 0xc1, 0xa6, 0x36, 0x3d, 0x24, 0x69, 0x68, 0xf5, 0xe0, 0x9d, 0x28, 0xa7,
-	0xc9, 0x08, 0x87, 0x61, 0x4e, 0x18, 0xd3, 0x7b, 0xd8, 0x5f, 0x14, 0xce, 0xae, 0xd2, 0x58, 0xad,
-	0x22, 0xbf, 0x29, 0xd2, 0xe7, 0x2a, 0xb3, 0x9e, 0x41, 0xc8, 0x69, 0xc5, 0xac, 0x49, 0xe6, 0xfd,
-	0xe5, 0x99, 0x2f, 0x6b, 0xc8, 0x37, 0x39, 0x2d, 0x59, 0xbf, 0x2f, 0xb2, 0xfe, 0x1f, 0x16, 0xb9,
-	0xf1, 0x8f, 0x8b, 0xfc, 0x0a, 0xe0, 0xee, 0xca, 0x99, 0xdc, 0x9a, 0x65, 0x76, 0xbf, 0x00, 0x58,
-	0x1f, 0xb0, 0xd8, 0x3a, 0x81, 0x1b, 0xf2, 0x3a, 0x39, 0xee, 0x9f, 0x6f, 0xaa, 0xab, 0xa7, 0x6b,
-	0x3f, 0x5a, 0x03, 0xa8, 0x46, 0x7f, 0x0b, 0x1b, 0xd5, 0x2f, 0xf2, 0x70, 0x0d, 0x49, 0x80, 0xda,
-	0x4f, 0xfe, 0x02, 0x54, 0xaa, 0xf7, 0x5f, 0x5f, 0xcf, 0x6c, 0x70, 0x33, 0xb3, 0xc1, 0x8f, 0x99,
-	0x0d, 0x3e, 0xce, 0x6d, 0xe3, 0x66, 0x6e, 0x1b, 0xdf, 0xe7, 0xb6, 0xf1, 0xa6, 0x1b, 0x4f, 0xf8,
-	0xbb, 0xf7, 0x81, 0x3b, 0xa6, 0x89, 0xa7, 0x05, 0x9f, 0x9e, 0xe2, 0x80, 0x95, 0x89, 0x77, 0xd6,
-	0x3d, 0xf6, 0x2e, 0xca, 0x97, 0x8a, 0x5f, 0x66, 0x84, 0x05, 0x9b, 0xf2, 0x5d, 0x39, 0xfe, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0xd2, 0xf4, 0xe1, 0x28, 0xc8, 0x04, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x3b, 0x6f, 0x13, 0x4f,
+	0x10, 0xf7, 0xd9, 0x79, 0xd8, 0x9b, 0xfc, 0xf3, 0xb8, 0xbc, 0x1c, 0x17, 0x77, 0xf9, 0x6f, 0x24,
+	0x08, 0x8a, 0x72, 0x27, 0x3b, 0x34, 0xa4, 0x8b, 0x83, 0x40, 0x48, 0x58, 0x44, 0xe7, 0x0e, 0x21,
+	0x59, 0x6b, 0xdf, 0xda, 0x9c, 0x9c, 0xbb, 0xb5, 0x76, 0x37, 0xaf, 0x8e, 0x0a, 0x51, 0xf2, 0x11,
+	0xd2, 0xf0, 0x05, 0x90, 0xa0, 0xa4, 0x4e, 0x99, 0x12, 0x51, 0x9c, 0x50, 0xd2, 0x50, 0xfb, 0x13,
+	0xa0, 0x7d, 0xdc, 0xc5, 0x91, 0x62, 0x0c, 0x48, 0x20, 0x51, 0x79, 0x66, 0xe7, 0xf7, 0xfb, 0xcd,
+	0x78, 0x66, 0x76, 0x0f, 0xd8, 0x84, 0x85, 0x84, 0x05, 0xcc, 0x0d, 0x11, 0xed, 0x62, 0xee, 0x1e,
+	0x95, 0x9b, 0x98, 0xa3, 0xb2, 0xcb, 0x4f, 0x9c, 0x1e, 0x25, 0x9c, 0x98, 0xcb, 0x1a, 0xe0, 0x28,
+	0x80, 0xa3, 0x01, 0xa5, 0xc5, 0x0e, 0xe9, 0x10, 0x09, 0x71, 0x85, 0xa5, 0xd0, 0x25, 0xab, 0x25,
+	0xe1, 0x6e, 0x13, 0x31, 0x9c, 0x6a, 0xb5, 0x48, 0x10, 0xe9, 0xf8, 0xfa, 0x90, 0x74, 0x5a, 0x5c,
+	0x82, 0xe0, 0x87, 0x2c, 0x98, 0xac, 0xb1, 0x4e, 0xfd, 0x18, 0xf5, 0xcc, 0x7b, 0x60, 0x82, 0x53,
+	0xe4, 0x63, 0x5a, 0x34, 0xd6, 0x8c, 0x8d, 0x42, 0x75, 0xbe, 0x1f, 0xdb, 0xff, 0x9d, 0xa2, 0xf0,
+	0x60, 0x07, 0xaa, 0x73, 0xe8, 0x69, 0x80, 0x59, 0x07, 0x80, 0xb4, 0xdb, 0x98, 0x36, 0x44, 0xbe,
+	0x62, 0x76, 0xcd, 0xd8, 0x98, 0xaa, 0xac, 0x3a, 0xaa, 0x20, 0x47, 0x14, 0x94, 0xd4, 0xee, 0xec,
+	0x91, 0x20, 0xaa, 0xae, 0x9e, 0xc7, 0x76, 0xa6, 0x1f, 0xdb, 0xf3, 0x4a, 0xed, 0x9a, 0x0a, 0xbd,
+	0x82, 0x74, 0x04, 0xca, 0x2c, 0x83, 0x02, 0x62, 0xdd, 0x86, 0x8f, 0x23, 0x12, 0x16, 0x73, 0xb2,
+	0x84, 0xc5, 0x7e, 0x6c, 0xcf, 0x29, 0x52, 0x1a, 0x82, 0x5e, 0x1e, 0xb1, 0xee, 0x43, 0x61, 0x9a,
+	0x21, 0x98, 0x09, 0x83, 0xa8, 0x21, 0x62, 0x28, 0x24, 0x87, 0x11, 0x2f, 0x8e, 0x49, 0xde, 0x63,
+	0x91, 0xf0, 0x4b, 0x6c, 0xdf, 0xe9, 0x04, 0xfc, 0xe5, 0x61, 0xd3, 0x69, 0x91, 0xd0, 0xd5, 0xed,
+	0x52, 0x3f, 0x5b, 0xcc, 0xef, 0xba, 0xfc, 0xb4, 0x87, 0x99, 0xf3, 0x24, 0xe2, 0xfd, 0xd8, 0x5e,
+	0x52, 0x59, 0x6e, 0xaa, 0x41, 0x6f, 0x3a, 0x0c, 0xa2, 0x5d, 0xd6, 0xdd, 0x95, 0xee, 0x4e, 0xfe,
+	0xcd, 0x99, 0x9d, 0xf9, 0x76, 0x66, 0x67, 0xe0, 0x7b, 0x03, 0xcc, 0xea, 0xbe, 0x79, 0x98, 0xf5,
+	0x48, 0xc4, 0xb0, 0xb9, 0x0f, 0x0a, 0xec, 0x18, 0xf5, 0x54, 0x4f, 0x8c, 0x51, 0x3d, 0x29, 0xea,
+	0x9e, 0xe8, 0xbf, 0x97, 0x32, 0xa1, 0x97, 0x17, 0xb6, 0xec, 0x48, 0x0d, 0x48, 0xbb, 0xd1, 0xc6,
+	0x78, 0x74, 0x93, 0x57, 0xb4, 0xe0, 0xec, 0x80, 0x60, 0x1b, 0x63, 0xe8, 0x4d, 0x0a, 0xf3, 0x11,
+	0xc6, 0xf0, 0x55, 0x0e, 0x4c, 0xe9, 0xa2, 0xeb, 0x38, 0xf2, 0xcd, 0x1d, 0x30, 0xdd, 0xa6, 0x24,
+	0x6c, 0x20, 0xdf, 0xa7, 0x98, 0x31, 0x3d, 0xf6, 0x95, 0x7e, 0x6c, 0x2f, 0x28, 0x8d, 0xc1, 0x28,
+	0xf4, 0xa6, 0x84, 0xbb, 0xab, 0x3c, 0xf3, 0x3e, 0x00, 0x9c, 0xa4, 0xcc, 0xac, 0x64, 0x2e, 0x5d,
+	0x8f, 0xf8, 0x3a, 0x06, 0xbd, 0x02, 0x27, 0x09, 0xeb, 0xe6, 0xde, 0xe4, 0xfe, 0xc0, 0xde, 0x8c,
+	0xfd, 0xe6, 0xde, 0x8c, 0xff, 0x9d, 0xbd, 0xf9, 0x68, 0x80, 0x85, 0x81, 0x11, 0xfc, 0x3b, 0xbb,
+	0xf3, 0xce, 0x00, 0xab, 0x35, 0xd6, 0x79, 0x76, 0x84, 0x29, 0x0d, 0x7c, 0xbc, 0x17, 0xd0, 0xd6,
+	0x61, 0xc0, 0xab, 0x14, 0xa3, 0x2e, 0xa6, 0xe2, 0xe9, 0x60, 0x38, 0xba, 0xf5, 0xe9, 0x50, 0xe7,
+	0xd0, 0xd3, 0x00, 0xb3, 0x0e, 0xc6, 0x19, 0x47, 0x5c, 0x15, 0x35, 0x53, 0xd9, 0x74, 0x6e, 0x7f,
+	0xf4, 0x9c, 0x9b, 0x19, 0xea, 0x82, 0x52, 0x9d, 0xeb, 0xc7, 0xf6, 0xb4, 0x96, 0x15, 0x07, 0xd0,
+	0x53, 0x5a, 0x03, 0x0d, 0x5e, 0x07, 0xff, 0x0f, 0x2d, 0x33, 0xe9, 0x76, 0xe5, 0x53, 0x16, 0xe4,
+	0x6a, 0xac, 0x63, 0xee, 0x83, 0x31, 0xf9, 0xf2, 0xd9, 0xc3, 0x8a, 0xd0, 0xa3, 0x2a, 0xdd, 0x1d,
+	0x01, 0x48, 0xe7, 0xf8, 0x02, 0xe4, 0xd3, 0xeb, 0xb5, 0x3e, 0x82, 0x24, 0x40, 0xa5, 0xcd, 0x9f,
+	0x00, 0xa5, 0xea, 0xaf, 0x0d, 0xb0, 0x3c, 0x64, 0x02, 0xe5, 0x1f, 0xe8, 0xdc, 0x4e, 0x29, 0x3d,
+	0xf8, 0x65, 0x4a, 0x52, 0x48, 0xf5, 0xe9, 0xf9, 0xa5, 0x65, 0x5c, 0x5c, 0x5a, 0xc6, 0xd7, 0x4b,
+	0xcb, 0x78, 0x7b, 0x65, 0x65, 0x2e, 0xae, 0xac, 0xcc, 0xe7, 0x2b, 0x2b, 0xf3, 0xbc, 0x32, 0x70,
+	0x73, 0xb4, 0xfc, 0xd6, 0x01, 0x6a, 0xb2, 0xc4, 0x71, 0x8f, 0x2a, 0xdb, 0xee, 0x49, 0xf2, 0x4d,
+	0x92, 0x37, 0xa9, 0x39, 0x21, 0xbf, 0x45, 0xdb, 0xdf, 0x07, 0x00, 0x3e, 0x84, 0x2b, 0x45, 0x21,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -280,6 +381,9 @@ type MsgClient interface {
 	// SwapSend defines a method for swapping and sending coin from a account to
 	// other account.
 	SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.CallOption) (*MsgSwapSendResponse, error)
+	// OverrideCircuitBreaker defines a governance method for pinning the state
+	// of the circuit breaker, or lifting the override.
+	OverrideCircuitBreaker(ctx context.Context, in *MsgOverrideCircuitBreaker, opts ...grpc.CallOption) (*MsgOverrideCircuitBreakerResponse, error)
 }
 
 type msgClient struct {
@@ -308,6 +412,15 @@ func (c *msgClient) SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) OverrideCircuitBreaker(ctx context.Context, in *MsgOverrideCircuitBreaker, opts ...grpc.CallOption) (*MsgOverrideCircuitBreakerResponse, error) {
+	out := new(MsgOverrideCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.market.v1beta1.Msg/OverrideCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Swap defines a method for swapping coin from one denom to another
@@ -316,6 +429,9 @@ type MsgServer interface {
 	// SwapSend defines a method for swapping and sending coin from a account to
 	// other account.
 	SwapSend(context.Context, *MsgSwapSend) (*MsgSwapSendResponse, error)
+	// OverrideCircuitBreaker defines a governance method for pinning the state
+	// of the circuit breaker, or lifting the override.
+	OverrideCircuitBreaker(context.Context, *MsgOverrideCircuitBreaker) (*MsgOverrideCircuitBreakerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -328,6 +444,9 @@ func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwap
 func (*UnimplementedMsgServer) SwapSend(ctx context.Context, req *MsgSwapSend) (*MsgSwapSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapSend not implemented")
 }
+func (*UnimplementedMsgServer) OverrideCircuitBreaker(ctx context.Context, req *MsgOverrideCircuitBreaker) (*MsgOverrideCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideCircuitBreaker not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -369,6 +488,24 @@ func _Msg_SwapSend_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_OverrideCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOverrideCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OverrideCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.market.v1beta1.Msg/OverrideCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OverrideCircuitBreaker(ctx, req.(*MsgOverrideCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.market.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -381,6 +518,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapSend",
 			Handler:    _Msg_SwapSend_Handler,
 		},
+		{
+			MethodName: "OverrideCircuitBreaker",
+			Handler:    _Msg_OverrideCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/market/v1beta1/tx.proto",
@@ -406,6 +547,16 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinAskAmount.Size()
+		i -= size
+		if _, err := m.MinAskAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.AskDenom) > 0 {
 		i -= len(m.AskDenom)
 		copy(dAtA[i:], m.AskDenom)
@@ -496,6 +647,16 @@ func (m *MsgSwapSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinAskAmount.Size()
+		i -= size
+		if _, err := m.MinAskAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.AskDenom) > 0 {
 		i -= len(m.AskDenom)
 		copy(dAtA[i:], m.AskDenom)
@@ -573,6 +734,64 @@ func (m *MsgSwapSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgOverrideCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOverrideCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOverrideCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOverrideCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOverrideCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOverrideCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -600,6 +819,8 @@ func (m *MsgSwap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.MinAskAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -636,6 +857,8 @@ func (m *MsgSwapSend) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.MinAskAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -652,6 +875,31 @@ func (m *MsgSwapSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgOverrideCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovTx(uint64(m.State))
+	}
+	return n
+}
+
+func (m *MsgOverrideCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +1032,40 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			}
 			m.AskDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAskAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1079,6 +1361,40 @@ func (m *MsgSwapSend) Unmarshal(dAtA []byte) error {
 			}
 			m.AskDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAskAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1216,6 +1532,157 @@ func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgOverrideCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOverrideCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOverrideCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CircuitBreakerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOverrideCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOverrideCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOverrideCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
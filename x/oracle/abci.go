@@ -47,19 +47,20 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			return false
 		})
 
-		// Clear all exchange rates
-		// TODO: yurii: enable cleaning of exchange rates
-		//k.IterateNoteExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
-		//	k.DeleteMelodyExchangeRate(ctx, denom)
-		//	return false
-		//})
+		// Clear the exchange rates which haven't been updated for MaxRateAge blocks,
+		// so that the denoms whose ballots stop passing don't keep their last price forever
+		k.DeleteStaleExchangeRates(ctx)
+
+		// Prune the historical exchange rates older than HistoryKeepPeriod
+		k.PruneHistoricalExchangeRates(ctx)
 
 		// Organize votes to ballot by denom
 		// NOTE: **Filter out inactive or jailed validators**
 		// NOTE: **Make abstain votes to have zero vote power**
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 
-		if referenceSymphony := PickReferenceSymphony(ctx, k, voteTargets, voteMap); referenceSymphony != "" {
+		referenceSymphony := PickReferenceSymphony(ctx, k, voteTargets, voteMap)
+		if referenceSymphony != "" {
 			// make voteMap of Reference Symphony to calculate cross exchange rates
 			ballotRT := voteMap[referenceSymphony]
 			voteMapRT := ballotRT.ToMap()
@@ -80,22 +81,34 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 					exchangeRate = exchangeRateRT.Quo(exchangeRate)
 				}
 
-				// Set the exchange rate, emit ABCI event
+				// The rates of the denoms winding down stay frozen at their settlement rate
+				if k.IsWindingDown(ctx, denom) {
+					continue
+				}
+
+				// Set the exchange rate, emit ABCI event, and keep it in the history
 				k.SetMelodyExchangeRateWithEvent(ctx, denom, exchangeRate)
+				k.RecordHistoricalExchangeRate(ctx, denom, exchangeRate)
 			}
 		}
 
 		//---------------------------
 		// Do miss counting & slashing
-		voteTargetsLen := len(voteTargets)
-		for _, claim := range validatorClaimMap {
-			// Skip abstain & valid voters
-			if int(claim.WinCount) == voteTargetsLen {
-				continue
-			}
+		// No ballot has passed if the oracle is globally down, so nobody is
+		// penalized, and the vote period doesn't count towards the slash window
+		if referenceSymphony != "" {
+			k.SetActiveVotePeriods(ctx, k.GetActiveVotePeriods(ctx)+1)
+
+			voteTargetsLen := len(voteTargets)
+			for _, claim := range validatorClaimMap {
+				// Skip abstain & valid voters
+				if int(claim.WinCount) == voteTargetsLen {
+					continue
+				}
 
-			// Increase miss counter
-			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
+				// Increase miss counter
+				k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
+			}
 		}
 
 		// Distribute rewards to ballot winners
@@ -117,7 +130,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Do slash who did miss voting over threshold and
 	// reset miss counters of all validators at the last block of slash window
 	if appparams.IsPeriodLastBlock(ctx, params.SlashWindow) {
-		// TODO: yurii: enable slashing
-		//k.SlashAndResetMissCounters(ctx)
+		k.SlashAndResetMissCounters(ctx)
 	}
 }
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +15,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagStartTime   = "start-time"
+	flagEndTime     = "end-time"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	oracleQueryCmd := &cobra.Command{
@@ -25,6 +34,7 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryHistoricalExchangeRates(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
@@ -33,6 +43,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
 		GetCmdQueryTobinTaxes(),
+		GetCmdQueryWindDowns(),
 	)
 
 	return oracleQueryCmd
@@ -87,6 +98,76 @@ $ symphonyd query oracle exchange-rates ukrw
 	return cmd
 }
 
+// GetCmdQueryHistoricalExchangeRates implements the query historical rates command.
+func GetCmdQueryHistoricalExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-exchange-rates [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the Melody exchange rates w.r.t an asset finalized within a height or time range",
+		Long: strings.TrimSpace(`
+Query the Melody exchange rates with an asset finalized at the end of the vote periods.
+The range can be bounded by height and by time, the bounds are inclusive.
+
+$ symphonyd query oracle historical-exchange-rates ukrw --start-height 1000 --end-height 2000
+
+$ symphonyd query oracle historical-exchange-rates ukrw --start-time 2024-01-01T00:00:00Z --end-time 2024-01-02T00:00:00Z
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryHistoricalExchangeRatesRequest{Denom: args[0]}
+			if req.StartHeight, err = cmd.Flags().GetInt64(flagStartHeight); err != nil {
+				return err
+			}
+			if req.EndHeight, err = cmd.Flags().GetInt64(flagEndHeight); err != nil {
+				return err
+			}
+			if req.StartTime, err = parseTimeFlag(cmd, flagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = parseTimeFlag(cmd, flagEndTime); err != nil {
+				return err
+			}
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricalExchangeRates(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "Lowest snapshot height, inclusive")
+	cmd.Flags().Int64(flagEndHeight, 0, "Highest snapshot height, inclusive")
+	cmd.Flags().String(flagStartTime, "", "Earliest snapshot time in RFC3339 format, inclusive")
+	cmd.Flags().String(flagEndTime, "", "Latest snapshot time in RFC3339 format, inclusive")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historical exchange rates")
+	return cmd
+}
+
+// parseTimeFlag parses an optional RFC3339 time flag
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	return &t, nil
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -417,3 +498,32 @@ Or, can
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryWindDowns implements the query wind-downs command.
+func GetCmdQueryWindDowns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wind-downs",
+		Args:  cobra.NoArgs,
+		Short: "Query the wind-downs of the whitelisted denoms",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.WindDowns(
+				context.Background(),
+				&types.QueryWindDownsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
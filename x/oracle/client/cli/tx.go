@@ -30,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDelegateFeederPermission(),
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdAggregateExchangeRateVoteAndPrevote(),
 	)
 
 	return oracleTxCmd
@@ -209,3 +210,75 @@ $ symphonyd tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr symphony
 
 	return cmd
 }
+
+// GetCmdAggregateExchangeRateVoteAndPrevote will create a aggregateExchangeRateVoteAndPrevote tx and sign it with the given key.
+func GetCmdAggregateExchangeRateVoteAndPrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-vote-and-prevote [salt] [exchange-rates] [next-salt] [next-exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(4, 5),
+		Short: "Submit an oracle aggregate vote for the exchange_rates of Melody, and the prevote of the next vote period",
+		Long: strings.TrimSpace(`
+Submit a aggregate vote for the exchange_rates of Melody w.r.t the input denom, companion to a prevote submitted in the previous vote period,
+together with the aggregate prevote for the next vote period.
+
+$ symphonyd tx oracle aggregate-vote-and-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr 5678 8890.0ukrw,1.245uusd,0.98usdr
+
+where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro Melody in micro denoms from the voter's point of view.
+
+"salt" should match the salt used to generate the SHA256 hex in the aggregated pre-vote, while "next-salt" and "next-exchange-rates"
+are hashed into the pre-vote for the next vote period.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ symphonyd tx oracle aggregate-vote-and-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr 5678 8890.0ukrw,1.245uusd,0.98usdr symphonyvaloper1....
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			exchangeRatesStr := args[1]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rate {%s} is not a valid format; exchange rate should be formatted as DecCoin; %s", exchangeRatesStr, err.Error())
+			}
+
+			nextSalt := args[2]
+			nextExchangeRatesStr := args[3]
+			_, err = types.ParseExchangeRateTuples(nextExchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given next exchange_rate {%s} is not a valid format; exchange rate should be formatted as DecCoin; %s", nextExchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 5 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[4])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(nextSalt, nextExchangeRatesStr, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVoteAndPrevote(salt, exchangeRatesStr, hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ package exported
 import "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 
 type (
-	MsgAggregateExchangeRatePrevote = types.MsgAggregateExchangeRatePrevote
-	MsgAggregateExchangeRateVote    = types.MsgAggregateExchangeRateVote
+	MsgAggregateExchangeRatePrevote        = types.MsgAggregateExchangeRatePrevote
+	MsgAggregateExchangeRateVote           = types.MsgAggregateExchangeRateVote
+	MsgAggregateExchangeRateVoteAndPrevote = types.MsgAggregateExchangeRateVoteAndPrevote
 )
@@ -68,6 +68,16 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		}
 	}
 
+	keeper.SetActiveVotePeriods(ctx, data.ActiveVotePeriods)
+
+	for _, snapshot := range data.HistoricalExchangeRates {
+		keeper.SetHistoricalExchangeRate(ctx, snapshot)
+	}
+
+	for _, windDown := range data.WindDowns {
+		keeper.SetWindDown(ctx, windDown)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 
 	// check if the module account exists
@@ -124,11 +134,16 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params,
+	genesis := types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
 		aggregateExchangeRateVotes,
 		tobinTaxes)
+	genesis.ActiveVotePeriods = keeper.GetActiveVotePeriods(ctx)
+	genesis.HistoricalExchangeRates = keeper.GetAllHistoricalExchangeRates(ctx)
+	genesis.WindDowns = keeper.GetWindDowns(ctx)
+
+	return genesis
 }
@@ -1,14 +1,12 @@
 package keeper
 
 import (
-	"fmt"
+	"bytes"
 	"sort"
-	"strings"
 
 	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // OrganizeBallotByDenom collects all oracle votes for the period, categorized by the votes' denom parameter
@@ -74,6 +72,9 @@ func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
 
 // ApplyWhitelist update vote target denom list and set tobin tax with params whitelist
 func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteTargets map[string]sdk.Dec) {
+	// retire the denoms which completed their wind-down
+	whitelist = k.retireWoundDownDenoms(ctx, whitelist, voteTargets)
+
 	// check is there any update in whitelist params
 	updateRequired := false
 	if len(voteTargets) != len(whitelist) {
@@ -92,25 +93,41 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 
 		for _, item := range whitelist {
 			k.SetTobinTax(ctx, item.Name, item.TobinTax)
+		}
+	}
 
-			// Register meta data to bank module
-			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name); !ok {
-				base := item.Name
-				display := base[1:]
-
-				k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
-					Description: "The native stable token of the Symphony.",
-					DenomUnits: []*banktypes.DenomUnit{
-						{Denom: "u" + display, Exponent: uint32(0), Aliases: []string{"micro" + display}},
-						{Denom: "m" + display, Exponent: uint32(3), Aliases: []string{"milli" + display}},
-						{Denom: display, Exponent: uint32(6), Aliases: []string{}},
-					},
-					Base:    base,
-					Display: display,
-					Name:    fmt.Sprintf("%s TERRA", strings.ToUpper(display)),
-					Symbol:  fmt.Sprintf("%sT", strings.ToUpper(display[:len(display)-1])),
-				})
-			}
+	// Register meta data to bank module for the denoms without any. The existing meta data is only replaced
+	// when governance sets the meta data fields of the denom in the whitelist.
+	for _, item := range whitelist {
+		metadata := item.Metadata()
+		existing, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name)
+		if ok && (!item.HasMetadata() || bytes.Equal(k.cdc.MustMarshal(&existing), k.cdc.MustMarshal(&metadata))) {
+			continue
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+}
+
+// retireWoundDownDenoms drops the denoms whose wind-down completed from the whitelist,
+// together with their frozen exchange rates, so that their leftover supply no longer counts
+// towards the exchange requirement
+func (k Keeper) retireWoundDownDenoms(ctx sdk.Context, whitelist types.DenomList, voteTargets map[string]sdk.Dec) types.DenomList {
+	targets := make(types.DenomList, 0, len(whitelist))
+	for _, item := range whitelist {
+		if !k.IsWindDownComplete(ctx, item.Name) {
+			targets = append(targets, item)
+			continue
+		}
+
+		k.DeleteMelodyExchangeRate(ctx, item.Name)
+		if _, ok := voteTargets[item.Name]; ok {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeWindDownComplete,
+					sdk.NewAttribute(types.AttributeKeyDenom, item.Name),
+					sdk.NewAttribute(types.AttributeKeyLeftoverSupply, k.bankKeeper.GetSupply(ctx, item.Name).String()),
+				),
+			)
 		}
 	}
+	return targets
 }
@@ -4,10 +4,12 @@ import (
 	"sort"
 
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
 	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -168,13 +170,79 @@ func (s *KeeperTestSuite) TestApplyWhitelist() {
 	s.Require().True(ok)
 	s.Require().Equal(metadata.Base, "uusd")
 	s.Require().Equal(metadata.Display, "usd")
-	s.Require().Equal(len(metadata.DenomUnits), 3)
+	s.Require().Equal(len(metadata.DenomUnits), 2)
 	s.Require().Equal(metadata.Description, "The native stable token of the Symphony.")
 
 	metadata, ok = s.App.BankKeeper.GetDenomMetaData(s.Ctx, "ukrw")
 	s.Require().True(ok)
 	s.Require().Equal(metadata.Base, "ukrw")
 	s.Require().Equal(metadata.Display, "krw")
-	s.Require().Equal(len(metadata.DenomUnits), 3)
+	s.Require().Equal(len(metadata.DenomUnits), 2)
 	s.Require().Equal(metadata.Description, "The native stable token of the Symphony.")
 }
+
+func (s *KeeperTestSuite) TestApplyWhitelist_UpdatesMetadata() {
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, types.DenomList{
+		types.Denom{Name: "uusd", TobinTax: sdk.OneDec()},
+	}, map[string]sdk.Dec{})
+
+	metadata, ok := s.App.BankKeeper.GetDenomMetaData(s.Ctx, "uusd")
+	s.Require().True(ok)
+	s.Require().Equal("USD", metadata.Name)
+	s.Require().Equal("USD", metadata.Symbol)
+	s.Require().Equal(uint32(types.DefaultDenomExponent), metadata.DenomUnits[1].Exponent)
+
+	// governance changes the metadata while the tobin tax stays the same
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, types.DenomList{
+		types.Denom{
+			Name:        "uusd",
+			TobinTax:    sdk.OneDec(),
+			DisplayName: "Symphony Dollar",
+			Symbol:      "SUSD",
+			Exponent:    proto.Uint32(8),
+			Description: "The dollar of the Symphony.",
+		},
+	}, map[string]sdk.Dec{"uusd": sdk.OneDec()})
+
+	metadata, ok = s.App.BankKeeper.GetDenomMetaData(s.Ctx, "uusd")
+	s.Require().True(ok)
+	s.Require().Equal("Symphony Dollar", metadata.Name)
+	s.Require().Equal("SUSD", metadata.Symbol)
+	s.Require().Equal("The dollar of the Symphony.", metadata.Description)
+	s.Require().Equal(uint32(8), metadata.DenomUnits[1].Exponent)
+}
+
+func (s *KeeperTestSuite) TestApplyWhitelist_KeepsExistingMetadata() {
+	existing := banktypes.Metadata{
+		Description: "The dollar of the Symphony.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uusd", Exponent: 0, Aliases: []string{"microusd"}},
+			{Denom: "musd", Exponent: 3, Aliases: []string{"milliusd"}},
+			{Denom: "usd", Exponent: 6},
+		},
+		Base:    "uusd",
+		Display: "usd",
+		Name:    "USD",
+		Symbol:  "USD",
+	}
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, existing)
+
+	// the whitelist doesn't set any metadata field, the existing metadata is kept
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, types.DenomList{
+		types.Denom{Name: "uusd", TobinTax: sdk.OneDec()},
+	}, map[string]sdk.Dec{})
+
+	metadata, ok := s.App.BankKeeper.GetDenomMetaData(s.Ctx, "uusd")
+	s.Require().True(ok)
+	s.Require().Equal(existing, metadata)
+
+	// governance sets the metadata fields, the metadata is replaced
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, types.DenomList{
+		types.Denom{Name: "uusd", TobinTax: sdk.OneDec(), Exponent: proto.Uint32(0)},
+	}, map[string]sdk.Dec{"uusd": sdk.OneDec()})
+
+	metadata, ok = s.App.BankKeeper.GetDenomMetaData(s.Ctx, "uusd")
+	s.Require().True(ok)
+	s.Require().Equal("uusd", metadata.Display)
+	s.Require().Len(metadata.DenomUnits, 1)
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// GetHistoricalExchangeRate returns the exchange rate of the denom finalized at the given height
+func (k Keeper) GetHistoricalExchangeRate(ctx sdk.Context, denom string, height int64) (types.ExchangeRateSnapshot, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHistoricalExchangeRateKey(denom, height))
+	if bz == nil {
+		return types.ExchangeRateSnapshot{}, errorsmod.Wrapf(types.ErrNoHistoricalRate, "%s at height %d", denom, height)
+	}
+
+	var snapshot types.ExchangeRateSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, nil
+}
+
+// SetHistoricalExchangeRate stores the exchange rate snapshot along with its height index
+func (k Keeper) SetHistoricalExchangeRate(ctx sdk.Context, snapshot types.ExchangeRateSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetHistoricalExchangeRateKey(snapshot.Denom, snapshot.Height), bz)
+	store.Set(types.GetHistoricalExchangeRateIndexKey(snapshot.Height, snapshot.Denom), []byte{})
+}
+
+// RecordHistoricalExchangeRate stores the exchange rate of the denom finalized at the current block
+func (k Keeper) RecordHistoricalExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	k.SetHistoricalExchangeRate(ctx, types.NewExchangeRateSnapshot(denom, exchangeRate, ctx.BlockHeight(), ctx.BlockTime()))
+}
+
+// deleteHistoricalExchangeRate removes the exchange rate snapshot along with its height index
+func (k Keeper) deleteHistoricalExchangeRate(ctx sdk.Context, denom string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalExchangeRateKey(denom, height))
+	store.Delete(types.GetHistoricalExchangeRateIndexKey(height, denom))
+}
+
+// IterateHistoricalExchangeRates iterates over the exchange rate snapshots of the denom in ascending height order
+func (k Keeper) IterateHistoricalExchangeRates(ctx sdk.Context, denom string, handler func(snapshot types.ExchangeRateSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetHistoricalExchangeRatePrefix(denom))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.ExchangeRateSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		if handler(snapshot) {
+			break
+		}
+	}
+}
+
+// GetLastMelodyExchangeRate returns the most recent exchange rate recorded for the denom, which outlives its
+// current exchange rate until the snapshot is pruned
+func (k Keeper) GetLastMelodyExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetHistoricalExchangeRatePrefix(denom))
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrNoHistoricalRate, denom)
+	}
+
+	var snapshot types.ExchangeRateSnapshot
+	k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+	return snapshot.ExchangeRate, nil
+}
+
+// GetAllHistoricalExchangeRates returns the exchange rate snapshots of all denoms
+func (k Keeper) GetAllHistoricalExchangeRates(ctx sdk.Context) []types.ExchangeRateSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalExchangeRateKey)
+	defer iter.Close()
+
+	snapshots := []types.ExchangeRateSnapshot{}
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.ExchangeRateSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// PruneHistoricalExchangeRates deletes the exchange rate snapshots older than HistoryKeepPeriod.
+// The height index is walked from the oldest snapshot, and the walk stops at the first one to keep.
+func (k Keeper) PruneHistoricalExchangeRates(ctx sdk.Context) {
+	pruneBefore := ctx.BlockTime().Add(-k.HistoryKeepPeriod(ctx))
+
+	type snapshotKey struct {
+		height int64
+		denom  string
+	}
+	var expired []snapshotKey
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalExchangeRateIndexKey)
+	for ; iter.Valid(); iter.Next() {
+		height, denom := types.ParseHistoricalExchangeRateIndexKey(iter.Key())
+		snapshot, err := k.GetHistoricalExchangeRate(ctx, denom, height)
+		if err == nil && !snapshot.Time.Before(pruneBefore) {
+			break
+		}
+		expired = append(expired, snapshotKey{height: height, denom: denom})
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		k.deleteHistoricalExchangeRate(ctx, key.denom, key.height)
+	}
+}
+
+// GetArithmeticTwap returns the time weighted average of the exchange rate of the denom between
+// startTime and endTime. Each snapshot's rate is weighted by the time until the next snapshot.
+// Returns an error if there is no snapshot at or before startTime, e.g. because it has been pruned.
+func (k Keeper) GetArithmeticTwap(ctx sdk.Context, denom string, startTime time.Time, endTime time.Time) (sdk.Dec, error) {
+	if endTime.Sub(startTime) < time.Millisecond {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidTimeRange, "start time %s must be at least 1ms before end time %s", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidTimeRange, "end time %s must not be after the block time %s", endTime, ctx.BlockTime())
+	}
+
+	accumulator := sdk.ZeroDec()
+	var prev *types.ExchangeRateSnapshot
+	k.IterateHistoricalExchangeRates(ctx, denom, func(snapshot types.ExchangeRateSnapshot) (stop bool) {
+		if snapshot.Time.After(startTime) {
+			if prev == nil {
+				// no rate is known at startTime
+				return true
+			}
+			accumulator = accumulator.Add(weightedRate(*prev, startTime, minTime(snapshot.Time, endTime)))
+		}
+
+		prev = &snapshot
+		return !snapshot.Time.Before(endTime)
+	})
+
+	if prev == nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrNoHistoricalRate, "%s at %s", denom, startTime)
+	}
+	if prev.Time.Before(endTime) {
+		accumulator = accumulator.Add(weightedRate(*prev, startTime, endTime))
+	}
+
+	return accumulator.QuoInt64(endTime.Sub(startTime).Milliseconds()), nil
+}
+
+// GetArithmeticTwapToNow returns the time weighted average of the exchange rate of the denom
+// between startTime and the current block time.
+func (k Keeper) GetArithmeticTwapToNow(ctx sdk.Context, denom string, startTime time.Time) (sdk.Dec, error) {
+	return k.GetArithmeticTwap(ctx, denom, startTime, ctx.BlockTime())
+}
+
+// weightedRate returns the snapshot rate weighted by the milliseconds it was in effect
+// between max(snapshot time, startTime) and endTime.
+func weightedRate(snapshot types.ExchangeRateSnapshot, startTime time.Time, endTime time.Time) sdk.Dec {
+	from := snapshot.Time
+	if from.Before(startTime) {
+		from = startTime
+	}
+	return snapshot.ExchangeRate.MulInt64(endTime.Sub(from).Milliseconds())
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
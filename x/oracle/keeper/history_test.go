@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+var historyStartTime = time.Unix(1_700_000_000, 0).UTC()
+
+// recordHistory stores one snapshot of the denom per minute, starting at historyStartTime and height 100
+func (s *KeeperTestSuite) recordHistory(denom string, rates ...int64) {
+	for i, rate := range rates {
+		ctx := s.Ctx.WithBlockHeight(100 + int64(i)).WithBlockTime(historyStartTime.Add(time.Duration(i) * time.Minute))
+		s.App.OracleKeeper.RecordHistoricalExchangeRate(ctx, denom, sdk.NewDec(rate))
+	}
+}
+
+func (s *KeeperTestSuite) TestRecordHistoricalExchangeRate() {
+	s.recordHistory(assets.MicroSDRDenom, 10, 20)
+	s.recordHistory(assets.MicroKRWDenom, 1000)
+
+	snapshot, err := s.App.OracleKeeper.GetHistoricalExchangeRate(s.Ctx, assets.MicroSDRDenom, 101)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewExchangeRateSnapshot(assets.MicroSDRDenom, sdk.NewDec(20), 101, historyStartTime.Add(time.Minute)), snapshot)
+
+	_, err = s.App.OracleKeeper.GetHistoricalExchangeRate(s.Ctx, assets.MicroKRWDenom, 101)
+	s.Require().ErrorIs(err, types.ErrNoHistoricalRate)
+
+	var heights []int64
+	s.App.OracleKeeper.IterateHistoricalExchangeRates(s.Ctx, assets.MicroSDRDenom, func(snapshot types.ExchangeRateSnapshot) (stop bool) {
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	s.Require().Equal([]int64{100, 101}, heights)
+	s.Require().Len(s.App.OracleKeeper.GetAllHistoricalExchangeRates(s.Ctx), 3)
+}
+
+func (s *KeeperTestSuite) TestPruneHistoricalExchangeRates() {
+	s.recordHistory(assets.MicroSDRDenom, 10, 20, 30, 40)
+	s.recordHistory(assets.MicroKRWDenom, 1000, 2000)
+
+	params := s.App.OracleKeeper.GetParams(s.Ctx)
+	params.HistoryKeepPeriod = 2 * time.Minute
+	s.App.OracleKeeper.SetParams(s.Ctx, params)
+
+	// snapshots older than 2 minutes before the block time are pruned
+	ctx := s.Ctx.WithBlockTime(historyStartTime.Add(3 * time.Minute))
+	s.App.OracleKeeper.PruneHistoricalExchangeRates(ctx)
+
+	var heights []int64
+	s.App.OracleKeeper.IterateHistoricalExchangeRates(ctx, assets.MicroSDRDenom, func(snapshot types.ExchangeRateSnapshot) (stop bool) {
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	s.Require().Equal([]int64{101, 102, 103}, heights)
+
+	_, err := s.App.OracleKeeper.GetHistoricalExchangeRate(ctx, assets.MicroKRWDenom, 100)
+	s.Require().ErrorIs(err, types.ErrNoHistoricalRate)
+	_, err = s.App.OracleKeeper.GetHistoricalExchangeRate(ctx, assets.MicroKRWDenom, 101)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGetArithmeticTwap() {
+	// 10 for the 1st minute, 20 for the 2nd minute, 40 from the 3rd minute
+	s.recordHistory(assets.MicroSDRDenom, 10, 20, 40)
+	ctx := s.Ctx.WithBlockTime(historyStartTime.Add(10 * time.Minute))
+
+	tests := map[string]struct {
+		start       time.Time
+		end         time.Time
+		expected    sdk.Dec
+		expectedErr error
+	}{
+		"within a single snapshot": {
+			start:    historyStartTime.Add(10 * time.Second),
+			end:      historyStartTime.Add(50 * time.Second),
+			expected: sdk.NewDec(10),
+		},
+		"spanning all snapshots": {
+			start:    historyStartTime,
+			end:      historyStartTime.Add(4 * time.Minute),
+			expected: sdk.NewDec(10 + 20 + 40 + 40).QuoInt64(4),
+		},
+		"starting between snapshots": {
+			start:    historyStartTime.Add(30 * time.Second),
+			end:      historyStartTime.Add(2 * time.Minute),
+			expected: sdk.NewDec(10*30 + 20*60).QuoInt64(90),
+		},
+		"after the last snapshot": {
+			start:    historyStartTime.Add(5 * time.Minute),
+			end:      historyStartTime.Add(6 * time.Minute),
+			expected: sdk.NewDec(40),
+		},
+		"to now": {
+			start:    historyStartTime.Add(time.Minute),
+			end:      ctx.BlockTime(),
+			expected: sdk.NewDec(20 + 40*8).QuoInt64(9),
+		},
+		"no rate known at start time": {
+			start:       historyStartTime.Add(-time.Second),
+			end:         historyStartTime.Add(time.Minute),
+			expectedErr: types.ErrNoHistoricalRate,
+		},
+		"end time in the future": {
+			start:       historyStartTime,
+			end:         ctx.BlockTime().Add(time.Second),
+			expectedErr: types.ErrInvalidTimeRange,
+		},
+		"empty time range": {
+			start:       historyStartTime,
+			end:         historyStartTime,
+			expectedErr: types.ErrInvalidTimeRange,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			twap, err := s.App.OracleKeeper.GetArithmeticTwap(ctx, assets.MicroSDRDenom, tc.start, tc.end)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, twap)
+		})
+	}
+
+	twap, err := s.App.OracleKeeper.GetArithmeticTwapToNow(ctx, assets.MicroSDRDenom, historyStartTime.Add(time.Minute))
+	s.Require().NoError(err)
+	s.Require().Equal(tests["to now"].expected, twap)
+
+	_, err = s.App.OracleKeeper.GetArithmeticTwapToNow(ctx, assets.MicroKRWDenom, historyStartTime)
+	s.Require().ErrorIs(err, types.ErrNoHistoricalRate)
+}
+
+func (s *KeeperTestSuite) TestQueryHistoricalExchangeRates() {
+	s.recordHistory(assets.MicroSDRDenom, 10, 20, 30, 40)
+	s.recordHistory(assets.MicroKRWDenom, 1000)
+	querier := keeper.NewQuerier(*s.App.OracleKeeper)
+
+	heightsOf := func(res *types.QueryHistoricalExchangeRatesResponse) []int64 {
+		heights := []int64{}
+		for _, snapshot := range res.HistoricalExchangeRates {
+			s.Require().Equal(assets.MicroSDRDenom, snapshot.Denom)
+			heights = append(heights, snapshot.Height)
+		}
+		return heights
+	}
+
+	res, err := querier.HistoricalExchangeRates(sdk.WrapSDKContext(s.Ctx), &types.QueryHistoricalExchangeRatesRequest{Denom: assets.MicroSDRDenom})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{100, 101, 102, 103}, heightsOf(res))
+
+	res, err = querier.HistoricalExchangeRates(sdk.WrapSDKContext(s.Ctx), &types.QueryHistoricalExchangeRatesRequest{
+		Denom:       assets.MicroSDRDenom,
+		StartHeight: 101,
+		EndHeight:   102,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{101, 102}, heightsOf(res))
+
+	startTime, endTime := historyStartTime.Add(2*time.Minute), historyStartTime.Add(time.Hour)
+	res, err = querier.HistoricalExchangeRates(sdk.WrapSDKContext(s.Ctx), &types.QueryHistoricalExchangeRatesRequest{
+		Denom:     assets.MicroSDRDenom,
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{102, 103}, heightsOf(res))
+
+	res, err = querier.HistoricalExchangeRates(sdk.WrapSDKContext(s.Ctx), &types.QueryHistoricalExchangeRatesRequest{
+		Denom:      assets.MicroSDRDenom,
+		StartTime:  &startTime,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{102}, heightsOf(res))
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = querier.HistoricalExchangeRates(sdk.WrapSDKContext(s.Ctx), &types.QueryHistoricalExchangeRatesRequest{
+		Denom:       assets.MicroSDRDenom,
+		StartHeight: 102,
+		EndHeight:   101,
+	})
+	s.Require().Error(err)
+
+	_, err = querier.HistoricalExchangeRates(sdk.WrapSDKContext(s.Ctx), &types.QueryHistoricalExchangeRatesRequest{})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerRecordsHistoricalExchangeRates() {
+	s.setupSlashing()
+
+	for height := int64(slashTestWindow); height < slashTestWindow+3; height++ {
+		s.runVotePeriod(height, ValAddrs[0], ValAddrs[1], ValAddrs[2])
+	}
+	// no snapshot when the ballot doesn't pass
+	s.runVotePeriod(slashTestWindow + 3)
+
+	var heights []int64
+	s.App.OracleKeeper.IterateHistoricalExchangeRates(s.Ctx, assets.MicroSDRDenom, func(snapshot types.ExchangeRateSnapshot) (stop bool) {
+		s.Require().Equal(randomExchangeRate, snapshot.ExchangeRate)
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	s.Require().Equal([]int64{slashTestWindow, slashTestWindow + 1, slashTestWindow + 2}, heights)
+
+	// the history survives a genesis export and import
+	genesis := oracle.ExportGenesis(s.Ctx, *s.App.OracleKeeper)
+	s.Require().Len(genesis.HistoricalExchangeRates, 3)
+	s.Require().NoError(types.ValidateGenesis(genesis))
+
+	s.SetupTest()
+	oracle.InitGenesis(s.Ctx, *s.App.OracleKeeper, genesis)
+	s.Require().Equal(genesis.HistoricalExchangeRates, s.App.OracleKeeper.GetAllHistoricalExchangeRates(s.Ctx))
+}
@@ -24,10 +24,11 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	paramSpace paramstypes.Subspace
 
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	distrKeeper   types.DistributionKeeper
-	StakingKeeper types.StakingKeeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistributionKeeper
+	StakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
 
 	distrName string
 }
@@ -41,6 +42,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
 	distrName string,
 ) Keeper {
 	// ensure oracle module account is set
@@ -54,14 +56,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramSpace:    paramspace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		distrKeeper:   distrKeeper,
-		StakingKeeper: stakingKeeper,
-		distrName:     distrName,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		paramSpace:     paramspace,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		StakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		distrName:      distrName,
 	}
 }
 
@@ -74,6 +77,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // ExchangeRate logic
 
 // GetMelodyExchangeRate gets the consensus exchange rate of Melody denominated in the denom asset from the store.
+// Returns an error if the rate hasn't been updated during the last MaxRateAge blocks.
 func (k Keeper) GetMelodyExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
 	if denom == appparams.BaseCoinUnit {
 		return sdk.OneDec(), nil
@@ -85,16 +89,60 @@ func (k Keeper) GetMelodyExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, e
 		return sdk.ZeroDec(), errorsmod.Wrap(types.ErrUnknownDenom, denom)
 	}
 
+	if k.IsExchangeRateStale(ctx, denom) {
+		updateHeight, _ := k.GetExchangeRateUpdateHeight(ctx, denom)
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrStaleExchangeRate, "%s: last updated at height %d", denom, updateHeight)
+	}
+
 	dp := sdk.DecProto{}
 	k.cdc.MustUnmarshal(b, &dp)
 	return dp.Dec, nil
 }
 
 // SetMelodyExchangeRate sets the consensus exchange rate of Melody denominated in the denom asset to the store.
+// The current block height is recorded as the rate last update height.
 func (k Keeper) SetMelodyExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: exchangeRate})
 	store.Set(types.GetExchangeRateKey(denom), bz)
+
+	k.setExchangeRateUpdateHeight(ctx, denom, ctx.BlockHeight())
+}
+
+// setExchangeRateUpdateHeight records the block height at which the exchange rate of the denom was last updated.
+func (k Keeper) setExchangeRateUpdateHeight(ctx sdk.Context, denom string, updateHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: updateHeight})
+	store.Set(types.GetExchangeRateUpdateHeightKey(denom), bz)
+}
+
+// GetExchangeRateUpdateHeight returns the block height at which the exchange rate of the denom was last updated.
+func (k Keeper) GetExchangeRateUpdateHeight(ctx sdk.Context, denom string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExchangeRateUpdateHeightKey(denom))
+	if bz == nil {
+		return 0, false
+	}
+
+	var updateHeight gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &updateHeight)
+	return updateHeight.Value, true
+}
+
+// IsExchangeRateStale returns true if the exchange rate of the denom hasn't been updated
+// during the last MaxRateAge blocks. Rates without a recorded update height are stale,
+// while the rates frozen by a wind-down never are.
+func (k Keeper) IsExchangeRateStale(ctx sdk.Context, denom string) bool {
+	if k.IsWindingDown(ctx, denom) {
+		return false
+	}
+
+	updateHeight, found := k.GetExchangeRateUpdateHeight(ctx, denom)
+	if !found {
+		return true
+	}
+
+	return ctx.BlockHeight()-updateHeight > int64(k.MaxRateAge(ctx))
 }
 
 // SetMelodyExchangeRateWithEvent sets the consensus exchange rate of Note
@@ -113,6 +161,22 @@ func (k Keeper) SetMelodyExchangeRateWithEvent(ctx sdk.Context, denom string, ex
 func (k Keeper) DeleteMelodyExchangeRate(ctx sdk.Context, denom string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetExchangeRateKey(denom))
+	store.Delete(types.GetExchangeRateUpdateHeightKey(denom))
+}
+
+// DeleteStaleExchangeRates deletes the exchange rates which haven't been updated during the last MaxRateAge blocks.
+func (k Keeper) DeleteStaleExchangeRates(ctx sdk.Context) {
+	var staleDenoms []string
+	k.IterateNoteExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
+		if k.IsExchangeRateStale(ctx, denom) {
+			staleDenoms = append(staleDenoms, denom)
+		}
+		return false
+	})
+
+	for _, denom := range staleDenoms {
+		k.DeleteMelodyExchangeRate(ctx, denom)
+	}
 }
 
 // IterateNoteExchangeRates iterates over note rates in the store
@@ -217,6 +281,27 @@ func (k Keeper) IterateMissCounters(ctx sdk.Context,
 	}
 }
 
+// GetActiveVotePeriods retrieves the # of vote periods in this oracle slash window
+// in which the oracle was active, i.e. at least one ballot passed
+func (k Keeper) GetActiveVotePeriods(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ActiveVotePeriodsKey)
+	if bz == nil {
+		return 0
+	}
+
+	var activeVotePeriods gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &activeVotePeriods)
+	return activeVotePeriods.Value
+}
+
+// SetActiveVotePeriods updates the # of active vote periods in this oracle slash window
+func (k Keeper) SetActiveVotePeriods(ctx sdk.Context, activeVotePeriods uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: activeVotePeriods})
+	store.Set(types.ActiveVotePeriodsKey, bz)
+}
+
 //-----------------------------------
 // AggregateExchangeRatePrevote logic
 
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -190,6 +191,58 @@ func (s *KeeperTestSuite) TestExchangeRate() {
 	s.Require().True(numExchangeRates == 3)
 }
 
+func (s *KeeperTestSuite) TestExchangeRateStaleness() {
+	maxRateAge := s.App.OracleKeeper.MaxRateAge(s.Ctx)
+	updateHeight := s.Ctx.BlockHeight()
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, randomExchangeRate)
+
+	height, found := s.App.OracleKeeper.GetExchangeRateUpdateHeight(s.Ctx, assets.MicroSDRDenom)
+	s.Require().True(found)
+	s.Require().Equal(updateHeight, height)
+
+	// the rate can be used for MaxRateAge blocks
+	ctx := s.Ctx.WithBlockHeight(updateHeight + int64(maxRateAge))
+	s.Require().False(s.App.OracleKeeper.IsExchangeRateStale(ctx, assets.MicroSDRDenom))
+	rate, err := s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(randomExchangeRate, rate)
+
+	// then it's stale
+	ctx = s.Ctx.WithBlockHeight(updateHeight + int64(maxRateAge) + 1)
+	s.Require().True(s.App.OracleKeeper.IsExchangeRateStale(ctx, assets.MicroSDRDenom))
+	_, err = s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().ErrorIs(err, types.ErrStaleExchangeRate)
+
+	// the base denom never gets stale
+	rate, err = s.App.OracleKeeper.GetMelodyExchangeRate(ctx, appparams.BaseCoinUnit)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// an update refreshes the rate
+	s.App.OracleKeeper.SetMelodyExchangeRate(ctx, assets.MicroSDRDenom, randomExchangeRate)
+	_, err = s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestDeleteStaleExchangeRates() {
+	maxRateAge := int64(s.App.OracleKeeper.MaxRateAge(s.Ctx))
+	height := s.Ctx.BlockHeight()
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, randomExchangeRate)
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx.WithBlockHeight(height+maxRateAge), assets.MicroKRWDenom, randomExchangeRate)
+
+	ctx := s.Ctx.WithBlockHeight(height + maxRateAge + 1)
+	s.App.OracleKeeper.DeleteStaleExchangeRates(ctx)
+
+	_, err := s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+	_, found := s.App.OracleKeeper.GetExchangeRateUpdateHeight(ctx, assets.MicroSDRDenom)
+	s.Require().False(found)
+
+	rate, err := s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroKRWDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(randomExchangeRate, rate)
+}
+
 func (s *KeeperTestSuite) TestIterateMelodyExchangeRates() {
 	cnyExchangeRate := sdk.NewDecWithPrec(839, int64(OracleDecPrecision)).MulInt64(appparams.MicroUnit)
 	gbpExchangeRate := sdk.NewDecWithPrec(4995, int64(OracleDecPrecision)).MulInt64(appparams.MicroUnit)
@@ -243,6 +296,9 @@ func (s *KeeperTestSuite) TestParams() {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	jailDuration := time.Hour
+	maxRateAge := uint64(40)
+	historyKeepPeriod := time.Hour * 24
 	whitelist := types.DenomList{
 		{Name: assets.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
 		{Name: assets.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
@@ -258,6 +314,9 @@ func (s *KeeperTestSuite) TestParams() {
 		SlashFraction:            slashFraction,
 		SlashWindow:              slashWindow,
 		MinValidPerWindow:        minValidPerWindow,
+		JailDuration:             jailDuration,
+		MaxRateAge:               maxRateAge,
+		HistoryKeepPeriod:        historyKeepPeriod,
 	}
 	s.App.OracleKeeper.SetParams(s.Ctx, newParams)
 
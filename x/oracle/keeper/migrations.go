@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added since version 1, JailDuration, MaxRateAge and HistoryKeepPeriod,
+// to their default values. The exchange rates stored before have no update height, so they're stamped
+// with the current height to keep them usable until the next votes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyJailDuration, defaults.JailDuration)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMaxRateAge, defaults.MaxRateAge)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyHistoryKeepPeriod, defaults.HistoryKeepPeriod)
+
+	var unstampedDenoms []string
+	m.keeper.IterateNoteExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
+		if _, found := m.keeper.GetExchangeRateUpdateHeight(ctx, denom); !found {
+			unstampedDenoms = append(unstampedDenoms, denom)
+		}
+		return false
+	})
+
+	for _, denom := range unstampedDenoms {
+		m.keeper.setExchangeRateUpdateHeight(ctx, denom, ctx.BlockHeight())
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	// rates stored before version 2 have no update height
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom, randomExchangeRate)
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+	store.Delete(types.GetExchangeRateUpdateHeightKey(assets.MicroSDRDenom))
+	_, err := s.App.OracleKeeper.GetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom)
+	s.Require().ErrorIs(err, types.ErrStaleExchangeRate)
+
+	// the rates with an update height keep it
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx.WithBlockHeight(s.Ctx.BlockHeight()-1), assets.MicroKRWDenom, randomExchangeRate)
+
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	err = keeper.NewMigrator(*s.App.OracleKeeper).Migrate1to2(ctx)
+	s.Require().NoError(err)
+
+	// right after the upgrade, the rates are still usable
+	rate, err := s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(randomExchangeRate, rate)
+	height, found := s.App.OracleKeeper.GetExchangeRateUpdateHeight(ctx, assets.MicroSDRDenom)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockHeight(), height)
+
+	height, found = s.App.OracleKeeper.GetExchangeRateUpdateHeight(ctx, assets.MicroKRWDenom)
+	s.Require().True(found)
+	s.Require().Equal(s.Ctx.BlockHeight()-1, height)
+
+	// and the stale rate pruning keeps them
+	s.App.OracleKeeper.DeleteStaleExchangeRates(ctx)
+	_, err = s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().NoError(err)
+}
@@ -129,6 +129,21 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
 }
 
+// AggregateExchangeRateVoteAndPrevote reveals the vote of the current vote period and submits the prevote
+// of the next one atomically, with the same checks as the separate vote and prevote messages.
+func (ms msgServer) AggregateExchangeRateVoteAndPrevote(goCtx context.Context, msg *types.MsgAggregateExchangeRateVoteAndPrevote) (*types.MsgAggregateExchangeRateVoteAndPrevoteResponse, error) {
+	// the vote deletes the revealed prevote, which makes room for the prevote of the next vote period
+	if _, err := ms.AggregateExchangeRateVote(goCtx, msg.Vote()); err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.AggregateExchangeRatePrevote(goCtx, msg.Prevote()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAggregateExchangeRateVoteAndPrevoteResponse{}, nil
+}
+
 func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -172,3 +172,58 @@ func (s *KeeperTestSuite) TestMsgServer_AggregatePrevoteVote() {
 	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(2)), aggregateExchangeRateVoteMsg)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestMsgServer_AggregateVoteAndPrevote() {
+	msgServer := s.setupServer()
+
+	salt, nextSalt := "1", "2"
+	exchangeRatesStr := randomExchangeRate.String() + assets.MicroSDRDenom
+	nextExchangeRatesStr := sdk.NewDec(1800).String() + assets.MicroSDRDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, ValAddrs[0])
+	nextHash := types.GetAggregateVoteHash(nextSalt, nextExchangeRatesStr, ValAddrs[0])
+
+	// Without a prevote to reveal fails
+	voteAndPrevoteMsg := types.NewMsgAggregateExchangeRateVoteAndPrevote(salt, exchangeRatesStr, nextHash, Addrs[0], ValAddrs[0])
+	_, err := msgServer.AggregateExchangeRateVoteAndPrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(0)), voteAndPrevoteMsg)
+	s.Require().Error(err)
+
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(0)), prevoteMsg)
+	s.Require().NoError(err)
+
+	// Invalid reveal period
+	_, err = msgServer.AggregateExchangeRateVoteAndPrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(0)), voteAndPrevoteMsg)
+	s.Require().Error(err)
+
+	// Unauthorized feeder
+	unauthorizedMsg := types.NewMsgAggregateExchangeRateVoteAndPrevote(salt, exchangeRatesStr, nextHash, Addrs[1], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateVoteAndPrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(1)), unauthorizedMsg)
+	s.Require().Error(err)
+
+	// Reveal not matching the prevote hash
+	otherSaltMsg := types.NewMsgAggregateExchangeRateVoteAndPrevote(nextSalt, exchangeRatesStr, nextHash, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateVoteAndPrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(1)), otherSaltMsg)
+	s.Require().Error(err)
+
+	// Valid reveal of the current vote and commit of the next one
+	_, err = msgServer.AggregateExchangeRateVoteAndPrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(1)), voteAndPrevoteMsg)
+	s.Require().NoError(err)
+
+	vote, err := s.App.OracleKeeper.GetAggregateExchangeRateVote(s.Ctx, ValAddrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(randomExchangeRate, vote.ExchangeRateTuples[0].ExchangeRate)
+
+	prevote, err := s.App.OracleKeeper.GetAggregateExchangeRatePrevote(s.Ctx, ValAddrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(nextHash.String(), prevote.Hash)
+	s.Require().Equal(uint64(1), prevote.SubmitBlock)
+
+	// The next vote period reveals the committed vote
+	nextMsg := types.NewMsgAggregateExchangeRateVoteAndPrevote(nextSalt, nextExchangeRatesStr, hash, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateVoteAndPrevote(sdk.WrapSDKContext(s.Ctx.WithBlockHeight(2)), nextMsg)
+	s.Require().NoError(err)
+
+	vote, err = s.App.OracleKeeper.GetAggregateExchangeRateVote(s.Ctx, ValAddrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(1800), vote.ExchangeRateTuples[0].ExchangeRate)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -60,6 +62,24 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// JailDuration returns the duration a validator slashed for missing votes stays jailed.
+func (k Keeper) JailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyJailDuration, &res)
+	return
+}
+
+// MaxRateAge returns the number of blocks after which an exchange rate is considered stale
+func (k Keeper) MaxRateAge(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxRateAge, &res)
+	return
+}
+
+// HistoryKeepPeriod returns how long the historical exchange rates are kept
+func (k Keeper) HistoryKeepPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyHistoryKeepPeriod, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSetIfExists(ctx, &params)
@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 )
@@ -62,6 +64,54 @@ func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesReq
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
+// HistoricalExchangeRates queries the exchange rate snapshots of a denom within a height and time range
+func (q querier) HistoricalExchangeRates(c context.Context, req *types.QueryHistoricalExchangeRatesRequest) (*types.QueryHistoricalExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.StartHeight < 0 || req.EndHeight < 0 || (req.EndHeight != 0 && req.StartHeight > req.EndHeight) {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && req.StartTime.After(*req.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetHistoricalExchangeRatePrefix(req.Denom))
+
+	var snapshots []types.ExchangeRateSnapshot
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var snapshot types.ExchangeRateSnapshot
+		if err := q.cdc.Unmarshal(value, &snapshot); err != nil {
+			return false, err
+		}
+
+		if snapshot.Height < req.StartHeight || (req.EndHeight != 0 && snapshot.Height > req.EndHeight) {
+			return false, nil
+		}
+
+		if (req.StartTime != nil && snapshot.Time.Before(*req.StartTime)) || (req.EndTime != nil && snapshot.Time.After(*req.EndTime)) {
+			return false, nil
+		}
+
+		if accumulate {
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalExchangeRatesResponse{HistoricalExchangeRates: snapshots, Pagination: pageRes}, nil
+}
+
 // TobinTax queries tobin tax of a denom
 func (q querier) TobinTax(c context.Context, req *types.QueryTobinTaxRequest) (*types.QueryTobinTaxResponse, error) {
 	if req == nil {
@@ -116,6 +166,12 @@ func (q querier) VoteTargets(c context.Context, _ *types.QueryVoteTargetsRequest
 	return &types.QueryVoteTargetsResponse{VoteTargets: q.GetVoteTargets(ctx)}, nil
 }
 
+// WindDowns queries the wind-downs of all denoms
+func (q querier) WindDowns(c context.Context, _ *types.QueryWindDownsRequest) (*types.QueryWindDownsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryWindDownsResponse{WindDowns: q.GetWindDowns(ctx)}, nil
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
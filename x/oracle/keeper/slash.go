@@ -1,34 +1,44 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 )
 
-// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero.
+// Only the vote periods in which the oracle was active count towards the window, so validators are not
+// penalized for the periods when the oracle was globally down. The whole window is skipped if the oracle
+// was down during all of its vote periods.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-	// slash_window / vote_period
-	votePeriodsPerWindow := uint64(
-		sdk.NewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
-			TruncateInt64(),
-	)
-	minValidPerWindow := k.MinValidPerWindow(ctx)
-	slashFraction := k.SlashFraction(ctx)
+	params := k.GetParams(ctx)
+	activeVotePeriods := k.GetActiveVotePeriods(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	jailedUntil := ctx.BlockHeader().Time.Add(params.JailDuration)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
-		// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
-		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePeriodsPerWindow - missCounter))).
-			QuoInt64(int64(votePeriodsPerWindow))
+		defer k.DeleteMissCounter(ctx, operator)
+
+		if activeVotePeriods == 0 {
+			return false
+		}
+
+		// Calculate miss ratio; MissCounter/ActiveVotePeriods
+		missRatio := sdk.NewDec(int64(missCounter)).QuoInt64(int64(activeVotePeriods))
+		if missRatio.GT(sdk.OneDec()) {
+			missRatio = sdk.OneDec()
+		}
 
 		// Penalize the validator whose the valid vote rate is smaller than min threshold
-		if validVoteRate.LT(minValidPerWindow) {
+		validVoteRate := sdk.OneDec().Sub(missRatio)
+		if validVoteRate.LT(params.MinValidPerWindow) {
 			validator := k.StakingKeeper.Validator(ctx, operator)
-			if validator.IsBonded() && !validator.IsJailed() {
+			if validator != nil && validator.IsBonded() && !validator.IsJailed() {
 				consAddr, err := validator.GetConsAddr()
 				if err != nil {
 					panic(err)
@@ -36,13 +46,30 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 
 				k.StakingKeeper.Slash(
 					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
+					distributionHeight, validator.GetConsensusPower(powerReduction), params.SlashFraction,
 				)
 				k.StakingKeeper.Jail(ctx, consAddr)
+
+				// x/slashing doesn't allow to unjail the validator before this time
+				if k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+					k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil)
+				}
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeSlash,
+						sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+						sdk.NewAttribute(types.AttributeKeyMissCounter, sdk.NewIntFromUint64(missCounter).String()),
+						sdk.NewAttribute(types.AttributeKeyMissRatio, missRatio.String()),
+						sdk.NewAttribute(types.AttributeKeySlashFraction, params.SlashFraction.String()),
+						sdk.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.Format(time.RFC3339)),
+					),
+				)
 			}
 		}
 
-		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	k.SetActiveVotePeriods(ctx, 0)
 }
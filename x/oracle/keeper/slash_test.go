@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+const slashTestWindow = 4
+
+// setupSlashing creates three bonded validators and configures the oracle so that every
+// block is a vote period and every slashTestWindow blocks close a slash window.
+func (s *KeeperTestSuite) setupSlashing() {
+	params := s.App.OracleKeeper.GetParams(s.Ctx)
+	params.VotePeriod = 1
+	params.SlashWindow = slashTestWindow
+	params.RewardDistributionWindow = slashTestWindow
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 2)
+	params.JailDuration = time.Hour
+	params.Whitelist = types.DenomList{
+		{Name: assets.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
+	}
+	s.App.OracleKeeper.SetParams(s.Ctx, params)
+	s.App.OracleKeeper.ClearTobinTaxes(s.Ctx)
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, assets.MicroSDRDenom, types.DefaultTobinTax)
+
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(s.App.StakingKeeper)
+	for i := 0; i < 3; i++ {
+		_, err := stakingMsgSvr.CreateValidator(s.Ctx, s.NewTestMsgCreateValidator(ValAddrs[i], s.valPubKeys[i], amt))
+		s.Require().NoError(err)
+	}
+	staking.EndBlocker(s.Ctx, s.App.StakingKeeper)
+}
+
+// runVotePeriod stores votes of the given validators and runs the oracle end blocker at the given height.
+func (s *KeeperTestSuite) runVotePeriod(height int64, voters ...sdk.ValAddress) sdk.Context {
+	ctx := s.Ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	for _, voter := range voters {
+		s.App.OracleKeeper.SetAggregateExchangeRateVote(ctx, voter, types.NewAggregateExchangeRateVote(
+			types.ExchangeRateTuples{{Denom: assets.MicroSDRDenom, ExchangeRate: randomExchangeRate}}, voter))
+	}
+	oracle.EndBlocker(ctx, *s.App.OracleKeeper)
+	return ctx
+}
+
+func (s *KeeperTestSuite) validatorConsAddr(valAddr sdk.ValAddress) sdk.ConsAddress {
+	validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+	s.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	return consAddr
+}
+
+func (s *KeeperTestSuite) TestSlashAndResetMissCounters() {
+	s.setupSlashing()
+	tokensBefore := s.App.StakingKeeper.Validator(s.Ctx, ValAddrs[2]).GetBondedTokens()
+
+	// ValAddrs[2] misses every vote period of the window
+	var ctx sdk.Context
+	for height := int64(slashTestWindow); height < 2*slashTestWindow; height++ {
+		ctx = s.runVotePeriod(height, ValAddrs[0], ValAddrs[1])
+
+		if height < 2*slashTestWindow-1 {
+			s.Require().Equal(uint64(height-slashTestWindow+1), s.App.OracleKeeper.GetActiveVotePeriods(s.Ctx))
+			s.Require().Equal(uint64(height-slashTestWindow+1), s.App.OracleKeeper.GetMissCounter(s.Ctx, ValAddrs[2]))
+		}
+	}
+
+	// the window is reset
+	s.Require().Equal(uint64(0), s.App.OracleKeeper.GetActiveVotePeriods(s.Ctx))
+	s.Require().Equal(uint64(0), s.App.OracleKeeper.GetMissCounter(s.Ctx, ValAddrs[2]))
+
+	// voting validators are untouched
+	s.Require().False(s.App.StakingKeeper.Validator(s.Ctx, ValAddrs[0]).IsJailed())
+	s.Require().False(s.App.StakingKeeper.Validator(s.Ctx, ValAddrs[1]).IsJailed())
+
+	// the missing validator is slashed and jailed for the configured duration
+	validator := s.App.StakingKeeper.Validator(s.Ctx, ValAddrs[2])
+	s.Require().True(validator.IsJailed())
+	s.Require().Equal(
+		tokensBefore.Sub(sdk.NewDecFromInt(tokensBefore).Mul(sdk.NewDecWithPrec(1, 2)).TruncateInt()),
+		validator.GetTokens(),
+	)
+
+	jailedUntil := ctx.BlockTime().Add(time.Hour)
+	signingInfo, found := s.App.SlashingKeeper.GetValidatorSigningInfo(s.Ctx, s.validatorConsAddr(ValAddrs[2]))
+	s.Require().True(found)
+	s.Require().Equal(jailedUntil, signingInfo.JailedUntil)
+
+	var slashEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSlash {
+			continue
+		}
+		attrs := s.ExtractAttributes(event)
+		if attrs[types.AttributeKeyOperator] == ValAddrs[2].String() {
+			slashEvent = event
+		}
+	}
+	attrs := s.ExtractAttributes(slashEvent)
+	s.Require().Equal("4", attrs[types.AttributeKeyMissCounter])
+	s.Require().Equal(sdk.OneDec().String(), attrs[types.AttributeKeyMissRatio])
+	s.Require().Equal(sdk.NewDecWithPrec(1, 2).String(), attrs[types.AttributeKeySlashFraction])
+	s.Require().Equal(jailedUntil.Format(time.RFC3339), attrs[types.AttributeKeyJailedUntil])
+}
+
+func (s *KeeperTestSuite) TestSlashAndResetMissCounters_InactiveVotePeriods() {
+	s.setupSlashing()
+
+	// No ballot passes in the first three vote periods, so they don't count towards the window
+	for height := int64(slashTestWindow); height < 2*slashTestWindow-1; height++ {
+		s.runVotePeriod(height, ValAddrs[2])
+	}
+	s.Require().Equal(uint64(0), s.App.OracleKeeper.GetActiveVotePeriods(s.Ctx))
+	s.Require().Equal(uint64(0), s.App.OracleKeeper.GetMissCounter(s.Ctx, ValAddrs[0]))
+
+	// Every validator votes in the only active vote period
+	ctx := s.runVotePeriod(2*slashTestWindow-1, ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	for i := 0; i < 3; i++ {
+		s.Require().False(s.App.StakingKeeper.Validator(s.Ctx, ValAddrs[i]).IsJailed())
+		s.Require().Equal(uint64(0), s.App.OracleKeeper.GetMissCounter(s.Ctx, ValAddrs[i]))
+	}
+	s.Require().Equal(uint64(0), s.App.OracleKeeper.GetActiveVotePeriods(s.Ctx))
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSlash {
+			continue
+		}
+		operator := s.ExtractAttributes(event)[types.AttributeKeyOperator]
+		for i := 0; i < 3; i++ {
+			s.Require().NotEqual(ValAddrs[i].String(), operator)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestSlashAndResetMissCounters_OracleDown() {
+	s.setupSlashing()
+
+	// Nobody votes during the whole window
+	var ctx sdk.Context
+	for height := int64(slashTestWindow); height < 2*slashTestWindow; height++ {
+		ctx = s.runVotePeriod(height)
+	}
+
+	for i := 0; i < 3; i++ {
+		s.Require().False(s.App.StakingKeeper.Validator(s.Ctx, ValAddrs[i]).IsJailed())
+	}
+	for _, event := range ctx.EventManager().Events() {
+		s.Require().NotEqual(types.EventTypeSlash, event.Type)
+	}
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// GetWindDown returns the wind-down of the denom and whether the denom is winding down
+func (k Keeper) GetWindDown(ctx sdk.Context, denom string) (types.WindDown, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWindDownKey(denom))
+	if bz == nil {
+		return types.WindDown{}, false
+	}
+
+	var windDown types.WindDown
+	k.cdc.MustUnmarshal(bz, &windDown)
+	return windDown, true
+}
+
+// SetWindDown stores the wind-down of a denom
+func (k Keeper) SetWindDown(ctx sdk.Context, windDown types.WindDown) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&windDown)
+	store.Set(types.GetWindDownKey(windDown.Denom), bz)
+}
+
+// IterateWindDowns iterates over the wind-downs in the store
+func (k Keeper) IterateWindDowns(ctx sdk.Context, handler func(windDown types.WindDown) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WindDownKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var windDown types.WindDown
+		k.cdc.MustUnmarshal(iter.Value(), &windDown)
+		if handler(windDown) {
+			break
+		}
+	}
+}
+
+// GetWindDowns returns all wind-downs, including the completed ones
+func (k Keeper) GetWindDowns(ctx sdk.Context) []types.WindDown {
+	windDowns := []types.WindDown{}
+	k.IterateWindDowns(ctx, func(windDown types.WindDown) (stop bool) {
+		windDowns = append(windDowns, windDown)
+		return false
+	})
+	return windDowns
+}
+
+// IsWindingDown returns true if the denom has been moved into wind-down
+func (k Keeper) IsWindingDown(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetWindDownKey(denom))
+}
+
+// IsWindDownComplete returns true if the denom is winding down and either its redemption period is over or its whole
+// supply has been redeemed. The supply left after the deadline can no longer be redeemed and isn't backed anymore.
+func (k Keeper) IsWindDownComplete(ctx sdk.Context, denom string) bool {
+	windDown, found := k.GetWindDown(ctx, denom)
+	if !found {
+		return false
+	}
+
+	return !windDown.IsRedeemable(ctx.BlockTime()) || k.bankKeeper.GetSupply(ctx, denom).IsZero()
+}
+
+// StartWindDown moves a whitelisted denom into wind-down and freezes its exchange rate at the settlement rate
+func (k Keeper) StartWindDown(ctx sdk.Context, windDown types.WindDown) error {
+	if err := windDown.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidWindDown, err.Error())
+	}
+
+	if !k.Whitelist(ctx).Contains(windDown.Denom) {
+		return errorsmod.Wrapf(types.ErrUnknownDenom, "%s is not whitelisted", windDown.Denom)
+	}
+
+	if k.IsWindingDown(ctx, windDown.Denom) {
+		return errorsmod.Wrap(types.ErrDenomWindingDown, windDown.Denom)
+	}
+
+	k.SetWindDown(ctx, windDown)
+	k.SetMelodyExchangeRateWithEvent(ctx, windDown.Denom, windDown.SettlementRate)
+	k.RecordHistoricalExchangeRate(ctx, windDown.Denom, windDown.SettlementRate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeWindDown,
+			sdk.NewAttribute(types.AttributeKeyDenom, windDown.Denom),
+			sdk.NewAttribute(types.AttributeKeySettlementRate, windDown.SettlementRate.String()),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, windDown.RedemptionRate.String()),
+			sdk.NewAttribute(types.AttributeKeyDeadline, windDown.Deadline.Format(time.RFC3339)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+)
+
+// whitelistSDRAndKRW sets SDR and KRW as the whitelisted vote targets
+func (s *KeeperTestSuite) whitelistSDRAndKRW() types.DenomList {
+	whitelist := types.DenomList{
+		{Name: assets.MicroSDRDenom, TobinTax: sdk.NewDecWithPrec(2, 2)},
+		{Name: assets.MicroKRWDenom, TobinTax: sdk.NewDecWithPrec(3, 2)},
+	}
+
+	params := s.App.OracleKeeper.GetParams(s.Ctx)
+	params.Whitelist = whitelist
+	s.App.OracleKeeper.SetParams(s.Ctx, params)
+	for _, denom := range whitelist {
+		s.App.OracleKeeper.SetTobinTax(s.Ctx, denom.Name, denom.TobinTax)
+	}
+	return whitelist
+}
+
+func (s *KeeperTestSuite) TestStartWindDown() {
+	s.whitelistSDRAndKRW()
+	deadline := s.Ctx.BlockTime().Add(time.Hour)
+
+	// only whitelisted denoms can wind down
+	err := s.App.OracleKeeper.StartWindDown(s.Ctx, types.NewWindDown("uusd", sdk.NewDec(2), sdk.OneDec(), deadline))
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	// the redemption rate can't exceed the settlement rate
+	err = s.App.OracleKeeper.StartWindDown(s.Ctx, types.NewWindDown(assets.MicroSDRDenom, sdk.NewDec(2), sdk.NewDec(3), deadline))
+	s.Require().ErrorIs(err, types.ErrInvalidWindDown)
+
+	windDown := types.NewWindDown(assets.MicroSDRDenom, sdk.NewDec(2), sdk.OneDec(), deadline)
+	s.Require().NoError(s.App.OracleKeeper.StartWindDown(s.Ctx, windDown))
+	s.Require().True(s.App.OracleKeeper.IsWindingDown(s.Ctx, assets.MicroSDRDenom))
+	s.Require().False(s.App.OracleKeeper.IsWindingDown(s.Ctx, assets.MicroKRWDenom))
+
+	res, found := s.App.OracleKeeper.GetWindDown(s.Ctx, assets.MicroSDRDenom)
+	s.Require().True(found)
+	s.Require().Equal(windDown.SettlementRate, res.SettlementRate)
+	s.Require().Equal(windDown.RedemptionRate, res.RedemptionRate)
+	s.Require().True(windDown.Deadline.Equal(res.Deadline))
+	s.Require().Len(s.App.OracleKeeper.GetWindDowns(s.Ctx), 1)
+
+	// a denom can only wind down once
+	err = s.App.OracleKeeper.StartWindDown(s.Ctx, windDown)
+	s.Require().ErrorIs(err, types.ErrDenomWindingDown)
+
+	// the rate is frozen at the settlement rate and never gets stale
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + int64(s.App.OracleKeeper.MaxRateAge(s.Ctx)) + 1)
+	s.App.OracleKeeper.DeleteStaleExchangeRates(ctx)
+	rate, err := s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), rate)
+}
+
+func (s *KeeperTestSuite) TestApplyWhitelistRetiresWoundDownDenom() {
+	whitelist := s.whitelistSDRAndKRW()
+	voteTargets := map[string]sdk.Dec{}
+	for _, denom := range whitelist {
+		voteTargets[denom.Name] = denom.TobinTax
+	}
+
+	windDown := types.NewWindDown(assets.MicroSDRDenom, sdk.NewDec(2), sdk.OneDec(), s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.App.OracleKeeper.StartWindDown(s.Ctx, windDown))
+
+	// the denom stays a vote target while it has a supply
+	coins := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, 1000))
+	s.Require().NoError(s.FundAccount(Addrs[0], coins))
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, whitelist, voteTargets)
+	s.Require().ElementsMatch([]string{assets.MicroSDRDenom, assets.MicroKRWDenom}, s.App.OracleKeeper.GetVoteTargets(s.Ctx))
+
+	// then it's retired once the whole supply is redeemed
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromAccountToModule(s.Ctx, Addrs[0], FaucetAccountName, coins))
+	s.Require().NoError(s.App.BankKeeper.BurnCoins(s.Ctx, FaucetAccountName, coins))
+	s.Require().True(s.App.OracleKeeper.IsWindDownComplete(s.Ctx, assets.MicroSDRDenom))
+
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, whitelist, voteTargets)
+	s.Require().Equal([]string{assets.MicroKRWDenom}, s.App.OracleKeeper.GetVoteTargets(s.Ctx))
+	_, err := s.App.OracleKeeper.GetMelodyExchangeRate(s.Ctx, assets.MicroSDRDenom)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	// the retired denom isn't added back by the following vote periods
+	s.App.OracleKeeper.ApplyWhitelist(s.Ctx, whitelist, map[string]sdk.Dec{assets.MicroKRWDenom: whitelist[1].TobinTax})
+	s.Require().Equal([]string{assets.MicroKRWDenom}, s.App.OracleKeeper.GetVoteTargets(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestApplyWhitelistRetiresDenomAtDeadline() {
+	whitelist := s.whitelistSDRAndKRW()
+	voteTargets := map[string]sdk.Dec{}
+	for _, denom := range whitelist {
+		voteTargets[denom.Name] = denom.TobinTax
+	}
+
+	windDown := types.NewWindDown(assets.MicroSDRDenom, sdk.NewDec(2), sdk.OneDec(), s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.App.OracleKeeper.StartWindDown(s.Ctx, windDown))
+	s.Require().NoError(s.FundAccount(Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, 1000))))
+
+	// the wind-down isn't complete while the supply can still be redeemed
+	s.Require().False(s.App.OracleKeeper.IsWindDownComplete(s.Ctx, assets.MicroSDRDenom))
+	s.Require().False(s.App.OracleKeeper.IsWindDownComplete(s.Ctx.WithBlockTime(windDown.Deadline), assets.MicroSDRDenom))
+
+	// past the deadline the denom is retired along with its leftover supply
+	ctx := s.Ctx.WithBlockTime(windDown.Deadline.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	s.Require().True(s.App.OracleKeeper.IsWindDownComplete(ctx, assets.MicroSDRDenom))
+	s.App.OracleKeeper.ApplyWhitelist(ctx, whitelist, voteTargets)
+	s.Require().Equal([]string{assets.MicroKRWDenom}, s.App.OracleKeeper.GetVoteTargets(ctx))
+	_, err := s.App.OracleKeeper.GetMelodyExchangeRate(ctx, assets.MicroSDRDenom)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeWindDownComplete, events[0].Type)
+	leftover, found := events[0].GetAttribute(types.AttributeKeyLeftoverSupply)
+	s.Require().True(found)
+	s.Require().Equal(s.App.BankKeeper.GetSupply(ctx, assets.MicroSDRDenom).String(), leftover.Value)
+}
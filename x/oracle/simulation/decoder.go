@@ -45,6 +45,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &tobinTaxA)
 			cdc.MustUnmarshal(kvB.Value, &tobinTaxB)
 			return fmt.Sprintf("%v\n%v", tobinTaxA, tobinTaxB)
+		case bytes.Equal(kvA.Key[:1], types.WindDownKey):
+			var windDownA, windDownB types.WindDown
+			cdc.MustUnmarshal(kvA.Value, &windDownA)
+			cdc.MustUnmarshal(kvB.Value, &windDownB)
+			return fmt.Sprintf("%v\n%v", windDownA, windDownB)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
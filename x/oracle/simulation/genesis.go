@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -21,6 +22,9 @@ const (
 	slashFractionKey            = "slash_fraction"
 	slashWindowKey              = "slash_window"
 	minValidPerWindowKey        = "min_valid_per_window"
+	jailDurationKey             = "jail_duration"
+	maxRateAgeKey               = "max_rate_age"
+	historyKeepPeriodKey        = "history_keep_period"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -58,6 +62,21 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenJailDuration randomized JailDuration
+func GenJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(1+r.Intn(3600)) * time.Second
+}
+
+// GenMaxRateAge randomized MaxRateAge, never shorter than the longest generated VotePeriod
+func GenMaxRateAge(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(1000))
+}
+
+// GenHistoryKeepPeriod randomized HistoryKeepPeriod
+func GenHistoryKeepPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(1+r.Intn(168)) * time.Hour
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -102,6 +121,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var jailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, jailDurationKey, &jailDuration, simState.Rand,
+		func(r *rand.Rand) { jailDuration = GenJailDuration(r) },
+	)
+
+	var maxRateAge uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxRateAgeKey, &maxRateAge, simState.Rand,
+		func(r *rand.Rand) { maxRateAge = GenMaxRateAge(r) },
+	)
+
+	var historyKeepPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, historyKeepPeriodKey, &historyKeepPeriod, simState.Rand,
+		func(r *rand.Rand) { historyKeepPeriod = GenHistoryKeepPeriod(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:               votePeriod,
@@ -112,6 +149,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:            slashFraction,
 			SlashWindow:              slashWindow,
 			MinValidPerWindow:        minValidPerWindow,
+			JailDuration:             jailDuration,
+			MaxRateAge:               maxRateAge,
+			HistoryKeepPeriod:        historyKeepPeriod,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
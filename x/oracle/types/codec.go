@@ -14,6 +14,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote")
 	legacy.RegisterAminoMsg(cdc, &MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgAggregateExchangeRateVoteAndPrevote{}, "oracle/MsgAggregateVoteAndPrevote")
 	legacy.RegisterAminoMsg(cdc, &MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent")
 }
 
@@ -23,6 +24,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgAggregateExchangeRateVoteAndPrevote{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -3,9 +3,18 @@ package types
 import (
 	"strings"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// DefaultDenomExponent is the exponent of the display denom of a whitelisted denom without one
+	DefaultDenomExponent = 6
+
+	// DefaultDenomDescription is the bank metadata description of a whitelisted denom without one
+	DefaultDenomDescription = "The native stable token of the Symphony."
+)
+
 // String implements fmt.Stringer interface
 func (d Denom) String() string {
 	out, _ := yaml.Marshal(d)
@@ -14,7 +23,69 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax)
+	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax) &&
+		d.DisplayName == d1.DisplayName && d.Symbol == d1.Symbol &&
+		equalExponent(d.Exponent, d1.Exponent) && d.Description == d1.Description
+}
+
+func equalExponent(e1, e2 *uint32) bool {
+	if e1 == nil || e2 == nil {
+		return e1 == e2
+	}
+	return *e1 == *e2
+}
+
+// HasMetadata returns true if any of the bank metadata fields is set on the denom
+func (d Denom) HasMetadata() bool {
+	return d.DisplayName != "" || d.Symbol != "" || d.Exponent != nil || d.Description != ""
+}
+
+// Metadata returns the bank metadata of the denom. The display denom is the base denom
+// without its unit prefix, e.g. krw for ukrw, and the unset fields take their defaults.
+// With a zero exponent the base denom is displayed as is.
+func (d Denom) Metadata() banktypes.Metadata {
+	base := d.Name
+	unprefixed := base
+	if len(base) > 1 {
+		unprefixed = base[1:]
+	}
+
+	exponent := uint32(DefaultDenomExponent)
+	if d.Exponent != nil {
+		exponent = *d.Exponent
+	}
+
+	display := unprefixed
+	denomUnits := []*banktypes.DenomUnit{{Denom: base, Exponent: 0, Aliases: []string{}}}
+	if exponent == 0 {
+		display = base
+	} else {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent, Aliases: []string{}})
+	}
+
+	name := d.DisplayName
+	if name == "" {
+		name = strings.ToUpper(unprefixed)
+	}
+
+	symbol := d.Symbol
+	if symbol == "" {
+		symbol = strings.ToUpper(unprefixed)
+	}
+
+	description := d.Description
+	if description == "" {
+		description = DefaultDenomDescription
+	}
+
+	return banktypes.Metadata{
+		Description: description,
+		DenomUnits:  denomUnits,
+		Base:        base,
+		Display:     display,
+		Name:        name,
+		Symbol:      symbol,
+	}
 }
 
 // DenomList is array of Denom
@@ -27,3 +98,13 @@ func (dl DenomList) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// Contains returns true if the list has a denom of the name
+func (dl DenomList) Contains(name string) bool {
+	for _, d := range dl {
+		if d.Name == name {
+			return true
+		}
+	}
+	return false
+}
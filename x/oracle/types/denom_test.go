@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v23/x/oracle/types"
@@ -32,4 +33,49 @@ func Test_DenomList(t *testing.T) {
 	require.Equal(t, "name: denom2\ntobin_tax: \"200.000000000000000000\"\n", denoms[1].String())
 	require.Equal(t, "name: denom3\ntobin_tax: \"300.000000000000000000\"\n", denoms[2].String())
 	require.Equal(t, "name: denom1\ntobin_tax: \"100.000000000000000000\"\n\nname: denom2\ntobin_tax: \"200.000000000000000000\"\n\nname: denom3\ntobin_tax: \"300.000000000000000000\"", denoms.String())
+
+	require.True(t, denoms.Contains("denom2"))
+	require.False(t, denoms.Contains("denom4"))
+}
+
+func Test_DenomMetadata(t *testing.T) {
+	metadata := types.Denom{Name: "ukrw", TobinTax: sdk.ZeroDec()}.Metadata()
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "ukrw", metadata.Base)
+	require.Equal(t, "krw", metadata.Display)
+	require.Equal(t, "KRW", metadata.Name)
+	require.Equal(t, "KRW", metadata.Symbol)
+	require.Equal(t, types.DefaultDenomDescription, metadata.Description)
+	require.Len(t, metadata.DenomUnits, 2)
+	require.Equal(t, uint32(types.DefaultDenomExponent), metadata.DenomUnits[1].Exponent)
+
+	metadata = types.Denom{
+		Name:        "ukrw",
+		TobinTax:    sdk.ZeroDec(),
+		DisplayName: "Symphony Won",
+		Symbol:      "SKRW",
+		Exponent:    proto.Uint32(2),
+		Description: "The won of the Symphony.",
+	}.Metadata()
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "Symphony Won", metadata.Name)
+	require.Equal(t, "SKRW", metadata.Symbol)
+	require.Equal(t, "The won of the Symphony.", metadata.Description)
+	require.Equal(t, uint32(2), metadata.DenomUnits[1].Exponent)
+}
+
+func Test_DenomMetadataZeroExponent(t *testing.T) {
+	denom := types.Denom{Name: "ukrw", TobinTax: sdk.ZeroDec(), Exponent: proto.Uint32(0)}
+	require.True(t, denom.HasMetadata())
+	require.False(t, denom.Equal(&types.Denom{Name: "ukrw", TobinTax: sdk.ZeroDec()}))
+
+	// the base denom is displayed as is
+	metadata := denom.Metadata()
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "ukrw", metadata.Display)
+	require.Equal(t, "KRW", metadata.Name)
+	require.Len(t, metadata.DenomUnits, 1)
+	require.Equal(t, uint32(0), metadata.DenomUnits[0].Exponent)
+
+	require.False(t, types.Denom{Name: "ukrw", TobinTax: sdk.ZeroDec()}.HasMetadata())
 }
@@ -22,4 +22,9 @@ var (
 	ErrNoAggregateVote       = errorsmod.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoTobinTax            = errorsmod.Register(ModuleName, 13, "no tobin tax")
 	ErrUnknownDenom          = errorsmod.Register(ModuleName, 14, "unknown denom")
+	ErrStaleExchangeRate     = errorsmod.Register(ModuleName, 15, "stale exchange rate")
+	ErrNoHistoricalRate      = errorsmod.Register(ModuleName, 16, "no historical exchange rate")
+	ErrInvalidTimeRange      = errorsmod.Register(ModuleName, 17, "invalid time range")
+	ErrInvalidWindDown       = errorsmod.Register(ModuleName, 18, "invalid wind-down")
+	ErrDenomWindingDown      = errorsmod.Register(ModuleName, 19, "denom is winding down")
 )
@@ -8,13 +8,24 @@ const (
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeSlash              = "oracle_slash"
+	EventTypeWindDown           = "wind_down"
+	EventTypeWindDownComplete   = "wind_down_complete"
 
-	AttributeKeyDenom         = "denom"
-	AttributeKeyVoter         = "voter"
-	AttributeKeyExchangeRate  = "exchange_rate"
-	AttributeKeyExchangeRates = "exchange_rates"
-	AttributeKeyOperator      = "operator"
-	AttributeKeyFeeder        = "feeder"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyExchangeRate   = "exchange_rate"
+	AttributeKeyExchangeRates  = "exchange_rates"
+	AttributeKeyOperator       = "operator"
+	AttributeKeyFeeder         = "feeder"
+	AttributeKeyMissCounter    = "miss_counter"
+	AttributeKeyMissRatio      = "miss_ratio"
+	AttributeKeySlashFraction  = "slash_fraction"
+	AttributeKeyJailedUntil    = "jailed_until"
+	AttributeKeySettlementRate = "settlement_rate"
+	AttributeKeyRedemptionRate = "redemption_rate"
+	AttributeKeyDeadline       = "deadline"
+	AttributeKeyLeftoverSupply = "leftover_supply"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -27,17 +28,38 @@ func NewGenesisState(
 
 // DefaultGenesisState - default GenesisState used by columbus-2
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(),
+	genesis := NewGenesisState(DefaultParams(),
 		[]ExchangeRateTuple{},
 		[]FeederDelegation{},
 		[]MissCounter{},
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
 		[]TobinTax{})
+	genesis.HistoricalExchangeRates = []ExchangeRateSnapshot{}
+	genesis.WindDowns = []WindDown{}
+	return genesis
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	for _, snapshot := range data.HistoricalExchangeRates {
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenWindDowns := make(map[string]bool, len(data.WindDowns))
+	for _, windDown := range data.WindDowns {
+		if seenWindDowns[windDown.Denom] {
+			return fmt.Errorf("duplicate wind-down for denom %s", windDown.Denom)
+		}
+		seenWindDowns[windDown.Denom] = true
+
+		if err := windDown.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return data.Params.Validate()
 }
 
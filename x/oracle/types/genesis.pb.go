@@ -33,6 +33,11 @@ type GenesisState struct {
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	TobinTaxes                    []TobinTax                     `protobuf:"bytes,7,rep,name=tobin_taxes,json=tobinTaxes,proto3" json:"tobin_taxes"`
+	// active_vote_periods is the number of vote periods in the current slash
+	// window in which at least one ballot passed
+	ActiveVotePeriods       uint64                 `protobuf:"varint,8,opt,name=active_vote_periods,json=activeVotePeriods,proto3" json:"active_vote_periods,omitempty"`
+	HistoricalExchangeRates []ExchangeRateSnapshot `protobuf:"bytes,9,rep,name=historical_exchange_rates,json=historicalExchangeRates,proto3" json:"historical_exchange_rates"`
+	WindDowns               []WindDown             `protobuf:"bytes,10,rep,name=wind_downs,json=windDowns,proto3" json:"wind_downs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -117,6 +122,27 @@ func (m *GenesisState) GetTobinTaxes() []TobinTax {
 	return nil
 }
 
+func (m *GenesisState) GetActiveVotePeriods() uint64 {
+	if m != nil {
+		return m.ActiveVotePeriods
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHistoricalExchangeRates() []ExchangeRateSnapshot {
+	if m != nil {
+		return m.HistoricalExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWindDowns() []WindDown {
+	if m != nil {
+		return m.WindDowns
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -285,42 +311,48 @@ func init() {
 }
 
 var fileDescriptor_00d991d274be17e0 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0xfe, 0x09, 0xed, 0x26, 0xa9, 0xda, 0x55, 0x85, 0xa2, 0x48, 0x75, 0x42, 0x0a,
-	0x28, 0x08, 0xd5, 0x56, 0x52, 0x8e, 0x5c, 0x1a, 0x0a, 0x3d, 0xf0, 0x47, 0x95, 0x89, 0x38, 0x20,
-	0x55, 0xd6, 0xc6, 0x9e, 0xb8, 0x16, 0xb6, 0x37, 0xf2, 0x6c, 0xa2, 0xc0, 0x95, 0x17, 0xe0, 0x39,
-	0x38, 0xf3, 0x10, 0x3d, 0xf6, 0x88, 0x38, 0x14, 0x94, 0xbc, 0x08, 0xf2, 0x7a, 0x93, 0x98, 0x12,
-	0x23, 0x71, 0x4a, 0x76, 0xfc, 0xfb, 0xbe, 0x6f, 0x66, 0x3d, 0x32, 0xb9, 0xcf, 0x31, 0xe4, 0xe8,
-	0xa3, 0xc9, 0x63, 0xe6, 0x04, 0x60, 0x8e, 0xdb, 0x7d, 0x10, 0xac, 0x6d, 0x7a, 0x10, 0x01, 0xfa,
-	0x68, 0x0c, 0x63, 0x2e, 0x38, 0xbd, 0xab, 0x28, 0x23, 0xa5, 0x0c, 0x45, 0xd5, 0xf6, 0x3d, 0xee,
-	0x71, 0x89, 0x98, 0xc9, 0xbf, 0x94, 0xae, 0x1d, 0xe6, 0x78, 0x2a, 0xb1, 0x84, 0x9a, 0xdf, 0x36,
-	0x49, 0xf9, 0x2c, 0x0d, 0x79, 0x2b, 0x98, 0x00, 0xfa, 0x94, 0x14, 0x87, 0x2c, 0x66, 0x21, 0x56,
-	0xb5, 0x86, 0xd6, 0x2a, 0x75, 0x74, 0x63, 0x75, 0xa8, 0x71, 0x2e, 0xa9, 0xee, 0xc6, 0xd5, 0x4d,
-	0xbd, 0x60, 0x29, 0x0d, 0xbd, 0x20, 0x74, 0x00, 0xe0, 0x42, 0x6c, 0xbb, 0x10, 0x80, 0xc7, 0x84,
-	0xcf, 0x23, 0xac, 0xae, 0x35, 0xd6, 0x5b, 0xa5, 0x4e, 0x2b, 0xcf, 0xe9, 0x85, 0x54, 0x9c, 0x2e,
-	0x04, 0xca, 0x73, 0x6f, 0x70, 0xab, 0x8e, 0x34, 0x20, 0x3b, 0x30, 0x71, 0x2e, 0x59, 0xe4, 0x81,
-	0x1d, 0x33, 0x01, 0x58, 0x5d, 0x97, 0xd6, 0x8f, 0xf2, 0xac, 0x9f, 0x2b, 0xda, 0x62, 0x02, 0x7a,
-	0xa3, 0x61, 0x00, 0xdd, 0x5a, 0xe2, 0xfd, 0xf5, 0x67, 0x9d, 0xfe, 0xf5, 0x08, 0xad, 0x0a, 0x64,
-	0x6a, 0x48, 0xdf, 0x90, 0x4a, 0xe8, 0x23, 0xda, 0x0e, 0x1f, 0x45, 0x02, 0x62, 0xac, 0x6e, 0xc8,
-	0xb0, 0xc3, 0xbc, 0xb0, 0xd7, 0x3e, 0xe2, 0xb3, 0x94, 0x55, 0x23, 0x94, 0xc3, 0x65, 0x09, 0xe9,
-	0x67, 0x8d, 0x34, 0x98, 0xe7, 0xc5, 0xc9, 0x38, 0x60, 0xff, 0x31, 0x88, 0x3d, 0x8c, 0x61, 0xcc,
-	0x93, 0x81, 0x36, 0x65, 0xc6, 0x93, 0xbc, 0x8c, 0x93, 0xb9, 0x3e, 0xdb, 0xfe, 0x79, 0x2a, 0x56,
-	0xa1, 0x07, 0xec, 0x1f, 0x0c, 0xd2, 0x4f, 0xe4, 0x20, 0xaf, 0x89, 0xb4, 0x83, 0xa2, 0xec, 0xa0,
-	0xfd, 0x5f, 0x1d, 0xbc, 0x5b, 0xc6, 0xd7, 0x58, 0x1e, 0x80, 0xf4, 0x8c, 0x94, 0x04, 0xef, 0xfb,
-	0x91, 0x2d, 0xd8, 0x04, 0xb0, 0x7a, 0x47, 0x26, 0x35, 0xf2, 0x92, 0x7a, 0x09, 0xda, 0x63, 0x13,
-	0x65, 0x4c, 0x84, 0x3a, 0x03, 0x36, 0x07, 0x64, 0xf7, 0xf6, 0xd6, 0xd0, 0x07, 0x64, 0x47, 0xed,
-	0x1e, 0x73, 0xdd, 0x18, 0x30, 0xdd, 0xe0, 0x6d, 0xab, 0x92, 0x56, 0x4f, 0xd2, 0x22, 0x7d, 0x4c,
-	0xf6, 0xc6, 0x2c, 0xf0, 0x5d, 0x26, 0xf8, 0x92, 0x5c, 0x93, 0xe4, 0xee, 0xe2, 0x81, 0x82, 0x9b,
-	0x17, 0xa4, 0x94, 0x79, 0xab, 0xab, 0xb5, 0xda, 0x6a, 0x2d, 0xbd, 0x47, 0xca, 0xd9, 0xf5, 0x91,
-	0x19, 0x1b, 0x56, 0x29, 0xb3, 0x12, 0xcd, 0x90, 0x6c, 0xcd, 0x87, 0xa4, 0xfb, 0x64, 0xd3, 0x85,
-	0x88, 0x87, 0xca, 0x2f, 0x3d, 0xd0, 0x97, 0x64, 0x7b, 0x71, 0x63, 0x69, 0x97, 0x5d, 0x23, 0xb9,
-	0x8d, 0x1f, 0x37, 0xf5, 0x87, 0x9e, 0x2f, 0x2e, 0x47, 0x7d, 0xc3, 0xe1, 0xa1, 0xe9, 0xc8, 0x2b,
-	0x54, 0x3f, 0x47, 0xe8, 0x7e, 0x30, 0xc5, 0xc7, 0x21, 0xa0, 0x71, 0x0a, 0x8e, 0xb5, 0x35, 0xbf,
-	0xb7, 0xee, 0xab, 0xab, 0xa9, 0xae, 0x5d, 0x4f, 0x75, 0xed, 0xd7, 0x54, 0xd7, 0xbe, 0xcc, 0xf4,
-	0xc2, 0xf5, 0x4c, 0x2f, 0x7c, 0x9f, 0xe9, 0x85, 0xf7, 0x9d, 0x8c, 0x97, 0x7a, 0x1b, 0x47, 0x01,
-	0xeb, 0xe3, 0xfc, 0x60, 0x8e, 0x3b, 0xc7, 0xe6, 0x64, 0xfe, 0x25, 0x91, 0xde, 0xfd, 0xa2, 0xfc,
-	0x82, 0x1c, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x1a, 0x0f, 0x11, 0xbc, 0x04, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x21, 0xfc, 0x64, 0x02, 0x08, 0xe6, 0x43, 0x5f, 0xdd, 0x48, 0x98, 0x34, 0xb4, 0x55,
+	0xaa, 0x16, 0x5b, 0x84, 0x2e, 0xbb, 0x21, 0x85, 0xb2, 0xe8, 0x8f, 0x90, 0x41, 0xad, 0x54, 0x09,
+	0x59, 0x13, 0x7b, 0x70, 0x46, 0xb5, 0x3d, 0x96, 0xef, 0x90, 0xa4, 0xdd, 0xf6, 0x05, 0xba, 0xef,
+	0x1b, 0xf4, 0x49, 0x58, 0xb2, 0xac, 0xba, 0xa0, 0x15, 0xbc, 0x48, 0xe5, 0x99, 0x09, 0x09, 0x14,
+	0xa3, 0x76, 0x95, 0xcc, 0x9d, 0x73, 0xcf, 0x39, 0xd7, 0x3e, 0xd7, 0xe8, 0x3e, 0x87, 0x98, 0x03,
+	0x03, 0x87, 0x67, 0xc4, 0x8f, 0xa8, 0xd3, 0xdb, 0xe8, 0x50, 0x41, 0x36, 0x9c, 0x90, 0x26, 0x14,
+	0x18, 0xd8, 0x69, 0xc6, 0x05, 0xc7, 0xff, 0x6b, 0x94, 0xad, 0x50, 0xb6, 0x46, 0xd5, 0x96, 0x43,
+	0x1e, 0x72, 0x09, 0x71, 0xf2, 0x7f, 0x0a, 0x5d, 0x5b, 0x2b, 0xe0, 0xd4, 0xcd, 0x12, 0xd4, 0xf8,
+	0x3a, 0x83, 0xe6, 0x76, 0x95, 0xc8, 0xbe, 0x20, 0x82, 0xe2, 0x67, 0x68, 0x3a, 0x25, 0x19, 0x89,
+	0xc1, 0x34, 0xea, 0x46, 0xb3, 0xda, 0xb2, 0xec, 0x9b, 0x45, 0xed, 0x3d, 0x89, 0x6a, 0x97, 0x4f,
+	0xce, 0x56, 0x4b, 0xae, 0xee, 0xc1, 0x87, 0x08, 0x1f, 0x51, 0x1a, 0xd0, 0xcc, 0x0b, 0x68, 0x44,
+	0x43, 0x22, 0x18, 0x4f, 0xc0, 0x9c, 0xa8, 0x4f, 0x36, 0xab, 0xad, 0x66, 0x11, 0xd3, 0x0b, 0xd9,
+	0xb1, 0x7d, 0xd9, 0xa0, 0x39, 0x97, 0x8e, 0xae, 0xd5, 0x01, 0x47, 0x68, 0x81, 0x0e, 0xfc, 0x2e,
+	0x49, 0x42, 0xea, 0x65, 0x44, 0x50, 0x30, 0x27, 0x25, 0xf5, 0xa3, 0x22, 0xea, 0x1d, 0x8d, 0x76,
+	0x89, 0xa0, 0x07, 0xc7, 0x69, 0x44, 0xdb, 0xb5, 0x9c, 0xfb, 0xdb, 0xcf, 0x55, 0xfc, 0xc7, 0x15,
+	0xb8, 0xf3, 0x74, 0xac, 0x06, 0xf8, 0x0d, 0x9a, 0x8f, 0x19, 0x80, 0xe7, 0xf3, 0xe3, 0x44, 0xd0,
+	0x0c, 0xcc, 0xb2, 0x14, 0x5b, 0x2b, 0x12, 0x7b, 0xcd, 0x00, 0x9e, 0x2b, 0xac, 0x1e, 0x61, 0x2e,
+	0x1e, 0x95, 0x00, 0x7f, 0x36, 0x50, 0x9d, 0x84, 0x61, 0x96, 0x8f, 0x43, 0xbd, 0x2b, 0x83, 0x78,
+	0x69, 0x46, 0x7b, 0x3c, 0x1f, 0x68, 0x4a, 0x6a, 0x3c, 0x2d, 0xd2, 0xd8, 0x1a, 0xf6, 0x8f, 0xdb,
+	0xdf, 0x53, 0xcd, 0x5a, 0x74, 0x85, 0xdc, 0x82, 0x01, 0xfc, 0x09, 0xad, 0x14, 0x99, 0x50, 0x0e,
+	0xa6, 0xa5, 0x83, 0x8d, 0x7f, 0x72, 0xf0, 0x76, 0x24, 0x5f, 0x23, 0x45, 0x00, 0xc0, 0xbb, 0xa8,
+	0x2a, 0x78, 0x87, 0x25, 0x9e, 0x20, 0x03, 0x0a, 0xe6, 0x8c, 0x54, 0xaa, 0x17, 0x29, 0x1d, 0xe4,
+	0xd0, 0x03, 0x32, 0xd0, 0xc4, 0x48, 0xe8, 0x33, 0x05, 0x6c, 0xa3, 0xff, 0x88, 0x2f, 0x58, 0x4f,
+	0x79, 0xf6, 0x52, 0x9a, 0x31, 0x1e, 0x80, 0x39, 0x5b, 0x37, 0x9a, 0x65, 0x77, 0x49, 0x5d, 0xe5,
+	0x92, 0x7b, 0xea, 0x02, 0x27, 0xe8, 0x6e, 0x97, 0x81, 0xe0, 0x19, 0xf3, 0x49, 0xe4, 0x5d, 0xcb,
+	0x50, 0x45, 0xda, 0x78, 0xf2, 0x37, 0x19, 0xda, 0x4f, 0x48, 0x0a, 0x5d, 0x2e, 0xb4, 0xa5, 0x3b,
+	0x23, 0xd2, 0x9d, 0x2b, 0xd1, 0xd9, 0x41, 0xa8, 0xcf, 0x92, 0xc0, 0x0b, 0x78, 0x3f, 0x01, 0x13,
+	0xdd, 0x3e, 0xe7, 0x3b, 0x96, 0x04, 0xdb, 0xbc, 0x3f, 0xcc, 0x7d, 0xa5, 0xaf, 0xcf, 0xd0, 0x38,
+	0x42, 0x8b, 0xd7, 0x97, 0x03, 0x3f, 0x40, 0x0b, 0x7a, 0xc5, 0x48, 0x10, 0x64, 0x14, 0xd4, 0xa2,
+	0x56, 0xdc, 0x79, 0x55, 0xdd, 0x52, 0x45, 0xfc, 0x18, 0x2d, 0xf5, 0x48, 0xc4, 0x02, 0x22, 0xf8,
+	0x08, 0x39, 0x21, 0x91, 0x8b, 0x97, 0x17, 0x1a, 0xdc, 0x38, 0x44, 0xd5, 0xb1, 0xf0, 0xde, 0xdc,
+	0x6b, 0xdc, 0xdc, 0x8b, 0xef, 0xa1, 0xb9, 0xf1, 0x2d, 0x91, 0x1a, 0x65, 0xb7, 0x3a, 0x96, 0xfc,
+	0x46, 0x8c, 0x66, 0x87, 0xef, 0x12, 0x2f, 0xa3, 0xa9, 0x80, 0x26, 0x3c, 0xd6, 0x7c, 0xea, 0x80,
+	0x5f, 0xa2, 0xca, 0x65, 0x30, 0x94, 0xcb, 0xb6, 0x9d, 0x3f, 0x8c, 0x1f, 0x67, 0xab, 0x0f, 0x43,
+	0x26, 0xba, 0xc7, 0x1d, 0xdb, 0xe7, 0xb1, 0xe3, 0xcb, 0x27, 0xa8, 0x7f, 0xd6, 0x21, 0xf8, 0xe0,
+	0x88, 0x8f, 0x29, 0x05, 0x7b, 0x9b, 0xfa, 0xee, 0xec, 0x30, 0x1e, 0xed, 0x57, 0x27, 0xe7, 0x96,
+	0x71, 0x7a, 0x6e, 0x19, 0xbf, 0xce, 0x2d, 0xe3, 0xcb, 0x85, 0x55, 0x3a, 0xbd, 0xb0, 0x4a, 0xdf,
+	0x2f, 0xac, 0xd2, 0xfb, 0xd6, 0x18, 0x97, 0x7e, 0x19, 0xeb, 0x11, 0xe9, 0xc0, 0xf0, 0xe0, 0xf4,
+	0x5a, 0x9b, 0xce, 0x60, 0xf8, 0xc1, 0x94, 0xdc, 0x9d, 0x69, 0xf9, 0xa1, 0xdc, 0xfc, 0x3d, 0x00,
+	0xd4, 0x39, 0xf3, 0xdf, 0xa3, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -343,6 +375,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WindDowns) > 0 {
+		for iNdEx := len(m.WindDowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindDowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.HistoricalExchangeRates) > 0 {
+		for iNdEx := len(m.HistoricalExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ActiveVotePeriods != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ActiveVotePeriods))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.TobinTaxes) > 0 {
 		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -607,6 +672,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ActiveVotePeriods != 0 {
+		n += 1 + sovGenesis(uint64(m.ActiveVotePeriods))
+	}
+	if len(m.HistoricalExchangeRates) > 0 {
+		for _, e := range m.HistoricalExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WindDowns) > 0 {
+		for _, e := range m.WindDowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -930,6 +1010,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveVotePeriods", wireType)
+			}
+			m.ActiveVotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveVotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalExchangeRates = append(m.HistoricalExchangeRates, ExchangeRateSnapshot{})
+			if err := m.HistoricalExchangeRates[len(m.HistoricalExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindDowns = append(m.WindDowns, WindDown{})
+			if err := m.WindDowns[len(m.WindDowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
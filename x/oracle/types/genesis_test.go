@@ -3,7 +3,9 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/v23/app"
 	"github.com/stretchr/testify/require"
 
@@ -16,6 +18,39 @@ func TestGenesisValidation(t *testing.T) {
 
 	genState.Params.VotePeriod = 0
 	require.Error(t, types.ValidateGenesis(genState))
+
+	genState = types.DefaultGenesisState()
+	genState.HistoricalExchangeRates = []types.ExchangeRateSnapshot{
+		types.NewExchangeRateSnapshot("usdr", sdk.NewDec(2), 10, time.Now()),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.HistoricalExchangeRates[0].ExchangeRate = sdk.ZeroDec()
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState.HistoricalExchangeRates[0] = types.NewExchangeRateSnapshot("", sdk.NewDec(2), 10, time.Now())
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState = types.DefaultGenesisState()
+	genState.WindDowns = []types.WindDown{
+		types.NewWindDown("usdr", sdk.NewDec(2), sdk.OneDec(), time.Now()),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.WindDowns[0].RedemptionRate = sdk.NewDec(3)
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState.WindDowns[0] = types.NewWindDown("usdr", sdk.ZeroDec(), sdk.ZeroDec(), time.Now())
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState.WindDowns[0] = types.NewWindDown("usdr", sdk.NewDec(2), sdk.OneDec(), time.Time{})
+	require.Error(t, types.ValidateGenesis(genState))
+
+	genState.WindDowns = []types.WindDown{
+		types.NewWindDown("usdr", sdk.NewDec(2), sdk.OneDec(), time.Now()),
+		types.NewWindDown("usdr", sdk.NewDec(2), sdk.OneDec(), time.Now()),
+	}
+	require.Error(t, types.ValidateGenesis(genState))
 }
 
 func TestGetGenesisStateFromAppState(t *testing.T) {
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewExchangeRateSnapshot creates an ExchangeRateSnapshot instance
+func NewExchangeRateSnapshot(denom string, exchangeRate sdk.Dec, height int64, blockTime time.Time) ExchangeRateSnapshot {
+	return ExchangeRateSnapshot{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		Height:       height,
+		Time:         blockTime,
+	}
+}
+
+// Validate performs basic validation of the snapshot
+func (s ExchangeRateSnapshot) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.ExchangeRate.IsNil() || !s.ExchangeRate.IsPositive() {
+		return fmt.Errorf("exchange rate of %s at height %d must be positive", s.Denom, s.Height)
+	}
+
+	if s.Height < 0 {
+		return fmt.Errorf("height of %s snapshot must be non-negative: %d", s.Denom, s.Height)
+	}
+
+	return nil
+}
@@ -33,6 +33,16 @@ const (
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07: uint64
+//
+// - 0x08<denom_Bytes>: int64
+//
+// - 0x09<denom_Bytes><height_Bytes>: ExchangeRateSnapshot
+//
+// - 0x0A<height_Bytes><denom_Bytes>: []byte{}
+//
+// - 0x0B<denom_Bytes>: WindDown
 var (
 	// Keys for store prefixes
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -41,6 +51,11 @@ var (
 	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	TobinTaxKey                     = []byte{0x06} // prefix for each key to a tobin tax
+	ActiveVotePeriodsKey            = []byte{0x07} // key for the number of active vote periods in the slash window
+	ExchangeRateUpdateHeightKey     = []byte{0x08} // prefix for each key to a rate last update height
+	HistoricalExchangeRateKey       = []byte{0x09} // prefix for each key to a historical rate
+	HistoricalExchangeRateIndexKey  = []byte{0x0A} // prefix for each key to a historical rate height index
+	WindDownKey                     = []byte{0x0B} // prefix for each key to a wind-down
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -48,6 +63,40 @@ func GetExchangeRateKey(denom string) []byte {
 	return append(ExchangeRateKey, []byte(denom)...)
 }
 
+// GetExchangeRateUpdateHeightKey - stored by *denom*
+func GetExchangeRateUpdateHeightKey(denom string) []byte {
+	return append(ExchangeRateUpdateHeightKey, []byte(denom)...)
+}
+
+// GetHistoricalExchangeRatePrefix - stored by *denom*
+func GetHistoricalExchangeRatePrefix(denom string) []byte {
+	return append(HistoricalExchangeRateKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetHistoricalExchangeRateKey - stored by *denom* and *height*
+func GetHistoricalExchangeRateKey(denom string, height int64) []byte {
+	return append(GetHistoricalExchangeRatePrefix(denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetHistoricalExchangeRateIndexKey - stored by *height* and *denom*
+func GetHistoricalExchangeRateIndexKey(height int64, denom string) []byte {
+	key := append(HistoricalExchangeRateIndexKey, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, []byte(denom)...)
+}
+
+// ParseHistoricalExchangeRateIndexKey - split height and denom from the historical rate index key
+func ParseHistoricalExchangeRateIndexKey(key []byte) (height int64, denom string) {
+	key = key[len(HistoricalExchangeRateIndexKey):]
+	height = int64(sdk.BigEndianToUint64(key[:8]))
+	denom = string(key[8:])
+	return
+}
+
+// GetWindDownKey - stored by *denom*
+func GetWindDownKey(denom string) []byte {
+	return append(WindDownKey, []byte(denom)...)
+}
+
 // GetFeederDelegationKey - stored by *Validator* address
 func GetFeederDelegationKey(v sdk.ValAddress) []byte {
 	return append(FeederDelegationKey, address.MustLengthPrefix(v)...)
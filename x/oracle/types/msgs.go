@@ -13,13 +13,15 @@ var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAggregateExchangeRateVoteAndPrevote{}
 )
 
 // oracle message types
 const (
-	TypeMsgDelegateFeedConsent          = "delegate_feeder"
-	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
-	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgDelegateFeedConsent                 = "delegate_feeder"
+	TypeMsgAggregateExchangeRatePrevote        = "aggregate_exchange_rate_prevote"
+	TypeMsgAggregateExchangeRateVote           = "aggregate_exchange_rate_vote"
+	TypeMsgAggregateExchangeRateVoteAndPrevote = "aggregate_exchange_rate_vote_and_prevote"
 )
 
 //-------------------------------------------------
@@ -148,6 +150,68 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgAggregateExchangeRateVoteAndPrevote returns MsgAggregateExchangeRateVoteAndPrevote instance
+func NewMsgAggregateExchangeRateVoteAndPrevote(salt string, exchangeRates string, hash AggregateVoteHash, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVoteAndPrevote {
+	return &MsgAggregateExchangeRateVoteAndPrevote{
+		Salt:          salt,
+		ExchangeRates: exchangeRates,
+		Hash:          hash.String(),
+		Feeder:        feeder.String(),
+		Validator:     validator.String(),
+	}
+}
+
+// Vote returns the aggregate exchange rate vote revealed by the message
+func (msg MsgAggregateExchangeRateVoteAndPrevote) Vote() *MsgAggregateExchangeRateVote {
+	return &MsgAggregateExchangeRateVote{
+		Salt:          msg.Salt,
+		ExchangeRates: msg.ExchangeRates,
+		Feeder:        msg.Feeder,
+		Validator:     msg.Validator,
+	}
+}
+
+// Prevote returns the aggregate exchange rate prevote submitted by the message
+func (msg MsgAggregateExchangeRateVoteAndPrevote) Prevote() *MsgAggregateExchangeRatePrevote {
+	return &MsgAggregateExchangeRatePrevote{
+		Hash:      msg.Hash,
+		Feeder:    msg.Feeder,
+		Validator: msg.Validator,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAggregateExchangeRateVoteAndPrevote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregateExchangeRateVoteAndPrevote) Type() string {
+	return TypeMsgAggregateExchangeRateVoteAndPrevote
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregateExchangeRateVoteAndPrevote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregateExchangeRateVoteAndPrevote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAggregateExchangeRateVoteAndPrevote) ValidateBasic() error {
+	if err := msg.Vote().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return msg.Prevote().ValidateBasic()
+}
+
 // NewMsgDelegateFeedConsent creates a MsgDelegateFeedConsent instance
 func NewMsgDelegateFeedConsent(operatorAddress sdk.ValAddress, feederAddress sdk.AccAddress) *MsgDelegateFeedConsent {
 	return &MsgDelegateFeedConsent{
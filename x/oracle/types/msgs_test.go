@@ -107,6 +107,38 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	}
 }
 
+func TestMsgAggregateExchangeRateVoteAndPrevote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := "1.0foo,1232.132bar"
+	hash := types.GetAggregateVoteHash("1", exchangeRates, sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		voter         sdk.AccAddress
+		salt          string
+		exchangeRates string
+		hash          types.AggregateVoteHash
+		expectPass    bool
+	}{
+		{addrs[0], "123", exchangeRates, hash, true},
+		{addrs[0], "123", "a,b", hash, false},
+		{addrs[0], "", exchangeRates, hash, false},
+		{addrs[0], "123", exchangeRates, []byte{}, false},
+		{sdk.AccAddress{}, "123", exchangeRates, hash, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAggregateExchangeRateVoteAndPrevote(tc.salt, tc.exchangeRates, tc.hash, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 func randSeq(n int) string {
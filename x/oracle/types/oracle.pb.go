@@ -8,15 +8,21 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +40,15 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// jail_duration is how long a validator slashed for missing oracle votes
+	// stays jailed before it can unjail
+	JailDuration time.Duration `protobuf:"bytes,9,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+	// max_rate_age is the number of blocks after its last update during which
+	// an exchange rate can be used; older rates are considered stale
+	MaxRateAge uint64 `protobuf:"varint,10,opt,name=max_rate_age,json=maxRateAge,proto3" json:"max_rate_age,omitempty" yaml:"max_rate_age"`
+	// history_keep_period is how long the historical exchange rates are kept
+	// before being pruned
+	HistoryKeepPeriod time.Duration `protobuf:"bytes,11,opt,name=history_keep_period,json=historyKeepPeriod,proto3,stdduration" json:"history_keep_period" yaml:"history_keep_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -96,10 +111,43 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRateAge() uint64 {
+	if m != nil {
+		return m.MaxRateAge
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryKeepPeriod() time.Duration {
+	if m != nil {
+		return m.HistoryKeepPeriod
+	}
+	return 0
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name     string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	TobinTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tobin_tax,json=tobinTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tobin_tax" yaml:"tobin_tax"`
+	// display_name is the name of the denom in the bank metadata; empty
+	// defaults to the display denom in upper case
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty" yaml:"display_name,omitempty"`
+	// symbol is the ticker of the denom in the bank metadata; empty defaults to
+	// the display denom in upper case
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol,omitempty"`
+	// exponent is the power of 10 converting the display denom to the base
+	// denom; unset defaults to 6
+	Exponent *uint32 `protobuf:"bytes,5,opt,name=exponent,proto3,wktptr" json:"exponent,omitempty" yaml:"exponent,omitempty"`
+	// description is the description of the denom in the bank metadata; empty
+	// defaults to a generic stable token description
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty" yaml:"description,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -253,12 +301,104 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// ExchangeRateSnapshot - struct to store the exchange rate of a denom
+// finalized at the end of a vote period
+type ExchangeRateSnapshot struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	Height       int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time         time.Time                              `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *ExchangeRateSnapshot) Reset()         { *m = ExchangeRateSnapshot{} }
+func (m *ExchangeRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateSnapshot) ProtoMessage()    {}
+func (*ExchangeRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_832530dbdc08fd60, []int{5}
+}
+func (m *ExchangeRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateSnapshot.Merge(m, src)
+}
+func (m *ExchangeRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateSnapshot proto.InternalMessageInfo
+
+// WindDown - struct to store the wind-down of a whitelisted denom. While a
+// denom winds down, it can no longer be minted, its exchange rate is frozen at
+// the settlement rate, and its holders can redeem it from the treasury reserve
+// until the deadline.
+type WindDown struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// settlement_rate is the exchange rate the denom is frozen at
+	SettlementRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=settlement_rate,json=settlementRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_rate" yaml:"settlement_rate"`
+	// redemption_rate is the amount of note paid out of the reserve for each
+	// unit of the denom redeemed; it never exceeds the settlement rate
+	RedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate" yaml:"redemption_rate"`
+	// deadline is the time after which the denom can no longer be redeemed
+	Deadline time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline" yaml:"deadline"`
+}
+
+func (m *WindDown) Reset()         { *m = WindDown{} }
+func (m *WindDown) String() string { return proto.CompactTextString(m) }
+func (*WindDown) ProtoMessage()    {}
+func (*WindDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_832530dbdc08fd60, []int{6}
+}
+func (m *WindDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindDown.Merge(m, src)
+}
+func (m *WindDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindDown proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "osmosis.oracle.v1beta1.Denom")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "osmosis.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "osmosis.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "osmosis.oracle.v1beta1.ExchangeRateTuple")
+	proto.RegisterType((*ExchangeRateSnapshot)(nil), "osmosis.oracle.v1beta1.ExchangeRateSnapshot")
+	proto.RegisterType((*WindDown)(nil), "osmosis.oracle.v1beta1.WindDown")
 }
 
 func init() {
@@ -266,55 +406,82 @@ func init() {
 }
 
 var fileDescriptor_832530dbdc08fd60 = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xd3, 0x4c,
-	0x18, 0x8e, 0xbf, 0xb6, 0xf9, 0x9a, 0x4b, 0xfa, 0x7d, 0xad, 0x09, 0xc5, 0x14, 0x88, 0xcb, 0x55,
-	0xad, 0xca, 0xd0, 0x44, 0x6d, 0x07, 0x44, 0x36, 0xac, 0x50, 0x96, 0x22, 0x45, 0x56, 0x55, 0x24,
-	0x16, 0xeb, 0x6c, 0x1f, 0xb1, 0x55, 0xdb, 0x17, 0xf9, 0x2e, 0x3f, 0xba, 0x30, 0x33, 0x32, 0x01,
-	0x12, 0x4b, 0x67, 0x76, 0xf8, 0x1b, 0x3a, 0x76, 0x44, 0x0c, 0x06, 0xb5, 0x0b, 0x62, 0xcc, 0x5f,
-	0x80, 0xee, 0x7c, 0x69, 0x9d, 0x26, 0x48, 0x44, 0x4c, 0xcd, 0xfb, 0x3e, 0xef, 0x3d, 0xef, 0x73,
-	0xef, 0x73, 0x6f, 0x0d, 0xd6, 0x08, 0x0d, 0x09, 0xf5, 0x69, 0x8d, 0xc4, 0xc8, 0x09, 0x70, 0xad,
-	0xbb, 0x6d, 0x63, 0x86, 0xb6, 0x65, 0x58, 0x6d, 0xc7, 0x84, 0x11, 0x75, 0x59, 0x16, 0x55, 0x65,
-	0x56, 0x16, 0xad, 0x94, 0x5b, 0xa4, 0x45, 0x44, 0x49, 0x8d, 0xff, 0x4a, 0xab, 0xe1, 0xbb, 0x3c,
-	0xc8, 0x37, 0x51, 0x8c, 0x42, 0xaa, 0x3e, 0x04, 0xc5, 0x2e, 0x61, 0xd8, 0x6a, 0xe3, 0xd8, 0x27,
-	0xae, 0xa6, 0xac, 0x2a, 0x9b, 0xb3, 0xc6, 0xf2, 0x20, 0xd1, 0xd5, 0x63, 0x14, 0x06, 0x75, 0x98,
-	0x01, 0xa1, 0x09, 0x78, 0xd4, 0x14, 0x81, 0x1a, 0x81, 0xff, 0x04, 0xc6, 0xbc, 0x18, 0x53, 0x8f,
-	0x04, 0xae, 0xf6, 0xcf, 0xaa, 0xb2, 0x59, 0x30, 0x9e, 0x9e, 0x26, 0x7a, 0xee, 0x6b, 0xa2, 0x6f,
-	0xb4, 0x7c, 0xe6, 0x75, 0xec, 0xaa, 0x43, 0xc2, 0x9a, 0x23, 0xd4, 0xc9, 0x3f, 0x5b, 0xd4, 0x3d,
-	0xaa, 0xb1, 0xe3, 0x36, 0xa6, 0xd5, 0x06, 0x76, 0x06, 0x89, 0x7e, 0x33, 0xd3, 0xe9, 0x92, 0x0d,
-	0x9a, 0x0b, 0x3c, 0x71, 0x30, 0x8c, 0x55, 0x0c, 0x8a, 0x31, 0xee, 0xa1, 0xd8, 0xb5, 0x6c, 0x14,
-	0xb9, 0xda, 0x8c, 0x68, 0xd6, 0x98, 0xba, 0x99, 0xbc, 0x56, 0x86, 0x0a, 0x9a, 0x20, 0x8d, 0x0c,
-	0x14, 0xb9, 0xaa, 0x03, 0x56, 0x24, 0xe6, 0xfa, 0x94, 0xc5, 0xbe, 0xdd, 0x61, 0x3e, 0x89, 0xac,
-	0x9e, 0x1f, 0xb9, 0xa4, 0xa7, 0xcd, 0x8a, 0xf1, 0xac, 0x0f, 0x12, 0xfd, 0xfe, 0x08, 0xcf, 0x84,
-	0x5a, 0x68, 0x6a, 0x29, 0xd8, 0xc8, 0x60, 0xcf, 0x05, 0xa4, 0xda, 0xa0, 0xd0, 0xf3, 0x7c, 0x86,
-	0x03, 0x9f, 0x32, 0x6d, 0x6e, 0x75, 0x66, 0xb3, 0xb8, 0x73, 0xaf, 0x3a, 0xd9, 0xc1, 0x6a, 0x03,
-	0x47, 0x24, 0x34, 0xd6, 0xf9, 0x45, 0x07, 0x89, 0xbe, 0x98, 0xb6, 0xbd, 0x3c, 0x0d, 0x3f, 0x7e,
-	0xd3, 0x0b, 0xa2, 0x64, 0xdf, 0xa7, 0xcc, 0xbc, 0xa2, 0xe5, 0xfe, 0xd0, 0x00, 0x51, 0xcf, 0x7a,
-	0x19, 0x23, 0x87, 0xf7, 0xd6, 0xf2, 0x7f, 0xe7, 0xcf, 0x28, 0x1b, 0x34, 0x17, 0x44, 0x62, 0x4f,
-	0xc6, 0x6a, 0x1d, 0x94, 0xd2, 0x0a, 0x39, 0xaa, 0x7f, 0xc5, 0xa8, 0x6e, 0x0d, 0x12, 0xfd, 0x46,
-	0xf6, 0xfc, 0x70, 0x38, 0x45, 0x11, 0xca, 0x79, 0xbc, 0x02, 0xe5, 0xd0, 0x8f, 0xac, 0x2e, 0x0a,
-	0x7c, 0x97, 0x3f, 0xb6, 0x21, 0xc7, 0xbc, 0x50, 0xfc, 0x6c, 0x6a, 0xc5, 0x77, 0xd2, 0x8e, 0x93,
-	0x38, 0xa1, 0xb9, 0x14, 0xfa, 0xd1, 0x21, 0xcf, 0x36, 0x71, 0x9c, 0xf6, 0xaf, 0xcf, 0xbf, 0x3f,
-	0xd1, 0x73, 0x3f, 0x4e, 0x74, 0x05, 0x7e, 0x50, 0xc0, 0x9c, 0x18, 0xa7, 0xba, 0x06, 0x66, 0x23,
-	0x14, 0x62, 0xb1, 0x11, 0x05, 0xe3, 0xff, 0x41, 0xa2, 0x17, 0x53, 0x56, 0x9e, 0x85, 0xa6, 0x00,
-	0x55, 0x0b, 0x14, 0x18, 0xb1, 0xfd, 0xc8, 0x62, 0xa8, 0x2f, 0xdf, 0xbf, 0x31, 0xb5, 0x5a, 0xe9,
-	0xe9, 0x25, 0x11, 0x34, 0xe7, 0xc5, 0xef, 0x03, 0xd4, 0xaf, 0x97, 0x5e, 0x9f, 0xe8, 0x39, 0xa9,
-	0x2e, 0x07, 0x3f, 0x29, 0xe0, 0xee, 0xe3, 0x56, 0x2b, 0xc6, 0x2d, 0xc4, 0xf0, 0x93, 0xbe, 0xe3,
-	0xa1, 0xa8, 0x85, 0x4d, 0xc4, 0x70, 0x33, 0xc6, 0x7c, 0x5b, 0xb8, 0x68, 0x0f, 0x51, 0x6f, 0x5c,
-	0x34, 0xcf, 0x42, 0x53, 0x80, 0xea, 0x06, 0x98, 0xe3, 0xc5, 0xb1, 0x14, 0xbc, 0x38, 0x48, 0xf4,
-	0xd2, 0xd5, 0x0a, 0xc6, 0xd0, 0x4c, 0x61, 0xe1, 0x68, 0xc7, 0x0e, 0x7d, 0x66, 0xd9, 0x01, 0x71,
-	0x8e, 0xc4, 0xca, 0x8d, 0x3a, 0x9a, 0x41, 0xb9, 0xa3, 0x22, 0x34, 0x78, 0x74, 0x4d, 0xf7, 0x4f,
-	0x05, 0xdc, 0x9e, 0xa8, 0xfb, 0x90, 0x8b, 0x7e, 0xab, 0x80, 0x32, 0x96, 0x49, 0x2b, 0x46, 0xfc,
-	0xbf, 0x40, 0xa7, 0x1d, 0x60, 0xaa, 0x29, 0x62, 0x33, 0x1e, 0xfc, 0x6e, 0x33, 0xb2, 0x44, 0x07,
-	0xfc, 0x84, 0xf1, 0x48, 0x6e, 0x89, 0xf4, 0x7f, 0x12, 0x29, 0x5f, 0x18, 0x75, 0xec, 0x24, 0x35,
-	0x55, 0x3c, 0x96, 0xfb, 0xd3, 0x41, 0x5d, 0xbb, 0xec, 0x67, 0x05, 0x2c, 0x8d, 0x35, 0xe0, 0x5c,
-	0x2e, 0x7f, 0x57, 0xd2, 0x9a, 0x0c, 0x97, 0x48, 0x43, 0x33, 0x85, 0xd5, 0x23, 0xb0, 0x30, 0x22,
-	0x5b, 0xf6, 0xde, 0x9b, 0xfa, 0x55, 0x95, 0x27, 0xcc, 0x00, 0x9a, 0xa5, 0xec, 0x35, 0x47, 0x85,
-	0x1b, 0xfb, 0xa7, 0xe7, 0x15, 0xe5, 0xec, 0xbc, 0xa2, 0x7c, 0x3f, 0xaf, 0x28, 0x6f, 0x2e, 0x2a,
-	0xb9, 0xb3, 0x8b, 0x4a, 0xee, 0xcb, 0x45, 0x25, 0xf7, 0x62, 0x27, 0xd3, 0x55, 0x9a, 0xb1, 0x15,
-	0x20, 0x9b, 0x0e, 0x83, 0x5a, 0x77, 0x67, 0xb7, 0xd6, 0x1f, 0x7e, 0xa0, 0x84, 0x0a, 0x3b, 0x2f,
-	0x3e, 0x35, 0xbb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x85, 0xc1, 0x9d, 0xbf, 0x06, 0x00,
-	0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0x23, 0xc7, 0x5f, 0xe9, 0x24, 0xc7, 0x31, 0xa3, 0x6f, 0xc2, 0xd8, 0x89, 0xa8, 0x5c,
+	0x90, 0xc0, 0x01, 0x1a, 0x09, 0x91, 0x87, 0x22, 0xde, 0x42, 0xa8, 0x49, 0x8b, 0xa6, 0x85, 0xc1,
+	0xb8, 0x29, 0xd0, 0x0e, 0xec, 0x51, 0xbc, 0x90, 0xac, 0x49, 0x1e, 0xc3, 0x3b, 0x59, 0xd2, 0xd2,
+	0x39, 0x63, 0xa6, 0x22, 0xa3, 0xb7, 0xa2, 0xdd, 0xdb, 0xfe, 0x0b, 0x19, 0x33, 0x16, 0x1d, 0x98,
+	0x22, 0x59, 0x8a, 0x8e, 0x5a, 0xba, 0x16, 0x77, 0x3c, 0x5a, 0xd4, 0x8f, 0xa0, 0x36, 0xb2, 0x74,
+	0xb2, 0xde, 0xfb, 0xbc, 0xfb, 0xbc, 0x77, 0x9f, 0x7b, 0xef, 0x99, 0xe0, 0x3a, 0xa1, 0x21, 0xa1,
+	0x3e, 0xed, 0x90, 0x04, 0xf5, 0x03, 0xdc, 0x39, 0xbc, 0x63, 0x63, 0x86, 0xee, 0x48, 0xb3, 0x1d,
+	0x27, 0x84, 0x11, 0xf5, 0xa2, 0x0c, 0x6a, 0x4b, 0xaf, 0x0c, 0xda, 0x6c, 0xb8, 0xc4, 0x25, 0x22,
+	0xa4, 0xc3, 0x7f, 0x65, 0xd1, 0x9b, 0x4d, 0x97, 0x10, 0x37, 0xc0, 0x1d, 0x61, 0xd9, 0x83, 0x27,
+	0x1d, 0x67, 0x90, 0x20, 0xe6, 0x93, 0x48, 0xe2, 0xfa, 0x3c, 0xce, 0xfc, 0x10, 0x53, 0x86, 0xc2,
+	0xf8, 0x5d, 0x04, 0xc3, 0x04, 0xc5, 0x31, 0x4e, 0x68, 0x86, 0xc3, 0x1f, 0x2a, 0x60, 0x75, 0x0f,
+	0x25, 0x28, 0xa4, 0xea, 0x87, 0xa0, 0x76, 0x48, 0x18, 0xb6, 0x62, 0x9c, 0xf8, 0xc4, 0xd1, 0x94,
+	0x96, 0xb2, 0xbd, 0x62, 0x5c, 0x9c, 0xa4, 0xba, 0x3a, 0x46, 0x61, 0xb0, 0x0b, 0x0b, 0x20, 0x34,
+	0x01, 0xb7, 0xf6, 0x84, 0xa1, 0x46, 0xe0, 0x9c, 0xc0, 0x98, 0x97, 0x60, 0xea, 0x91, 0xc0, 0xd1,
+	0xce, 0xb4, 0x94, 0xed, 0xaa, 0xf1, 0xe0, 0x65, 0xaa, 0x97, 0x7e, 0x4f, 0xf5, 0x9b, 0xae, 0xcf,
+	0xbc, 0x81, 0xdd, 0xee, 0x93, 0xb0, 0xd3, 0x17, 0xd7, 0x97, 0x7f, 0x6e, 0x53, 0xe7, 0xa0, 0xc3,
+	0xc6, 0x31, 0xa6, 0xed, 0x1e, 0xee, 0x4f, 0x52, 0xfd, 0xff, 0x85, 0x4c, 0xc7, 0x6c, 0xd0, 0x5c,
+	0xe3, 0x8e, 0xfd, 0xdc, 0x56, 0x31, 0xa8, 0x25, 0x78, 0x88, 0x12, 0xc7, 0xb2, 0x51, 0xe4, 0x68,
+	0x65, 0x91, 0xac, 0x77, 0xea, 0x64, 0xf2, 0x5a, 0x05, 0x2a, 0x68, 0x82, 0xcc, 0x32, 0x50, 0xe4,
+	0xa8, 0x7d, 0xb0, 0x29, 0x31, 0xc7, 0xa7, 0x2c, 0xf1, 0xed, 0x01, 0x17, 0xde, 0x1a, 0xfa, 0x91,
+	0x43, 0x86, 0xda, 0x8a, 0x90, 0xe7, 0xc6, 0x24, 0xd5, 0xaf, 0xcd, 0xf0, 0x2c, 0x89, 0x85, 0xa6,
+	0x96, 0x81, 0xbd, 0x02, 0xf6, 0xa5, 0x80, 0x54, 0x1b, 0x54, 0x87, 0x9e, 0xcf, 0x70, 0xe0, 0x53,
+	0xa6, 0x9d, 0x6d, 0x95, 0xb7, 0x6b, 0xdd, 0xab, 0xed, 0xe5, 0x2d, 0xd2, 0xee, 0xe1, 0x88, 0x84,
+	0xc6, 0x0d, 0x7e, 0xd1, 0x49, 0xaa, 0x9f, 0xcf, 0xd2, 0x1e, 0x9f, 0x86, 0x3f, 0xbd, 0xd6, 0xab,
+	0x22, 0xe4, 0xa1, 0x4f, 0x99, 0x39, 0xa5, 0xe5, 0xef, 0x43, 0x03, 0x44, 0x3d, 0xeb, 0x49, 0x82,
+	0xfa, 0x3c, 0xb7, 0xb6, 0xfa, 0x7e, 0xef, 0x33, 0xcb, 0x06, 0xcd, 0x35, 0xe1, 0xb8, 0x2f, 0x6d,
+	0x75, 0x17, 0xd4, 0xb3, 0x08, 0x29, 0xd5, 0xff, 0x84, 0x54, 0x97, 0x26, 0xa9, 0x7e, 0xa1, 0x78,
+	0x3e, 0x17, 0xa7, 0x26, 0x4c, 0xa9, 0xc7, 0x77, 0xa0, 0x11, 0xfa, 0x91, 0x75, 0x88, 0x02, 0xdf,
+	0xe1, 0xcd, 0x96, 0x73, 0x54, 0x44, 0xc5, 0x9f, 0x9d, 0xba, 0xe2, 0xad, 0x2c, 0xe3, 0x32, 0x4e,
+	0x68, 0x6e, 0x84, 0x7e, 0xf4, 0x98, 0x7b, 0xf7, 0x70, 0x22, 0xf3, 0x7f, 0x03, 0xd6, 0xbe, 0x45,
+	0x7e, 0x60, 0xe5, 0x73, 0xa6, 0x55, 0x5b, 0xca, 0x76, 0xad, 0x7b, 0xb9, 0x9d, 0xcd, 0x51, 0x3b,
+	0x9f, 0xa3, 0x76, 0x4f, 0x06, 0x18, 0x2d, 0xf9, 0x1e, 0x8d, 0x2c, 0xd3, 0xcc, 0x69, 0xf8, 0xe2,
+	0xb5, 0xae, 0x98, 0x75, 0xee, 0xcb, 0xe3, 0xd5, 0xbb, 0xa0, 0x1e, 0xa2, 0x91, 0x95, 0x20, 0x86,
+	0x2d, 0xe4, 0x62, 0x0d, 0xcc, 0xab, 0x53, 0x44, 0xa1, 0x09, 0x42, 0x34, 0x32, 0x11, 0xc3, 0xf7,
+	0x5c, 0xac, 0x3e, 0x05, 0x17, 0x3c, 0x9f, 0x32, 0x92, 0x8c, 0xad, 0x03, 0x8c, 0xe3, 0x7c, 0x52,
+	0x6b, 0xff, 0x56, 0xe2, 0x4d, 0x59, 0xe2, 0x66, 0x96, 0x60, 0x09, 0x47, 0x56, 0xe8, 0x86, 0x44,
+	0x3e, 0xc5, 0x38, 0xce, 0x66, 0x7b, 0xb7, 0xf2, 0xe2, 0x48, 0x2f, 0xfd, 0x79, 0xa4, 0x2b, 0xf0,
+	0xd7, 0x32, 0x38, 0x2b, 0xda, 0x4b, 0xbd, 0x0e, 0x56, 0x22, 0x14, 0x62, 0xb1, 0x21, 0xaa, 0xc6,
+	0xfa, 0x24, 0xd5, 0x6b, 0x19, 0x31, 0xf7, 0x42, 0x53, 0x80, 0xaa, 0x05, 0xaa, 0x8c, 0xd8, 0x7e,
+	0x64, 0x31, 0x34, 0x92, 0xfb, 0xc0, 0x38, 0xf5, 0xeb, 0xc9, 0x1e, 0x3f, 0x26, 0x82, 0x66, 0x45,
+	0xfc, 0xde, 0x47, 0x23, 0xb5, 0x07, 0xea, 0x8e, 0x4f, 0xe3, 0x00, 0x8d, 0x2d, 0x51, 0x4d, 0xb6,
+	0x06, 0xae, 0x4d, 0x52, 0xfd, 0x6a, 0x76, 0xaa, 0x88, 0x7e, 0x40, 0x42, 0x9f, 0xe1, 0x30, 0x66,
+	0x63, 0x68, 0xd6, 0x24, 0xf0, 0x39, 0x2f, 0x73, 0x07, 0xac, 0xd2, 0x71, 0x68, 0x93, 0x40, 0x0c,
+	0x74, 0xd5, 0xd8, 0x9a, 0xa4, 0xfa, 0x25, 0xd9, 0xa5, 0xc2, 0x5f, 0x3c, 0x29, 0x43, 0xd5, 0xaf,
+	0x41, 0x05, 0x8f, 0x62, 0x12, 0xe1, 0x88, 0xcf, 0x2c, 0x17, 0xff, 0xca, 0x82, 0xf8, 0x5f, 0x7c,
+	0x12, 0xb1, 0x9d, 0xee, 0x63, 0x14, 0x0c, 0xb0, 0x28, 0xea, 0x72, 0x46, 0x9a, 0x9f, 0x2b, 0xd0,
+	0x1e, 0x71, 0xe9, 0x8f, 0x09, 0x55, 0x03, 0xd4, 0x1c, 0x4c, 0xfb, 0x89, 0x1f, 0x17, 0x46, 0xb5,
+	0x35, 0x49, 0xf5, 0x2b, 0xf2, 0x5a, 0x53, 0x70, 0xf6, 0x56, 0x53, 0xff, 0x6e, 0xfd, 0xd9, 0x91,
+	0x5e, 0x92, 0x2f, 0x57, 0x82, 0x3f, 0x2b, 0xe0, 0xca, 0x3d, 0xd7, 0x4d, 0xb0, 0x8b, 0x18, 0xfe,
+	0x68, 0xd4, 0xf7, 0x50, 0xe4, 0x62, 0xde, 0x53, 0x7b, 0x09, 0xe6, 0x9b, 0x95, 0x3f, 0xa8, 0x87,
+	0xa8, 0xb7, 0xf8, 0xa0, 0xdc, 0x0b, 0x4d, 0x01, 0xaa, 0x37, 0xc1, 0x59, 0x1e, 0x9c, 0xc8, 0xc7,
+	0x3c, 0x3f, 0x49, 0xf5, 0xfa, 0x74, 0x5d, 0x27, 0xd0, 0xcc, 0x60, 0x31, 0xfd, 0x03, 0x3b, 0xf4,
+	0x99, 0x65, 0x07, 0xa4, 0x7f, 0xa0, 0x95, 0xe7, 0xfb, 0xbb, 0x88, 0xf2, 0xe9, 0x17, 0xa6, 0xc1,
+	0xad, 0xb9, 0xba, 0xff, 0x52, 0xc0, 0xe5, 0xa5, 0x75, 0x3f, 0xe6, 0x45, 0x7f, 0xaf, 0x80, 0x06,
+	0x96, 0xce, 0x6c, 0x5e, 0xd8, 0x20, 0x0e, 0x30, 0xd5, 0x14, 0xb1, 0x45, 0x6f, 0xbd, 0x6b, 0x8b,
+	0x16, 0x89, 0xf6, 0xf9, 0x09, 0xe3, 0xae, 0x1c, 0x8f, 0xad, 0xfc, 0x89, 0x16, 0x49, 0xf9, 0x72,
+	0x55, 0x17, 0x4e, 0x52, 0x53, 0xc5, 0x0b, 0xbe, 0x93, 0x0a, 0x35, 0x77, 0xd9, 0x5f, 0x14, 0xb0,
+	0xb1, 0x90, 0x80, 0x73, 0x39, 0x7c, 0xe6, 0x34, 0x65, 0x9e, 0x4b, 0xb8, 0xa1, 0x99, 0xc1, 0xea,
+	0x01, 0x58, 0x9b, 0x29, 0x5b, 0xe6, 0xbe, 0x7f, 0xea, 0x89, 0x6b, 0x2c, 0xd1, 0x00, 0x9a, 0xf5,
+	0xe2, 0x35, 0xe7, 0x0a, 0xff, 0xf1, 0x0c, 0x68, 0x14, 0x0b, 0x7f, 0x14, 0xa1, 0x98, 0x7a, 0x84,
+	0xfd, 0x27, 0x6b, 0x57, 0x6f, 0x81, 0x55, 0x0f, 0xfb, 0xae, 0xc7, 0x44, 0x5f, 0x96, 0x8d, 0x8d,
+	0x49, 0xaa, 0xaf, 0xc9, 0x66, 0x17, 0x7e, 0x68, 0xca, 0x00, 0xf5, 0x01, 0x58, 0xe1, 0x5f, 0x53,
+	0x62, 0x31, 0xd4, 0xba, 0x9b, 0x0b, 0x13, 0xbe, 0x9f, 0x7f, 0x6a, 0x19, 0x97, 0x64, 0x03, 0xc9,
+	0xa9, 0xe1, 0xa7, 0xe0, 0x73, 0x3e, 0xd5, 0x82, 0x60, 0xb7, 0xf2, 0x2c, 0xd7, 0xea, 0xef, 0x33,
+	0xa0, 0xc2, 0xff, 0xd1, 0xf4, 0xc8, 0x30, 0x3a, 0xb1, 0x3e, 0x4f, 0xc1, 0x3a, 0xc5, 0x8c, 0x05,
+	0x38, 0xc4, 0x11, 0x2b, 0x2a, 0xf4, 0xf1, 0xa9, 0x15, 0xba, 0x28, 0x27, 0x70, 0x96, 0x0e, 0x9a,
+	0xe7, 0xa6, 0x1e, 0xa1, 0xd2, 0x53, 0xb0, 0x9e, 0x60, 0x87, 0x6f, 0x16, 0xfe, 0x15, 0x23, 0x52,
+	0x96, 0xdf, 0x2f, 0xe5, 0x1c, 0x1d, 0x34, 0xcf, 0x4d, 0x3d, 0x22, 0xe5, 0x23, 0x50, 0x71, 0x30,
+	0x72, 0x02, 0x3f, 0x3a, 0x89, 0xe2, 0x5b, 0x52, 0xf1, 0xf5, 0x5c, 0xb0, 0xec, 0x64, 0xa6, 0xfa,
+	0x31, 0xd1, 0x54, 0x79, 0xe3, 0xe1, 0xcb, 0x37, 0x4d, 0xe5, 0xd5, 0x9b, 0xa6, 0xf2, 0xc7, 0x9b,
+	0xa6, 0xf2, 0xfc, 0x6d, 0xb3, 0xf4, 0xea, 0x6d, 0xb3, 0xf4, 0xdb, 0xdb, 0x66, 0xe9, 0xab, 0x6e,
+	0xe1, 0x2a, 0x72, 0x65, 0xdc, 0x0e, 0x90, 0x4d, 0x73, 0xa3, 0x73, 0xd8, 0xdd, 0xe9, 0x8c, 0xf2,
+	0x6f, 0x7a, 0x71, 0x35, 0x7b, 0x55, 0x94, 0xb4, 0xf3, 0xcf, 0x00, 0x7c, 0x1a, 0x44, 0x89, 0xf2,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -365,6 +532,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.MaxRateAge != that1.MaxRateAge {
+		return false
+	}
+	if this.HistoryKeepPeriod != that1.HistoryKeepPeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -387,6 +563,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HistoryKeepPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxRateAge != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxRateAge))
+		i--
+		dAtA[i] = 0x50
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -479,6 +676,37 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Exponent != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdUInt32MarshalTo(*m.Exponent, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdUInt32(*m.Exponent):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintOracle(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.TobinTax.Size()
 		i -= size
@@ -625,6 +853,117 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExchangeRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SettlementRate.Size()
+		i -= size
+		if _, err := m.SettlementRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -665,6 +1004,13 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinValidPerWindow.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MaxRateAge != 0 {
+		n += 1 + sovOracle(uint64(m.MaxRateAge))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HistoryKeepPeriod)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -680,6 +1026,22 @@ func (m *Denom) Size() (n int) {
 	}
 	l = m.TobinTax.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Exponent != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdUInt32(*m.Exponent)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
@@ -737,6 +1099,45 @@ func (m *ExchangeRateTuple) Size() (n int) {
 	return n
 }
 
+func (m *ExchangeRateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *WindDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.SettlementRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -999,33 +1400,118 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Denom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAge", wireType)
+			}
+			m.MaxRateAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRateAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowOracle
@@ -1115,6 +1601,138 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exponent == nil {
+				m.Exponent = new(uint32)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdUInt32Unmarshal(m.Exponent, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1501,6 +2119,357 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExchangeRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -21,6 +22,9 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyJailDuration             = []byte("JailDuration")
+	KeyMaxRateAge               = []byte("MaxRateAge")
+	KeyHistoryKeepPeriod        = []byte("HistoryKeepPeriod")
 )
 
 // Default parameter values
@@ -28,6 +32,9 @@ const (
 	DefaultVotePeriod               = appparams.BlocksPerMinute / 2 // 30 seconds, 7.5 * 4 seconds per block
 	DefaultSlashWindow              = appparams.BlocksPerWeek       // window for a week
 	DefaultRewardDistributionWindow = appparams.BlocksPerYear       // window for a year
+	DefaultJailDuration             = time.Minute * 10              // same as the x/slashing downtime jail duration
+	DefaultMaxRateAge               = DefaultVotePeriod * 4         // 4 vote periods, ~2 minutes
+	DefaultHistoryKeepPeriod        = time.Hour * 24 * 7            // a week of historical rates
 )
 
 // Default parameter values
@@ -53,6 +60,9 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		JailDuration:             DefaultJailDuration,
+		MaxRateAge:               DefaultMaxRateAge,
+		HistoryKeepPeriod:        DefaultHistoryKeepPeriod,
 	}
 }
 
@@ -73,6 +83,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyJailDuration, &p.JailDuration, validateJailDuration),
+		paramstypes.NewParamSetPair(KeyMaxRateAge, &p.MaxRateAge, validateMaxRateAge),
+		paramstypes.NewParamSetPair(KeyHistoryKeepPeriod, &p.HistoryKeepPeriod, validateHistoryKeepPeriod),
 	}
 }
 
@@ -111,6 +124,18 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.JailDuration < 0 {
+		return fmt.Errorf("oracle parameter JailDuration must be non-negative")
+	}
+
+	if p.MaxRateAge < p.VotePeriod {
+		return fmt.Errorf("oracle parameter MaxRateAge must be greater than or equal with VotePeriod")
+	}
+
+	if p.HistoryKeepPeriod < 0 {
+		return fmt.Errorf("oracle parameter HistoryKeepPeriod must be non-negative")
+	}
+
 	for _, denom := range p.Whitelist {
 		if denom.TobinTax.GT(sdk.OneDec()) || denom.TobinTax.IsNegative() {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have TobinTax between [0, 1]")
@@ -118,6 +143,9 @@ func (p Params) Validate() error {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if err := denom.Metadata().Validate(); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s has invalid metadata: %w", denom.Name, err)
+		}
 	}
 	return nil
 }
@@ -195,6 +223,9 @@ func validateWhitelist(i interface{}) error {
 		if len(d.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if err := d.Metadata().Validate(); err != nil {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s has invalid metadata: %w", d.Name, err)
+		}
 	}
 
 	return nil
@@ -246,3 +277,42 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("jail duration must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxRateAge(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max rate age must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateHistoryKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("history keep period must be non-negative: %s", v)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -87,7 +88,8 @@ func TestValidate(t *testing.T) {
 		switch {
 		case bytes.Equal(types.KeyVotePeriod, pair.Key) ||
 			bytes.Equal(types.KeyRewardDistributionWindow, pair.Key) ||
-			bytes.Equal(types.KeySlashWindow, pair.Key):
+			bytes.Equal(types.KeySlashWindow, pair.Key) ||
+			bytes.Equal(types.KeyMaxRateAge, pair.Key):
 			require.NoError(t, pair.ValidatorFn(uint64(1)))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(uint64(0)))
@@ -129,6 +131,12 @@ func TestValidate(t *testing.T) {
 					TobinTax: sdk.NewDecWithPrec(-1, 2),
 				},
 			}))
+		case bytes.Equal(types.KeyJailDuration, pair.Key) ||
+			bytes.Equal(types.KeyHistoryKeepPeriod, pair.Key):
+			require.NoError(t, pair.ValidatorFn(time.Duration(0)))
+			require.NoError(t, pair.ValidatorFn(time.Hour))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(-time.Second))
 		}
 	}
 }
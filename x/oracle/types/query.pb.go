@@ -8,22 +8,27 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -198,6 +203,111 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos
 	return nil
 }
 
+// QueryHistoricalExchangeRatesRequest is the request type for the
+// Query/HistoricalExchangeRates RPC method.
+type QueryHistoricalExchangeRatesRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_height and end_height bound the snapshot heights, inclusive; zero
+	// means unbounded.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// start_time and end_time bound the snapshot times, inclusive; unset means
+	// unbounded.
+	StartTime  *time.Time         `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndTime    *time.Time         `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricalExchangeRatesRequest) Reset()         { *m = QueryHistoricalExchangeRatesRequest{} }
+func (m *QueryHistoricalExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryHistoricalExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{4}
+}
+func (m *QueryHistoricalExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryHistoricalExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalExchangeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalExchangeRatesRequest proto.InternalMessageInfo
+
+// QueryHistoricalExchangeRatesResponse is response type for the
+// Query/HistoricalExchangeRates RPC method.
+type QueryHistoricalExchangeRatesResponse struct {
+	// historical_exchange_rates defines the exchange rate snapshots in ascending
+	// height order
+	HistoricalExchangeRates []ExchangeRateSnapshot `protobuf:"bytes,1,rep,name=historical_exchange_rates,json=historicalExchangeRates,proto3" json:"historical_exchange_rates"`
+	Pagination              *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricalExchangeRatesResponse) Reset()         { *m = QueryHistoricalExchangeRatesResponse{} }
+func (m *QueryHistoricalExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryHistoricalExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{5}
+}
+func (m *QueryHistoricalExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryHistoricalExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalExchangeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalExchangeRatesResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalExchangeRatesResponse) GetHistoricalExchangeRates() []ExchangeRateSnapshot {
+	if m != nil {
+		return m.HistoricalExchangeRates
+	}
+	return nil
+}
+
+func (m *QueryHistoricalExchangeRatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTobinTaxRequest is the request type for the Query/TobinTax RPC method.
 type QueryTobinTaxRequest struct {
 	// denom defines the denomination to query for.
@@ -208,7 +318,7 @@ func (m *QueryTobinTaxRequest) Reset()         { *m = QueryTobinTaxRequest{} }
 func (m *QueryTobinTaxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxRequest) ProtoMessage()    {}
 func (*QueryTobinTaxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{4}
+	return fileDescriptor_a199bc01df476dac, []int{6}
 }
 func (m *QueryTobinTaxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +358,7 @@ func (m *QueryTobinTaxResponse) Reset()         { *m = QueryTobinTaxResponse{} }
 func (m *QueryTobinTaxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxResponse) ProtoMessage()    {}
 func (*QueryTobinTaxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{5}
+	return fileDescriptor_a199bc01df476dac, []int{7}
 }
 func (m *QueryTobinTaxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +396,7 @@ func (m *QueryTobinTaxesRequest) Reset()         { *m = QueryTobinTaxesRequest{}
 func (m *QueryTobinTaxesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxesRequest) ProtoMessage()    {}
 func (*QueryTobinTaxesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{6}
+	return fileDescriptor_a199bc01df476dac, []int{8}
 }
 func (m *QueryTobinTaxesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +436,7 @@ func (m *QueryTobinTaxesResponse) Reset()         { *m = QueryTobinTaxesResponse
 func (m *QueryTobinTaxesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxesResponse) ProtoMessage()    {}
 func (*QueryTobinTaxesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{7}
+	return fileDescriptor_a199bc01df476dac, []int{9}
 }
 func (m *QueryTobinTaxesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +480,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{8}
+	return fileDescriptor_a199bc01df476dac, []int{10}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +521,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{9}
+	return fileDescriptor_a199bc01df476dac, []int{11}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +566,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{10}
+	return fileDescriptor_a199bc01df476dac, []int{12}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +607,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{11}
+	return fileDescriptor_a199bc01df476dac, []int{13}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,6 +643,92 @@ func (m *QueryVoteTargetsResponse) GetVoteTargets() []string {
 	return nil
 }
 
+// QueryWindDownsRequest is the request type for the Query/WindDowns RPC
+// method.
+type QueryWindDownsRequest struct {
+}
+
+func (m *QueryWindDownsRequest) Reset()         { *m = QueryWindDownsRequest{} }
+func (m *QueryWindDownsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWindDownsRequest) ProtoMessage()    {}
+func (*QueryWindDownsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{14}
+}
+func (m *QueryWindDownsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindDownsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindDownsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindDownsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindDownsRequest.Merge(m, src)
+}
+func (m *QueryWindDownsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindDownsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindDownsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindDownsRequest proto.InternalMessageInfo
+
+// QueryWindDownsResponse is response type for the
+// Query/WindDowns RPC method.
+type QueryWindDownsResponse struct {
+	// wind_downs defines the wind-downs of all denoms, including the completed
+	// ones
+	WindDowns []WindDown `protobuf:"bytes,1,rep,name=wind_downs,json=windDowns,proto3" json:"wind_downs"`
+}
+
+func (m *QueryWindDownsResponse) Reset()         { *m = QueryWindDownsResponse{} }
+func (m *QueryWindDownsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWindDownsResponse) ProtoMessage()    {}
+func (*QueryWindDownsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a199bc01df476dac, []int{15}
+}
+func (m *QueryWindDownsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindDownsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindDownsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindDownsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindDownsResponse.Merge(m, src)
+}
+func (m *QueryWindDownsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindDownsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindDownsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindDownsResponse proto.InternalMessageInfo
+
+func (m *QueryWindDownsResponse) GetWindDowns() []WindDown {
+	if m != nil {
+		return m.WindDowns
+	}
+	return nil
+}
+
 // QueryFeederDelegationRequest is the request type for the
 // Query/FeederDelegation RPC method.
 type QueryFeederDelegationRequest struct {
@@ -544,7 +740,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{12}
+	return fileDescriptor_a199bc01df476dac, []int{16}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +780,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{13}
+	return fileDescriptor_a199bc01df476dac, []int{17}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +827,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{14}
+	return fileDescriptor_a199bc01df476dac, []int{18}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -671,7 +867,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{15}
+	return fileDescriptor_a199bc01df476dac, []int{19}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +914,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{16}
+	return fileDescriptor_a199bc01df476dac, []int{20}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +955,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{17}
+	return fileDescriptor_a199bc01df476dac, []int{21}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,7 +1000,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{18}
+	return fileDescriptor_a199bc01df476dac, []int{22}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +1041,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{19}
+	return fileDescriptor_a199bc01df476dac, []int{23}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +1088,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{20}
+	return fileDescriptor_a199bc01df476dac, []int{24}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1129,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{21}
+	return fileDescriptor_a199bc01df476dac, []int{25}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1174,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{22}
+	return fileDescriptor_a199bc01df476dac, []int{26}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1215,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{23}
+	return fileDescriptor_a199bc01df476dac, []int{27}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1259,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{24}
+	return fileDescriptor_a199bc01df476dac, []int{28}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1102,7 +1298,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a199bc01df476dac, []int{25}
+	return fileDescriptor_a199bc01df476dac, []int{29}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,6 +1339,8 @@ func init() {
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "osmosis.oracle.v1beta1.QueryExchangeRateResponse")
 	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "osmosis.oracle.v1beta1.QueryExchangeRatesRequest")
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "osmosis.oracle.v1beta1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryHistoricalExchangeRatesRequest)(nil), "osmosis.oracle.v1beta1.QueryHistoricalExchangeRatesRequest")
+	proto.RegisterType((*QueryHistoricalExchangeRatesResponse)(nil), "osmosis.oracle.v1beta1.QueryHistoricalExchangeRatesResponse")
 	proto.RegisterType((*QueryTobinTaxRequest)(nil), "osmosis.oracle.v1beta1.QueryTobinTaxRequest")
 	proto.RegisterType((*QueryTobinTaxResponse)(nil), "osmosis.oracle.v1beta1.QueryTobinTaxResponse")
 	proto.RegisterType((*QueryTobinTaxesRequest)(nil), "osmosis.oracle.v1beta1.QueryTobinTaxesRequest")
@@ -1151,6 +1349,8 @@ func init() {
 	proto.RegisterType((*QueryActivesResponse)(nil), "osmosis.oracle.v1beta1.QueryActivesResponse")
 	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "osmosis.oracle.v1beta1.QueryVoteTargetsRequest")
 	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "osmosis.oracle.v1beta1.QueryVoteTargetsResponse")
+	proto.RegisterType((*QueryWindDownsRequest)(nil), "osmosis.oracle.v1beta1.QueryWindDownsRequest")
+	proto.RegisterType((*QueryWindDownsResponse)(nil), "osmosis.oracle.v1beta1.QueryWindDownsResponse")
 	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "osmosis.oracle.v1beta1.QueryFeederDelegationRequest")
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "osmosis.oracle.v1beta1.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryMissCounterRequest)(nil), "osmosis.oracle.v1beta1.QueryMissCounterRequest")
@@ -1172,85 +1372,104 @@ func init() {
 }
 
 var fileDescriptor_a199bc01df476dac = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0x3d, 0xbf, 0x5f, 0x5f, 0x92, 0xc7, 0x71, 0x48, 0x86, 0xb4, 0xa4, 0x6e, 0x6a, 0x87,
-	0x45, 0x84, 0xa8, 0x49, 0x76, 0x13, 0xe7, 0x85, 0xca, 0x4a, 0xa5, 0xc6, 0x4d, 0x41, 0x2a, 0xa9,
-	0x28, 0x6e, 0x94, 0x03, 0x07, 0xcc, 0xd8, 0x1e, 0xdc, 0x15, 0xb6, 0xd7, 0xdd, 0xd9, 0x58, 0x89,
-	0x4a, 0x85, 0xd4, 0x53, 0x51, 0x2f, 0x48, 0x20, 0xce, 0xe5, 0xc0, 0xa5, 0x12, 0x07, 0x90, 0xf8,
-	0x03, 0x80, 0x4b, 0x4f, 0xa8, 0x12, 0x17, 0xc4, 0x21, 0x45, 0x09, 0x07, 0xce, 0xfc, 0x05, 0x68,
-	0x67, 0x1f, 0xaf, 0x77, 0x6d, 0xaf, 0x77, 0xed, 0x9e, 0x1c, 0xcf, 0x3c, 0x2f, 0x9f, 0xe7, 0x99,
-	0xd9, 0x7d, 0xbe, 0x0e, 0x28, 0x86, 0xa8, 0x19, 0x42, 0x17, 0x9a, 0x61, 0xb2, 0x52, 0x95, 0x6b,
-	0xcd, 0x95, 0x22, 0xb7, 0xd8, 0x8a, 0x76, 0x6f, 0x9f, 0x9b, 0x87, 0x6a, 0xc3, 0x34, 0x2c, 0x83,
-	0x9e, 0x47, 0x1b, 0xd5, 0xb1, 0x51, 0xd1, 0x26, 0x39, 0x55, 0x31, 0x2a, 0x86, 0x34, 0xd1, 0xec,
-	0xbf, 0x1c, 0xeb, 0xe4, 0x4c, 0xc5, 0x30, 0x2a, 0x55, 0xae, 0xb1, 0x86, 0xae, 0xb1, 0x7a, 0xdd,
-	0xb0, 0x98, 0xa5, 0x1b, 0x75, 0x81, 0xbb, 0x6f, 0x04, 0xe4, 0xc3, 0xd0, 0x8e, 0x51, 0xaa, 0x24,
-	0xad, 0xb4, 0x22, 0x13, 0x6d, 0x8b, 0x92, 0xa1, 0xd7, 0x9d, 0x7d, 0x25, 0x0b, 0xd3, 0x1f, 0xd8,
-	0x7c, 0x37, 0x0e, 0x4a, 0x77, 0x59, 0xbd, 0xc2, 0xf3, 0xcc, 0xe2, 0x79, 0x7e, 0x6f, 0x9f, 0x0b,
-	0x8b, 0x4e, 0xc1, 0xe9, 0x32, 0xaf, 0x1b, 0xb5, 0x69, 0x32, 0x4b, 0xe6, 0x47, 0xf3, 0xce, 0x97,
-	0xec, 0xc8, 0xa3, 0x27, 0xe9, 0xd8, 0x3f, 0x4f, 0xd2, 0x31, 0xa5, 0x01, 0x17, 0x7a, 0xf8, 0x8a,
-	0x86, 0x51, 0x17, 0x9c, 0xde, 0x81, 0x04, 0xc7, 0xf5, 0x82, 0xc9, 0x2c, 0xee, 0x04, 0xc9, 0xa9,
-	0xcf, 0x8e, 0xd2, 0xb1, 0x3f, 0x8f, 0xd2, 0x73, 0x15, 0xdd, 0xba, 0xbb, 0x5f, 0x54, 0x4b, 0x46,
-	0x4d, 0x43, 0x44, 0xe7, 0x63, 0x49, 0x94, 0x3f, 0xd5, 0xac, 0xc3, 0x06, 0x17, 0xea, 0x36, 0x2f,
-	0xe5, 0xc7, 0xb8, 0x27, 0xb8, 0x72, 0xb1, 0x47, 0x46, 0x81, 0xb8, 0xca, 0x37, 0x04, 0x92, 0xbd,
-	0x76, 0x11, 0xe8, 0x00, 0xc6, 0x7d, 0x40, 0x62, 0x9a, 0xcc, 0xfe, 0x7f, 0x3e, 0x9e, 0x99, 0x51,
-	0x9d, 0xc4, 0xaa, 0xdd, 0xa2, 0xd6, 0x81, 0xd8, 0xb9, 0xaf, 0x1b, 0x7a, 0x3d, 0xb7, 0x6a, 0xf3,
-	0x3e, 0x7d, 0x91, 0x5e, 0x88, 0xc6, 0x6b, 0xfb, 0x88, 0x7c, 0xc2, 0x0b, 0x2d, 0x94, 0x0d, 0x98,
-	0x92, 0x5c, 0xbb, 0x46, 0x51, 0xaf, 0xef, 0xb2, 0x83, 0xa8, 0xfd, 0x2d, 0xc3, 0xb9, 0x0e, 0x3f,
-	0x2c, 0xe5, 0x3d, 0x18, 0xb5, 0xec, 0xb5, 0x82, 0xc5, 0x0e, 0x86, 0xec, 0xeb, 0x88, 0x85, 0x41,
-	0x95, 0x69, 0x38, 0xef, 0xcb, 0xd2, 0x6e, 0xe8, 0x43, 0x02, 0xaf, 0x75, 0x6d, 0x21, 0x42, 0x05,
-	0xe2, 0x2e, 0x82, 0xdb, 0xca, 0x4b, 0x6a, 0xef, 0xeb, 0xad, 0x6e, 0xdb, 0x95, 0xe5, 0xde, 0xb2,
-	0x19, 0xff, 0x3d, 0x4a, 0xd3, 0x43, 0x56, 0xab, 0x66, 0x15, 0x8f, 0xbf, 0xf2, 0xf4, 0x45, 0x7a,
-	0x54, 0x1a, 0xed, 0xe8, 0xc2, 0xca, 0x83, 0xe5, 0x26, 0x54, 0xce, 0xc1, 0xab, 0x92, 0x61, 0xab,
-	0x64, 0xe9, 0xcd, 0x36, 0xdb, 0x32, 0xf6, 0xd4, 0x5d, 0x46, 0xae, 0x69, 0x38, 0xcb, 0x9c, 0x25,
-	0xc9, 0x34, 0x9a, 0x6f, 0x7d, 0x55, 0x2e, 0x60, 0x31, 0x7b, 0x86, 0xc5, 0x77, 0x99, 0x59, 0xe1,
-	0x96, 0x1b, 0xec, 0x2a, 0x3e, 0x04, 0xbe, 0x2d, 0x0c, 0xf8, 0x3a, 0x8c, 0x35, 0x0d, 0x8b, 0x17,
-	0x2c, 0x67, 0x1d, 0xa3, 0xc6, 0x9b, 0x6d, 0x53, 0xe5, 0x7d, 0x98, 0x91, 0xee, 0xef, 0x70, 0x5e,
-	0xe6, 0xe6, 0x36, 0xaf, 0xf2, 0x8a, 0x7c, 0x50, 0x5b, 0xe7, 0xfc, 0x26, 0x8c, 0x37, 0x59, 0x55,
-	0x2f, 0x33, 0xcb, 0x30, 0x0b, 0xac, 0x5c, 0x36, 0xf1, 0xc0, 0x13, 0xee, 0xea, 0x56, 0xb9, 0x6c,
-	0x7a, 0x0e, 0xfe, 0x1a, 0x5c, 0x0a, 0x08, 0x88, 0x50, 0x69, 0x88, 0x7f, 0x22, 0xf7, 0xbc, 0xe1,
-	0xc0, 0x59, 0xb2, 0x63, 0x29, 0x37, 0xb1, 0xd8, 0x5b, 0xba, 0x10, 0xd7, 0x8d, 0xfd, 0xba, 0xc5,
-	0xcd, 0xa1, 0x69, 0x5a, 0xdd, 0xf1, 0xc5, 0x6a, 0x77, 0xa7, 0xa6, 0x0b, 0x51, 0x28, 0x39, 0xeb,
-	0x32, 0xd4, 0xa9, 0x7c, 0xbc, 0xd6, 0x36, 0x75, 0xbb, 0xb3, 0x55, 0xa9, 0x98, 0x76, 0x1d, 0xfc,
-	0xb6, 0xc9, 0xed, 0xee, 0x0d, 0xcd, 0xf3, 0x88, 0x60, 0x7b, 0xba, 0x23, 0xba, 0x97, 0x73, 0x92,
-	0xb5, 0xf6, 0x0a, 0x0d, 0x67, 0x53, 0x46, 0x8d, 0x67, 0xd6, 0x82, 0xae, 0xa8, 0x1b, 0xcc, 0xfb,
-	0xf6, 0xc0, 0xc0, 0xb9, 0x53, 0xf6, 0xcd, 0xcd, 0x4f, 0xb0, 0x8e, 0x84, 0x4a, 0x3a, 0x80, 0xc4,
-	0xbd, 0x59, 0x8f, 0x09, 0xa4, 0x82, 0x2c, 0x10, 0x56, 0x07, 0xda, 0x05, 0xdb, 0x7a, 0xa0, 0x5e,
-	0x86, 0x76, 0xb2, 0x93, 0x56, 0x28, 0x3b, 0xf8, 0xfa, 0x74, 0xbd, 0xf7, 0x5e, 0xe6, 0x1c, 0x3e,
-	0xc3, 0xd7, 0x6d, 0x47, 0x34, 0x2c, 0xeb, 0x23, 0x18, 0x6f, 0x97, 0xe5, 0x39, 0x80, 0x95, 0x81,
-	0x4a, 0xda, 0x6b, 0xd7, 0x93, 0x60, 0xde, 0x3c, 0xca, 0x4c, 0xaf, 0xec, 0x6e, 0xdf, 0x3f, 0x87,
-	0x8b, 0x3d, 0x77, 0x11, 0xee, 0x63, 0x78, 0xc5, 0x0f, 0xd7, 0x6a, 0xf8, 0xd0, 0x74, 0xe3, 0x3e,
-	0x3a, 0xa1, 0x4c, 0x01, 0x95, 0x00, 0xb7, 0x99, 0xc9, 0x6a, 0x2e, 0xd6, 0x1d, 0x7c, 0x99, 0xb5,
-	0x56, 0x11, 0x67, 0x13, 0xce, 0x34, 0xe4, 0x0a, 0xf6, 0x28, 0x15, 0x44, 0xe1, 0xf8, 0x61, 0x4a,
-	0xf4, 0xc9, 0x3c, 0xa6, 0x70, 0x5a, 0x46, 0xa5, 0x3f, 0x10, 0x18, 0xf3, 0xf2, 0xd1, 0xe5, 0xa0,
-	0x40, 0x41, 0x33, 0x3f, 0xb9, 0x32, 0x80, 0x87, 0x43, 0xaf, 0x6c, 0x3e, 0xfc, 0xfd, 0xef, 0xaf,
-	0xfe, 0xb7, 0x41, 0xd7, 0xb4, 0x00, 0x41, 0x22, 0xe7, 0x9a, 0xd0, 0xee, 0xcb, 0xcf, 0x07, 0x9a,
-	0x6f, 0x0a, 0xd3, 0xef, 0x09, 0x24, 0x7c, 0x03, 0x9b, 0x46, 0x47, 0x68, 0xf5, 0x35, 0x99, 0x19,
-	0xc4, 0x05, 0xb1, 0xd7, 0x25, 0xb6, 0x46, 0x97, 0x42, 0xb0, 0xfd, 0xa2, 0x81, 0x7e, 0x4b, 0x60,
-	0xa4, 0x35, 0x0f, 0xe9, 0x62, 0xdf, 0xbc, 0x1d, 0xf3, 0x3e, 0xb9, 0x14, 0xd1, 0x1a, 0x01, 0xaf,
-	0x48, 0xc0, 0x0c, 0x5d, 0x8e, 0xd8, 0x57, 0x77, 0x9e, 0xda, 0x8c, 0xd0, 0x9e, 0xd9, 0x54, 0x8d,
-	0x94, 0xb7, 0xdd, 0x4d, 0x2d, 0xb2, 0x3d, 0x92, 0x66, 0x24, 0xe9, 0x22, 0xbd, 0x1c, 0x42, 0xea,
-	0x99, 0xf8, 0xf4, 0x6b, 0x02, 0x67, 0x71, 0x78, 0xd3, 0x85, 0xbe, 0x09, 0xfd, 0x93, 0x3f, 0xb9,
-	0x18, 0xcd, 0x18, 0xd1, 0x54, 0x89, 0x36, 0x4f, 0xe7, 0x42, 0xd0, 0x50, 0x25, 0xd0, 0xef, 0x08,
-	0xc4, 0x3d, 0x32, 0x80, 0xf6, 0xef, 0x45, 0xb7, 0x96, 0x48, 0x2e, 0x47, 0x77, 0x40, 0xc4, 0x55,
-	0x89, 0xb8, 0x44, 0x17, 0x42, 0x10, 0xbd, 0x32, 0x84, 0xfe, 0x4a, 0x60, 0xa2, 0x53, 0x1e, 0xd0,
-	0xb5, 0xbe, 0xb9, 0x03, 0xe4, 0x49, 0x72, 0x7d, 0x40, 0x2f, 0xc4, 0xce, 0x49, 0xec, 0x4d, 0x9a,
-	0x0d, 0xc2, 0x76, 0xe7, 0x86, 0xd0, 0xee, 0xfb, 0x27, 0xcb, 0x03, 0xcd, 0x91, 0x2a, 0xf4, 0x47,
-	0x02, 0x71, 0x8f, 0xac, 0x08, 0xe9, 0x76, 0xb7, 0x98, 0x09, 0xe9, 0x76, 0x0f, 0xc5, 0xa2, 0x5c,
-	0x93, 0xd8, 0x59, 0x7a, 0x65, 0x18, 0x6c, 0x5b, 0xd7, 0xd0, 0xdf, 0x08, 0x4c, 0x74, 0x8e, 0xf3,
-	0x90, 0xd6, 0x07, 0x68, 0x9f, 0x90, 0xd6, 0x07, 0xe9, 0x1b, 0xe5, 0x96, 0xac, 0xe1, 0x5d, 0x7a,
-	0x63, 0x98, 0x1a, 0xba, 0xc4, 0x06, 0xfd, 0x99, 0xc0, 0x64, 0x97, 0x3e, 0xa1, 0x83, 0xb1, 0xb9,
-	0xf7, 0x7f, 0x63, 0x50, 0x37, 0xac, 0xe9, 0xaa, 0xac, 0xe9, 0x6d, 0xba, 0x1e, 0xa1, 0xa6, 0x6e,
-	0xbd, 0x44, 0x7f, 0x21, 0x90, 0xf0, 0x0d, 0xfb, 0x90, 0x31, 0xd2, 0x4b, 0x02, 0x85, 0x8c, 0x91,
-	0x9e, 0x3a, 0x47, 0xb9, 0x29, 0xb9, 0xb7, 0x69, 0xae, 0x0f, 0x77, 0x59, 0x0f, 0x3d, 0x0b, 0x79,
-	0x10, 0x3f, 0x11, 0x18, 0xf7, 0x2b, 0x16, 0x3a, 0x00, 0x92, 0x7b, 0x04, 0xab, 0x03, 0xf9, 0x60,
-	0x1d, 0x59, 0x59, 0xc7, 0x1a, 0xcd, 0x0c, 0xd4, 0x7f, 0xa7, 0xf9, 0x5f, 0x10, 0x38, 0xe3, 0x48,
-	0x13, 0x7a, 0xb9, 0x6f, 0x6e, 0x9f, 0x1a, 0x4a, 0x2e, 0x44, 0xb2, 0x45, 0xbe, 0x39, 0xc9, 0x37,
-	0x4b, 0x53, 0x41, 0x7c, 0x8e, 0x1a, 0xca, 0xed, 0x3c, 0x3b, 0x4e, 0x91, 0xe7, 0xc7, 0x29, 0xf2,
-	0xd7, 0x71, 0x8a, 0x7c, 0x79, 0x92, 0x8a, 0x3d, 0x3f, 0x49, 0xc5, 0xfe, 0x38, 0x49, 0xc5, 0x3e,
-	0xcc, 0x78, 0x7e, 0x1a, 0x63, 0x8c, 0xa5, 0x2a, 0x2b, 0x0a, 0x37, 0x60, 0x33, 0xb3, 0xaa, 0x1d,
-	0xb4, 0xc2, 0xca, 0x9f, 0xca, 0xc5, 0x33, 0xf2, 0xbf, 0x24, 0xab, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x34, 0x8e, 0xa6, 0x59, 0xdc, 0x11, 0x00, 0x00,
+	// 1548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0x4d, 0x6f, 0x13, 0x47,
+	0x18, 0xc7, 0x33, 0x21, 0x84, 0xf8, 0x71, 0x92, 0xc2, 0x10, 0x88, 0x59, 0x12, 0x3b, 0x2c, 0x6d,
+	0x48, 0x49, 0xb2, 0x9b, 0x38, 0x09, 0x45, 0x21, 0xa8, 0x60, 0xc2, 0x8b, 0x28, 0xa8, 0xd4, 0x44,
+	0x54, 0xea, 0xa1, 0xee, 0xc4, 0x3b, 0x6c, 0x56, 0x8d, 0x77, 0xcd, 0xce, 0x26, 0x04, 0x51, 0x54,
+	0x89, 0x13, 0x55, 0x7b, 0x40, 0x6a, 0xd5, 0x5b, 0x25, 0x7a, 0xe8, 0x05, 0xa9, 0x87, 0x56, 0xea,
+	0x07, 0x68, 0x7b, 0xe1, 0xd2, 0x0a, 0xa9, 0x97, 0xaa, 0x87, 0xa4, 0x82, 0x1e, 0x7a, 0xee, 0x27,
+	0xa8, 0x76, 0x76, 0x76, 0xbd, 0xeb, 0x78, 0xed, 0xb5, 0x39, 0x39, 0x9e, 0x79, 0x5e, 0x7e, 0xcf,
+	0xcb, 0x66, 0xff, 0x32, 0xc8, 0x16, 0xab, 0x58, 0xcc, 0x60, 0xaa, 0x65, 0x93, 0xf2, 0x3a, 0x55,
+	0x37, 0x67, 0x57, 0xa9, 0x43, 0x66, 0xd5, 0x3b, 0x1b, 0xd4, 0xbe, 0xa7, 0x54, 0x6d, 0xcb, 0xb1,
+	0xf0, 0x61, 0x61, 0xa3, 0x78, 0x36, 0x8a, 0xb0, 0x91, 0x86, 0x74, 0x4b, 0xb7, 0xb8, 0x89, 0xea,
+	0xfe, 0xe5, 0x59, 0x4b, 0x23, 0xba, 0x65, 0xe9, 0xeb, 0x54, 0x25, 0x55, 0x43, 0x25, 0xa6, 0x69,
+	0x39, 0xc4, 0x31, 0x2c, 0x93, 0x89, 0xdb, 0x9c, 0xb8, 0xe5, 0xdf, 0x56, 0x37, 0x6e, 0xab, 0x8e,
+	0x51, 0xa1, 0xcc, 0x21, 0x95, 0xaa, 0x30, 0x38, 0x59, 0xe6, 0xd9, 0xd4, 0x55, 0xc2, 0xa8, 0x47,
+	0x11, 0x30, 0x55, 0x89, 0x6e, 0x98, 0x3c, 0x9a, 0xb0, 0x3d, 0x1e, 0x03, 0x2f, 0x38, 0x3d, 0xa3,
+	0x6c, 0x38, 0xa0, 0x6f, 0x51, 0xb6, 0x0c, 0x11, 0x44, 0x5e, 0x84, 0xcc, 0x7b, 0x6e, 0x9a, 0x8b,
+	0x5b, 0xe5, 0x35, 0x62, 0xea, 0xb4, 0x48, 0x1c, 0x5a, 0xa4, 0x77, 0x36, 0x28, 0x73, 0xf0, 0x10,
+	0xec, 0xd5, 0xa8, 0x69, 0x55, 0x32, 0x68, 0x0c, 0x4d, 0xa4, 0x8a, 0xde, 0x97, 0xc5, 0xbe, 0x47,
+	0x4f, 0x72, 0x5d, 0xff, 0x3e, 0xc9, 0x75, 0xc9, 0x55, 0x38, 0xd2, 0xc0, 0x97, 0x55, 0x2d, 0x93,
+	0x51, 0x7c, 0x13, 0x06, 0xa8, 0x38, 0x2f, 0xd9, 0xc4, 0xa1, 0x5e, 0x90, 0x82, 0xf2, 0x6c, 0x3b,
+	0xd7, 0xf5, 0xd7, 0x76, 0x6e, 0x5c, 0x37, 0x9c, 0xb5, 0x8d, 0x55, 0xa5, 0x6c, 0x55, 0x54, 0x81,
+	0xe8, 0x7d, 0x4c, 0x33, 0xed, 0x63, 0xd5, 0xb9, 0x57, 0xa5, 0x4c, 0x59, 0xa6, 0xe5, 0x62, 0x3f,
+	0x0d, 0x05, 0x97, 0x8f, 0x36, 0xc8, 0xc8, 0x04, 0xae, 0xfc, 0x35, 0x02, 0xa9, 0xd1, 0xad, 0x00,
+	0xda, 0x82, 0xc1, 0x08, 0x10, 0xcb, 0xa0, 0xb1, 0x3d, 0x13, 0xe9, 0xfc, 0x88, 0xe2, 0x25, 0x56,
+	0xdc, 0x16, 0xf9, 0xd3, 0x75, 0x73, 0x5f, 0xb0, 0x0c, 0xb3, 0x30, 0xe7, 0xf2, 0x3e, 0xdd, 0xc9,
+	0x4d, 0x26, 0xe3, 0x75, 0x7d, 0x58, 0x71, 0x20, 0x0c, 0xcd, 0xe4, 0xdf, 0xba, 0xe1, 0x38, 0x07,
+	0xbb, 0x62, 0x30, 0xc7, 0xb2, 0x8d, 0x32, 0x59, 0x6f, 0x54, 0x40, 0xe3, 0x7e, 0xe3, 0x63, 0xd0,
+	0xcf, 0x1c, 0x62, 0x3b, 0xa5, 0x35, 0x6a, 0xe8, 0x6b, 0x4e, 0xa6, 0x7b, 0x0c, 0x4d, 0xec, 0x29,
+	0xa6, 0xf9, 0xd9, 0x15, 0x7e, 0x84, 0x47, 0x01, 0xa8, 0xa9, 0xf9, 0x06, 0x7b, 0xb8, 0x41, 0x8a,
+	0x9a, 0x9a, 0xb8, 0x7e, 0x1b, 0xc0, 0x8b, 0xe0, 0x6e, 0x5b, 0xa6, 0x67, 0x0c, 0x4d, 0xa4, 0xf3,
+	0x92, 0xe2, 0xad, 0xa2, 0xe2, 0xaf, 0xa2, 0xb2, 0xe2, 0xaf, 0x62, 0xa1, 0xe7, 0xf1, 0x4e, 0x0e,
+	0x15, 0x53, 0xdc, 0xc7, 0x3d, 0xc5, 0x67, 0xa0, 0xcf, 0x8d, 0xcf, 0xdd, 0xf7, 0x26, 0x74, 0xdf,
+	0x47, 0x4d, 0x8d, 0x3b, 0x5f, 0x02, 0xa8, 0xad, 0x6e, 0xa6, 0x97, 0xbb, 0x8f, 0x47, 0x7a, 0xee,
+	0x3d, 0x6d, 0x7e, 0xe7, 0x6f, 0x10, 0xdd, 0xdf, 0xc0, 0x62, 0xc8, 0x33, 0xb4, 0x77, 0x3b, 0x08,
+	0x5e, 0x6f, 0xde, 0x4f, 0x31, 0x72, 0x13, 0x8e, 0xac, 0x05, 0x26, 0xa5, 0x86, 0xd3, 0x9f, 0x52,
+	0x1a, 0x3f, 0xde, 0x4a, 0x38, 0xe2, 0x4d, 0x93, 0x54, 0xd9, 0x9a, 0xe5, 0x14, 0x7a, 0xdc, 0x6d,
+	0x28, 0x0e, 0xaf, 0x35, 0xce, 0x8b, 0x2f, 0x47, 0x4a, 0xed, 0xe6, 0xa5, 0x9e, 0x68, 0x59, 0xaa,
+	0x07, 0x1b, 0xae, 0x55, 0x3e, 0x05, 0x43, 0xbc, 0xc0, 0x15, 0x6b, 0xd5, 0x30, 0x57, 0xc8, 0x56,
+	0xd2, 0x27, 0x52, 0x83, 0x43, 0x75, 0x7e, 0xa2, 0x13, 0xef, 0x40, 0xca, 0x71, 0xcf, 0x4a, 0x0e,
+	0xd9, 0xea, 0xf0, 0x49, 0xec, 0x73, 0x44, 0x50, 0x39, 0x03, 0x87, 0x23, 0x59, 0x6a, 0x8f, 0xe0,
+	0x43, 0x04, 0xc3, 0xbb, 0xae, 0x04, 0x82, 0x0e, 0xe9, 0x00, 0x21, 0x68, 0xff, 0x68, 0x5c, 0xfb,
+	0x97, 0xdd, 0xca, 0x0a, 0x27, 0x5c, 0xc6, 0xff, 0xb6, 0x73, 0xf8, 0x1e, 0xa9, 0xac, 0x2f, 0xca,
+	0x21, 0x7f, 0xf9, 0xe9, 0x4e, 0x2e, 0xc5, 0x8d, 0xae, 0x19, 0xee, 0xa2, 0x38, 0x41, 0x42, 0xf9,
+	0x10, 0x1c, 0xe4, 0x0c, 0xe7, 0xcb, 0x8e, 0xb1, 0x59, 0x63, 0x9b, 0x81, 0xa1, 0xe8, 0xb1, 0xe0,
+	0xca, 0xc0, 0x3e, 0xe2, 0x1d, 0x71, 0xa6, 0x54, 0xd1, 0xff, 0x2a, 0x1f, 0x11, 0xc5, 0xdc, 0xb2,
+	0x1c, 0xba, 0x42, 0x6c, 0x9d, 0x3a, 0x41, 0xb0, 0xb3, 0x90, 0xd9, 0x7d, 0x25, 0x02, 0x1e, 0x83,
+	0xfe, 0x4d, 0xcb, 0xa1, 0x25, 0xc7, 0x3b, 0x17, 0x51, 0xd3, 0x9b, 0x35, 0x53, 0x79, 0x58, 0xcc,
+	0xe9, 0x7d, 0xc3, 0xd4, 0x96, 0xad, 0xbb, 0x66, 0x10, 0xb7, 0x04, 0x87, 0xeb, 0x2f, 0x44, 0xd4,
+	0x8b, 0x00, 0x77, 0x0d, 0x53, 0x2b, 0x69, 0xee, 0xa9, 0xe8, 0xde, 0x58, 0x5c, 0xf7, 0x7c, 0x77,
+	0xb1, 0xb0, 0xa9, 0xbb, 0x7e, 0x38, 0xf9, 0x5d, 0x18, 0xe1, 0x09, 0x2e, 0x51, 0xaa, 0x51, 0x7b,
+	0x99, 0xae, 0x53, 0x9d, 0xaf, 0x9c, 0xbf, 0x61, 0x6f, 0xc0, 0xe0, 0x26, 0x59, 0x37, 0x34, 0xe2,
+	0x58, 0x76, 0x89, 0x68, 0x9a, 0x2d, 0x56, 0x6d, 0x20, 0x38, 0x3d, 0xaf, 0x69, 0x76, 0x68, 0xe5,
+	0xce, 0xc1, 0x68, 0x4c, 0x40, 0x01, 0x9e, 0x83, 0xf4, 0x6d, 0x7e, 0x17, 0x0e, 0x07, 0xde, 0x91,
+	0x1b, 0x4b, 0xbe, 0x2a, 0xda, 0x7c, 0xdd, 0x60, 0xec, 0x82, 0xb5, 0x61, 0x3a, 0xd4, 0xee, 0x98,
+	0xc6, 0x9f, 0x4b, 0x24, 0x56, 0x6d, 0x2e, 0x15, 0x83, 0xb1, 0x52, 0xd9, 0x3b, 0xe7, 0xa1, 0x7a,
+	0x8a, 0xe9, 0x4a, 0xcd, 0x34, 0xe8, 0xce, 0x79, 0x5d, 0xb7, 0xdd, 0x3a, 0xe8, 0x0d, 0x9b, 0xba,
+	0x73, 0xeb, 0x98, 0xe7, 0x11, 0x82, 0xd1, 0x98, 0x88, 0xc1, 0x63, 0x71, 0x80, 0xf8, 0x77, 0xa5,
+	0xaa, 0x77, 0xc9, 0xa3, 0xa6, 0xf3, 0xf3, 0x71, 0xe3, 0x0d, 0x82, 0x85, 0xff, 0xfd, 0x88, 0xc0,
+	0x62, 0xe4, 0xfb, 0x49, 0x5d, 0x42, 0x39, 0x17, 0x43, 0x12, 0xec, 0xde, 0xe7, 0x08, 0xb2, 0x71,
+	0x16, 0x02, 0xd6, 0x00, 0xbc, 0x0b, 0xd6, 0x5f, 0xc6, 0x57, 0xa1, 0x3d, 0x50, 0x4f, 0xcb, 0xe4,
+	0x6b, 0xe2, 0x55, 0x1f, 0x78, 0xdf, 0x7a, 0x95, 0x39, 0x7c, 0x02, 0x52, 0xa3, 0x68, 0xa2, 0xac,
+	0x0f, 0x61, 0xb0, 0x56, 0x56, 0x68, 0x00, 0xb3, 0x6d, 0x95, 0x74, 0xab, 0x56, 0xcf, 0x00, 0x09,
+	0xe7, 0x91, 0x47, 0x1a, 0x65, 0x0f, 0xfa, 0xfe, 0x29, 0x1c, 0x6d, 0x78, 0x2b, 0xe0, 0x3e, 0x82,
+	0xd7, 0xa2, 0x70, 0x7e, 0xc3, 0x3b, 0xa6, 0x1b, 0x8c, 0xd0, 0x31, 0x79, 0x08, 0x30, 0x07, 0xb8,
+	0x41, 0x6c, 0x52, 0x09, 0xb0, 0x6e, 0xc2, 0xc1, 0xc8, 0xa9, 0xc0, 0x59, 0x82, 0xde, 0x2a, 0x3f,
+	0x11, 0x3d, 0xca, 0xc6, 0x51, 0x78, 0x7e, 0x22, 0xa5, 0xf0, 0xc9, 0x7f, 0x71, 0x08, 0xf6, 0xf2,
+	0xa8, 0xf8, 0x07, 0x04, 0xfd, 0x61, 0x3e, 0x3c, 0x13, 0x17, 0x28, 0x4e, 0x9f, 0x4a, 0xb3, 0x6d,
+	0x78, 0x78, 0xf4, 0xf2, 0xd2, 0xc3, 0x3f, 0xfe, 0xf9, 0xb2, 0xfb, 0x14, 0x9e, 0x57, 0x63, 0xc4,
+	0x33, 0x7f, 0xa3, 0x32, 0xf5, 0x3e, 0xff, 0x7c, 0xa0, 0x46, 0x34, 0x03, 0xfe, 0x1e, 0xc1, 0x40,
+	0xf4, 0x8d, 0x9f, 0x1c, 0xc1, 0xef, 0xab, 0x94, 0x6f, 0xc7, 0x45, 0x60, 0x2f, 0x70, 0x6c, 0x15,
+	0x4f, 0xb7, 0xc0, 0x8e, 0xe0, 0x32, 0xbc, 0x8d, 0x60, 0x38, 0x46, 0x23, 0xe1, 0x33, 0x4d, 0x31,
+	0x9a, 0x2b, 0x55, 0x69, 0xa9, 0x33, 0x67, 0x51, 0xcd, 0x15, 0x5e, 0x4d, 0x01, 0x9f, 0x4b, 0x38,
+	0x84, 0x58, 0x0d, 0x87, 0xbf, 0x45, 0xd0, 0xe7, 0x4b, 0x0d, 0x3c, 0xd5, 0x14, 0xaa, 0x4e, 0x4a,
+	0x49, 0xd3, 0x09, 0xad, 0x05, 0xf3, 0x69, 0xce, 0x9c, 0xc7, 0x33, 0x09, 0x99, 0x03, 0xa9, 0xe2,
+	0x32, 0x42, 0x4d, 0x0e, 0x61, 0x25, 0x51, 0xde, 0x5a, 0xab, 0xd5, 0xc4, 0xf6, 0x82, 0x34, 0xcf,
+	0x49, 0xa7, 0xf0, 0xc9, 0x16, 0xa4, 0x21, 0x31, 0x85, 0xbf, 0x42, 0xb0, 0x4f, 0xe8, 0x22, 0x3c,
+	0xd9, 0x34, 0x61, 0x54, 0x54, 0x49, 0x53, 0xc9, 0x8c, 0x05, 0x9a, 0xc2, 0xd1, 0x26, 0xf0, 0x78,
+	0x0b, 0x34, 0x21, 0xc0, 0xf0, 0x77, 0x08, 0xd2, 0x21, 0x85, 0x85, 0x9b, 0xf7, 0x62, 0xb7, 0x4c,
+	0x93, 0x66, 0x92, 0x3b, 0x08, 0xc4, 0x39, 0x8e, 0x38, 0x8d, 0x27, 0x5b, 0x20, 0x86, 0x15, 0x1e,
+	0xfe, 0x06, 0x41, 0x2a, 0x50, 0x6c, 0xb8, 0xf9, 0x66, 0xd5, 0x4b, 0x3e, 0x49, 0x49, 0x6a, 0x2e,
+	0x08, 0x67, 0x39, 0xe1, 0x24, 0x7e, 0xb3, 0x05, 0x61, 0x4d, 0x2d, 0xe2, 0x5f, 0x11, 0xec, 0xaf,
+	0xd7, 0x67, 0x78, 0xbe, 0x69, 0xde, 0x18, 0x7d, 0x28, 0x2d, 0xb4, 0xe9, 0x25, 0xa0, 0x0b, 0x1c,
+	0x7a, 0x09, 0x2f, 0xc6, 0x41, 0x07, 0x2f, 0x6e, 0xa6, 0xde, 0x8f, 0xbe, 0xda, 0x1f, 0xa8, 0x9e,
+	0x56, 0xc4, 0x3f, 0x22, 0x48, 0x87, 0x74, 0x5d, 0x8b, 0x6d, 0xd8, 0xad, 0x26, 0xa5, 0x99, 0xe4,
+	0x0e, 0x02, 0xfb, 0x1c, 0xc7, 0x5e, 0xc4, 0xa7, 0x3b, 0xc1, 0x76, 0x85, 0x25, 0xfe, 0x1d, 0xc1,
+	0xfe, 0x7a, 0x3d, 0xd5, 0xa2, 0xf5, 0x31, 0xe2, 0x53, 0x5a, 0x68, 0xd3, 0x4b, 0xd4, 0x70, 0x9d,
+	0xd7, 0x70, 0x19, 0x5f, 0xec, 0xa4, 0x86, 0x5d, 0x6a, 0x0f, 0xff, 0x8c, 0xe0, 0x40, 0x7d, 0x2e,
+	0x86, 0xdb, 0x63, 0x0b, 0x76, 0xff, 0x54, 0xbb, 0x6e, 0xa2, 0xa6, 0xb3, 0xbc, 0xa6, 0xb7, 0xf0,
+	0x42, 0x82, 0x9a, 0x76, 0x95, 0xc0, 0xf0, 0x2f, 0x08, 0x06, 0x22, 0x6a, 0xab, 0xc5, 0x7b, 0xbc,
+	0x91, 0x06, 0x95, 0xf2, 0xed, 0xb8, 0x08, 0xee, 0xab, 0x9c, 0x7b, 0x19, 0x17, 0x9a, 0x70, 0x6b,
+	0x46, 0xcb, 0x59, 0xf0, 0x41, 0xfc, 0x84, 0x60, 0x30, 0x92, 0x85, 0xe1, 0x36, 0x90, 0x82, 0x11,
+	0xcc, 0xb5, 0xe5, 0x23, 0xea, 0x58, 0xe4, 0x75, 0xcc, 0xe3, 0x7c, 0x5b, 0xfd, 0xf7, 0x9a, 0xff,
+	0x19, 0x82, 0x5e, 0x4f, 0x1b, 0xe2, 0x93, 0x4d, 0x73, 0x47, 0xe4, 0xa8, 0x34, 0x99, 0xc8, 0x56,
+	0xf0, 0x8d, 0x73, 0xbe, 0x31, 0x9c, 0x8d, 0xe3, 0xf3, 0xe4, 0x68, 0xe1, 0xda, 0xb3, 0x17, 0x59,
+	0xf4, 0xfc, 0x45, 0x16, 0xfd, 0xfd, 0x22, 0x8b, 0x1e, 0xbf, 0xcc, 0x76, 0x3d, 0x7f, 0x99, 0xed,
+	0xfa, 0xf3, 0x65, 0xb6, 0xeb, 0x83, 0x7c, 0xe8, 0x57, 0x11, 0x11, 0x63, 0x7a, 0x9d, 0xac, 0xb2,
+	0x20, 0xe0, 0x66, 0x7e, 0x4e, 0xdd, 0xf2, 0xc3, 0xf2, 0x5f, 0x49, 0x56, 0x7b, 0xf9, 0x8f, 0x61,
+	0x73, 0xff, 0x0f, 0x00, 0x49, 0xd4, 0x69, 0x0d, 0x56, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1269,6 +1488,9 @@ type QueryClient interface {
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRates returns exchange rates of all denoms
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// HistoricalExchangeRates returns the exchange rates of a denom finalized
+	// at the end of the vote periods within a height and time range
+	HistoricalExchangeRates(ctx context.Context, in *QueryHistoricalExchangeRatesRequest, opts ...grpc.CallOption) (*QueryHistoricalExchangeRatesResponse, error)
 	// TobinTax returns tobin tax of a denom
 	TobinTax(ctx context.Context, in *QueryTobinTaxRequest, opts ...grpc.CallOption) (*QueryTobinTaxResponse, error)
 	// TobinTaxes returns tobin taxes of all denoms
@@ -1277,6 +1499,8 @@ type QueryClient interface {
 	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
 	// VoteTargets returns all vote target denoms
 	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
+	// WindDowns returns the wind-downs of all denoms
+	WindDowns(ctx context.Context, in *QueryWindDownsRequest, opts ...grpc.CallOption) (*QueryWindDownsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
@@ -1319,6 +1543,15 @@ func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesR
 	return out, nil
 }
 
+func (c *queryClient) HistoricalExchangeRates(ctx context.Context, in *QueryHistoricalExchangeRatesRequest, opts ...grpc.CallOption) (*QueryHistoricalExchangeRatesResponse, error) {
+	out := new(QueryHistoricalExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Query/HistoricalExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TobinTax(ctx context.Context, in *QueryTobinTaxRequest, opts ...grpc.CallOption) (*QueryTobinTaxResponse, error) {
 	out := new(QueryTobinTaxResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Query/TobinTax", in, out, opts...)
@@ -1355,6 +1588,15 @@ func (c *queryClient) VoteTargets(ctx context.Context, in *QueryVoteTargetsReque
 	return out, nil
 }
 
+func (c *queryClient) WindDowns(ctx context.Context, in *QueryWindDownsRequest, opts ...grpc.CallOption) (*QueryWindDownsResponse, error) {
+	out := new(QueryWindDownsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Query/WindDowns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
 	out := new(QueryFeederDelegationResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Query/FeederDelegation", in, out, opts...)
@@ -1424,6 +1666,9 @@ type QueryServer interface {
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRates returns exchange rates of all denoms
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// HistoricalExchangeRates returns the exchange rates of a denom finalized
+	// at the end of the vote periods within a height and time range
+	HistoricalExchangeRates(context.Context, *QueryHistoricalExchangeRatesRequest) (*QueryHistoricalExchangeRatesResponse, error)
 	// TobinTax returns tobin tax of a denom
 	TobinTax(context.Context, *QueryTobinTaxRequest) (*QueryTobinTaxResponse, error)
 	// TobinTaxes returns tobin taxes of all denoms
@@ -1432,6 +1677,8 @@ type QueryServer interface {
 	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
 	// VoteTargets returns all vote target denoms
 	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
+	// WindDowns returns the wind-downs of all denoms
+	WindDowns(context.Context, *QueryWindDownsRequest) (*QueryWindDownsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
@@ -1458,6 +1705,9 @@ func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExc
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
+func (*UnimplementedQueryServer) HistoricalExchangeRates(ctx context.Context, req *QueryHistoricalExchangeRatesRequest) (*QueryHistoricalExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalExchangeRates not implemented")
+}
 func (*UnimplementedQueryServer) TobinTax(ctx context.Context, req *QueryTobinTaxRequest) (*QueryTobinTaxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TobinTax not implemented")
 }
@@ -1470,6 +1720,9 @@ func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesR
 func (*UnimplementedQueryServer) VoteTargets(ctx context.Context, req *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteTargets not implemented")
 }
+func (*UnimplementedQueryServer) WindDowns(ctx context.Context, req *QueryWindDownsRequest) (*QueryWindDownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindDowns not implemented")
+}
 func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
 }
@@ -1532,6 +1785,24 @@ func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.oracle.v1beta1.Query/HistoricalExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalExchangeRates(ctx, req.(*QueryHistoricalExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TobinTax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTobinTaxRequest)
 	if err := dec(in); err != nil {
@@ -1604,6 +1875,24 @@ func _Query_VoteTargets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WindDowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWindDownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WindDowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.oracle.v1beta1.Query/WindDowns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WindDowns(ctx, req.(*QueryWindDownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeederDelegationRequest)
 	if err := dec(in); err != nil {
@@ -1742,6 +2031,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
 		},
+		{
+			MethodName: "HistoricalExchangeRates",
+			Handler:    _Query_HistoricalExchangeRates_Handler,
+		},
 		{
 			MethodName: "TobinTax",
 			Handler:    _Query_TobinTax_Handler,
@@ -1758,6 +2051,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoteTargets",
 			Handler:    _Query_VoteTargets_Handler,
 		},
+		{
+			MethodName: "WindDowns",
+			Handler:    _Query_WindDowns_Handler,
+		},
 		{
 			MethodName: "FeederDelegation",
 			Handler:    _Query_FeederDelegation_Handler,
@@ -1914,7 +2211,7 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHistoricalExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1924,16 +2221,58 @@ func (m *QueryTobinTaxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -1944,7 +2283,7 @@ func (m *QueryTobinTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHistoricalExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1954,30 +2293,46 @@ func (m *QueryTobinTaxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TobinTax.Size()
-		i -= size
-		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HistoricalExchangeRates) > 0 {
+		for iNdEx := len(m.HistoricalExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTobinTaxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1987,20 +2342,27 @@ func (m *QueryTobinTaxesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTobinTaxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2010,20 +2372,76 @@ func (m *QueryTobinTaxesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TobinTaxes) > 0 {
-		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TobinTaxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	{
+		size := m.TobinTax.Size()
+		i -= size
+		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTobinTaxesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTobinTaxesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTobinTaxesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTobinTaxesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTobinTaxesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTobinTaxesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TobinTaxes) > 0 {
+		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TobinTaxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2147,6 +2565,66 @@ func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryWindDownsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWindDownsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindDownsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWindDownsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWindDownsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindDownsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WindDowns) > 0 {
+		for iNdEx := len(m.WindDowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindDowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2626,6 +3104,56 @@ func (m *QueryExchangeRatesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHistoricalExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricalExchangeRates) > 0 {
+		for _, e := range m.HistoricalExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTobinTaxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2722,6 +3250,30 @@ func (m *QueryVoteTargetsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryWindDownsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWindDownsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WindDowns) > 0 {
+		for _, e := range m.WindDowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryFeederDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3195,7 +3747,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTobinTaxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricalExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3218,10 +3770,10 @@ func (m *QueryTobinTaxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTobinTaxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricalExchangeRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTobinTaxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricalExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3256,61 +3808,49 @@ func (m *QueryTobinTaxRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTobinTaxResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTobinTaxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTobinTaxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3320,23 +3860,383 @@ func (m *QueryTobinTaxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalExchangeRates = append(m.HistoricalExchangeRates, ExchangeRateSnapshot{})
+			if err := m.HistoricalExchangeRates[len(m.HistoricalExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTobinTaxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTobinTaxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTobinTaxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTobinTaxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTobinTaxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTobinTaxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TobinTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TobinTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3759,6 +4659,140 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryWindDownsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWindDownsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWindDownsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWindDownsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWindDownsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWindDownsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindDowns = append(m.WindDowns, WindDown{})
+			if err := m.WindDowns[len(m.WindDowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
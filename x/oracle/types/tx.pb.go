@@ -189,6 +189,94 @@ func (m *MsgAggregateExchangeRateVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAggregateExchangeRateVoteResponse proto.InternalMessageInfo
 
+// MsgAggregateExchangeRateVoteAndPrevote represents a message to reveal the
+// aggregate exchange rate vote of the current vote period, and to submit the
+// aggregate exchange rate prevote of the next one.
+type MsgAggregateExchangeRateVoteAndPrevote struct {
+	Salt          string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	ExchangeRates string `protobuf:"bytes,2,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
+	Hash          string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Feeder        string `protobuf:"bytes,4,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	Validator     string `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevote) Reset() {
+	*m = MsgAggregateExchangeRateVoteAndPrevote{}
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevote) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRateVoteAndPrevote) ProtoMessage()    {}
+func (*MsgAggregateExchangeRateVoteAndPrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9579aae5b37f1ac7, []int{4}
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevote.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevote proto.InternalMessageInfo
+
+// MsgAggregateExchangeRateVoteAndPrevoteResponse defines the
+// Msg/AggregateExchangeRateVoteAndPrevote response type.
+type MsgAggregateExchangeRateVoteAndPrevoteResponse struct {
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) Reset() {
+	*m = MsgAggregateExchangeRateVoteAndPrevoteResponse{}
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAggregateExchangeRateVoteAndPrevoteResponse) ProtoMessage() {}
+func (*MsgAggregateExchangeRateVoteAndPrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9579aae5b37f1ac7, []int{5}
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevoteResponse.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRateVoteAndPrevoteResponse proto.InternalMessageInfo
+
 // MsgDelegateFeedConsent represents a message to
 // delegate oracle voting rights to another address.
 type MsgDelegateFeedConsent struct {
@@ -200,7 +288,7 @@ func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{}
 func (m *MsgDelegateFeedConsent) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsent) ProtoMessage()    {}
 func (*MsgDelegateFeedConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9579aae5b37f1ac7, []int{4}
+	return fileDescriptor_9579aae5b37f1ac7, []int{6}
 }
 func (m *MsgDelegateFeedConsent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +326,7 @@ func (m *MsgDelegateFeedConsentResponse) Reset()         { *m = MsgDelegateFeedC
 func (m *MsgDelegateFeedConsentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsentResponse) ProtoMessage()    {}
 func (*MsgDelegateFeedConsentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9579aae5b37f1ac7, []int{5}
+	return fileDescriptor_9579aae5b37f1ac7, []int{7}
 }
 func (m *MsgDelegateFeedConsentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,6 +360,8 @@ func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "osmosis.oracle.v1beta1.MsgAggregateExchangeRatePrevoteResponse")
 	proto.RegisterType((*MsgAggregateExchangeRateVote)(nil), "osmosis.oracle.v1beta1.MsgAggregateExchangeRateVote")
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "osmosis.oracle.v1beta1.MsgAggregateExchangeRateVoteResponse")
+	proto.RegisterType((*MsgAggregateExchangeRateVoteAndPrevote)(nil), "osmosis.oracle.v1beta1.MsgAggregateExchangeRateVoteAndPrevote")
+	proto.RegisterType((*MsgAggregateExchangeRateVoteAndPrevoteResponse)(nil), "osmosis.oracle.v1beta1.MsgAggregateExchangeRateVoteAndPrevoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "osmosis.oracle.v1beta1.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "osmosis.oracle.v1beta1.MsgDelegateFeedConsentResponse")
 }
@@ -279,39 +369,42 @@ func init() {
 func init() { proto.RegisterFile("osmosis/oracle/v1beta1/tx.proto", fileDescriptor_9579aae5b37f1ac7) }
 
 var fileDescriptor_9579aae5b37f1ac7 = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0xa4, 0xaa, 0xda, 0x43, 0xa5, 0xe0, 0x96, 0x2a, 0x8d, 0x2a, 0x5f, 0x75, 0x20,
-	0xa0, 0x03, 0xb6, 0x9a, 0x22, 0x90, 0x2a, 0x24, 0xa0, 0xbc, 0x4c, 0x44, 0x42, 0x37, 0x30, 0xb0,
-	0xa0, 0x4b, 0xf2, 0x70, 0x89, 0xe4, 0xe4, 0x22, 0xdf, 0x11, 0xa5, 0x03, 0x1b, 0x03, 0x1b, 0x8c,
-	0x8c, 0xfd, 0x06, 0x88, 0x6f, 0xc1, 0xd8, 0x91, 0xc9, 0x42, 0xc9, 0xc2, 0xc4, 0xe0, 0x4f, 0x80,
-	0xec, 0x3b, 0x9b, 0xa0, 0xa6, 0xa9, 0x9c, 0x2d, 0x79, 0xfe, 0xbf, 0xe7, 0xed, 0xef, 0x47, 0x87,
-	0x89, 0x54, 0x3d, 0xa9, 0xba, 0x2a, 0x90, 0x11, 0x6f, 0x85, 0x10, 0x0c, 0xf7, 0x9b, 0xa0, 0xf9,
-	0x7e, 0xa0, 0x47, 0xfe, 0x20, 0x92, 0x5a, 0xba, 0x5b, 0x16, 0xf0, 0x0d, 0xe0, 0x5b, 0xa0, 0xb6,
-	0x29, 0xa4, 0x90, 0x19, 0x12, 0xa4, 0xbf, 0x0c, 0x4d, 0xbf, 0x21, 0x4c, 0x1a, 0x4a, 0x3c, 0x11,
-	0x22, 0x02, 0xc1, 0x35, 0x3c, 0x1f, 0xb5, 0x3a, 0xbc, 0x2f, 0x80, 0x71, 0x0d, 0xaf, 0x22, 0x18,
-	0x4a, 0x0d, 0xee, 0x0d, 0xbc, 0xd4, 0xe1, 0xaa, 0x53, 0x45, 0xbb, 0xe8, 0xce, 0xea, 0xd1, 0x7a,
-	0x12, 0x93, 0xcb, 0xc7, 0xbc, 0x17, 0x1e, 0xd2, 0x34, 0x4a, 0x59, 0x26, 0xba, 0x7b, 0x78, 0xf9,
-	0x1d, 0x40, 0x1b, 0xa2, 0xea, 0xa5, 0x0c, 0xbb, 0x96, 0xc4, 0x64, 0xcd, 0x60, 0x26, 0x4e, 0x99,
-	0x05, 0xdc, 0x3a, 0x5e, 0x1d, 0xf2, 0xb0, 0xdb, 0xe6, 0x5a, 0x46, 0xd5, 0x4a, 0x46, 0x6f, 0x26,
-	0x31, 0xb9, 0x6a, 0xe8, 0x42, 0xa2, 0xec, 0x1f, 0x76, 0xb8, 0xf2, 0xe9, 0x84, 0x38, 0xbf, 0x4f,
-	0x88, 0x43, 0xf7, 0xf0, 0xed, 0x0b, 0x06, 0x66, 0xa0, 0x06, 0xb2, 0xaf, 0x80, 0xfe, 0x41, 0x78,
-	0xe7, 0x3c, 0xf6, 0xb5, 0xdd, 0x4c, 0xf1, 0x50, 0x9f, 0xdd, 0x2c, 0x8d, 0x52, 0x96, 0x89, 0xee,
-	0x63, 0x7c, 0x05, 0x6c, 0xe2, 0xdb, 0x88, 0x6b, 0x50, 0x76, 0xc3, 0xed, 0x24, 0x26, 0xd7, 0x0d,
-	0xfe, 0xbf, 0x4e, 0xd9, 0x1a, 0x4c, 0x75, 0x52, 0x53, 0xde, 0x54, 0x4a, 0x79, 0xb3, 0x54, 0xd6,
-	0x9b, 0x5b, 0xf8, 0xe6, 0xbc, 0x7d, 0x0b, 0x63, 0x3e, 0x22, 0xbc, 0xd5, 0x50, 0xe2, 0x19, 0x84,
-	0x19, 0xf7, 0x02, 0xa0, 0xfd, 0x34, 0x15, 0xfa, 0xda, 0x0d, 0xf0, 0x8a, 0x1c, 0x40, 0x94, 0xf5,
-	0x37, 0xb6, 0x6c, 0x24, 0x31, 0x59, 0x37, 0xfd, 0x73, 0x85, 0xb2, 0x02, 0x4a, 0x13, 0xda, 0xb6,
-	0x8e, 0x35, 0x66, 0x2a, 0x21, 0x57, 0x28, 0x2b, 0xa0, 0xa9, 0x71, 0x77, 0xb1, 0x37, 0x7b, 0x8a,
-	0x7c, 0xd0, 0xfa, 0xf7, 0x0a, 0xae, 0x34, 0x94, 0x70, 0xbf, 0x22, 0xbc, 0x33, 0xf7, 0x46, 0x1f,
-	0xf8, 0xb3, 0xcf, 0xde, 0xbf, 0xe0, 0x56, 0x6a, 0x8f, 0x16, 0x4c, 0xcc, 0x47, 0x74, 0x3f, 0x23,
-	0xbc, 0x7d, 0xfe, 0x85, 0xdd, 0x2b, 0x5b, 0x3e, 0xcd, 0xaa, 0x3d, 0x5c, 0x24, 0xab, 0x98, 0xe8,
-	0x03, 0xde, 0x98, 0xf5, 0x65, 0xfd, 0x39, 0x45, 0x67, 0xf0, 0xb5, 0xfb, 0xe5, 0xf8, 0xbc, 0xfd,
-	0xd1, 0xcb, 0x1f, 0x63, 0x0f, 0x9d, 0x8e, 0x3d, 0xf4, 0x6b, 0xec, 0xa1, 0x2f, 0x13, 0xcf, 0x39,
-	0x9d, 0x78, 0xce, 0xcf, 0x89, 0xe7, 0xbc, 0xa9, 0x8b, 0xae, 0xee, 0xbc, 0x6f, 0xfa, 0x2d, 0xd9,
-	0x0b, 0x6c, 0xed, 0xbb, 0x21, 0x6f, 0xaa, 0xfc, 0x4f, 0x30, 0xac, 0x1f, 0x04, 0xa3, 0xfc, 0x65,
-	0xd3, 0xc7, 0x03, 0x50, 0xcd, 0xe5, 0xec, 0x9d, 0x3a, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x33,
-	0xe8, 0x63, 0x9c, 0xf8, 0x04, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0x49, 0xa8, 0xda, 0x43, 0xa5, 0xe0, 0x96, 0x2a, 0xb5, 0x2a, 0xbb, 0xba, 0xa2,
+	0x42, 0x07, 0x6c, 0x9a, 0x22, 0x90, 0x2a, 0x04, 0xb4, 0x40, 0x27, 0x22, 0x21, 0x0f, 0x0c, 0x2c,
+	0xe8, 0x12, 0xbf, 0x5c, 0x22, 0x39, 0xb9, 0xc8, 0x77, 0x44, 0xe9, 0xc0, 0xc6, 0xc0, 0x06, 0x23,
+	0x0b, 0x52, 0xbf, 0x00, 0xe2, 0x6b, 0x30, 0x76, 0x64, 0xb2, 0x20, 0x59, 0x98, 0x18, 0xfc, 0x09,
+	0x90, 0xff, 0x36, 0x40, 0x9a, 0xc4, 0x41, 0xea, 0x96, 0xdc, 0xfb, 0x7b, 0xee, 0x9e, 0xf7, 0xc9,
+	0xbd, 0x39, 0x6c, 0x70, 0xd1, 0xe2, 0xa2, 0x29, 0x2c, 0xee, 0xd1, 0xba, 0x0b, 0x56, 0x77, 0xa7,
+	0x06, 0x92, 0xee, 0x58, 0xb2, 0x67, 0x76, 0x3c, 0x2e, 0xb9, 0xba, 0x9a, 0x00, 0x66, 0x0c, 0x98,
+	0x09, 0xa0, 0xad, 0x30, 0xce, 0x78, 0x84, 0x58, 0xe1, 0xa7, 0x98, 0x26, 0x5f, 0x10, 0x36, 0xaa,
+	0x82, 0xed, 0x33, 0xe6, 0x01, 0xa3, 0x12, 0x9e, 0xf4, 0xea, 0x0d, 0xda, 0x66, 0x60, 0x53, 0x09,
+	0xcf, 0x3c, 0xe8, 0x72, 0x09, 0xea, 0x26, 0x2e, 0x35, 0xa8, 0x68, 0x94, 0xd1, 0x06, 0xba, 0xb1,
+	0x70, 0xb0, 0x14, 0xf8, 0xc6, 0xc5, 0x23, 0xda, 0x72, 0xf7, 0x48, 0xb8, 0x4a, 0xec, 0xa8, 0xa8,
+	0x6e, 0xe3, 0xb9, 0x57, 0x00, 0x0e, 0x78, 0xe5, 0x42, 0x84, 0x5d, 0x09, 0x7c, 0x63, 0x31, 0xc6,
+	0xe2, 0x75, 0x62, 0x27, 0x80, 0x5a, 0xc1, 0x0b, 0x5d, 0xea, 0x36, 0x1d, 0x2a, 0xb9, 0x57, 0x2e,
+	0x46, 0xf4, 0x4a, 0xe0, 0x1b, 0x97, 0x63, 0x3a, 0x2b, 0x11, 0xfb, 0x14, 0xdb, 0x9b, 0x7f, 0x77,
+	0x6c, 0x28, 0x3f, 0x8f, 0x0d, 0x85, 0x6c, 0xe3, 0xeb, 0x13, 0x0c, 0xdb, 0x20, 0x3a, 0xbc, 0x2d,
+	0x80, 0xfc, 0x42, 0x78, 0xfd, 0x2c, 0xf6, 0x79, 0xd2, 0x99, 0xa0, 0xae, 0xfc, 0xb7, 0xb3, 0x70,
+	0x95, 0xd8, 0x51, 0x51, 0x7d, 0x88, 0x2f, 0x41, 0x22, 0x7c, 0xe9, 0x51, 0x09, 0x22, 0xe9, 0x70,
+	0x2d, 0xf0, 0x8d, 0xab, 0x31, 0xfe, 0x67, 0x9d, 0xd8, 0x8b, 0x30, 0x74, 0x92, 0x18, 0xca, 0xa6,
+	0x98, 0x2b, 0x9b, 0x52, 0xde, 0x6c, 0xb6, 0xf0, 0xb5, 0x71, 0xfd, 0x66, 0xc1, 0x7c, 0x2a, 0xe0,
+	0xad, 0x71, 0xe0, 0x7e, 0xdb, 0x19, 0xfa, 0xf1, 0xcf, 0x23, 0xa2, 0xf4, 0x8e, 0x15, 0xa7, 0xbb,
+	0x63, 0xa5, 0x5c, 0x39, 0x5e, 0xc8, 0x9b, 0xe3, 0x2d, 0x6c, 0x4e, 0x17, 0x4f, 0x96, 0xe8, 0x5b,
+	0x84, 0x57, 0xab, 0x82, 0x3d, 0x06, 0x37, 0x52, 0x1c, 0x02, 0x38, 0x8f, 0xc2, 0x42, 0x5b, 0xaa,
+	0x16, 0x9e, 0xe7, 0x1d, 0xf0, 0x22, 0x27, 0x71, 0x8a, 0xcb, 0x81, 0x6f, 0x2c, 0xc5, 0x4e, 0xd2,
+	0x0a, 0xb1, 0x33, 0x28, 0x14, 0x38, 0xc9, 0x3e, 0xe5, 0xc2, 0xdf, 0x82, 0xb4, 0x42, 0xec, 0x0c,
+	0x1a, 0x32, 0xbe, 0x81, 0xf5, 0xd1, 0x2e, 0x52, 0xa3, 0x95, 0x1f, 0x25, 0x5c, 0xac, 0x0a, 0xa6,
+	0x7e, 0x44, 0x78, 0x7d, 0xec, 0xd4, 0xdf, 0x35, 0x47, 0xff, 0x91, 0x98, 0x13, 0xa6, 0x4f, 0x7b,
+	0x30, 0xa3, 0x30, 0xb5, 0xa8, 0xbe, 0x47, 0x78, 0xed, 0xec, 0x99, 0xbd, 0x9d, 0x77, 0xfb, 0x50,
+	0xa5, 0xdd, 0x9b, 0x45, 0x95, 0x39, 0xfa, 0x8c, 0xf0, 0xe6, 0x34, 0xc3, 0x72, 0x7f, 0x96, 0x53,
+	0x4e, 0xf5, 0xda, 0xe1, 0xff, 0xe9, 0x33, 0xbf, 0x6f, 0xf0, 0xf2, 0xa8, 0x9b, 0x68, 0x8e, 0xd9,
+	0x7e, 0x04, 0xaf, 0xdd, 0xc9, 0xc7, 0xa7, 0xc7, 0x1f, 0x3c, 0xfd, 0xda, 0xd7, 0xd1, 0x49, 0x5f,
+	0x47, 0xdf, 0xfb, 0x3a, 0xfa, 0x30, 0xd0, 0x95, 0x93, 0x81, 0xae, 0x7c, 0x1b, 0xe8, 0xca, 0x8b,
+	0x0a, 0x6b, 0xca, 0xc6, 0xeb, 0x9a, 0x59, 0xe7, 0x2d, 0x2b, 0xd9, 0xfb, 0xa6, 0x4b, 0x6b, 0x22,
+	0xfd, 0x62, 0x75, 0x2b, 0xbb, 0x56, 0x2f, 0x7d, 0xdb, 0xe4, 0x51, 0x07, 0x44, 0x6d, 0x2e, 0x7a,
+	0xa9, 0x76, 0x7f, 0x0f, 0x00, 0xd0, 0x8d, 0xa8, 0x41, 0xfa, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -332,6 +425,9 @@ type MsgClient interface {
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
+	// AggregateExchangeRateVoteAndPrevote defines a method for submitting
+	// aggregate exchange rate vote and the prevote for the next vote period
+	AggregateExchangeRateVoteAndPrevote(ctx context.Context, in *MsgAggregateExchangeRateVoteAndPrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteAndPrevoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 }
@@ -362,6 +458,15 @@ func (c *msgClient) AggregateExchangeRateVote(ctx context.Context, in *MsgAggreg
 	return out, nil
 }
 
+func (c *msgClient) AggregateExchangeRateVoteAndPrevote(ctx context.Context, in *MsgAggregateExchangeRateVoteAndPrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteAndPrevoteResponse, error) {
+	out := new(MsgAggregateExchangeRateVoteAndPrevoteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Msg/AggregateExchangeRateVoteAndPrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error) {
 	out := new(MsgDelegateFeedConsentResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.oracle.v1beta1.Msg/DelegateFeedConsent", in, out, opts...)
@@ -379,6 +484,9 @@ type MsgServer interface {
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
+	// AggregateExchangeRateVoteAndPrevote defines a method for submitting
+	// aggregate exchange rate vote and the prevote for the next vote period
+	AggregateExchangeRateVoteAndPrevote(context.Context, *MsgAggregateExchangeRateVoteAndPrevote) (*MsgAggregateExchangeRateVoteAndPrevoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 }
@@ -393,6 +501,9 @@ func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context,
 func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
+func (*UnimplementedMsgServer) AggregateExchangeRateVoteAndPrevote(ctx context.Context, req *MsgAggregateExchangeRateVoteAndPrevote) (*MsgAggregateExchangeRateVoteAndPrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVoteAndPrevote not implemented")
+}
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
@@ -437,6 +548,24 @@ func _Msg_AggregateExchangeRateVote_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AggregateExchangeRateVoteAndPrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAggregateExchangeRateVoteAndPrevote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AggregateExchangeRateVoteAndPrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.oracle.v1beta1.Msg/AggregateExchangeRateVoteAndPrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AggregateExchangeRateVoteAndPrevote(ctx, req.(*MsgAggregateExchangeRateVoteAndPrevote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDelegateFeedConsent)
 	if err := dec(in); err != nil {
@@ -467,6 +596,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AggregateExchangeRateVote",
 			Handler:    _Msg_AggregateExchangeRateVote_Handler,
 		},
+		{
+			MethodName: "AggregateExchangeRateVoteAndPrevote",
+			Handler:    _Msg_AggregateExchangeRateVoteAndPrevote_Handler,
+		},
 		{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
@@ -617,6 +750,87 @@ func (m *MsgAggregateExchangeRateVoteResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAggregateExchangeRateVoteAndPrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExchangeRates) > 0 {
+		i -= len(m.ExchangeRates)
+		copy(dAtA[i:], m.ExchangeRates)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRates)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -752,6 +966,44 @@ func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAggregateExchangeRateVoteAndPrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRates)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegateFeedConsent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1208,6 +1460,266 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAggregateExchangeRateVoteAndPrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteAndPrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteAndPrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVoteAndPrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteAndPrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteAndPrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
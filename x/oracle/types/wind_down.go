@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewWindDown creates a WindDown instance
+func NewWindDown(denom string, settlementRate, redemptionRate sdk.Dec, deadline time.Time) WindDown {
+	return WindDown{
+		Denom:          denom,
+		SettlementRate: settlementRate,
+		RedemptionRate: redemptionRate,
+		Deadline:       deadline,
+	}
+}
+
+// Validate performs basic validation of the wind-down
+func (w WindDown) Validate() error {
+	if err := sdk.ValidateDenom(w.Denom); err != nil {
+		return err
+	}
+
+	if w.SettlementRate.IsNil() || !w.SettlementRate.IsPositive() {
+		return fmt.Errorf("settlement rate of %s must be positive", w.Denom)
+	}
+
+	if w.RedemptionRate.IsNil() || w.RedemptionRate.IsNegative() {
+		return fmt.Errorf("redemption rate of %s must be non-negative", w.Denom)
+	}
+
+	if w.RedemptionRate.GT(w.SettlementRate) {
+		return fmt.Errorf("redemption rate of %s must not exceed the settlement rate: %s > %s", w.Denom, w.RedemptionRate, w.SettlementRate)
+	}
+
+	if w.Deadline.IsZero() {
+		return fmt.Errorf("deadline of %s must be set", w.Denom)
+	}
+
+	return nil
+}
+
+// IsRedeemable returns true if the denom can still be redeemed at the given time
+func (w WindDown) IsRedeemable(blockTime time.Time) bool {
+	return !blockTime.After(w.Deadline)
+}
@@ -1,11 +1,13 @@
 package treasury
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"github.com/osmosis-labs/osmosis/v23/x/treasury/keeper"
 	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
@@ -15,21 +17,101 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	if appparams.IsPeriodLastBlock(ctx, k.GetParams(ctx).RefillInterval) {
+		// Turn the tax collected in-kind into reserve before rebalancing the market vault with it
+		if swapped := k.SwapTaxProceeds(ctx); swapped.IsPositive() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeTaxProceedsSwap,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeySwappedAmount, swapped.String()),
+				),
+			)
+		}
+		rebalanceExchangePool(ctx, k)
+	}
+
+	// Pause the market swaps the backing of the stable supply can't support
+	k.UpdateCircuitBreaker(ctx)
+
 	// Check epoch last block
-	if !appparams.IsPeriodLastBlock(ctx, 3*appparams.BlocksPerMinute) {
+	if !appparams.IsPeriodLastBlock(ctx, types.EpochLength) {
 		return
 	}
 
-	refillAmount := k.RefillExchangePool(ctx)
+	// Record the indicators of the closing epoch, keeping the last WindowLong epochs
+	epochState := k.UpdateIndicators(ctx)
+	k.PruneEpochStates(ctx)
+
+	// Skip the tax rate update while under probation
 	oldTaxRate := k.GetTaxRate(ctx)
-	newTaxRate := k.UpdateReserveFee(ctx)
+	newTaxRate := oldTaxRate
+	if !k.IsProbation(ctx) {
+		newTaxRate = k.UpdateTaxPolicy(ctx)
+	}
+
+	k.SetEpoch(ctx, epochState.Epoch+1)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeTaxRateUpdate,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyExchangePoolRefillAmount, refillAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epochState.Epoch)),
+			sdk.NewAttribute(types.AttributeKeyTaxProceeds, epochState.TaxProceeds.String()),
+			sdk.NewAttribute(types.AttributeKeyReserveCoverage, epochState.ReserveCoverage.String()),
 			sdk.NewAttribute(types.AttributeKeyOldTaxRate, oldTaxRate.String()),
 			sdk.NewAttribute(types.AttributeKeyNewTaxRate, newTaxRate.String()),
 		),
 	)
 }
+
+// rebalanceExchangePool refills the market vault from the reserve, or drains its surplus back to the reserve.
+// A failed transfer is dropped and reported in an event instead of halting the chain.
+func rebalanceExchangePool(ctx sdk.Context, k keeper.Keeper) {
+	refillAmount := sdk.ZeroInt()
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) (err error) {
+		refillAmount, err = k.RefillExchangePool(cacheCtx)
+		return err
+	})
+	if err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeReserveRefillFailed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeReserveRefill,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyExchangePoolRefillAmount, refillAmount.String()),
+		),
+	)
+	if refillAmount.IsPositive() {
+		return
+	}
+
+	drainAmount := sdk.ZeroInt()
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) (err error) {
+		drainAmount, err = k.DrainExchangePool(cacheCtx)
+		return err
+	})
+	if err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeReserveDrainFailed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		return
+	}
+
+	if drainAmount.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeReserveDrain,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyExchangePoolDrainAmount, drainAmount.String()),
+			),
+		)
+	}
+}
@@ -1,5 +1,47 @@
 package treasury
 
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestEndBlockerTaxPolicy(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+
+	// the tax rate is frozen during the probation, but the indicators are recorded
+	for epoch := int64(0); epoch < int64(params.WindowProbation); epoch++ {
+		EndBlocker(input.Ctx.WithBlockHeight(int64(types.EpochLength)*(epoch+1)-1), input.TreasuryKeeper)
+		require.Equal(t, taxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	}
+	require.Equal(t, params.WindowProbation, input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.Len(t, input.TreasuryKeeper.GetAllEpochStates(input.Ctx), int(params.WindowProbation))
+
+	// blocks within the epoch do not touch the tax rate
+	EndBlocker(input.Ctx.WithBlockHeight(int64(types.EpochLength)*int64(params.WindowProbation)), input.TreasuryKeeper)
+	require.Equal(t, params.WindowProbation, input.TreasuryKeeper.GetEpoch(input.Ctx))
+
+	// the reserve is empty, so the tax rate rises once the probation is over
+	EndBlocker(input.Ctx.WithBlockHeight(int64(types.EpochLength)*int64(params.WindowProbation+1)-1), input.TreasuryKeeper)
+	require.Equal(t, taxRate.Add(params.MaxTaxRateChange), input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	require.Equal(t, params.WindowProbation+1, input.TreasuryKeeper.GetEpoch(input.Ctx))
+}
+
+func TestEndBlockerCircuitBreaker(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	require.Equal(t, markettypes.DefaultCircuitBreaker(), input.MarketKeeper.GetCircuitBreaker(input.Ctx))
+
+	// the stable supply isn't backed, so swaps are halted at the end of any block
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.TreasuryKeeper)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED, input.MarketKeeper.GetCircuitBreaker(input.Ctx).State)
+}
+
 //func TestEndBlockerIssuanceUpdateWithBurnModule(t *testing.T) {
 //	input := keeper.CreateTestInput(t)
 //
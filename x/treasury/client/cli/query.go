@@ -23,6 +23,16 @@ func GetQueryCmd() *cobra.Command {
 	}
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryTaxRate(),
+		GetCmdQueryTaxRateHistory(),
+		GetCmdQueryTaxProceeds(),
+		GetCmdQueryIndicators(),
+		GetCmdQueryReserveHistory(),
+		GetCmdQueryTaxCap(),
+		GetCmdQueryTaxCaps(),
+		GetCmdQueryTaxExemptionZones(),
+		GetCmdQueryTaxExemptionAddresses(),
+		GetCmdQueryTaxExemptionRoutes(),
+		GetCmdQuerySolvency(),
 		GetCmdQueryParams(),
 	)
 
@@ -60,6 +70,326 @@ $ terrad query treasury tax-rate
 	return cmd
 }
 
+// GetCmdQueryTaxRateHistory implements the query tax-rate-history command.
+func GetCmdQueryTaxRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-rate-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the tax rates and indicators of the past epochs",
+		Long: strings.TrimSpace(`
+Query the tax rate, tax proceeds and reserve coverage recorded at the end of each of the past epochs.
+
+$ symphonyd query treasury tax-rate-history
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxRateHistory(context.Background(), &types.QueryTaxRateHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaxProceeds implements the query tax-proceeds command.
+func GetCmdQueryTaxProceeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-proceeds",
+		Args:  cobra.NoArgs,
+		Short: "Query the stability tax collected during the current epoch",
+		Long: strings.TrimSpace(`
+Query the stability tax paid to the reserve during the current epoch, in note and per taxed denom.
+
+$ symphonyd query treasury tax-proceeds
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxProceeds(context.Background(), &types.QueryTaxProceedsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIndicators implements the query indicators command.
+func GetCmdQueryIndicators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indicators",
+		Args:  cobra.NoArgs,
+		Short: "Query the short and long window averages of the treasury indicators",
+		Long: strings.TrimSpace(`
+Query the averages of the tax proceeds, seigniorage and reserve coverage over WindowShort and WindowLong.
+
+$ symphonyd query treasury indicators
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Indicators(context.Background(), &types.QueryIndicatorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReserveHistory implements the query reserve-history command.
+func GetCmdQueryReserveHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the reserve balance and flows of the past epochs",
+		Long: strings.TrimSpace(`
+Query the reserve balance, refills of the market vault and tax proceeds recorded at the end of each of the past epochs.
+
+$ symphonyd query treasury reserve-history
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReserveHistory(context.Background(), &types.QueryReserveHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySolvency implements the query solvency command.
+func GetCmdQuerySolvency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "solvency",
+		Args:  cobra.NoArgs,
+		Short: "Query the collateralization of the stable supply",
+		Long: strings.TrimSpace(`
+Query the supply of every stable denom valued in note, the market vault and reserve balances backing it, their collateralization ratio and whether it is healthy.
+
+$ symphonyd query treasury solvency
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Solvency(context.Background(), &types.QuerySolvencyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaxCap implements the query tax-cap command.
+func GetCmdQueryTaxCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-cap [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the stability tax cap of a denom",
+		Long: strings.TrimSpace(`
+Query the largest stability tax charged on a single transfer of the denom, in units of the denom.
+
+$ symphonyd query treasury tax-cap uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxCap(context.Background(), &types.QueryTaxCapRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaxCaps implements the query tax-caps command.
+func GetCmdQueryTaxCaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-caps",
+		Args:  cobra.NoArgs,
+		Short: "Query the stability tax caps of all capped denoms",
+		Long: strings.TrimSpace(`
+Query the stability tax caps of all capped denoms. Denoms without a cap are taxed without limit.
+
+$ symphonyd query treasury tax-caps
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxCaps(context.Background(), &types.QueryTaxCapsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaxExemptionZones implements the query tax-exemption-zones command.
+func GetCmdQueryTaxExemptionZones() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemption-zones",
+		Args:  cobra.NoArgs,
+		Short: "Query the tax exemption zones",
+		Long: strings.TrimSpace(`
+Query the tax exemption zones. Addresses of a zone move funds among themselves without paying the stability tax.
+
+$ symphonyd query treasury tax-exemption-zones
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxExemptionZones(context.Background(), &types.QueryTaxExemptionZonesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTaxExemptionAddresses implements the query tax-exemption-addresses command.
+func GetCmdQueryTaxExemptionAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemption-addresses [zone]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the addresses of the tax exemption zones",
+		Long: strings.TrimSpace(`
+Query the addresses of a tax exemption zone, or of all zones if no zone is given.
+
+$ symphonyd query treasury tax-exemption-addresses custody
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTaxExemptionAddressesRequest{}
+			if len(args) == 1 {
+				req.Zone = args[0]
+			}
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.TaxExemptionAddresses(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tax exemption addresses")
+	return cmd
+}
+
+// GetCmdQueryTaxExemptionRoutes implements the query tax-exemption-routes command.
+func GetCmdQueryTaxExemptionRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemption-routes",
+		Args:  cobra.NoArgs,
+		Short: "Query the tax exemption routes",
+		Long: strings.TrimSpace(`
+Query the message routes exempt from the stability tax. A route is a message type URL, optionally followed by the
+address of the contract a wasm message executes.
+
+$ symphonyd query treasury tax-exemption-routes
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxExemptionRoutes(context.Background(), &types.QueryTaxExemptionRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
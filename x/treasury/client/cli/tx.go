@@ -0,0 +1,357 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+const (
+	FlagTaxCaps        = "tax-caps"
+	FlagOutgoing       = "outgoing"
+	FlagIncoming       = "incoming"
+	FlagSettlementRate = "settlement-rate"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(cmd, NewRedeemCmd)
+
+	return cmd
+}
+
+// NewRedeemCmd will create and send a MsgRedeem
+func NewRedeemCmd() (*osmocli.TxCliDesc, *types.MsgRedeem) {
+	return &osmocli.TxCliDesc{
+		Use:     "redeem",
+		NumArgs: 1,
+		Short:   "Redeem a denom winding down for note out of the reserve",
+		Long: strings.TrimSpace(`
+   Redeem the coin of a denom winding down at its redemption rate, until the end of its redemption period.
+
+   $ symphonyd tx treasury redeem 1000ukrw
+   `),
+		ParseAndBuildMsg: NewRedeemMsg,
+	}, &types.MsgRedeem{}
+}
+
+func NewRedeemMsg(clientCtx client.Context, args []string, _ *flag.FlagSet) (sdk.Msg, error) {
+	coin, err := sdk.ParseCoinNormalized(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgRedeem(clientCtx.GetFromAddress(), coin), nil
+}
+
+func NewCmdSubmitUpdateTaxCapsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-tax-caps [flags]",
+		Args:    cobra.ExactArgs(0),
+		Example: "update-tax-caps --tax-caps uusd,1000000,ukrw,0 --from val --chain-id symphony-1",
+		Short:   "Submit a update stability tax caps proposal",
+		Long: strings.TrimSpace(`Submit a update stability tax caps proposal.
+
+Passing in denom,cap pairs separated by commas would be parsed automatically to pairs of tax cap records.
+Ex) uusd,1000000,ukrw,0 -> [Caps the uusd tax at 1000000uusd, Removes the ukrw tax cap]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseTaxCapsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagTaxCaps, "", "The tax cap records array")
+
+	return cmd
+}
+
+func parseTaxCaps(cmd *cobra.Command) ([]types.TaxCap, error) {
+	taxCapsStr, err := cmd.Flags().GetString(FlagTaxCaps)
+	if err != nil {
+		return nil, err
+	}
+
+	taxCaps := strings.Split(taxCapsStr, ",")
+
+	if len(taxCaps)%2 != 0 {
+		return nil, errors.New("tax cap records should be a comma separated list of denom and cap pairs")
+	}
+
+	taxCapRecords := []types.TaxCap{}
+	for i := 0; i < len(taxCaps); i += 2 {
+		taxCap, ok := sdk.NewIntFromString(taxCaps[i+1])
+		if !ok {
+			return nil, errors.New("failed to parse tax cap " + taxCaps[i+1])
+		}
+
+		taxCapRecords = append(taxCapRecords, types.NewTaxCap(taxCaps[i], taxCap))
+	}
+
+	return taxCapRecords, nil
+}
+
+func parseTaxCapsArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	taxCapRecords, err := parseTaxCaps(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.UpdateTaxCapsProposal{
+		Title:       title,
+		Description: description,
+		TaxCaps:     taxCapRecords,
+	}
+	return content, nil
+}
+
+func NewCmdSubmitAddTaxExemptionZoneProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-tax-exemption-zone [zone] [address]... [flags]",
+		Args:    cobra.MinimumNArgs(1),
+		Example: "add-tax-exemption-zone custody symphony1... symphony1... --outgoing --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to create or update a tax exemption zone and add addresses to it",
+		Long: strings.TrimSpace(`Submit a proposal to create or update a tax exemption zone and add addresses to it.
+
+Addresses of a zone move funds among themselves without paying the stability tax.
+With --outgoing transfers from the zone to any address are exempt, with --incoming transfers from any address to the zone are exempt.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outgoing, err := cmd.Flags().GetBool(FlagOutgoing)
+			if err != nil {
+				return err
+			}
+
+			incoming, err := cmd.Flags().GetBool(FlagIncoming)
+			if err != nil {
+				return err
+			}
+
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewAddTaxExemptionZoneProposal(title, description, types.NewZone(args[0], outgoing, incoming), args[1:])
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Bool(FlagOutgoing, false, "Exempt transfers from the zone to any address")
+	cmd.Flags().Bool(FlagIncoming, false, "Exempt transfers from any address to the zone")
+
+	return cmd
+}
+
+func NewCmdSubmitRemoveTaxExemptionZoneProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-tax-exemption-zone [zone] [flags]",
+		Args:    cobra.ExactArgs(1),
+		Example: "remove-tax-exemption-zone custody --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to remove a tax exemption zone together with all of its addresses",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewRemoveTaxExemptionZoneProposal(title, description, args[0])
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdSubmitAddTaxExemptionAddressProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-tax-exemption-address [zone] [address]... [flags]",
+		Args:    cobra.MinimumNArgs(2),
+		Example: "add-tax-exemption-address custody symphony1... --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to add addresses to an existing tax exemption zone",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewAddTaxExemptionAddressProposal(title, description, args[0], args[1:])
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdSubmitRemoveTaxExemptionAddressProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-tax-exemption-address [address]... [flags]",
+		Args:    cobra.MinimumNArgs(1),
+		Example: "remove-tax-exemption-address symphony1... --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to remove addresses from their tax exemption zones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewRemoveTaxExemptionAddressProposal(title, description, args)
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdSubmitAddTaxExemptionRouteProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-tax-exemption-route [route]... [flags]",
+		Args:    cobra.MinimumNArgs(1),
+		Example: "add-tax-exemption-route /osmosis.market.v1beta1.MsgSwapSend /cosmwasm.wasm.v1.MsgExecuteContract/symphony1... --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to exempt message routes from the stability tax",
+		Long: strings.TrimSpace(`Submit a proposal to exempt message routes from the stability tax.
+
+A route is a message type URL, which exempts every message of the type, or a wasm message type URL followed by
+"/" and a contract address, which exempts the messages executing that contract.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewAddTaxExemptionRouteProposal(title, description, args)
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdSubmitRemoveTaxExemptionRouteProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-tax-exemption-route [route]... [flags]",
+		Args:    cobra.MinimumNArgs(1),
+		Example: "remove-tax-exemption-route /osmosis.market.v1beta1.MsgSwapSend --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to remove message routes from the tax exemption routes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewRemoveTaxExemptionRouteProposal(title, description, args)
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdSubmitWindDownProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "wind-down [denom] [redemption-period] [flags]",
+		Args:    cobra.ExactArgs(2),
+		Example: "wind-down ukrw 720h --settlement-rate 0.0007 --from val --chain-id symphony-1",
+		Short:   "Submit a proposal to wind down a whitelisted stable denom",
+		Long: strings.TrimSpace(`Submit a proposal to wind down a whitelisted stable denom.
+
+The denom can no longer be minted, its exchange rate is frozen at the settlement rate, and its holders can
+redeem it pro-rata from the reserve during the redemption period. Without a settlement rate, the rate is
+frozen at the oracle exchange rate when the proposal passes.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			redemptionPeriod, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			settlementRate := sdk.ZeroDec()
+			settlementRateStr, err := cmd.Flags().GetString(FlagSettlementRate)
+			if err != nil {
+				return err
+			}
+			if settlementRateStr != "" {
+				settlementRate, err = sdk.NewDecFromStr(settlementRateStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			return submitTreasuryProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				content := types.NewWindDownProposal(title, description, args[0], settlementRate, redemptionPeriod)
+				return &content
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagSettlementRate, "", "The exchange rate to freeze the denom at; defaults to the oracle exchange rate")
+
+	return cmd
+}
+
+// submitTreasuryProposal wraps the legacy content built from the proposal title and summary flags in a gov proposal and broadcasts it
+func submitTreasuryProposal(cmd *cobra.Command, contentFn func(title, description string) govtypesv1beta1.Content) error {
+	clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return err
+	}
+
+	contentMsg, err := v1.NewLegacyContent(contentFn(title, description), authority.String())
+	if err != nil {
+		return err
+	}
+
+	msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+	proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+}
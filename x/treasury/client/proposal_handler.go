@@ -0,0 +1,18 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	SubmitUpdateTaxCapsProposalHandler             = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateTaxCapsProposal)
+	SubmitAddTaxExemptionZoneProposalHandler       = govclient.NewProposalHandler(cli.NewCmdSubmitAddTaxExemptionZoneProposal)
+	SubmitRemoveTaxExemptionZoneProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveTaxExemptionZoneProposal)
+	SubmitAddTaxExemptionAddressProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitAddTaxExemptionAddressProposal)
+	SubmitRemoveTaxExemptionAddressProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveTaxExemptionAddressProposal)
+	SubmitAddTaxExemptionRouteProposalHandler      = govclient.NewProposalHandler(cli.NewCmdSubmitAddTaxExemptionRouteProposal)
+	SubmitRemoveTaxExemptionRouteProposalHandler   = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveTaxExemptionRouteProposal)
+	SubmitWindDownProposalHandler                  = govclient.NewProposalHandler(cli.NewCmdSubmitWindDownProposal)
+)
@@ -15,6 +15,43 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetTaxRate(ctx, data.TaxRate)
+	keeper.SetEpoch(ctx, data.Epoch)
+	keeper.SetEpochTaxProceeds(ctx, data.TaxProceeds)
+	keeper.SetProbationStartEpoch(ctx, data.ProbationStartEpoch)
+	keeper.SetEpochTaxProceedsByDenom(ctx, data.TaxProceedsByDenom)
+	keeper.SetEpochReserveRefills(ctx, data.ReserveRefills)
+	keeper.SetEpochReserveDrains(ctx, data.ReserveDrains)
+	keeper.SetWithheldTaxProceeds(ctx, data.WithheldTaxProceeds)
+
+	if data.EpochInitialExchangePool != nil {
+		keeper.SetEpochInitialExchangePool(ctx, *data.EpochInitialExchangePool)
+	} else {
+		keeper.SetEpochInitialExchangePool(ctx, keeper.GetExchangePoolBalance(ctx).Amount)
+	}
+
+	for _, state := range data.EpochStates {
+		keeper.SetEpochState(ctx, state)
+	}
+
+	for _, taxCap := range data.TaxCaps {
+		keeper.SetTaxCap(ctx, taxCap.Denom, taxCap.Cap)
+	}
+
+	for _, zone := range data.Zones {
+		keeper.SetZone(ctx, zone)
+	}
+
+	for _, exemption := range data.TaxExemptions {
+		if err := keeper.AddTaxExemptionAddress(ctx, exemption.Zone, exemption.Address); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, route := range data.TaxExemptionRoutes {
+		if err := keeper.AddTaxExemptionRoute(ctx, route); err != nil {
+			panic(err)
+		}
+	}
 
 	// check if the module account exists
 	moduleAcc := keeper.GetTreasuryModuleAccount(ctx)
@@ -37,5 +74,21 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	params := keeper.GetParams(ctx)
 
 	taxRate := keeper.GetTaxRate(ctx)
-	return types.NewGenesisState(params, taxRate)
+	genesis := types.NewGenesisState(params, taxRate)
+	genesis.Epoch = keeper.GetEpoch(ctx)
+	genesis.TaxProceeds = keeper.GetEpochTaxProceeds(ctx)
+	genesis.ProbationStartEpoch = keeper.GetProbationStartEpoch(ctx)
+	genesis.EpochStates = keeper.GetAllEpochStates(ctx)
+	genesis.TaxProceedsByDenom = keeper.GetEpochTaxProceedsByDenom(ctx)
+	genesis.ReserveRefills = keeper.GetEpochReserveRefills(ctx)
+	genesis.ReserveDrains = keeper.GetEpochReserveDrains(ctx)
+	epochInitialExchangePool := keeper.GetEpochInitialExchangePool(ctx)
+	genesis.EpochInitialExchangePool = &epochInitialExchangePool
+	genesis.TaxCaps = keeper.GetTaxCaps(ctx)
+	genesis.Zones = keeper.GetZones(ctx)
+	genesis.TaxExemptions = keeper.GetTaxExemptions(ctx)
+	genesis.WithheldTaxProceeds = keeper.GetWithheldTaxProceeds(ctx)
+	genesis.TaxExemptionRoutes = keeper.GetTaxExemptionRoutes(ctx)
+
+	return genesis
 }
@@ -0,0 +1,69 @@
+package treasury
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func NewTreasuryProposalHandler(k keeper.Keeper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateTaxCapsProposal:
+			return handleUpdateTaxCapsProposal(ctx, k, c)
+		case *types.AddTaxExemptionZoneProposal:
+			return handleAddTaxExemptionZoneProposal(ctx, k, c)
+		case *types.RemoveTaxExemptionZoneProposal:
+			return handleRemoveTaxExemptionZoneProposal(ctx, k, c)
+		case *types.AddTaxExemptionAddressProposal:
+			return handleAddTaxExemptionAddressProposal(ctx, k, c)
+		case *types.RemoveTaxExemptionAddressProposal:
+			return handleRemoveTaxExemptionAddressProposal(ctx, k, c)
+		case *types.AddTaxExemptionRouteProposal:
+			return handleAddTaxExemptionRouteProposal(ctx, k, c)
+		case *types.RemoveTaxExemptionRouteProposal:
+			return handleRemoveTaxExemptionRouteProposal(ctx, k, c)
+		case *types.WindDownProposal:
+			return handleWindDownProposal(ctx, k, c)
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized treasury proposal content type: %T", c)
+		}
+	}
+}
+
+func handleUpdateTaxCapsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateTaxCapsProposal) error {
+	return k.HandleUpdateTaxCapsProposal(ctx, p)
+}
+
+func handleAddTaxExemptionZoneProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddTaxExemptionZoneProposal) error {
+	return k.HandleAddTaxExemptionZoneProposal(ctx, p)
+}
+
+func handleRemoveTaxExemptionZoneProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveTaxExemptionZoneProposal) error {
+	return k.HandleRemoveTaxExemptionZoneProposal(ctx, p)
+}
+
+func handleAddTaxExemptionAddressProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddTaxExemptionAddressProposal) error {
+	return k.HandleAddTaxExemptionAddressProposal(ctx, p)
+}
+
+func handleRemoveTaxExemptionAddressProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveTaxExemptionAddressProposal) error {
+	return k.HandleRemoveTaxExemptionAddressProposal(ctx, p)
+}
+
+func handleAddTaxExemptionRouteProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddTaxExemptionRouteProposal) error {
+	return k.HandleAddTaxExemptionRouteProposal(ctx, p)
+}
+
+func handleRemoveTaxExemptionRouteProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveTaxExemptionRouteProposal) error {
+	return k.HandleRemoveTaxExemptionRouteProposal(ctx, p)
+}
+
+func handleWindDownProposal(ctx sdk.Context, k keeper.Keeper, p *types.WindDownProposal) error {
+	return k.HandleWindDownProposal(ctx, p)
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func (k Keeper) getEpochTaxProceedsOfDenom(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxProceedsByDenomKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+func (k Keeper) setEpochTaxProceedsOfDenom(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(types.GetTaxProceedsByDenomKey(denom), bz)
+}
+
+// GetEpochTaxProceedsByDenom returns the stability tax assessed during the current epoch per taxed denom
+func (k Keeper) GetEpochTaxProceedsByDenom(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxProceedsByDenomKey)
+	defer iter.Close()
+
+	taxProceeds := sdk.Coins{}
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.TaxProceedsByDenomKey):])
+		ip := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &ip)
+		taxProceeds = taxProceeds.Add(sdk.NewCoin(denom, ip.Int))
+	}
+	return taxProceeds
+}
+
+// SetEpochTaxProceedsByDenom replaces the stability tax assessed during the current epoch per taxed denom
+func (k Keeper) SetEpochTaxProceedsByDenom(ctx sdk.Context, taxProceeds sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxProceedsByDenomKey)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, coin := range taxProceeds {
+		k.setEpochTaxProceedsOfDenom(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+func (k Keeper) getWithheldTaxProceedsOfDenom(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithheldTaxProceedsKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+func (k Keeper) setWithheldTaxProceedsOfDenom(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amount.IsPositive() {
+		store.Delete(types.GetWithheldTaxProceedsKey(denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(types.GetWithheldTaxProceedsKey(denom), bz)
+}
+
+// GetWithheldTaxProceeds returns the stability tax withheld in-kind from the fees, per denom, which hasn't been
+// converted into reserve yet
+func (k Keeper) GetWithheldTaxProceeds(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WithheldTaxProceedsKey)
+	defer iter.Close()
+
+	withheld := sdk.Coins{}
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.WithheldTaxProceedsKey):])
+		ip := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &ip)
+		withheld = withheld.Add(sdk.NewCoin(denom, ip.Int))
+	}
+	return withheld
+}
+
+// SetWithheldTaxProceeds replaces the stability tax withheld in-kind from the fees
+func (k Keeper) SetWithheldTaxProceeds(ctx sdk.Context, withheld sdk.Coins) {
+	for _, coin := range k.GetWithheldTaxProceeds(ctx) {
+		k.setWithheldTaxProceedsOfDenom(ctx, coin.Denom, sdk.ZeroInt())
+	}
+
+	for _, coin := range withheld {
+		k.setWithheldTaxProceedsOfDenom(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// RecordWithheldTaxProceeds adds the stability tax withheld in-kind from a fee to the proceeds to convert into
+// reserve. The tax withheld in the base denom adds to the reserve as is.
+func (k Keeper) RecordWithheldTaxProceeds(ctx sdk.Context, tax sdk.Coin) {
+	if tax.Denom == appparams.BaseCoinUnit || !tax.IsPositive() {
+		return
+	}
+
+	k.setWithheldTaxProceedsOfDenom(ctx, tax.Denom, k.getWithheldTaxProceedsOfDenom(ctx, tax.Denom).Add(tax.Amount))
+}
+
+// GetEpochReserveRefills returns the note sent from the reserve to the market vault during the current epoch
+func (k Keeper) GetEpochReserveRefills(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReserveRefillsKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+// SetEpochReserveRefills sets the note sent from the reserve to the market vault during the current epoch
+func (k Keeper) SetEpochReserveRefills(ctx sdk.Context, refills sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: refills})
+	store.Set(types.ReserveRefillsKey, bz)
+}
+
+// RecordReserveRefill adds a refill of the market vault to the reserve refills of the current epoch
+func (k Keeper) RecordReserveRefill(ctx sdk.Context, amount sdk.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	k.SetEpochReserveRefills(ctx, k.GetEpochReserveRefills(ctx).Add(amount))
+}
+
+// GetEpochReserveDrains returns the note sent from the market vault back to the reserve during the current epoch
+func (k Keeper) GetEpochReserveDrains(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReserveDrainsKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+// SetEpochReserveDrains sets the note sent from the market vault back to the reserve during the current epoch
+func (k Keeper) SetEpochReserveDrains(ctx sdk.Context, drains sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: drains})
+	store.Set(types.ReserveDrainsKey, bz)
+}
+
+// RecordReserveDrain adds a drain of the market vault surplus to the reserve drains of the current epoch
+func (k Keeper) RecordReserveDrain(ctx sdk.Context, amount sdk.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	k.SetEpochReserveDrains(ctx, k.GetEpochReserveDrains(ctx).Add(amount))
+}
+
+// GetEpochInitialExchangePool returns the market vault balance at the start of the current epoch
+func (k Keeper) GetEpochInitialExchangePool(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochInitialExchangePoolKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+// SetEpochInitialExchangePool sets the market vault balance at the start of the current epoch
+func (k Keeper) SetEpochInitialExchangePool(ctx sdk.Context, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(types.EpochInitialExchangePoolKey, bz)
+}
+
+// GetEpochSeigniorage returns the note the market vault took in through swaps during the current epoch.
+// Refills from the reserve and drains back to it are not seigniorage and are netted out; the result is negative
+// when more stable coins were redeemed than issued.
+func (k Keeper) GetEpochSeigniorage(ctx sdk.Context) sdk.Int {
+	exchangePool := k.marketKeeper.GetExchangePoolBalance(ctx).Amount
+	return exchangePool.Sub(k.GetEpochInitialExchangePool(ctx)).Sub(k.GetEpochReserveRefills(ctx)).Add(k.GetEpochReserveDrains(ctx))
+}
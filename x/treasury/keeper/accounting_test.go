@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestReserveRefills(t *testing.T) {
+	input := CreateTestInput(t)
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+	require.NoError(t, err)
+
+	refillAmount, err := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+	require.NoError(t, err)
+	require.True(t, refillAmount.IsPositive())
+	require.Equal(t, refillAmount, input.TreasuryKeeper.GetEpochReserveRefills(input.Ctx))
+
+	// refills are not seigniorage
+	require.True(t, input.TreasuryKeeper.GetEpochSeigniorage(input.Ctx).IsZero())
+
+	state := input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+	require.Equal(t, refillAmount, state.ReserveRefills)
+	require.Equal(t, input.TreasuryKeeper.GetReservePoolBalance(input.Ctx).Amount, state.ReserveBalance)
+	require.True(t, input.TreasuryKeeper.GetEpochReserveRefills(input.Ctx).IsZero())
+}
+
+func TestEpochSeigniorage(t *testing.T) {
+	input := CreateTestInput(t)
+	input.TreasuryKeeper.SetEpochInitialExchangePool(input.Ctx, input.MarketKeeper.GetExchangePoolBalance(input.Ctx).Amount)
+
+	// melody taken in by the market vault through swaps
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000), input.TreasuryKeeper.GetEpochSeigniorage(input.Ctx))
+
+	state := input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+	require.Equal(t, sdk.NewInt(1000), state.Seigniorage)
+
+	// melody paid out by the market vault for redeemed stable coins
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, markettypes.ModuleName, faucetAccountName, sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 400)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(-400), input.TreasuryKeeper.GetEpochSeigniorage(input.Ctx))
+}
+
+func TestReserveDrains(t *testing.T) {
+	input := CreateTestInput(t)
+	input.TreasuryKeeper.SetEpochInitialExchangePool(input.Ctx, input.MarketKeeper.GetExchangePoolBalance(input.Ctx).Amount)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.DrainSurplus = true
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	seigniorage := exchangeRequirement.MulInt64(2).TruncateInt()
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, seigniorage)))
+	require.NoError(t, err)
+
+	drainAmount, err := input.TreasuryKeeper.DrainExchangePool(input.Ctx)
+	require.NoError(t, err)
+	require.True(t, drainAmount.IsPositive())
+
+	// drains are not seigniorage
+	require.Equal(t, seigniorage, input.TreasuryKeeper.GetEpochSeigniorage(input.Ctx))
+
+	state := input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+	require.Equal(t, drainAmount, state.ReserveDrains)
+	require.True(t, input.TreasuryKeeper.GetEpochReserveDrains(input.Ctx).IsZero())
+}
@@ -16,3 +16,8 @@ func (k Keeper) GetTreasuryModuleAccount(ctx sdk.Context) authtypes.ModuleAccoun
 func (k Keeper) GetReservePoolBalance(ctx sdk.Context) sdk.Coin {
 	return k.BankKeeper.GetBalance(ctx, k.GetTreasuryModuleAccount(ctx).GetAddress(), appparams.BaseCoinUnit)
 }
+
+// GetExchangePoolBalance returns the amount of Melody in the market vault.
+func (k Keeper) GetExchangePoolBalance(ctx sdk.Context) sdk.Coin {
+	return k.marketKeeper.GetExchangePoolBalance(ctx)
+}
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+// GetZone returns the tax exemption zone of the name and whether it exists
+func (k Keeper) GetZone(ctx sdk.Context, name string) (types.Zone, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetZoneKey(name))
+	if bz == nil {
+		return types.Zone{}, false
+	}
+
+	zone := types.Zone{}
+	k.cdc.MustUnmarshal(bz, &zone)
+	return zone, true
+}
+
+// SetZone creates or updates a tax exemption zone
+func (k Keeper) SetZone(ctx sdk.Context, zone types.Zone) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&zone)
+	store.Set(types.GetZoneKey(zone.Name), bz)
+}
+
+// RemoveZone removes a tax exemption zone together with all of its addresses
+func (k Keeper) RemoveZone(ctx sdk.Context, name string) error {
+	if _, ok := k.GetZone(ctx, name); !ok {
+		return errorsmod.Wrap(types.ErrNoSuchTaxExemptionZone, name)
+	}
+
+	var addresses []sdk.AccAddress
+	k.IterateTaxExemptions(ctx, func(exemption types.TaxExemption) (stop bool) {
+		if exemption.Zone == name {
+			addresses = append(addresses, sdk.MustAccAddressFromBech32(exemption.Address))
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addresses {
+		store.Delete(types.GetTaxExemptionKey(addr))
+	}
+	store.Delete(types.GetZoneKey(name))
+	return nil
+}
+
+// IterateZones iterates over the tax exemption zones in the store
+func (k Keeper) IterateZones(ctx sdk.Context, handler func(zone types.Zone) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ZoneKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		zone := types.Zone{}
+		k.cdc.MustUnmarshal(iter.Value(), &zone)
+		if handler(zone) {
+			break
+		}
+	}
+}
+
+// GetZones returns all tax exemption zones
+func (k Keeper) GetZones(ctx sdk.Context) []types.Zone {
+	zones := []types.Zone{}
+	k.IterateZones(ctx, func(zone types.Zone) (stop bool) {
+		zones = append(zones, zone)
+		return false
+	})
+	return zones
+}
+
+// GetTaxExemptionZone returns the tax exemption zone the address belongs to and whether it belongs to one
+func (k Keeper) GetTaxExemptionZone(ctx sdk.Context, addr sdk.AccAddress) (types.Zone, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxExemptionKey(addr))
+	if bz == nil {
+		return types.Zone{}, false
+	}
+
+	return k.GetZone(ctx, string(bz))
+}
+
+// AddTaxExemptionAddress adds the address to an existing tax exemption zone
+func (k Keeper) AddTaxExemptionAddress(ctx sdk.Context, zoneName string, address string) error {
+	if _, ok := k.GetZone(ctx, zoneName); !ok {
+		return errorsmod.Wrap(types.ErrNoSuchTaxExemptionZone, zoneName)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	if zone, ok := k.GetTaxExemptionZone(ctx, addr); ok && zone.Name != zoneName {
+		return errorsmod.Wrapf(types.ErrTaxExemptionAddressInZone, "%s is in %s", address, zone.Name)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTaxExemptionKey(addr), []byte(zoneName))
+	return nil
+}
+
+// RemoveTaxExemptionAddress removes the address from its tax exemption zone
+func (k Keeper) RemoveTaxExemptionAddress(ctx sdk.Context, address string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTaxExemptionKey(addr)
+	if !store.Has(key) {
+		return errorsmod.Wrap(types.ErrNoSuchTaxExemptionAddress, address)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IterateTaxExemptions iterates over the tax exemption addresses in the store
+func (k Keeper) IterateTaxExemptions(ctx sdk.Context, handler func(exemption types.TaxExemption) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxExemptionKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Key()[len(types.TaxExemptionKey):])
+		if handler(types.NewTaxExemption(string(iter.Value()), addr.String())) {
+			break
+		}
+	}
+}
+
+// GetTaxExemptions returns all tax exemption addresses with their zones
+func (k Keeper) GetTaxExemptions(ctx sdk.Context) []types.TaxExemption {
+	exemptions := []types.TaxExemption{}
+	k.IterateTaxExemptions(ctx, func(exemption types.TaxExemption) (stop bool) {
+		exemptions = append(exemptions, exemption)
+		return false
+	})
+	return exemptions
+}
+
+// IsExemptedFromTax returns whether a transfer from the sender to all of the recipients is exempt from the stability tax.
+// A transfer is exempt when both ends are in the same zone, the sender's zone exempts outgoing transfers
+// or the recipient's zone exempts incoming transfers. Without recipients only the sender's zone is consulted.
+func (k Keeper) IsExemptedFromTax(ctx sdk.Context, senderAddr string, recipientAddrs ...string) bool {
+	sender, err := sdk.AccAddressFromBech32(senderAddr)
+	if err != nil {
+		return false
+	}
+
+	senderZone, senderExempt := k.GetTaxExemptionZone(ctx, sender)
+	if senderExempt && senderZone.Outgoing {
+		return true
+	}
+	if len(recipientAddrs) == 0 {
+		return false
+	}
+
+	for _, recipientAddr := range recipientAddrs {
+		recipient, err := sdk.AccAddressFromBech32(recipientAddr)
+		if err != nil {
+			return false
+		}
+
+		recipientZone, recipientExempt := k.GetTaxExemptionZone(ctx, recipient)
+		if !recipientExempt {
+			return false
+		}
+		if recipientZone.Incoming {
+			continue
+		}
+		if !senderExempt || senderZone.Name != recipientZone.Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddTaxExemptionRoute exempts the message route from the stability tax
+func (k Keeper) AddTaxExemptionRoute(ctx sdk.Context, route string) error {
+	if err := types.ValidateTaxExemptionRoute(route); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTaxExemptionRouteKey(route), []byte{})
+	return nil
+}
+
+// RemoveTaxExemptionRoute removes the message route from the tax exemption routes
+func (k Keeper) RemoveTaxExemptionRoute(ctx sdk.Context, route string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTaxExemptionRouteKey(route)
+	if !store.Has(key) {
+		return errorsmod.Wrap(types.ErrNoSuchTaxExemptionRoute, route)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IsExemptedRoute returns whether messages of the route are exempt from the stability tax
+func (k Keeper) IsExemptedRoute(ctx sdk.Context, route string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetTaxExemptionRouteKey(route))
+}
+
+// IterateTaxExemptionRoutes iterates over the tax exemption routes in the store
+func (k Keeper) IterateTaxExemptionRoutes(ctx sdk.Context, handler func(route string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxExemptionRouteKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if handler(string(iter.Key()[len(types.TaxExemptionRouteKey):])) {
+			break
+		}
+	}
+}
+
+// GetTaxExemptionRoutes returns all tax exemption routes
+func (k Keeper) GetTaxExemptionRoutes(ctx sdk.Context) []string {
+	routes := []string{}
+	k.IterateTaxExemptionRoutes(ctx, func(route string) (stop bool) {
+		routes = append(routes, route)
+		return false
+	})
+	return routes
+}
+
+// HandleAddTaxExemptionZoneProposal creates or updates the proposed zone and adds the proposed addresses to it
+func (k Keeper) HandleAddTaxExemptionZoneProposal(ctx sdk.Context, p *types.AddTaxExemptionZoneProposal) error {
+	if err := p.Zone.Validate(); err != nil {
+		return err
+	}
+
+	k.SetZone(ctx, p.Zone)
+	for _, address := range p.Addresses {
+		if err := k.AddTaxExemptionAddress(ctx, p.Zone.Name, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleRemoveTaxExemptionZoneProposal removes the proposed zone together with all of its addresses
+func (k Keeper) HandleRemoveTaxExemptionZoneProposal(ctx sdk.Context, p *types.RemoveTaxExemptionZoneProposal) error {
+	return k.RemoveZone(ctx, p.Zone)
+}
+
+// HandleAddTaxExemptionAddressProposal adds the proposed addresses to an existing zone
+func (k Keeper) HandleAddTaxExemptionAddressProposal(ctx sdk.Context, p *types.AddTaxExemptionAddressProposal) error {
+	for _, address := range p.Addresses {
+		if err := k.AddTaxExemptionAddress(ctx, p.Zone, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleRemoveTaxExemptionAddressProposal removes the proposed addresses from their zones
+func (k Keeper) HandleRemoveTaxExemptionAddressProposal(ctx sdk.Context, p *types.RemoveTaxExemptionAddressProposal) error {
+	for _, address := range p.Addresses {
+		if err := k.RemoveTaxExemptionAddress(ctx, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleAddTaxExemptionRouteProposal exempts the proposed routes from the stability tax
+func (k Keeper) HandleAddTaxExemptionRouteProposal(ctx sdk.Context, p *types.AddTaxExemptionRouteProposal) error {
+	for _, route := range p.Routes {
+		if err := k.AddTaxExemptionRoute(ctx, route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleRemoveTaxExemptionRouteProposal removes the proposed routes from the tax exemption routes
+func (k Keeper) HandleRemoveTaxExemptionRouteProposal(ctx sdk.Context, p *types.RemoveTaxExemptionRouteProposal) error {
+	for _, route := range p.Routes {
+		if err := k.RemoveTaxExemptionRoute(ctx, route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
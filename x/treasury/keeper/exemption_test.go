@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestTaxExemptionZones(t *testing.T) {
+	input := CreateTestInput(t)
+
+	custody := types.NewZone("custody", false, false)
+	input.TreasuryKeeper.SetZone(input.Ctx, custody)
+
+	// unknown zone
+	require.ErrorIs(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "vault", Addrs[0].String()), types.ErrNoSuchTaxExemptionZone)
+
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, custody.Name, Addrs[0].String()))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, custody.Name, Addrs[1].String()))
+
+	zone, ok := input.TreasuryKeeper.GetTaxExemptionZone(input.Ctx, Addrs[0])
+	require.True(t, ok)
+	require.Equal(t, custody, zone)
+
+	// an address belongs to at most one zone
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("vault", false, true))
+	require.ErrorIs(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "vault", Addrs[0].String()), types.ErrTaxExemptionAddressInZone)
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "vault", Addrs[2].String()))
+	require.Len(t, input.TreasuryKeeper.GetTaxExemptions(input.Ctx), 3)
+
+	require.NoError(t, input.TreasuryKeeper.RemoveTaxExemptionAddress(input.Ctx, Addrs[1].String()))
+	require.ErrorIs(t, input.TreasuryKeeper.RemoveTaxExemptionAddress(input.Ctx, Addrs[1].String()), types.ErrNoSuchTaxExemptionAddress)
+
+	// removing a zone removes its addresses
+	require.NoError(t, input.TreasuryKeeper.RemoveZone(input.Ctx, custody.Name))
+	_, ok = input.TreasuryKeeper.GetTaxExemptionZone(input.Ctx, Addrs[0])
+	require.False(t, ok)
+	require.Equal(t, []types.Zone{types.NewZone("vault", false, true)}, input.TreasuryKeeper.GetZones(input.Ctx))
+	require.Equal(t, []types.TaxExemption{types.NewTaxExemption("vault", Addrs[2].String())}, input.TreasuryKeeper.GetTaxExemptions(input.Ctx))
+	require.ErrorIs(t, input.TreasuryKeeper.RemoveZone(input.Ctx, custody.Name), types.ErrNoSuchTaxExemptionZone)
+}
+
+func TestIsExemptedFromTax(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("custody", false, false))
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("exchange", false, false))
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("vault", false, true))
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("treasury", true, false))
+
+	addr := func(i byte) string { return sdk.AccAddress(bytes.Repeat([]byte{i}, 20)).String() }
+	custody0, custody1, exchange, vault, treasury, normal := addr(1), addr(2), addr(3), addr(4), addr(5), addr(6)
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "custody", custody0))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "custody", custody1))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "exchange", exchange))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "vault", vault))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "treasury", treasury))
+
+	tests := []struct {
+		name       string
+		sender     string
+		recipients []string
+		exempt     bool
+	}{
+		{"same zone", custody0, []string{custody1}, true},
+		{"different zones", custody0, []string{exchange}, false},
+		{"zone to normal", custody0, []string{normal}, false},
+		{"normal to zone", normal, []string{custody0}, false},
+		{"normal to normal", normal, []string{normal}, false},
+		{"incoming zone", normal, []string{vault}, true},
+		{"outgoing zone", treasury, []string{normal}, true},
+		{"outgoing zone without recipients", treasury, nil, true},
+		{"zone without recipients", custody0, nil, false},
+		{"all recipients exempt", custody0, []string{custody1, vault}, true},
+		{"one recipient not exempt", custody0, []string{custody1, normal}, false},
+		{"invalid recipient", custody0, []string{"invalid"}, false},
+		{"invalid sender", "invalid", []string{vault}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exempt, input.TreasuryKeeper.IsExemptedFromTax(input.Ctx, tc.sender, tc.recipients...))
+		})
+	}
+}
+
+func TestHandleTaxExemptionProposals(t *testing.T) {
+	input := CreateTestInput(t)
+	zone := types.NewZone("custody", false, false)
+
+	addZone := types.NewAddTaxExemptionZoneProposal("title", "description", zone, []string{Addrs[0].String(), Addrs[1].String()})
+	require.NoError(t, input.TreasuryKeeper.HandleAddTaxExemptionZoneProposal(input.Ctx, &addZone))
+	require.True(t, input.TreasuryKeeper.IsExemptedFromTax(input.Ctx, Addrs[0].String(), Addrs[1].String()))
+
+	// re-adding the zone updates its flags
+	zone.Outgoing = true
+	addZone = types.NewAddTaxExemptionZoneProposal("title", "description", zone, nil)
+	require.NoError(t, input.TreasuryKeeper.HandleAddTaxExemptionZoneProposal(input.Ctx, &addZone))
+	require.True(t, input.TreasuryKeeper.IsExemptedFromTax(input.Ctx, Addrs[0].String(), Addrs[2].String()))
+
+	addAddress := types.NewAddTaxExemptionAddressProposal("title", "description", zone.Name, []string{Addrs[2].String()})
+	require.NoError(t, input.TreasuryKeeper.HandleAddTaxExemptionAddressProposal(input.Ctx, &addAddress))
+	require.Len(t, input.TreasuryKeeper.GetTaxExemptions(input.Ctx), 3)
+
+	removeAddress := types.NewRemoveTaxExemptionAddressProposal("title", "description", []string{Addrs[2].String()})
+	require.NoError(t, input.TreasuryKeeper.HandleRemoveTaxExemptionAddressProposal(input.Ctx, &removeAddress))
+	require.Len(t, input.TreasuryKeeper.GetTaxExemptions(input.Ctx), 2)
+
+	removeZone := types.NewRemoveTaxExemptionZoneProposal("title", "description", zone.Name)
+	require.NoError(t, input.TreasuryKeeper.HandleRemoveTaxExemptionZoneProposal(input.Ctx, &removeZone))
+	require.Empty(t, input.TreasuryKeeper.GetZones(input.Ctx))
+	require.Empty(t, input.TreasuryKeeper.GetTaxExemptions(input.Ctx))
+
+	// adding addresses to a removed zone fails
+	require.Error(t, input.TreasuryKeeper.HandleAddTaxExemptionAddressProposal(input.Ctx, &addAddress))
+}
+
+func TestHandleTaxExemptionRouteProposals(t *testing.T) {
+	input := CreateTestInput(t)
+	swapSend := "/osmosis.market.v1beta1.MsgSwapSend"
+	execute := types.ContractTaxExemptionRoute("/cosmwasm.wasm.v1.MsgExecuteContract", Addrs[0].String())
+
+	addRoute := types.NewAddTaxExemptionRouteProposal("title", "description", []string{swapSend, execute})
+	require.NoError(t, input.TreasuryKeeper.HandleAddTaxExemptionRouteProposal(input.Ctx, &addRoute))
+	require.True(t, input.TreasuryKeeper.IsExemptedRoute(input.Ctx, swapSend))
+	require.True(t, input.TreasuryKeeper.IsExemptedRoute(input.Ctx, execute))
+	require.False(t, input.TreasuryKeeper.IsExemptedRoute(input.Ctx, "/cosmwasm.wasm.v1.MsgExecuteContract"))
+	require.ElementsMatch(t, []string{swapSend, execute}, input.TreasuryKeeper.GetTaxExemptionRoutes(input.Ctx))
+
+	removeRoute := types.NewRemoveTaxExemptionRouteProposal("title", "description", []string{swapSend})
+	require.NoError(t, input.TreasuryKeeper.HandleRemoveTaxExemptionRouteProposal(input.Ctx, &removeRoute))
+	require.False(t, input.TreasuryKeeper.IsExemptedRoute(input.Ctx, swapSend))
+	require.Equal(t, []string{execute}, input.TreasuryKeeper.GetTaxExemptionRoutes(input.Ctx))
+
+	// removing a route that is not exempted fails
+	require.ErrorIs(t, input.TreasuryKeeper.HandleRemoveTaxExemptionRouteProposal(input.Ctx, &removeRoute), types.ErrNoSuchTaxExemptionRoute)
+}
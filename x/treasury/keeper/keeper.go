@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -10,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
 	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
@@ -25,6 +25,8 @@ type Keeper struct {
 	BankKeeper    types.BankKeeper
 	marketKeeper  types.MarketKeeper
 	oracleKeeper  types.OracleKeeper
+	txFeesKeeper  types.TxFeesKeeper
+	poolManager   types.PoolManagerKeeper
 }
 
 // NewKeeper creates a new treasury Keeper instance
@@ -36,6 +38,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	marketKeeper types.MarketKeeper,
 	oracleKeeper types.OracleKeeper,
+	txFeesKeeper types.TxFeesKeeper,
+	poolManager types.PoolManagerKeeper,
 ) Keeper {
 	// ensure treasury module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -55,6 +59,8 @@ func NewKeeper(
 		BankKeeper:    bankKeeper,
 		marketKeeper:  marketKeeper,
 		oracleKeeper:  oracleKeeper,
+		txFeesKeeper:  txFeesKeeper,
+		poolManager:   poolManager,
 	}
 }
 
@@ -83,50 +89,152 @@ func (k Keeper) SetTaxRate(ctx sdk.Context, taxRate sdk.Dec) {
 	store.Set(types.TaxRateKey, b)
 }
 
-// RefillExchangePool sends coins from the treasury module account to the market module account whenever there is a need to
-// refill it. It returns the number of coins sent to the market module account.
-func (k Keeper) RefillExchangePool(ctx sdk.Context) sdk.Dec {
+// RefillExchangePool sends coins from the treasury module account to the market module account whenever the market vault
+// falls short of the exchange requirement by more than ReserveAllowableOffset percent, moving at most MaxRefillPerPeriod.
+// The denoms winding down don't count towards the requirement, and the reserve earmarked for their redemption is kept.
+// It returns the number of coins sent to the market module account.
+func (k Keeper) RefillExchangePool(ctx sdk.Context) (sdk.Int, error) {
 	exchangeAmount := k.marketKeeper.GetExchangePoolBalance(ctx).Amount.ToLegacyDec()
-	reserveAmount := k.GetReservePoolBalance(ctx).Amount.ToLegacyDec()
-	exchangeRequirement := k.marketKeeper.GetExchangeRequirement(ctx)
-
-	if exchangeAmount.LT(exchangeRequirement) {
-		params := k.GetParams(ctx)
-		percentMissing := 100 - (exchangeAmount.Quo(exchangeRequirement).Mul(sdk.NewDec(100))).TruncateInt64()
-		if sdk.NewDec(percentMissing).GT(params.ReserveAllowableOffset) {
-			refillAmount := sdk.MinDec(reserveAmount, exchangeRequirement.Sub(exchangeAmount))
-			if refillAmount.IsPositive() {
-				err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, markettypes.ModuleName,
-					sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, refillAmount.TruncateInt())))
-				if err != nil {
-					panic(err)
-				}
+	reserveAmount := k.getAvailableReserve(ctx)
+	exchangeRequirement := k.getBackedExchangeRequirement(ctx)
+
+	if !exchangeAmount.LT(exchangeRequirement) {
+		return sdk.ZeroInt(), nil
+	}
+
+	params := k.GetParams(ctx)
+	percentMissing := 100 - (exchangeAmount.Quo(exchangeRequirement).Mul(sdk.NewDec(100))).TruncateInt64()
+	if !sdk.NewDec(percentMissing).GT(params.ReserveAllowableOffset) {
+		return sdk.ZeroInt(), nil
+	}
+
+	refillAmount := sdk.MinInt(reserveAmount, exchangeRequirement.Sub(exchangeAmount).TruncateInt())
+	refillAmount = sdk.MinInt(refillAmount, params.MaxRefillPerPeriod)
+	if !refillAmount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, markettypes.ModuleName,
+		sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, refillAmount)))
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	k.RecordReserveRefill(ctx, refillAmount)
+	return refillAmount, nil
+}
+
+// DrainExchangePool sends the market vault balance above the exchange requirement back to the treasury module account
+// whenever DrainSurplus is enabled and the surplus exceeds ReserveAllowableOffset percent of the requirement, moving at
+// most MaxRefillPerPeriod. The denoms winding down don't count towards the requirement, since the reserve pays them out.
+// It returns the number of coins sent to the treasury module account.
+func (k Keeper) DrainExchangePool(ctx sdk.Context) (sdk.Int, error) {
+	params := k.GetParams(ctx)
+	if !params.DrainSurplus {
+		return sdk.ZeroInt(), nil
+	}
+
+	// the requirement is zero while there are no exchange rates, which doesn't mean the vault is over-collateralized
+	exchangeRequirement := k.getBackedExchangeRequirement(ctx)
+	if !exchangeRequirement.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	surplus := k.marketKeeper.GetExchangePoolBalance(ctx).Amount.ToLegacyDec().Sub(exchangeRequirement)
+	if !surplus.GT(exchangeRequirement.Mul(params.ReserveAllowableOffset).QuoInt64(100)) {
+		return sdk.ZeroInt(), nil
+	}
+
+	drainAmount := sdk.MinInt(surplus.TruncateInt(), params.MaxRefillPerPeriod)
+	err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, markettypes.ModuleName, types.ModuleName,
+		sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, drainAmount)))
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	k.RecordReserveDrain(ctx, drainAmount)
+	return drainAmount, nil
+}
+
+// SwapTaxProceeds converts the stability tax withheld in-kind into reserve. The denoms on the oracle whitelist are
+// converted at the oracle exchange rate, without spread: the withheld coins are burnt, and the note backing them in the
+// market vault is released to the reserve and recorded with the reserve drains. The coins of a denom winding down are
+// only burnt, since the reserve already earmarks their redemption. The other denoms, withheld at their fee token pool
+// price, are swapped into note through that pool. The proceeds that can't be converted, e.g. without oracle exchange
+// rate, are kept for a later attempt and reported with an event. It returns the note added to the reserve.
+func (k Keeper) SwapTaxProceeds(ctx sdk.Context) sdk.Coin {
+	swapped := sdk.NewCoin(appparams.BaseCoinUnit, sdk.ZeroInt())
+	for _, coin := range k.GetWithheldTaxProceeds(ctx) {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			released, err := k.convertTaxProceeds(cacheCtx, coin)
+			if err != nil {
+				return err
 			}
-			return refillAmount
+			swapped = swapped.Add(released)
+			return nil
+		})
+		if err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeTaxProceedsSwapFailed,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyCoin, coin.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
 		}
 	}
-	return sdk.ZeroDec()
+
+	return swapped
 }
 
-// UpdateReserveFee updates the ReserveFeeMultiplier based on the current reserve balance and requirement.
-// If reserve is insufficient, the fee multiplier is increased based on the percentage difference.
-func (k Keeper) UpdateReserveFee(ctx sdk.Context) sdk.Dec {
-	currentTaxRate := k.GetTaxRate(ctx)
-	newTaxRate := sdk.ZeroDec()
-	reserveAmount := k.GetReservePoolBalance(ctx).Amount.ToLegacyDec()
-	exchangeRequirement := k.marketKeeper.GetExchangeRequirement(ctx)
-	if reserveAmount.LT(exchangeRequirement) {
-		params := k.GetParams(ctx)
-		percentMissing := 100 - (reserveAmount.Quo(exchangeRequirement).Mul(sdk.NewDec(100))).TruncateInt64()
-		if sdk.NewDec(percentMissing).GT(params.ReserveAllowableOffset) {
-			// Determine the power of 2 that the percentMissing falls beneath
-			powerOf2 := uint64(math.Log2(float64(percentMissing)))
-			newTaxRate = sdk.MinDec(params.MaxFeeMultiplier, currentTaxRate.Mul(sdk.NewDec(2).Power(powerOf2+1)))
-		} else {
-			// Double the base fee to fill the remaining difference
-			newTaxRate = currentTaxRate.Mul(sdk.NewDec(2))
+// convertTaxProceeds burns the tax withheld in a denom and releases its backing from the market vault to the reserve,
+// or swaps it into reserve through its fee token pool when the oracle doesn't price the denom
+func (k Keeper) convertTaxProceeds(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error) {
+	if !k.oracleKeeper.Whitelist(ctx).Contains(coin.Denom) {
+		return k.swapTaxProceedsThroughPool(ctx, coin)
+	}
+
+	released := sdk.NewCoin(appparams.BaseCoinUnit, sdk.ZeroInt())
+	if _, found := k.oracleKeeper.GetWindDown(ctx, coin.Denom); !found {
+		askCoin, err := k.marketKeeper.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(coin), appparams.BaseCoinUnit)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		released.Amount = askCoin.Amount.TruncateInt()
+	}
+
+	if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if released.IsPositive() {
+		err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, markettypes.ModuleName, types.ModuleName, sdk.NewCoins(released))
+		if err != nil {
+			return sdk.Coin{}, err
 		}
+		k.RecordReserveDrain(ctx, released.Amount)
 	}
-	k.SetTaxRate(ctx, newTaxRate)
-	return newTaxRate
+
+	k.setWithheldTaxProceedsOfDenom(ctx, coin.Denom, sdk.ZeroInt())
+	return released, nil
+}
+
+// swapTaxProceedsThroughPool swaps the tax withheld in a denom into note through the fee token pool it was priced by.
+// The note received stays in the treasury account, as reserve.
+func (k Keeper) swapTaxProceedsThroughPool(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error) {
+	feeToken, err := k.txFeesKeeper.GetFeeToken(ctx, coin.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Like the non-native fees swept by x/txfees, the swap allows full slippage: the fee token pool is
+	// required to be liquid for the denom to be accepted as fee.
+	treasuryAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	amountOut, err := k.poolManager.SwapExactAmountInNoTakerFee(ctx, treasuryAddr, feeToken.PoolID, coin, appparams.BaseCoinUnit, sdk.ZeroInt())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.setWithheldTaxProceedsOfDenom(ctx, coin.Denom, sdk.ZeroInt())
+	return sdk.NewCoin(appparams.BaseCoinUnit, amountOut), nil
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
 	"testing"
@@ -32,28 +33,71 @@ func TestParams(t *testing.T) {
 	require.Equal(t, defaultParams, retrievedParams)
 }
 
-// TestKeeper_UpdateReserveFee tests updating of reserve fee. If the reserve is full, it has to be zero. If the reserve is empty, it has to set non-zero tax rate.
-func TestKeeper_UpdateReserveFee(t *testing.T) {
+// TestKeeper_UpdateTaxPolicy tests updating of the tax rate. If the reserve is short, it has to rise. If the reserve is full,
+// it has to follow the tax proceeds. Each update is limited to MaxTaxRateChange.
+func TestKeeper_UpdateTaxPolicy(t *testing.T) {
 	t.Run("reserve is empty", func(t *testing.T) {
 		input := CreateTestInput(t)
+		params := input.TreasuryKeeper.GetParams(input.Ctx)
+		taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
 
-		// Update the reserve
-		newTaxRate := input.TreasuryKeeper.UpdateReserveFee(input.Ctx)
-		require.True(t, newTaxRate.GT(sdk.ZeroDec()), "reserve is empty so we should apply the tax rate")
-		require.True(t, newTaxRate.LTE(input.TreasuryKeeper.GetParams(input.Ctx).MaxFeeMultiplier))
+		input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+		input.TreasuryKeeper.SetEpoch(input.Ctx, 1)
+
+		newTaxRate := input.TreasuryKeeper.UpdateTaxPolicy(input.Ctx)
+		require.Equal(t, taxRate.Add(params.MaxTaxRateChange), newTaxRate, "reserve is empty so the tax rate has to rise")
+		require.Equal(t, newTaxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
 	})
 	t.Run("reserve is full", func(t *testing.T) {
 		input := CreateTestInput(t)
+		taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
 
 		exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
 		require.True(t, exchangeRequirement.GT(sdk.ZeroDec()))
 
-		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.Ceil().TruncateInt())))
 		require.NoError(t, err)
 
-		// Update the reserve
-		newTaxRate := input.TreasuryKeeper.UpdateReserveFee(input.Ctx)
-		require.True(t, newTaxRate.IsZero(), "has to be zero since reserve is full")
+		input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+		input.TreasuryKeeper.SetEpoch(input.Ctx, 1)
+
+		newTaxRate := input.TreasuryKeeper.UpdateTaxPolicy(input.Ctx)
+		require.Equal(t, taxRate, newTaxRate, "without tax proceeds the tax rate has to stay")
+	})
+	t.Run("tax proceeds outperform the long window", func(t *testing.T) {
+		input := CreateTestInput(t)
+		params := input.TreasuryKeeper.GetParams(input.Ctx)
+		taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+
+		for epoch := uint64(0); epoch < 14; epoch++ {
+			taxProceeds := sdk.NewInt(100)
+			if epoch >= 10 {
+				taxProceeds = sdk.NewInt(200)
+			}
+			input.TreasuryKeeper.SetEpochState(input.Ctx, types.NewEpochState(epoch, taxProceeds, sdk.OneDec(), taxRate))
+		}
+		input.TreasuryKeeper.SetEpoch(input.Ctx, 14)
+
+		newTaxRate := input.TreasuryKeeper.UpdateTaxPolicy(input.Ctx)
+		require.Equal(t, taxRate.Sub(params.MaxTaxRateChange), newTaxRate, "tax rate has to fall by the max change")
+	})
+	t.Run("tax proceeds fall behind the long window", func(t *testing.T) {
+		input := CreateTestInput(t)
+		taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+
+		for epoch := uint64(0); epoch < 14; epoch++ {
+			taxProceeds := sdk.NewInt(100)
+			if epoch >= 10 {
+				taxProceeds = sdk.NewInt(90)
+			}
+			input.TreasuryKeeper.SetEpochState(input.Ctx, types.NewEpochState(epoch, taxProceeds, sdk.OneDec(), taxRate))
+		}
+		input.TreasuryKeeper.SetEpoch(input.Ctx, 14)
+
+		// long window average is (10*100 + 4*90) / 14, short window average is 90
+		expected := taxRate.Mul(sdk.NewDec(1360).QuoInt64(14)).QuoInt64(90)
+		newTaxRate := input.TreasuryKeeper.UpdateTaxPolicy(input.Ctx)
+		require.Equal(t, expected, newTaxRate)
 	})
 }
 
@@ -69,7 +113,8 @@ func TestKeeper_RefillExchangePool(t *testing.T) {
 		err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
 		require.NoError(t, err)
 
-		refillAmount := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+		refillAmount, err := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+		require.NoError(t, err)
 		require.True(t, refillAmount.IsZero())
 	})
 	t.Run("exchange is pool is under threshold", func(t *testing.T) {
@@ -85,7 +130,8 @@ func TestKeeper_RefillExchangePool(t *testing.T) {
 		err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, fillValue)))
 		require.NoError(t, err)
 
-		refillAmount := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+		refillAmount, err := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+		require.NoError(t, err)
 		require.True(t, refillAmount.IsZero())
 	})
 	t.Run("exchange pool needs a refill", func(t *testing.T) {
@@ -95,9 +141,162 @@ func TestKeeper_RefillExchangePool(t *testing.T) {
 		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
 		require.NoError(t, err)
 
+		params := input.TreasuryKeeper.GetParams(input.Ctx)
+		params.MaxRefillPerPeriod = exchangeRequirement.TruncateInt()
+		input.TreasuryKeeper.SetParams(input.Ctx, params)
+
 		// since exchange pool is empty we will refill for full amount of reserve.
-		refillAmount := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
-		require.Equal(t, exchangeRequirement, refillAmount, "exchange pool should be refilled for full amount of reserve")
+		refillAmount, err := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, exchangeRequirement.TruncateInt(), refillAmount, "exchange pool should be refilled for full amount of reserve")
+	})
+	t.Run("refill is capped per period", func(t *testing.T) {
+		input := CreateTestInput(t)
+
+		exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+		require.NoError(t, err)
+
+		params := input.TreasuryKeeper.GetParams(input.Ctx)
+		params.MaxRefillPerPeriod = exchangeRequirement.QuoInt64(4).TruncateInt()
+		input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+		refillAmount, err := input.TreasuryKeeper.RefillExchangePool(input.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, params.MaxRefillPerPeriod, refillAmount)
+		require.Equal(t, params.MaxRefillPerPeriod, input.MarketKeeper.GetExchangePoolBalance(input.Ctx).Amount)
 	})
+}
+
+// TestKeeper_DrainExchangePool tests that the surplus of an over-collateralized exchange pool is drained to the reserve.
+func TestKeeper_DrainExchangePool(t *testing.T) {
+	t.Run("drain is disabled", func(t *testing.T) {
+		input := CreateTestInput(t)
+
+		exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.MulInt64(2).TruncateInt())))
+		require.NoError(t, err)
+
+		drainAmount, err := input.TreasuryKeeper.DrainExchangePool(input.Ctx)
+		require.NoError(t, err)
+		require.True(t, drainAmount.IsZero())
+	})
+	t.Run("surplus is under threshold", func(t *testing.T) {
+		input := CreateTestInput(t)
 
+		params := input.TreasuryKeeper.GetParams(input.Ctx)
+		params.DrainSurplus = true
+		input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+		exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+		fillValue := exchangeRequirement.Mul(sdk.NewDec(100).Add(params.ReserveAllowableOffset).QuoInt64(100)).TruncateInt()
+		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, fillValue)))
+		require.NoError(t, err)
+
+		drainAmount, err := input.TreasuryKeeper.DrainExchangePool(input.Ctx)
+		require.NoError(t, err)
+		require.True(t, drainAmount.IsZero())
+	})
+	t.Run("exchange pool has a surplus", func(t *testing.T) {
+		input := CreateTestInput(t)
+
+		exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+		params := input.TreasuryKeeper.GetParams(input.Ctx)
+		params.DrainSurplus = true
+		params.MaxRefillPerPeriod = exchangeRequirement.TruncateInt()
+		input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.MulInt64(2).TruncateInt())))
+		require.NoError(t, err)
+
+		reserveBefore := input.TreasuryKeeper.GetReservePoolBalance(input.Ctx).Amount
+		drainAmount, err := input.TreasuryKeeper.DrainExchangePool(input.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, exchangeRequirement.TruncateInt(), drainAmount)
+		require.Equal(t, reserveBefore.Add(drainAmount), input.TreasuryKeeper.GetReservePoolBalance(input.Ctx).Amount)
+		require.Equal(t, drainAmount, input.TreasuryKeeper.GetEpochReserveDrains(input.Ctx))
+	})
+
+}
+
+// TestKeeper_SwapTaxProceeds tests that the tax withheld in-kind is converted into reserve at the oracle rate.
+func TestKeeper_SwapTaxProceeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+	require.NoError(t, err)
+	vaultBefore := input.MarketKeeper.GetExchangePoolBalance(input.Ctx)
+
+	// the tax withheld in-kind, in a denom priced by the oracle and in one that can't be converted, next to coins sent to the treasury
+	taxProceeds := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, 1_000_000), sdk.NewInt64Coin("ufoo", 1_000_000))
+	err = input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, taxProceeds.Add(sdk.NewInt64Coin(assets.MicroSDRDenom, 500)))
+	require.NoError(t, err)
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, taxProceeds.Add(sdk.NewInt64Coin(assets.MicroSDRDenom, 500)))
+	require.NoError(t, err)
+	for _, tax := range taxProceeds {
+		input.TreasuryKeeper.RecordWithheldTaxProceeds(input.Ctx, tax)
+	}
+	input.TreasuryKeeper.RecordWithheldTaxProceeds(input.Ctx, sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000))
+	require.Equal(t, taxProceeds, input.TreasuryKeeper.GetWithheldTaxProceeds(input.Ctx))
+	exchangeRequirement = input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+
+	// the conversion pays no spread and ignores the circuit breaker
+	input.MarketKeeper.UpdateCircuitBreakerState(input.Ctx, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED)
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	swapped := input.TreasuryKeeper.SwapTaxProceeds(ctx)
+	require.Equal(t, sdk.NewInt64Coin(appparams.BaseCoinUnit, 100_000), swapped)
+	require.Equal(t, swapped, input.TreasuryKeeper.GetReservePoolBalance(ctx))
+	require.Equal(t, vaultBefore.Sub(swapped), input.MarketKeeper.GetExchangePoolBalance(ctx))
+	require.Equal(t, swapped.Amount, input.TreasuryKeeper.GetEpochReserveDrains(ctx))
+
+	// only the withheld coins are burnt, lowering the requirement
+	treasuryAddr := input.TreasuryKeeper.GetTreasuryModuleAccount(ctx).GetAddress()
+	require.Equal(t, int64(500), input.BankKeeper.GetBalance(ctx, treasuryAddr, assets.MicroSDRDenom).Amount.Int64())
+	require.True(t, input.MarketKeeper.GetExchangeRequirement(ctx).LT(exchangeRequirement))
+
+	// the denom priced neither by the oracle nor by a fee token pool is kept for a later attempt and reported
+	require.Equal(t, int64(1_000_000), input.BankKeeper.GetBalance(ctx, treasuryAddr, "ufoo").Amount.Int64())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1_000_000)), input.TreasuryKeeper.GetWithheldTaxProceeds(ctx))
+	failed := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeTaxProceedsSwapFailed {
+			failed++
+			coin, _ := event.GetAttribute(types.AttributeKeyCoin)
+			require.Equal(t, "1000000ufoo", coin.Value)
+		}
+	}
+	require.Equal(t, 1, failed)
+}
+
+// TestKeeper_SwapTaxProceeds_PoolPriced tests that the tax withheld in a denom the oracle doesn't price is swapped
+// into reserve through its fee token pool, leaving the market vault untouched.
+func TestKeeper_SwapTaxProceeds_PoolPriced(t *testing.T) {
+	input := CreateTestInput(t)
+	input.FeeTokenPools.SetFeeToken("uatom", sdk.NewDec(3))
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+	require.NoError(t, err)
+	vaultBefore := input.MarketKeeper.GetExchangePoolBalance(input.Ctx)
+	reserveBefore := input.TreasuryKeeper.GetReservePoolBalance(input.Ctx)
+
+	tax := sdk.NewInt64Coin("uatom", 1_000)
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, sdk.NewCoins(tax)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(tax)))
+	input.TreasuryKeeper.RecordWithheldTaxProceeds(input.Ctx, tax)
+
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	swapped := input.TreasuryKeeper.SwapTaxProceeds(ctx)
+	require.Equal(t, sdk.NewInt64Coin(appparams.BaseCoinUnit, 3_000), swapped)
+	require.Equal(t, reserveBefore.Add(swapped), input.TreasuryKeeper.GetReservePoolBalance(ctx))
+	require.Equal(t, vaultBefore, input.MarketKeeper.GetExchangePoolBalance(ctx))
+	require.True(t, input.TreasuryKeeper.GetEpochReserveDrains(ctx).IsZero())
+
+	treasuryAddr := input.TreasuryKeeper.GetTreasuryModuleAccount(ctx).GetAddress()
+	require.True(t, input.BankKeeper.GetBalance(ctx, treasuryAddr, "uatom").IsZero())
+	require.True(t, input.TreasuryKeeper.GetWithheldTaxProceeds(ctx).IsZero())
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeTaxProceedsSwapFailed, event.Type)
+	}
 }
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 sets the params added since version 3 to their default values, and starts the seigniorage
+// accounting of the current epoch from the market vault balance, as it wasn't tracked before version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMinTaxRate, defaults.MinTaxRate)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMaxTaxRateChange, defaults.MaxTaxRateChange)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyRefillInterval, defaults.RefillInterval)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyMaxRefillPerPeriod, defaults.MaxRefillPerPeriod)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyDrainSurplus, defaults.DrainSurplus)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyRedemptionOnlyRatio, defaults.RedemptionOnlyRatio)
+	osmoutils.SetParamIfMissing(ctx, m.keeper.paramSpace, types.KeyHaltRatio, defaults.HaltRatio)
+	m.keeper.SetEpochInitialExchangePool(ctx, m.keeper.GetExchangePoolBalance(ctx).Amount)
+	return nil
+}
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+// GetEpoch returns the current treasury epoch
+func (k Keeper) GetEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochKey)
+	if bz == nil {
+		return 0
+	}
+
+	var epoch gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch.Value
+}
+
+// SetEpoch sets the current treasury epoch
+func (k Keeper) SetEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: epoch})
+	store.Set(types.EpochKey, bz)
+}
+
+// GetProbationStartEpoch returns the epoch the current probation started at
+func (k Keeper) GetProbationStartEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProbationStartEpochKey)
+	if bz == nil {
+		return 0
+	}
+
+	var epoch gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch.Value
+}
+
+// SetProbationStartEpoch sets the epoch the current probation started at
+func (k Keeper) SetProbationStartEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: epoch})
+	store.Set(types.ProbationStartEpochKey, bz)
+}
+
+// StartProbation freezes the tax rate for the next WindowProbation epochs.
+// Upgrade handlers that change the tax economics call it, see app/upgrades/v25.
+func (k Keeper) StartProbation(ctx sdk.Context) {
+	k.SetProbationStartEpoch(ctx, k.GetEpoch(ctx))
+}
+
+// IsProbation returns true while the tax rate controller is frozen
+func (k Keeper) IsProbation(ctx sdk.Context) bool {
+	return k.GetEpoch(ctx) < k.GetProbationStartEpoch(ctx)+k.WindowProbation(ctx)
+}
+
+// GetEpochTaxProceeds returns the stability tax collected during the current epoch, in note
+func (k Keeper) GetEpochTaxProceeds(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TaxProceedsKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int
+}
+
+// SetEpochTaxProceeds sets the stability tax collected during the current epoch
+func (k Keeper) SetEpochTaxProceeds(ctx sdk.Context, taxProceeds sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: taxProceeds})
+	store.Set(types.TaxProceedsKey, bz)
+}
+
+// RecordEpochTaxProceeds adds the stability tax assessed per taxed denom, and the note it was settled
+// with, to the proceeds of the current epoch
+func (k Keeper) RecordEpochTaxProceeds(ctx sdk.Context, taxes sdk.Coins, collected sdk.Int) {
+	if !collected.IsPositive() {
+		return
+	}
+
+	k.SetEpochTaxProceeds(ctx, k.GetEpochTaxProceeds(ctx).Add(collected))
+	for _, tax := range taxes {
+		k.setEpochTaxProceedsOfDenom(ctx, tax.Denom, k.getEpochTaxProceedsOfDenom(ctx, tax.Denom).Add(tax.Amount))
+	}
+}
+
+// GetEpochState returns the indicators recorded at the end of the epoch
+func (k Keeper) GetEpochState(ctx sdk.Context, epoch uint64) (types.EpochState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochStateKey(epoch))
+	if bz == nil {
+		return types.EpochState{}, false
+	}
+
+	var state types.EpochState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// SetEpochState stores the indicators of an epoch
+func (k Keeper) SetEpochState(ctx sdk.Context, state types.EpochState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.GetEpochStateKey(state.Epoch), bz)
+}
+
+// IterateEpochStates iterates over the recorded epochs in ascending order
+func (k Keeper) IterateEpochStates(ctx sdk.Context, handler func(state types.EpochState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EpochStateKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var state types.EpochState
+		k.cdc.MustUnmarshal(iter.Value(), &state)
+		if handler(state) {
+			break
+		}
+	}
+}
+
+// GetAllEpochStates returns the recorded epochs in ascending order
+func (k Keeper) GetAllEpochStates(ctx sdk.Context) []types.EpochState {
+	states := []types.EpochState{}
+	k.IterateEpochStates(ctx, func(state types.EpochState) (stop bool) {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// PruneEpochStates deletes the epochs that fell out of the WindowLong window ending at the current epoch,
+// whose state has just been recorded
+func (k Keeper) PruneEpochStates(ctx sdk.Context) {
+	epoch := k.GetEpoch(ctx)
+	windowLong := k.WindowLong(ctx)
+	if epoch < windowLong {
+		return
+	}
+
+	var expired []uint64
+	k.IterateEpochStates(ctx, func(state types.EpochState) (stop bool) {
+		if state.Epoch >= epoch-windowLong+1 {
+			return true
+		}
+		expired = append(expired, state.Epoch)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, e := range expired {
+		store.Delete(types.GetEpochStateKey(e))
+	}
+}
+
+// GetReserveCoverage returns the ratio of the reserve pool balance to the exchange requirement.
+// Without an exchange requirement there is nothing to back, and the reserve counts as fully covering.
+func (k Keeper) GetReserveCoverage(ctx sdk.Context) sdk.Dec {
+	exchangeRequirement := k.marketKeeper.GetExchangeRequirement(ctx)
+	if !exchangeRequirement.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	return k.GetReservePoolBalance(ctx).Amount.ToLegacyDec().Quo(exchangeRequirement)
+}
+
+// UpdateIndicators records the indicators of the current epoch and resets the epoch counters for the next one
+func (k Keeper) UpdateIndicators(ctx sdk.Context) types.EpochState {
+	state := types.NewEpochState(
+		k.GetEpoch(ctx),
+		k.GetEpochTaxProceeds(ctx),
+		k.GetReserveCoverage(ctx),
+		k.GetTaxRate(ctx),
+	)
+	state.TaxProceedsByDenom = k.GetEpochTaxProceedsByDenom(ctx)
+	state.Seigniorage = k.GetEpochSeigniorage(ctx)
+	state.ReserveRefills = k.GetEpochReserveRefills(ctx)
+	state.ReserveBalance = k.GetReservePoolBalance(ctx).Amount
+	state.ReserveDrains = k.GetEpochReserveDrains(ctx)
+	k.SetEpochState(ctx, state)
+
+	k.SetEpochTaxProceeds(ctx, sdk.ZeroInt())
+	k.SetEpochTaxProceedsByDenom(ctx, sdk.Coins{})
+	k.SetEpochReserveRefills(ctx, sdk.ZeroInt())
+	k.SetEpochReserveDrains(ctx, sdk.ZeroInt())
+	k.SetEpochInitialExchangePool(ctx, k.marketKeeper.GetExchangePoolBalance(ctx).Amount)
+	return state
+}
+
+// rollingAverage returns the average of the indicator over the last window recorded epochs. The window ends at
+// the current epoch once its state is recorded at the end of the epoch, and at the previous one until then.
+func (k Keeper) rollingAverage(ctx sdk.Context, window uint64, indicator func(state types.EpochState) sdk.Dec) sdk.Dec {
+	states := k.GetAllEpochStates(ctx)
+	if len(states) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	last := states[len(states)-1].Epoch
+	var from uint64
+	if last+1 > window {
+		from = last - window + 1
+	}
+
+	sum := sdk.ZeroDec()
+	count := int64(0)
+	for _, state := range states {
+		if state.Epoch < from {
+			continue
+		}
+		sum = sum.Add(indicator(state))
+		count++
+	}
+	return sum.QuoInt64(count)
+}
+
+func taxProceedsIndicator(state types.EpochState) sdk.Dec {
+	return state.TaxProceeds.ToLegacyDec()
+}
+
+func seigniorageIndicator(state types.EpochState) sdk.Dec {
+	return state.Seigniorage.ToLegacyDec()
+}
+
+func reserveCoverageIndicator(state types.EpochState) sdk.Dec {
+	return state.ReserveCoverage
+}
+
+// GetIndicators returns the averages of the tax proceeds, seigniorage and reserve coverage over the
+// recorded epochs within WindowShort and WindowLong
+func (k Keeper) GetIndicators(ctx sdk.Context) types.QueryIndicatorsResponse {
+	windowShort := k.WindowShort(ctx)
+	windowLong := k.WindowLong(ctx)
+	return types.QueryIndicatorsResponse{
+		TaxProceedsShort:     k.rollingAverage(ctx, windowShort, taxProceedsIndicator),
+		TaxProceedsLong:      k.rollingAverage(ctx, windowLong, taxProceedsIndicator),
+		SeigniorageShort:     k.rollingAverage(ctx, windowShort, seigniorageIndicator),
+		SeigniorageLong:      k.rollingAverage(ctx, windowLong, seigniorageIndicator),
+		ReserveCoverageShort: k.rollingAverage(ctx, windowShort, reserveCoverageIndicator),
+		ReserveCoverageLong:  k.rollingAverage(ctx, windowLong, reserveCoverageIndicator),
+	}
+}
+
+// UpdateTaxPolicy computes the tax rate for the next epoch from the indicators of the recorded epochs.
+//
+// While the reserve covers less of the exchange requirement than ReserveAllowableOffset permits over
+// WindowShort, the rate is raised in proportion to the shortfall. Otherwise the rate follows the ratio
+// of the WindowLong to the WindowShort average tax proceeds, so that it is lowered when the proceeds
+// outperform the long term average and raised when they fall behind it. The result is clamped by the
+// MinTaxRate, MaxFeeMultiplier and MaxTaxRateChange params.
+func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	taxRate := k.GetTaxRate(ctx)
+	target := taxRate
+
+	coverage := k.rollingAverage(ctx, params.WindowShort, reserveCoverageIndicator)
+	coverageThreshold := sdk.OneDec().Sub(params.ReserveAllowableOffset.QuoInt64(100))
+	if coverage.LT(coverageThreshold) {
+		if taxRate.IsZero() || coverage.IsZero() {
+			target = params.MaxFeeMultiplier
+		} else {
+			target = taxRate.Quo(coverage)
+		}
+	} else {
+		shortProceeds := k.rollingAverage(ctx, params.WindowShort, taxProceedsIndicator)
+		longProceeds := k.rollingAverage(ctx, params.WindowLong, taxProceedsIndicator)
+		if shortProceeds.IsPositive() {
+			target = taxRate.Mul(longProceeds).Quo(shortProceeds)
+		}
+	}
+
+	newTaxRate := params.ClampTaxRate(taxRate, target)
+	k.SetTaxRate(ctx, newTaxRate)
+	return newTaxRate
+}
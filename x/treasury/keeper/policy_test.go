@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestEpochTaxProceeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.True(t, input.TreasuryKeeper.GetEpochTaxProceeds(input.Ctx).IsZero())
+
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("usdr", 80)), sdk.NewInt(100))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("usdr", 20), sdk.NewInt64Coin("uusd", 30)), sdk.NewInt(50))
+	// taxes that were not collected are not recorded
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("usdr", 10)), sdk.ZeroInt())
+	require.Equal(t, sdk.NewInt(150), input.TreasuryKeeper.GetEpochTaxProceeds(input.Ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdr", 100), sdk.NewInt64Coin("uusd", 30)), input.TreasuryKeeper.GetEpochTaxProceedsByDenom(input.Ctx))
+
+	// recording the indicators closes the epoch's proceeds
+	state := input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+	require.Equal(t, uint64(0), state.Epoch)
+	require.Equal(t, sdk.NewInt(150), state.TaxProceeds)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdr", 100), sdk.NewInt64Coin("uusd", 30)), state.TaxProceedsByDenom)
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), state.TaxRate)
+	require.True(t, input.TreasuryKeeper.GetEpochTaxProceeds(input.Ctx).IsZero())
+	require.True(t, input.TreasuryKeeper.GetEpochTaxProceedsByDenom(input.Ctx).IsZero())
+
+	stored, found := input.TreasuryKeeper.GetEpochState(input.Ctx, 0)
+	require.True(t, found)
+	require.Equal(t, state, stored)
+}
+
+func TestProbation(t *testing.T) {
+	input := CreateTestInput(t)
+	windowProbation := input.TreasuryKeeper.WindowProbation(input.Ctx)
+
+	require.True(t, input.TreasuryKeeper.IsProbation(input.Ctx))
+
+	input.TreasuryKeeper.SetEpoch(input.Ctx, windowProbation-1)
+	require.True(t, input.TreasuryKeeper.IsProbation(input.Ctx))
+
+	input.TreasuryKeeper.SetEpoch(input.Ctx, windowProbation)
+	require.False(t, input.TreasuryKeeper.IsProbation(input.Ctx))
+
+	// an upgrade restarts the probation
+	input.TreasuryKeeper.StartProbation(input.Ctx)
+	require.Equal(t, windowProbation, input.TreasuryKeeper.GetProbationStartEpoch(input.Ctx))
+	require.True(t, input.TreasuryKeeper.IsProbation(input.Ctx))
+
+	input.TreasuryKeeper.SetEpoch(input.Ctx, 2*windowProbation)
+	require.False(t, input.TreasuryKeeper.IsProbation(input.Ctx))
+}
+
+func TestPruneEpochStates(t *testing.T) {
+	input := CreateTestInput(t)
+	windowLong := input.TreasuryKeeper.WindowLong(input.Ctx)
+
+	for epoch := uint64(0); epoch < windowLong+5; epoch++ {
+		input.TreasuryKeeper.SetEpochState(input.Ctx, types.NewEpochState(epoch, sdk.ZeroInt(), sdk.OneDec(), sdk.ZeroDec()))
+	}
+
+	input.TreasuryKeeper.SetEpoch(input.Ctx, windowLong-1)
+	input.TreasuryKeeper.PruneEpochStates(input.Ctx)
+	require.Len(t, input.TreasuryKeeper.GetAllEpochStates(input.Ctx), int(windowLong+5))
+
+	// exactly WindowLong epochs are kept, the current one included
+	input.TreasuryKeeper.SetEpoch(input.Ctx, windowLong+4)
+	input.TreasuryKeeper.PruneEpochStates(input.Ctx)
+	states := input.TreasuryKeeper.GetAllEpochStates(input.Ctx)
+	require.Len(t, states, int(windowLong))
+	require.Equal(t, uint64(5), states[0].Epoch)
+	require.Equal(t, windowLong+4, states[len(states)-1].Epoch)
+}
+
+func TestRollingAverage(t *testing.T) {
+	input := CreateTestInput(t)
+	windowShort := input.TreasuryKeeper.WindowShort(input.Ctx)
+	require.Equal(t, uint64(4), windowShort)
+
+	require.True(t, input.TreasuryKeeper.GetIndicators(input.Ctx).TaxProceedsShort.IsZero())
+
+	// fewer epochs than the window are recorded, all of them are averaged
+	for epoch := uint64(0); epoch < 3; epoch++ {
+		input.TreasuryKeeper.SetEpochState(input.Ctx, types.NewEpochState(epoch, sdk.NewIntFromUint64(epoch), sdk.OneDec(), sdk.ZeroDec()))
+	}
+	input.TreasuryKeeper.SetEpoch(input.Ctx, 2)
+	require.Equal(t, sdk.NewDec(1), input.TreasuryKeeper.GetIndicators(input.Ctx).TaxProceedsShort)
+
+	// the current epoch is recorded, epochs 6 to 9 are averaged
+	for epoch := uint64(3); epoch < 10; epoch++ {
+		input.TreasuryKeeper.SetEpochState(input.Ctx, types.NewEpochState(epoch, sdk.NewIntFromUint64(epoch), sdk.OneDec(), sdk.ZeroDec()))
+	}
+	input.TreasuryKeeper.SetEpoch(input.Ctx, 9)
+	require.Equal(t, sdk.NewDecWithPrec(75, 1), input.TreasuryKeeper.GetIndicators(input.Ctx).TaxProceedsShort)
+
+	// the next epoch isn't recorded yet, epochs 6 to 9 are still averaged
+	input.TreasuryKeeper.SetEpoch(input.Ctx, 10)
+	require.Equal(t, sdk.NewDecWithPrec(75, 1), input.TreasuryKeeper.GetIndicators(input.Ctx).TaxProceedsShort)
+}
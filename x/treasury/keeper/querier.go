@@ -3,7 +3,13 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
 )
@@ -32,3 +38,118 @@ func (q querier) TaxRate(c context.Context, _ *types.QueryTaxRateRequest) (*type
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryTaxRateResponse{TaxRate: q.GetTaxRate(ctx)}, nil
 }
+
+// TaxRateHistory returns the indicators and tax rates of the past epochs
+func (q querier) TaxRateHistory(c context.Context, _ *types.QueryTaxRateHistoryRequest) (*types.QueryTaxRateHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxRateHistoryResponse{
+		Epoch:       q.GetEpoch(ctx),
+		InProbation: q.IsProbation(ctx),
+		EpochStates: q.GetAllEpochStates(ctx),
+	}, nil
+}
+
+// TaxProceeds returns the stability tax collected during the current epoch
+func (q querier) TaxProceeds(c context.Context, _ *types.QueryTaxProceedsRequest) (*types.QueryTaxProceedsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxProceedsResponse{
+		Epoch:              q.GetEpoch(ctx),
+		TaxProceeds:        q.GetEpochTaxProceeds(ctx),
+		TaxProceedsByDenom: q.GetEpochTaxProceedsByDenom(ctx),
+	}, nil
+}
+
+// Indicators returns the short and long window averages of the indicators
+func (q querier) Indicators(c context.Context, _ *types.QueryIndicatorsRequest) (*types.QueryIndicatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	indicators := q.GetIndicators(ctx)
+	return &indicators, nil
+}
+
+// ReserveHistory returns the reserve balance and refills of the past epochs
+func (q querier) ReserveHistory(c context.Context, _ *types.QueryReserveHistoryRequest) (*types.QueryReserveHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	records := []types.ReserveRecord{}
+	q.IterateEpochStates(ctx, func(state types.EpochState) (stop bool) {
+		records = append(records, state.ReserveRecord())
+		return false
+	})
+	return &types.QueryReserveHistoryResponse{ReserveRecords: records}, nil
+}
+
+// TaxCap returns the stability tax cap of a denom
+func (q querier) TaxCap(c context.Context, req *types.QueryTaxCapRequest) (*types.QueryTaxCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	taxCap, ok := q.GetTaxCap(ctx, req.Denom)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrNoTaxCap, req.Denom)
+	}
+	return &types.QueryTaxCapResponse{TaxCap: taxCap}, nil
+}
+
+// TaxCaps returns the stability tax caps of all capped denoms
+func (q querier) TaxCaps(c context.Context, _ *types.QueryTaxCapsRequest) (*types.QueryTaxCapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxCapsResponse{TaxCaps: q.GetTaxCaps(ctx)}, nil
+}
+
+// TaxExemptionZones returns all tax exemption zones
+func (q querier) TaxExemptionZones(c context.Context, _ *types.QueryTaxExemptionZonesRequest) (*types.QueryTaxExemptionZonesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxExemptionZonesResponse{Zones: q.GetZones(ctx)}, nil
+}
+
+// TaxExemptionAddresses returns the addresses of a tax exemption zone, or of all zones if no zone is given
+func (q querier) TaxExemptionAddresses(c context.Context, req *types.QueryTaxExemptionAddressesRequest) (*types.QueryTaxExemptionAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Zone != "" {
+		if _, ok := q.GetZone(ctx, req.Zone); !ok {
+			return nil, errorsmod.Wrap(types.ErrNoSuchTaxExemptionZone, req.Zone)
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.TaxExemptionKey)
+
+	var exemptions []types.TaxExemption
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		zone := string(value)
+		if req.Zone != "" && zone != req.Zone {
+			return false, nil
+		}
+
+		if accumulate {
+			exemptions = append(exemptions, types.NewTaxExemption(zone, sdk.AccAddress(key).String()))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTaxExemptionAddressesResponse{TaxExemptions: exemptions, Pagination: pageRes}, nil
+}
+
+// TaxExemptionRoutes returns the message routes exempt from the stability tax
+func (q querier) TaxExemptionRoutes(c context.Context, _ *types.QueryTaxExemptionRoutesRequest) (*types.QueryTaxExemptionRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxExemptionRoutesResponse{Routes: q.GetTaxExemptionRoutes(ctx)}, nil
+}
+
+// Solvency returns how well the stable supply is backed by the market vault and the reserve
+func (q querier) Solvency(c context.Context, _ *types.QuerySolvencyRequest) (*types.QuerySolvencyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := q.GetSolvency(ctx)
+	return &res, nil
+}
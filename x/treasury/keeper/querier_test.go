@@ -31,3 +31,168 @@ func TestQueryTaxRate(t *testing.T) {
 
 	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), res.TaxRate)
 }
+
+func TestQueryTaxRateHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("usdr", 100)), sdk.NewInt(100))
+	state := input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+	input.TreasuryKeeper.SetEpoch(input.Ctx, 1)
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.TaxRateHistory(ctx, &types.QueryTaxRateHistoryRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1), res.Epoch)
+	require.True(t, res.InProbation)
+	require.Equal(t, []types.EpochState{state}, res.EpochStates)
+}
+
+func TestQueryTaxProceeds(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin("usdr", 100), sdk.NewInt64Coin("uusd", 30))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, taxes, sdk.NewInt(150))
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.TaxProceeds(ctx, &types.QueryTaxProceedsRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(0), res.Epoch)
+	require.Equal(t, sdk.NewInt(150), res.TaxProceeds)
+	require.Equal(t, taxes, res.TaxProceedsByDenom)
+}
+
+func TestQueryIndicators(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	for epoch := uint64(0); epoch < 8; epoch++ {
+		state := types.NewEpochState(epoch, sdk.NewIntFromUint64(epoch*10), sdk.NewDecWithPrec(int64(epoch), 1), sdk.ZeroDec())
+		state.Seigniorage = sdk.NewInt(-5)
+		input.TreasuryKeeper.SetEpochState(input.Ctx, state)
+	}
+	input.TreasuryKeeper.SetEpoch(input.Ctx, 8)
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.Indicators(ctx, &types.QueryIndicatorsRequest{})
+	require.NoError(t, err)
+
+	// WindowShort covers epochs 4 to 7, WindowLong covers all
+	require.Equal(t, sdk.NewDec(55), res.TaxProceedsShort)
+	require.Equal(t, sdk.NewDec(35), res.TaxProceedsLong)
+	require.Equal(t, sdk.NewDec(-5), res.SeigniorageShort)
+	require.Equal(t, sdk.NewDec(-5), res.SeigniorageLong)
+	require.Equal(t, sdk.NewDecWithPrec(55, 2), res.ReserveCoverageShort)
+	require.Equal(t, sdk.NewDecWithPrec(35, 2), res.ReserveCoverageLong)
+}
+
+func TestQueryReserveHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.TreasuryKeeper.RecordReserveRefill(input.Ctx, sdk.NewInt(300))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("usdr", 100)), sdk.NewInt(100))
+	state := input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.ReserveHistory(ctx, &types.QueryReserveHistoryRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, []types.ReserveRecord{{
+		Epoch:       0,
+		Balance:     input.TreasuryKeeper.GetReservePoolBalance(input.Ctx).Amount,
+		Refills:     sdk.NewInt(300),
+		TaxProceeds: sdk.NewInt(100),
+		Coverage:    state.ReserveCoverage,
+		Drains:      sdk.ZeroInt(),
+	}}, res.ReserveRecords)
+}
+
+func TestQueryTaxCap(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, "uusd", sdk.NewInt(1000))
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.TaxCap(ctx, &types.QueryTaxCapRequest{Denom: "uusd"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000), res.TaxCap)
+
+	// uncapped denom
+	_, err = querier.TaxCap(ctx, &types.QueryTaxCapRequest{Denom: "ukrw"})
+	require.Error(t, err)
+
+	_, err = querier.TaxCap(ctx, &types.QueryTaxCapRequest{})
+	require.Error(t, err)
+}
+
+func TestQueryTaxCaps(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, "uusd", sdk.NewInt(1000))
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, "ukrw", sdk.NewInt(2000))
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.TaxCaps(ctx, &types.QueryTaxCapsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, input.TreasuryKeeper.GetTaxCaps(input.Ctx), res.TaxCaps)
+	require.Len(t, res.TaxCaps, 2)
+}
+
+func TestQueryTaxExemptions(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("custody", false, false))
+	input.TreasuryKeeper.SetZone(input.Ctx, types.NewZone("vault", false, true))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "custody", Addrs[0].String()))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "custody", Addrs[1].String()))
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionAddress(input.Ctx, "vault", Addrs[2].String()))
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	zonesRes, err := querier.TaxExemptionZones(ctx, &types.QueryTaxExemptionZonesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, input.TreasuryKeeper.GetZones(input.Ctx), zonesRes.Zones)
+
+	res, err := querier.TaxExemptionAddresses(ctx, &types.QueryTaxExemptionAddressesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.TaxExemptions, 3)
+
+	res, err = querier.TaxExemptionAddresses(ctx, &types.QueryTaxExemptionAddressesRequest{Zone: "custody"})
+	require.NoError(t, err)
+	require.Len(t, res.TaxExemptions, 2)
+	for _, exemption := range res.TaxExemptions {
+		require.Equal(t, "custody", exemption.Zone)
+	}
+
+	_, err = querier.TaxExemptionAddresses(ctx, &types.QueryTaxExemptionAddressesRequest{Zone: "unknown"})
+	require.Error(t, err)
+}
+
+func TestQueryTaxExemptionRoutes(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	require.NoError(t, input.TreasuryKeeper.AddTaxExemptionRoute(input.Ctx, "/osmosis.market.v1beta1.MsgSwapSend"))
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.TaxExemptionRoutes(ctx, &types.QueryTaxExemptionRoutesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/osmosis.market.v1beta1.MsgSwapSend"}, res.Routes)
+}
+
+func TestQuerySolvency(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.Solvency(ctx, &types.QuerySolvencyRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, input.TreasuryKeeper.GetSolvency(input.Ctx), *res)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+// GetSolvency reports how well the stable supply is backed by the market vault and the reserve together.
+// Without an exchange requirement there is nothing to back, and the ratio is one.
+// The denoms without a current exchange rate are valued at their last known one, and the solvency is unhealthy
+// as long as any of them has an outstanding supply.
+func (k Keeper) GetSolvency(ctx sdk.Context) types.QuerySolvencyResponse {
+	solvency, _ := k.solvency(ctx)
+	return solvency
+}
+
+func (k Keeper) solvency(ctx sdk.Context) (solvency types.QuerySolvencyResponse, unpriced []string) {
+	stableSupplies := []types.StableSupply{}
+	exchangeRequirement := sdk.ZeroDec()
+	for _, req := range k.marketKeeper.GetExchangeRequirements(ctx) {
+		value := req.BaseCurrency.Amount.ToLegacyDec().Mul(req.ExchangeRate)
+		stableSupplies = append(stableSupplies, types.StableSupply{
+			Supply:       req.BaseCurrency,
+			ExchangeRate: req.ExchangeRate,
+			Value:        value,
+		})
+		exchangeRequirement = exchangeRequirement.Add(value)
+	}
+
+	exchangePool := k.GetExchangePoolBalance(ctx)
+	reserve := k.GetReservePoolBalance(ctx)
+
+	ratio := sdk.OneDec()
+	if exchangeRequirement.IsPositive() {
+		ratio = exchangePool.Amount.Add(reserve.Amount).ToLegacyDec().Quo(exchangeRequirement)
+	}
+
+	unpriced = k.marketKeeper.GetUnpricedDenoms(ctx)
+	threshold := sdk.OneDec().Sub(k.GetParams(ctx).ReserveAllowableOffset.QuoInt64(100))
+	return types.QuerySolvencyResponse{
+		StableSupplies:         stableSupplies,
+		ExchangeRequirement:    exchangeRequirement,
+		ExchangePool:           exchangePool,
+		Reserve:                reserve,
+		CollateralizationRatio: ratio,
+		Healthy:                ratio.GTE(threshold) && len(unpriced) == 0,
+	}, unpriced
+}
+
+// UpdateCircuitBreaker moves the market circuit breaker to the state required by the collateralization ratio:
+// swaps are halted below HaltRatio, and only redemptions are accepted below RedemptionOnlyRatio.
+// Since the ratio can't be trusted while a stable denom with an outstanding supply has no current exchange rate,
+// only redemptions are accepted then as well.
+func (k Keeper) UpdateCircuitBreaker(ctx sdk.Context) markettypes.CircuitBreakerState {
+	params := k.GetParams(ctx)
+	solvency, unpriced := k.solvency(ctx)
+	ratio := solvency.CollateralizationRatio
+
+	state := markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL
+	if ratio.LT(params.HaltRatio) {
+		state = markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED
+	} else if ratio.LT(params.RedemptionOnlyRatio) || len(unpriced) > 0 {
+		state = markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY
+	}
+
+	k.marketKeeper.UpdateCircuitBreakerState(ctx, state)
+	return state
+}
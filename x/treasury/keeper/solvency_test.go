@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oraclekeeper "github.com/osmosis-labs/osmosis/v23/x/oracle/keeper"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestGetSolvency(t *testing.T) {
+	input := CreateTestInput(t)
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	require.True(t, exchangeRequirement.IsPositive())
+
+	solvency := input.TreasuryKeeper.GetSolvency(input.Ctx)
+	require.Equal(t, exchangeRequirement, solvency.ExchangeRequirement)
+	require.NotEmpty(t, solvency.StableSupplies)
+	total := sdk.ZeroDec()
+	for _, supply := range solvency.StableSupplies {
+		require.Equal(t, supply.Supply.Amount.ToLegacyDec().Mul(supply.ExchangeRate), supply.Value)
+		total = total.Add(supply.Value)
+	}
+	require.Equal(t, exchangeRequirement, total)
+
+	// nothing backs the stable supply yet
+	require.True(t, solvency.CollateralizationRatio.IsZero())
+	require.False(t, solvency.Healthy)
+
+	// the market vault and the reserve each back half of the stable supply
+	half := exchangeRequirement.QuoInt64(2).Ceil().TruncateInt()
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, half)))
+	require.NoError(t, err)
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, half)))
+	require.NoError(t, err)
+
+	solvency = input.TreasuryKeeper.GetSolvency(input.Ctx)
+	require.Equal(t, sdk.NewCoin(appparams.BaseCoinUnit, half), solvency.ExchangePool)
+	require.Equal(t, sdk.NewCoin(appparams.BaseCoinUnit, half), solvency.Reserve)
+	require.True(t, solvency.CollateralizationRatio.GTE(sdk.OneDec()))
+	require.True(t, solvency.Healthy)
+}
+
+func TestUpdateCircuitBreaker(t *testing.T) {
+	input := CreateTestInput(t)
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	fund := func(share sdk.Dec) {
+		amount := exchangeRequirement.Mul(share).Ceil().TruncateInt()
+		err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, amount)))
+		require.NoError(t, err)
+	}
+
+	// nothing backs the stable supply yet, so all swaps are halted, redemptions included
+	state := input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED, state)
+	require.Equal(t, state, input.MarketKeeper.GetCircuitBreaker(input.Ctx).State)
+	require.ErrorIs(t, input.MarketKeeper.ValidateSwapAllowed(input.Ctx, appparams.BaseCoinUnit), markettypes.ErrCircuitBreakerTripped)
+
+	// above HaltRatio only redemptions are accepted
+	fund(sdk.NewDecWithPrec(6, 1))
+	state = input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY, state)
+	require.NoError(t, input.MarketKeeper.ValidateSwapAllowed(input.Ctx, appparams.BaseCoinUnit))
+	require.ErrorIs(t, input.MarketKeeper.ValidateSwapAllowed(input.Ctx, assets.MicroSDRDenom), markettypes.ErrCircuitBreakerTripped)
+
+	// within the reserve allowable offset minting stays open
+	fund(sdk.NewDecWithPrec(36, 2))
+	require.True(t, input.TreasuryKeeper.GetSolvency(input.Ctx).CollateralizationRatio.LT(sdk.OneDec()))
+	state = input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL, state)
+
+	// governance pins the state whatever the backing
+	err := input.MarketKeeper.OverrideCircuitBreaker(input.Ctx, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY)
+	require.NoError(t, err)
+
+	input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx)
+	require.Equal(t, markettypes.NewCircuitBreaker(markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY, true), input.MarketKeeper.GetCircuitBreaker(input.Ctx))
+
+	// once the override is lifted the state follows the backing again
+	err = input.MarketKeeper.OverrideCircuitBreaker(input.Ctx, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED)
+	require.NoError(t, err)
+
+	state = input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL, state)
+	require.Equal(t, markettypes.DefaultCircuitBreaker(), input.MarketKeeper.GetCircuitBreaker(input.Ctx))
+}
+
+func TestGetSolvency_UnpricedDenom(t *testing.T) {
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper.(oraclekeeper.Keeper)
+
+	// the stable supply is backed twice over
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.MulInt64(2).Ceil().TruncateInt())))
+	require.NoError(t, err)
+	require.True(t, input.TreasuryKeeper.GetSolvency(input.Ctx).Healthy)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL, input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx))
+
+	// the rate of usdr goes stale, it's still valued at its last recorded rate
+	exchangeRate, err := oracleKeeper.GetMelodyExchangeRate(input.Ctx, assets.MicroSDRDenom)
+	require.NoError(t, err)
+	oracleKeeper.RecordHistoricalExchangeRate(input.Ctx, assets.MicroSDRDenom, exchangeRate)
+	oracleKeeper.DeleteMelodyExchangeRate(input.Ctx, assets.MicroSDRDenom)
+
+	require.Equal(t, []string{assets.MicroSDRDenom}, input.MarketKeeper.GetUnpricedDenoms(input.Ctx))
+	solvency := input.TreasuryKeeper.GetSolvency(input.Ctx)
+	require.Equal(t, exchangeRequirement, solvency.ExchangeRequirement)
+	require.False(t, solvency.Healthy)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY, input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx))
+
+	// without any recorded rate left, it's valued at zero but still trips the breaker
+	oracleKeeper.PruneHistoricalExchangeRates(input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(oracleKeeper.HistoryKeepPeriod(input.Ctx) + 1)))
+	solvency = input.TreasuryKeeper.GetSolvency(input.Ctx)
+	require.True(t, solvency.ExchangeRequirement.LT(exchangeRequirement))
+	require.False(t, solvency.Healthy)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY, input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx))
+
+	// the rate is voted again
+	oracleKeeper.SetMelodyExchangeRate(input.Ctx, assets.MicroSDRDenom, exchangeRate)
+	require.Empty(t, input.MarketKeeper.GetUnpricedDenoms(input.Ctx))
+	require.True(t, input.TreasuryKeeper.GetSolvency(input.Ctx).Healthy)
+	require.Equal(t, markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_NORMAL, input.TreasuryKeeper.UpdateCircuitBreaker(input.Ctx))
+}
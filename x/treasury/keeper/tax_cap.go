@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+// GetTaxCap returns the stability tax cap of the denom and whether the denom is capped
+func (k Keeper) GetTaxCap(ctx sdk.Context, denom string) (sdk.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxCapKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt(), false
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip.Int, true
+}
+
+// SetTaxCap sets the stability tax cap of the denom
+func (k Keeper) SetTaxCap(ctx sdk.Context, denom string, cap sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: cap})
+	store.Set(types.GetTaxCapKey(denom), bz)
+}
+
+// DeleteTaxCap removes the stability tax cap of the denom, leaving its tax uncapped
+func (k Keeper) DeleteTaxCap(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTaxCapKey(denom))
+}
+
+// IterateTaxCaps iterates over the tax caps in the store
+func (k Keeper) IterateTaxCaps(ctx sdk.Context, handler func(taxCap types.TaxCap) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxCapKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.TaxCapKey):])
+		ip := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &ip)
+		if handler(types.NewTaxCap(denom, ip.Int)) {
+			break
+		}
+	}
+}
+
+// GetTaxCaps returns the tax caps of all capped denoms
+func (k Keeper) GetTaxCaps(ctx sdk.Context) []types.TaxCap {
+	taxCaps := []types.TaxCap{}
+	k.IterateTaxCaps(ctx, func(taxCap types.TaxCap) (stop bool) {
+		taxCaps = append(taxCaps, taxCap)
+		return false
+	})
+	return taxCaps
+}
+
+// HandleUpdateTaxCapsProposal sets the proposed tax caps, removing the caps set to zero
+func (k Keeper) HandleUpdateTaxCapsProposal(ctx sdk.Context, p *types.UpdateTaxCapsProposal) error {
+	for _, taxCap := range p.TaxCaps {
+		if err := taxCap.Validate(); err != nil {
+			return err
+		}
+
+		if taxCap.Cap.IsZero() {
+			k.DeleteTaxCap(ctx, taxCap.Denom)
+			continue
+		}
+		k.SetTaxCap(ctx, taxCap.Denom, taxCap.Cap)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestTaxCap(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, ok := input.TreasuryKeeper.GetTaxCap(input.Ctx, "uusd")
+	require.False(t, ok)
+
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, "uusd", sdk.NewInt(1000))
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, "usdr", sdk.NewInt(2000))
+
+	taxCap, ok := input.TreasuryKeeper.GetTaxCap(input.Ctx, "uusd")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(1000), taxCap)
+	require.Equal(t, []types.TaxCap{
+		types.NewTaxCap("usdr", sdk.NewInt(2000)),
+		types.NewTaxCap("uusd", sdk.NewInt(1000)),
+	}, input.TreasuryKeeper.GetTaxCaps(input.Ctx))
+
+	input.TreasuryKeeper.DeleteTaxCap(input.Ctx, "uusd")
+	_, ok = input.TreasuryKeeper.GetTaxCap(input.Ctx, "uusd")
+	require.False(t, ok)
+}
+
+func TestHandleUpdateTaxCapsProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, "ukrw", sdk.NewInt(500))
+
+	proposal := types.NewUpdateTaxCapsProposal("title", "description", []types.TaxCap{
+		types.NewTaxCap("uusd", sdk.NewInt(1000)),
+		types.NewTaxCap("ukrw", sdk.ZeroInt()),
+	})
+	require.NoError(t, input.TreasuryKeeper.HandleUpdateTaxCapsProposal(input.Ctx, &proposal))
+
+	// a zero cap removes the cap of the denom
+	require.Equal(t, []types.TaxCap{
+		types.NewTaxCap("uusd", sdk.NewInt(1000)),
+	}, input.TreasuryKeeper.GetTaxCaps(input.Ctx))
+
+	// invalid caps are rejected
+	proposal = types.NewUpdateTaxCapsProposal("title", "description", []types.TaxCap{
+		types.NewTaxCap("uusd", sdk.NewInt(-1)),
+	})
+	require.Error(t, input.TreasuryKeeper.HandleUpdateTaxCapsProposal(input.Ctx, &proposal))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdkmath "cosmossdk.io/math"
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/crypto"
@@ -28,9 +30,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
 	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"github.com/osmosis-labs/osmosis/v23/x/market"
@@ -40,6 +45,7 @@ import (
 	oraclekeeper "github.com/osmosis-labs/osmosis/v23/x/oracle/keeper"
 	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v23/x/txfees/types"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -114,6 +120,7 @@ type TestInput struct {
 	BankKeeper     bankkeeper.Keeper
 	OracleKeeper   types.OracleKeeper
 	MarketKeeper   marketkeeper.Keeper
+	FeeTokenPools  *FeeTokenPools
 	TreasuryKeeper Keeper
 }
 
@@ -126,7 +133,9 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
-	keyMarket := sdk.NewKVStoreKey(types.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
+	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
+	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -141,7 +150,9 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTreasury, storetypes.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -161,7 +172,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		distrtypes.ModuleName:          nil,
 		oracletypes.ModuleName:         nil,
 		markettypes.ModuleName:         {authtypes.Burner, authtypes.Minter},
-		types.ModuleName:               nil,
+		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
 	}
 
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams, tKeyParams)
@@ -198,6 +209,12 @@ func CreateTestInput(t *testing.T) TestInput {
 	)
 
 	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+
+	slashingKeeper := slashingkeeper.NewKeeper(
+		appCodec, legacyAmino, keySlashing,
+		stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	distrParams := distrtypes.DefaultParams()
 	distrParams.CommunityTax = sdk.NewDecWithPrec(2, 2)
 	distrParams.BaseProposerReward = sdk.NewDecWithPrec(1, 2)
@@ -212,7 +229,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	distrAcc := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
 	oracleAcc := authtypes.NewEmptyModuleAccount(oracletypes.ModuleName)
 	marketAcc := authtypes.NewEmptyModuleAccount(markettypes.ModuleName, authtypes.Burner, authtypes.Minter)
-	treasuryAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	treasuryAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner)
 
 	err = bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, InitTokens.MulRaw(int64(len(Addrs))))))
 	require.NoError(t, err)
@@ -240,6 +257,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		slashingKeeper,
 		distrtypes.ModuleName,
 	)
 	oracleDefaultParams := oracletypes.DefaultParams()
@@ -258,16 +276,22 @@ func CreateTestInput(t *testing.T) TestInput {
 		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
 		accountKeeper,
 		bankKeeper,
-		oracleKeeper)
+		oracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
+
+	feeTokenPools := &FeeTokenPools{bankKeeper: bankKeeper}
 
 	keeper := NewKeeper(
 		appCodec,
-		keyMarket,
+		keyTreasury,
 		paramsKeeper.Subspace(types.ModuleName),
 		accountKeeper,
 		bankKeeper,
 		marketKeeper,
 		oracleKeeper,
+		feeTokenPools,
+		feeTokenPools,
 	)
 	keeper.SetParams(ctx, types.DefaultParams())
 
@@ -278,10 +302,56 @@ func CreateTestInput(t *testing.T) TestInput {
 		BankKeeper:     bankKeeper,
 		OracleKeeper:   oracleKeeper,
 		MarketKeeper:   marketKeeper,
+		FeeTokenPools:  feeTokenPools,
 		TreasuryKeeper: keeper,
 	}
 }
 
+// FeeTokenPools stands in for the fee tokens of x/txfees and their pools. Each fee token is
+// swapped into note at a fixed price, through the faucet account.
+type FeeTokenPools struct {
+	bankKeeper bankkeeper.Keeper
+	feeTokens  []txfeestypes.FeeToken
+	prices     []sdk.Dec
+}
+
+// SetFeeToken adds a fee token priced at the given note per unit, and returns the id of its pool
+func (p *FeeTokenPools) SetFeeToken(denom string, price sdk.Dec) uint64 {
+	poolID := uint64(len(p.feeTokens) + 1)
+	p.feeTokens = append(p.feeTokens, txfeestypes.FeeToken{Denom: denom, PoolID: poolID})
+	p.prices = append(p.prices, price)
+	return poolID
+}
+
+func (p *FeeTokenPools) GetFeeToken(_ sdk.Context, denom string) (txfeestypes.FeeToken, error) {
+	for _, feeToken := range p.feeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, nil
+		}
+	}
+	return txfeestypes.FeeToken{}, txfeestypes.ErrInvalidFeeToken
+}
+
+func (p *FeeTokenPools) SwapExactAmountInNoTakerFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount osmomath.Int) (osmomath.Int, error) {
+	if poolId == 0 || poolId > uint64(len(p.feeTokens)) || p.feeTokens[poolId-1].Denom != tokenIn.Denom || tokenOutDenom != appparams.BaseCoinUnit {
+		return osmomath.Int{}, fmt.Errorf("no pool %d from %s to %s", poolId, tokenIn.Denom, tokenOutDenom)
+	}
+
+	tokenOut := sdk.NewCoin(tokenOutDenom, p.prices[poolId-1].MulInt(tokenIn.Amount).TruncateInt())
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return osmomath.Int{}, fmt.Errorf("%s is less than the min amount out %s", tokenOut, tokenOutMinAmount)
+	}
+
+	faucetAddr := authtypes.NewModuleAddress(faucetAccountName)
+	if err := p.bankKeeper.SendCoins(ctx, sender, faucetAddr, sdk.NewCoins(tokenIn)); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := p.bankKeeper.SendCoins(ctx, faucetAddr, sender, sdk.NewCoins(tokenOut)); err != nil {
+		return osmomath.Int{}, err
+	}
+	return tokenOut.Amount, nil
+}
+
 // FundAccount is a utility function that funds an account by minting and
 // sending the coins to the address. This should be used for testing purposes
 // only!
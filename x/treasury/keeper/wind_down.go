@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+// GetRecoveryRatio returns the share of the settlement value the holders of a denom winding down can redeem,
+// which is the coverage of the backed exchange requirement by the market vault and the reserve left after the
+// redemption liability, capped at one. Paying every denom out at this ratio keeps the redemptions of all holders
+// within the backing of the stable supply. The share of the vault backing a denom winding down goes back to the
+// reserve with the next drain, since the denom no longer counts towards the requirement the vault backs.
+func (k Keeper) GetRecoveryRatio(ctx sdk.Context) sdk.Dec {
+	exchangeRequirement := k.getBackedExchangeRequirement(ctx)
+	if !exchangeRequirement.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	backing := k.getAvailableReserve(ctx).Add(k.marketKeeper.GetExchangePoolBalance(ctx).Amount)
+	ratio := backing.ToLegacyDec().Quo(exchangeRequirement)
+	return sdk.MinDec(ratio, sdk.OneDec())
+}
+
+// GetRedemptionLiability returns the note earmarked in the reserve for the holders of the denoms winding down,
+// i.e. the value of their supply at the redemption rate until the deadline. The earmark is never moved to the
+// market vault, where the holders couldn't reach it.
+func (k Keeper) GetRedemptionLiability(ctx sdk.Context) sdk.Int {
+	liability := sdk.ZeroDec()
+	for _, req := range k.marketKeeper.GetExchangeRequirements(ctx) {
+		windDown, found := k.oracleKeeper.GetWindDown(ctx, req.BaseCurrency.Denom)
+		if !found || !windDown.IsRedeemable(ctx.BlockTime()) {
+			continue
+		}
+		liability = liability.Add(windDown.RedemptionRate.MulInt(req.BaseCurrency.Amount))
+	}
+	return liability.Ceil().TruncateInt()
+}
+
+// getBackedExchangeRequirement returns the exchange requirement the market vault backs, which leaves out the
+// denoms winding down since their holders are paid out of the reserve
+func (k Keeper) getBackedExchangeRequirement(ctx sdk.Context) sdk.Dec {
+	exchangeRequirement := sdk.ZeroDec()
+	for _, req := range k.marketKeeper.GetExchangeRequirements(ctx) {
+		if _, found := k.oracleKeeper.GetWindDown(ctx, req.BaseCurrency.Denom); found {
+			continue
+		}
+		exchangeRequirement = exchangeRequirement.Add(req.BaseCurrency.Amount.ToLegacyDec().Mul(req.ExchangeRate))
+	}
+	return exchangeRequirement
+}
+
+// getAvailableReserve returns the reserve balance which isn't earmarked for the redemptions
+func (k Keeper) getAvailableReserve(ctx sdk.Context) sdk.Int {
+	available := k.GetReservePoolBalance(ctx).Amount.Sub(k.GetRedemptionLiability(ctx))
+	if available.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return available
+}
+
+// HandleWindDownProposal moves the denom into wind-down, freezing its exchange rate at the settlement rate and
+// fixing the rate its holders can redeem it at until the end of the redemption period
+func (k Keeper) HandleWindDownProposal(ctx sdk.Context, p *types.WindDownProposal) error {
+	settlementRate := p.SettlementRate
+	if settlementRate.IsZero() {
+		rate, err := k.oracleKeeper.GetMelodyExchangeRate(ctx, p.Denom)
+		if err != nil {
+			return err
+		}
+		settlementRate = rate
+	}
+
+	redemptionRate := settlementRate.Mul(k.GetRecoveryRatio(ctx))
+	windDown := oracletypes.NewWindDown(p.Denom, settlementRate, redemptionRate, ctx.BlockTime().Add(p.RedemptionPeriod))
+	return k.oracleKeeper.StartWindDown(ctx, windDown)
+}
+
+// Redeem burns the coin of a denom winding down and pays its holder out of the reserve at the redemption rate.
+// It returns the note paid out.
+func (k Keeper) Redeem(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	windDown, found := k.oracleKeeper.GetWindDown(ctx, coin.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrNotWindingDown, coin.Denom)
+	}
+
+	if !windDown.IsRedeemable(ctx.BlockTime()) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrRedemptionClosed, "%s could be redeemed until %s", coin.Denom, windDown.Deadline)
+	}
+
+	redeemedCoin := sdk.NewCoin(appparams.BaseCoinUnit, windDown.RedemptionRate.MulInt(coin.Amount).TruncateInt())
+	if reserve := k.GetReservePoolBalance(ctx); reserve.IsLT(redeemedCoin) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientReserve, "redeeming %s requires %s, reserve has %s", coin, redeemedCoin, reserve)
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// a zero redemption rate still lets the holders retire the denom
+	if redeemedCoin.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(redeemedCoin)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRedeem,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyCoin, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemedCoin, redeemedCoin.String()),
+		),
+	)
+	return redeemedCoin, nil
+}
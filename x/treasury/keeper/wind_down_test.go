@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v23/app/apptesting/assets"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
+	oraclekeeper "github.com/osmosis-labs/osmosis/v23/x/oracle/keeper"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	"github.com/osmosis-labs/osmosis/v23/x/treasury/types"
+)
+
+func TestGetRecoveryRatio(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// nothing backs the stable supply yet
+	require.True(t, input.TreasuryKeeper.GetRecoveryRatio(input.Ctx).IsZero())
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	half := exchangeRequirement.QuoInt64(2).TruncateInt()
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, half)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), input.TreasuryKeeper.GetRecoveryRatio(input.Ctx))
+
+	// the note in the market vault backs the stable supply as well
+	quarter := exchangeRequirement.QuoInt64(4).TruncateInt()
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, quarter)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), input.TreasuryKeeper.GetRecoveryRatio(input.Ctx))
+
+	// the ratio is capped at one
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), input.TreasuryKeeper.GetRecoveryRatio(input.Ctx))
+}
+
+func TestWindDown(t *testing.T) {
+	input := CreateTestInput(t)
+	msgServer := NewMsgServerImpl(input.TreasuryKeeper)
+
+	// the reserve backs half of the stable supply
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.QuoInt64(2).TruncateInt())))
+	require.NoError(t, err)
+
+	// only the denoms winding down can be redeemed
+	holder := Addrs[0]
+	coin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	err = input.BankKeeper.SendCoinsFromModuleToAccount(input.Ctx, faucetAccountName, holder, sdk.NewCoins(coin))
+	require.NoError(t, err)
+	_, err = msgServer.Redeem(sdk.WrapSDKContext(input.Ctx), types.NewMsgRedeem(holder, coin))
+	require.ErrorIs(t, err, types.ErrNotWindingDown)
+
+	// the settlement rate defaults to the oracle exchange rate
+	proposal := types.NewWindDownProposal("title", "description", assets.MicroSDRDenom, sdk.ZeroDec(), time.Hour)
+	require.NoError(t, input.TreasuryKeeper.HandleWindDownProposal(input.Ctx, &proposal))
+	windDown, found := input.OracleKeeper.GetWindDown(input.Ctx, assets.MicroSDRDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), windDown.SettlementRate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), windDown.RedemptionRate)
+	require.True(t, input.Ctx.BlockTime().Add(time.Hour).Equal(windDown.Deadline))
+
+	// a denom winds down only once
+	err = input.TreasuryKeeper.HandleWindDownProposal(input.Ctx, &proposal)
+	require.ErrorIs(t, err, oracletypes.ErrDenomWindingDown)
+
+	// the holder is paid half of the settlement value out of the reserve
+	reserveBefore := input.TreasuryKeeper.GetReservePoolBalance(input.Ctx)
+	supplyBefore := input.BankKeeper.GetSupply(input.Ctx, assets.MicroSDRDenom)
+	res, err := msgServer.Redeem(sdk.WrapSDKContext(input.Ctx), types.NewMsgRedeem(holder, coin))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(appparams.BaseCoinUnit, 50), res.RedeemedCoin)
+	require.Equal(t, reserveBefore.Sub(res.RedeemedCoin), input.TreasuryKeeper.GetReservePoolBalance(input.Ctx))
+	require.Equal(t, supplyBefore.Sub(coin), input.BankKeeper.GetSupply(input.Ctx, assets.MicroSDRDenom))
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, holder, assets.MicroSDRDenom).IsZero())
+
+	// the reserve must cover the redemption
+	err = input.BankKeeper.SendCoinsFromModuleToAccount(input.Ctx, faucetAccountName, holder, sdk.NewCoins(coin))
+	require.NoError(t, err)
+	cacheCtx, _ := input.Ctx.CacheContext()
+	reserve := input.TreasuryKeeper.GetReservePoolBalance(cacheCtx)
+	err = input.BankKeeper.SendCoinsFromModuleToModule(cacheCtx, types.ModuleName, markettypes.ModuleName, sdk.NewCoins(reserve))
+	require.NoError(t, err)
+	_, err = msgServer.Redeem(sdk.WrapSDKContext(cacheCtx), types.NewMsgRedeem(holder, coin))
+	require.ErrorIs(t, err, types.ErrInsufficientReserve)
+
+	// the redemption closes at the deadline
+	ctx := input.Ctx.WithBlockTime(windDown.Deadline.Add(time.Second))
+	_, err = msgServer.Redeem(sdk.WrapSDKContext(ctx), types.NewMsgRedeem(holder, coin))
+	require.ErrorIs(t, err, types.ErrRedemptionClosed)
+
+	// then the leftover supply is no longer backed
+	sdrValue := input.BankKeeper.GetSupply(ctx, assets.MicroSDRDenom).Amount.ToLegacyDec().Mul(windDown.SettlementRate)
+	require.True(t, sdrValue.IsPositive())
+	require.True(t, input.MarketKeeper.GetExchangeRequirement(input.Ctx).Sub(sdrValue).Equal(input.MarketKeeper.GetExchangeRequirement(ctx)))
+	for _, supply := range input.TreasuryKeeper.GetSolvency(ctx).StableSupplies {
+		require.NotEqual(t, assets.MicroSDRDenom, supply.Supply.Denom)
+	}
+}
+
+func TestRedemptionLiability(t *testing.T) {
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper.(oraclekeeper.Keeper)
+
+	// the market vault keeps backing a second stable denom
+	err := input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, sdk.NewCoins(sdk.NewInt64Coin(assets.MicroKRWDenom, 10_000*1e6)))
+	require.NoError(t, err)
+	oracleKeeper.SetMelodyExchangeRate(input.Ctx, assets.MicroKRWDenom, sdk.NewDecWithPrec(1, 3))
+	oracleKeeper.SetTobinTax(input.Ctx, assets.MicroKRWDenom, sdk.NewDecWithPrec(2, 2))
+	krwRequirement := sdk.NewDec(10_000 * 1e3)
+
+	exchangeRequirement := input.MarketKeeper.GetExchangeRequirement(input.Ctx)
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+	require.NoError(t, err)
+	require.True(t, input.TreasuryKeeper.GetRedemptionLiability(input.Ctx).IsZero())
+
+	// the reserve earmarks the redemption of the whole supply winding down
+	proposal := types.NewWindDownProposal("title", "description", assets.MicroSDRDenom, sdk.ZeroDec(), time.Hour)
+	require.NoError(t, input.TreasuryKeeper.HandleWindDownProposal(input.Ctx, &proposal))
+	windDown, _ := input.OracleKeeper.GetWindDown(input.Ctx, assets.MicroSDRDenom)
+	liability := windDown.RedemptionRate.MulInt(input.BankKeeper.GetSupply(input.Ctx, assets.MicroSDRDenom).Amount).Ceil().TruncateInt()
+	require.True(t, liability.IsPositive())
+	require.Equal(t, liability, input.TreasuryKeeper.GetRedemptionLiability(input.Ctx))
+
+	// the refill only covers the denoms still backed by the market vault and leaves the earmark in the reserve
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.MaxRefillPerPeriod = exchangeRequirement.TruncateInt()
+	params.DrainSurplus = true
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	cacheCtx, _ := input.Ctx.CacheContext()
+	refillAmount, err := input.TreasuryKeeper.RefillExchangePool(cacheCtx)
+	require.NoError(t, err)
+	require.Equal(t, krwRequirement.TruncateInt(), refillAmount)
+	require.True(t, input.TreasuryKeeper.GetReservePoolBalance(cacheCtx).Amount.GTE(liability))
+
+	// the backing of the denom winding down is drained from the market vault
+	err = input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, markettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, exchangeRequirement.TruncateInt())))
+	require.NoError(t, err)
+	drainAmount, err := input.TreasuryKeeper.DrainExchangePool(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, exchangeRequirement.Sub(krwRequirement).TruncateInt(), drainAmount)
+
+	// the earmark is released once the redemption closes
+	ctx := input.Ctx.WithBlockTime(windDown.Deadline.Add(time.Second))
+	require.True(t, input.TreasuryKeeper.GetRedemptionLiability(ctx).IsZero())
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -21,6 +23,32 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &taxRateA)
 			cdc.MustUnmarshal(kvB.Value, &taxRateB)
 			return fmt.Sprintf("%v\n%v", taxRateA, taxRateB)
+		case bytes.Equal(kvA.Key[:1], types.EpochKey), bytes.Equal(kvA.Key[:1], types.ProbationStartEpochKey):
+			var epochA, epochB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA.Value, epochB.Value)
+		case bytes.Equal(kvA.Key[:1], types.TaxProceedsKey), bytes.Equal(kvA.Key[:1], types.TaxProceedsByDenomKey),
+			bytes.Equal(kvA.Key[:1], types.ReserveRefillsKey), bytes.Equal(kvA.Key[:1], types.EpochInitialExchangePoolKey),
+			bytes.Equal(kvA.Key[:1], types.TaxCapKey), bytes.Equal(kvA.Key[:1], types.ReserveDrainsKey):
+			var taxProceedsA, taxProceedsB sdk.IntProto
+			cdc.MustUnmarshal(kvA.Value, &taxProceedsA)
+			cdc.MustUnmarshal(kvB.Value, &taxProceedsB)
+			return fmt.Sprintf("%v\n%v", taxProceedsA, taxProceedsB)
+		case bytes.Equal(kvA.Key[:1], types.EpochStateKey):
+			var epochStateA, epochStateB types.EpochState
+			cdc.MustUnmarshal(kvA.Value, &epochStateA)
+			cdc.MustUnmarshal(kvB.Value, &epochStateB)
+			return fmt.Sprintf("%v\n%v", epochStateA, epochStateB)
+		case bytes.Equal(kvA.Key[:1], types.ZoneKey):
+			var zoneA, zoneB types.Zone
+			cdc.MustUnmarshal(kvA.Value, &zoneA)
+			cdc.MustUnmarshal(kvB.Value, &zoneB)
+			return fmt.Sprintf("%v\n%v", zoneA, zoneB)
+		case bytes.Equal(kvA.Key[:1], types.TaxExemptionKey):
+			return fmt.Sprintf("%v\n%v", string(kvA.Value), string(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TaxExemptionRouteKey):
+			return fmt.Sprintf("%v\n%v", string(kvA.Key[1:]), string(kvB.Key[1:]))
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,10 +19,21 @@ func TestDecodeDistributionStore(t *testing.T) {
 	dec := NewDecodeStore(cdc)
 
 	taxRate := sdk.NewDecWithPrec(123, 2)
+	taxProceeds := sdk.NewInt(1234)
+	epochState := types.NewEpochState(3, taxProceeds, sdk.OneDec(), taxRate)
+	taxCap := sdk.NewInt(1000)
+	zone := types.NewZone("custody", false, true)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.TaxRateKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: taxRate})},
+			{Key: types.EpochKey, Value: cdc.MustMarshal(&gogotypes.UInt64Value{Value: 3})},
+			{Key: types.TaxProceedsKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: taxProceeds})},
+			{Key: types.GetEpochStateKey(3), Value: cdc.MustMarshal(&epochState)},
+			{Key: types.GetTaxCapKey("uusd"), Value: cdc.MustMarshal(&sdk.IntProto{Int: taxCap})},
+			{Key: types.GetZoneKey(zone.Name), Value: cdc.MustMarshal(&zone)},
+			{Key: types.GetTaxExemptionKey(sdk.AccAddress([]byte("addr1_______________"))), Value: []byte(zone.Name)},
+			{Key: types.GetTaxExemptionRouteKey("/osmosis.market.v1beta1.MsgSwapSend"), Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +43,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"TaxRate", fmt.Sprintf("%v\n%v", taxRate, taxRate)},
+		{"Epoch", fmt.Sprintf("%v\n%v", 3, 3)},
+		{"TaxProceeds", fmt.Sprintf("%v\n%v", sdk.IntProto{Int: taxProceeds}, sdk.IntProto{Int: taxProceeds})},
+		{"EpochState", fmt.Sprintf("%v\n%v", epochState, epochState)},
+		{"TaxCap", fmt.Sprintf("%v\n%v", sdk.IntProto{Int: taxCap}, sdk.IntProto{Int: taxCap})},
+		{"Zone", fmt.Sprintf("%v\n%v", zone, zone)},
+		{"TaxExemption", fmt.Sprintf("%v\n%v", zone.Name, zone.Name)},
+		{"TaxExemptionRoute", "/osmosis.market.v1beta1.MsgSwapSend\n/osmosis.market.v1beta1.MsgSwapSend"},
 		{"other", ""},
 	}
 
@@ -66,6 +66,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 			WindowShort:            windowShort,
 			WindowLong:             windowLong,
 			WindowProbation:        windowProbation,
+			MinTaxRate:             types.DefaultMinTaxRate,
+			MaxTaxRateChange:       types.DefaultMaxTaxRateChange,
+			RefillInterval:         types.DefaultRefillInterval,
+			MaxRefillPerPeriod:     types.DefaultMaxRefillPerPeriod,
+			DrainSurplus:           types.DefaultDrainSurplus,
+			RedemptionOnlyRatio:    types.DefaultRedemptionOnlyRatio,
+			HaltRatio:              types.DefaultHaltRatio,
 		},
 		sdk.Dec{},
 	)
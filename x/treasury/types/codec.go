@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&UpdateTaxCapsProposal{}, "symphony/UpdateTaxCapsProposal", nil)
+	cdc.RegisterConcrete(&AddTaxExemptionZoneProposal{}, "symphony/AddTaxExemptionZoneProposal", nil)
+	cdc.RegisterConcrete(&RemoveTaxExemptionZoneProposal{}, "symphony/RemoveTaxExemptionZoneProposal", nil)
+	cdc.RegisterConcrete(&AddTaxExemptionAddressProposal{}, "symphony/AddTaxExemptionAddressProposal", nil)
+	cdc.RegisterConcrete(&RemoveTaxExemptionAddressProposal{}, "symphony/RemoveTaxExemptionAddressProposal", nil)
+	cdc.RegisterConcrete(&AddTaxExemptionRouteProposal{}, "symphony/AddTaxExemptionRouteProposal", nil)
+	cdc.RegisterConcrete(&RemoveTaxExemptionRouteProposal{}, "symphony/RemoveTaxExemptionRouteProposal", nil)
+	cdc.RegisterConcrete(&WindDownProposal{}, "symphony/WindDownProposal", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgRedeem{}, "treasury/MsgRedeem")
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&UpdateTaxCapsProposal{},
+		&AddTaxExemptionZoneProposal{},
+		&RemoveTaxExemptionZoneProposal{},
+		&AddTaxExemptionAddressProposal{},
+		&RemoveTaxExemptionAddressProposal{},
+		&AddTaxExemptionRouteProposal{},
+		&RemoveTaxExemptionRouteProposal{},
+		&WindDownProposal{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRedeem{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEpochState creates an EpochState instance without tax breakdown, seigniorage or reserve flows
+func NewEpochState(epoch uint64, taxProceeds sdk.Int, reserveCoverage sdk.Dec, taxRate sdk.Dec) EpochState {
+	return EpochState{
+		Epoch:              epoch,
+		TaxProceeds:        taxProceeds,
+		ReserveCoverage:    reserveCoverage,
+		TaxRate:            taxRate,
+		TaxProceedsByDenom: sdk.Coins{},
+		Seigniorage:        sdk.ZeroInt(),
+		ReserveRefills:     sdk.ZeroInt(),
+		ReserveBalance:     sdk.ZeroInt(),
+		ReserveDrains:      sdk.ZeroInt(),
+	}
+}
+
+// Validate performs basic validation of the epoch state
+func (s EpochState) Validate() error {
+	if s.TaxProceeds.IsNil() || s.TaxProceeds.IsNegative() {
+		return fmt.Errorf("tax proceeds of epoch %d must be positive or zero: %s", s.Epoch, s.TaxProceeds)
+	}
+
+	if s.ReserveCoverage.IsNil() || s.ReserveCoverage.IsNegative() {
+		return fmt.Errorf("reserve coverage of epoch %d must be positive or zero: %s", s.Epoch, s.ReserveCoverage)
+	}
+
+	if s.TaxRate.IsNil() || s.TaxRate.IsNegative() {
+		return fmt.Errorf("tax rate of epoch %d must be positive or zero: %s", s.Epoch, s.TaxRate)
+	}
+
+	if err := s.TaxProceedsByDenom.Validate(); err != nil {
+		return fmt.Errorf("tax proceeds by denom of epoch %d are invalid: %w", s.Epoch, err)
+	}
+
+	if s.Seigniorage.IsNil() {
+		return fmt.Errorf("seigniorage of epoch %d must be set", s.Epoch)
+	}
+
+	if s.ReserveRefills.IsNil() || s.ReserveRefills.IsNegative() {
+		return fmt.Errorf("reserve refills of epoch %d must be positive or zero: %s", s.Epoch, s.ReserveRefills)
+	}
+
+	if s.ReserveBalance.IsNil() || s.ReserveBalance.IsNegative() {
+		return fmt.Errorf("reserve balance of epoch %d must be positive or zero: %s", s.Epoch, s.ReserveBalance)
+	}
+
+	if s.ReserveDrains.IsNil() || s.ReserveDrains.IsNegative() {
+		return fmt.Errorf("reserve drains of epoch %d must be positive or zero: %s", s.Epoch, s.ReserveDrains)
+	}
+
+	return nil
+}
+
+// ReserveRecord returns the reserve flows of the epoch
+func (s EpochState) ReserveRecord() ReserveRecord {
+	return ReserveRecord{
+		Epoch:       s.Epoch,
+		Balance:     s.ReserveBalance,
+		Refills:     s.ReserveRefills,
+		TaxProceeds: s.TaxProceeds,
+		Coverage:    s.ReserveCoverage,
+		Drains:      s.ReserveDrains,
+	}
+}
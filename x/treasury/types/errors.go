@@ -4,4 +4,18 @@ import (
 	errorsmod "cosmossdk.io/errors"
 )
 
-var ErrNoSuchBurnTaxExemptionAddress = errorsmod.Register(ModuleName, 1, "no such address in extemption list")
+var ErrNoSuchTaxExemptionAddress = errorsmod.Register(ModuleName, 1, "no such address in exemption list")
+
+var ErrNoTaxCap = errorsmod.Register(ModuleName, 2, "no tax cap for the denom")
+
+var ErrNoSuchTaxExemptionZone = errorsmod.Register(ModuleName, 3, "no such tax exemption zone")
+
+var ErrTaxExemptionAddressInZone = errorsmod.Register(ModuleName, 4, "address already belongs to another tax exemption zone")
+
+var ErrNotWindingDown = errorsmod.Register(ModuleName, 5, "denom is not winding down")
+
+var ErrRedemptionClosed = errorsmod.Register(ModuleName, 6, "redemption period is over")
+
+var ErrInsufficientReserve = errorsmod.Register(ModuleName, 7, "insufficient reserve")
+
+var ErrNoSuchTaxExemptionRoute = errorsmod.Register(ModuleName, 8, "no such route in exemption list")
@@ -2,12 +2,28 @@ package types
 
 // Treasury module event types
 const (
-	EventTypeTaxRateUpdate = "treasury_tax_rate_update"
+	EventTypeTaxRateUpdate         = "treasury_tax_rate_update"
+	EventTypeReserveRefill         = "treasury_reserve_refill"
+	EventTypeReserveRefillFailed   = "treasury_reserve_refill_failed"
+	EventTypeReserveDrain          = "treasury_reserve_drain"
+	EventTypeReserveDrainFailed    = "treasury_reserve_drain_failed"
+	EventTypeRedeem                = "treasury_redeem"
+	EventTypeTaxProceedsSwap       = "treasury_tax_proceeds_swap"
+	EventTypeTaxProceedsSwapFailed = "treasury_tax_proceeds_swap_failed"
 
 	AttributeKeyOldTaxRate               = "old_tax_rate"
 	AttributeKeyNewTaxRate               = "new_tax_rate"
 	AttributeKeyExchangePoolRefillAmount = "exchange_pool_refill_amount"
+	AttributeKeyExchangePoolDrainAmount  = "exchange_pool_drain_amount"
+	AttributeKeySwappedAmount            = "swapped_amount"
+	AttributeKeyError                    = "error"
 	AttributeKeyRewardWeight             = "reward_weight"
+	AttributeKeyEpoch                    = "epoch"
+	AttributeKeyTaxProceeds              = "tax_proceeds"
+	AttributeKeyReserveCoverage          = "reserve_coverage"
+	AttributeKeySender                   = "sender"
+	AttributeKeyCoin                     = "coin"
+	AttributeKeyRedeemedCoin             = "redeemed_coin"
 
 	AttributeValueCategory = ModuleName
 )
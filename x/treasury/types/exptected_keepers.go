@@ -6,7 +6,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
 	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v23/x/txfees/types"
 )
 
 // AccountKeeper expected account keeper
@@ -19,6 +22,8 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
@@ -31,6 +36,12 @@ type MarketKeeper interface {
 	GetExchangePoolBalance(ctx sdk.Context) sdk.Coin
 	// GetExchangeRequirement calculates the total amount of Melody asset required to back the assets in the exchange pool.
 	GetExchangeRequirement(ctx sdk.Context) sdk.Dec
+	// GetExchangeRequirements returns the supply and the note exchange rate of every stable denom.
+	GetExchangeRequirements(ctx sdk.Context) []markettypes.ExchangeRequirement
+	// GetUnpricedDenoms returns the stable denoms with an outstanding supply but without a current exchange rate.
+	GetUnpricedDenoms(ctx sdk.Context) []string
+	// UpdateCircuitBreakerState moves the market circuit breaker to the state, unless governance overrides it.
+	UpdateCircuitBreakerState(ctx sdk.Context, state markettypes.CircuitBreakerState)
 	ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, error)
 }
 
@@ -48,7 +59,27 @@ type DistributionKeeper interface {
 // OracleKeeper defines expected oracle keeper
 type OracleKeeper interface {
 	Whitelist(ctx sdk.Context) (res oracletypes.DenomList)
+	GetMelodyExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+	GetWindDown(ctx sdk.Context, denom string) (oracletypes.WindDown, bool)
+	StartWindDown(ctx sdk.Context, windDown oracletypes.WindDown) error
 
 	// only used for test purpose
 	SetWhitelist(ctx sdk.Context, whitelist oracletypes.DenomList)
 }
+
+// TxFeesKeeper defines expected txfees keeper
+type TxFeesKeeper interface {
+	GetFeeToken(ctx sdk.Context, denom string) (txfeestypes.FeeToken, error)
+}
+
+// PoolManagerKeeper defines expected pool manager keeper
+type PoolManagerKeeper interface {
+	SwapExactAmountInNoTakerFee(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolId uint64,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		tokenOutMinAmount osmomath.Int,
+	) (osmomath.Int, error)
+}
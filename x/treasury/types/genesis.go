@@ -11,17 +11,24 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, taxRate sdk.Dec) *GenesisState {
 	return &GenesisState{
-		Params:  params,
-		TaxRate: taxRate,
+		Params:              params,
+		TaxRate:             taxRate,
+		TaxProceeds:         sdk.ZeroInt(),
+		EpochStates:         []EpochState{},
+		TaxProceedsByDenom:  sdk.Coins{},
+		ReserveRefills:      sdk.ZeroInt(),
+		ReserveDrains:       sdk.ZeroInt(),
+		TaxCaps:             []TaxCap{},
+		Zones:               []Zone{},
+		TaxExemptions:       []TaxExemption{},
+		WithheldTaxProceeds: sdk.Coins{},
+		TaxExemptionRoutes:  []string{},
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{
-		Params:  DefaultParams(),
-		TaxRate: DefaultTaxRate,
-	}
+	return NewGenesisState(DefaultParams(), DefaultTaxRate)
 }
 
 // ValidateGenesis validates the provided oracle genesis state to ensure the
@@ -33,6 +40,84 @@ func ValidateGenesis(data *GenesisState) error {
 	if data.TaxRate.GT(data.Params.MaxFeeMultiplier) {
 		return fmt.Errorf("tax_rate must less than RateMax(%s)", data.Params.MaxFeeMultiplier)
 	}
+	if data.TaxProceeds.IsNil() || data.TaxProceeds.IsNegative() {
+		return fmt.Errorf("tax_proceeds must be positive or zero, is %s", data.TaxProceeds)
+	}
+	if err := data.TaxProceedsByDenom.Validate(); err != nil {
+		return fmt.Errorf("tax_proceeds_by_denom is invalid: %w", err)
+	}
+	if err := data.WithheldTaxProceeds.Validate(); err != nil {
+		return fmt.Errorf("withheld_tax_proceeds is invalid: %w", err)
+	}
+	if data.ReserveRefills.IsNil() || data.ReserveRefills.IsNegative() {
+		return fmt.Errorf("reserve_refills must be positive or zero, is %s", data.ReserveRefills)
+	}
+	if data.ReserveDrains.IsNil() || data.ReserveDrains.IsNegative() {
+		return fmt.Errorf("reserve_drains must be positive or zero, is %s", data.ReserveDrains)
+	}
+	if data.EpochInitialExchangePool != nil && data.EpochInitialExchangePool.IsNegative() {
+		return fmt.Errorf("epoch_initial_exchange_pool must be positive or zero, is %s", data.EpochInitialExchangePool)
+	}
+	if data.ProbationStartEpoch > data.Epoch {
+		return fmt.Errorf("probation_start_epoch must not be after the current epoch: (%d, %d)", data.ProbationStartEpoch, data.Epoch)
+	}
+
+	seen := make(map[uint64]bool, len(data.EpochStates))
+	for _, state := range data.EpochStates {
+		if state.Epoch >= data.Epoch {
+			return fmt.Errorf("epoch state %d must be before the current epoch %d", state.Epoch, data.Epoch)
+		}
+		if seen[state.Epoch] {
+			return fmt.Errorf("duplicate epoch state %d", state.Epoch)
+		}
+		seen[state.Epoch] = true
+
+		if err := state.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenCaps := make(map[string]bool, len(data.TaxCaps))
+	for _, taxCap := range data.TaxCaps {
+		if seenCaps[taxCap.Denom] {
+			return fmt.Errorf("duplicate tax cap for denom %s", taxCap.Denom)
+		}
+		seenCaps[taxCap.Denom] = true
+
+		if err := taxCap.Validate(); err != nil {
+			return err
+		}
+	}
+
+	zones := make(map[string]bool, len(data.Zones))
+	for _, zone := range data.Zones {
+		if zones[zone.Name] {
+			return fmt.Errorf("duplicate tax exemption zone %s", zone.Name)
+		}
+		zones[zone.Name] = true
+
+		if err := zone.Validate(); err != nil {
+			return err
+		}
+	}
+
+	exemptAddresses := make(map[string]bool, len(data.TaxExemptions))
+	for _, exemption := range data.TaxExemptions {
+		if err := exemption.Validate(); err != nil {
+			return err
+		}
+		if !zones[exemption.Zone] {
+			return fmt.Errorf("tax exemption address %s belongs to unknown zone %s", exemption.Address, exemption.Zone)
+		}
+		if exemptAddresses[exemption.Address] {
+			return fmt.Errorf("duplicate tax exemption address %s", exemption.Address)
+		}
+		exemptAddresses[exemption.Address] = true
+	}
+
+	if err := validateTaxExemptionRoutes(data.TaxExemptionRoutes); err != nil {
+		return err
+	}
 
 	return data.Params.Validate()
 }
@@ -6,8 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +30,33 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params  Params                                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	// epoch is the current treasury epoch
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// tax_proceeds is the stability tax collected during the current epoch
+	TaxProceeds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=tax_proceeds,json=taxProceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tax_proceeds"`
+	// probation_start_epoch is the epoch the current probation started at
+	ProbationStartEpoch uint64       `protobuf:"varint,5,opt,name=probation_start_epoch,json=probationStartEpoch,proto3" json:"probation_start_epoch,omitempty"`
+	EpochStates         []EpochState `protobuf:"bytes,6,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
+	// tax_proceeds_by_denom is the stability tax assessed during the current
+	// epoch per taxed denom
+	TaxProceedsByDenom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=tax_proceeds_by_denom,json=taxProceedsByDenom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds_by_denom"`
+	// reserve_refills is the note sent from the reserve to the market vault
+	// during the current epoch
+	ReserveRefills github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=reserve_refills,json=reserveRefills,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_refills"`
+	// epoch_initial_exchange_pool is the market vault balance at the start of
+	// the current epoch; the vault balance at genesis is used when unset
+	EpochInitialExchangePool *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=epoch_initial_exchange_pool,json=epochInitialExchangePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_initial_exchange_pool,omitempty"`
+	TaxCaps                  []TaxCap                                `protobuf:"bytes,10,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
+	Zones                    []Zone                                  `protobuf:"bytes,11,rep,name=zones,proto3" json:"zones"`
+	TaxExemptions            []TaxExemption                          `protobuf:"bytes,12,rep,name=tax_exemptions,json=taxExemptions,proto3" json:"tax_exemptions"`
+	// reserve_drains is the note sent from the market vault back to the reserve
+	// during the current epoch
+	ReserveDrains github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=reserve_drains,json=reserveDrains,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_drains"`
+	// withheld_tax_proceeds is the stability tax withheld in-kind from the fees
+	// and not converted into reserve yet
+	WithheldTaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=withheld_tax_proceeds,json=withheldTaxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withheld_tax_proceeds"`
+	// tax_exemption_routes are the message routes exempt from the stability tax
+	TaxExemptionRoutes []string `protobuf:"bytes,15,rep,name=tax_exemption_routes,json=taxExemptionRoutes,proto3" json:"tax_exemption_routes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,6 +99,69 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProbationStartEpoch() uint64 {
+	if m != nil {
+		return m.ProbationStartEpoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochStates() []EpochState {
+	if m != nil {
+		return m.EpochStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaxProceedsByDenom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxProceedsByDenom
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaxCaps() []TaxCap {
+	if m != nil {
+		return m.TaxCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetZones() []Zone {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaxExemptions() []TaxExemption {
+	if m != nil {
+		return m.TaxExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithheldTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithheldTaxProceeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaxExemptionRoutes() []string {
+	if m != nil {
+		return m.TaxExemptionRoutes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.treasury.v1beta1.GenesisState")
 }
@@ -81,26 +171,49 @@ func init() {
 }
 
 var fileDescriptor_da6b6ef11cad5829 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x29, 0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xaa, 0xd3, 0x83, 0xa9, 0xd3, 0x83, 0xaa, 0x93, 0x92, 0x4b, 0x06,
-	0x4b, 0xe9, 0x27, 0x25, 0x16, 0xa7, 0xc2, 0x35, 0x27, 0xe7, 0x67, 0xe6, 0x41, 0x74, 0x4a, 0x49,
-	0x42, 0xe4, 0xe3, 0xc1, 0x3c, 0x7d, 0x08, 0x07, 0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11,
-	0x07, 0xb1, 0xa0, 0xa2, 0xea, 0x38, 0x9d, 0x04, 0xb7, 0x1b, 0xac, 0x50, 0x69, 0x39, 0x23, 0x17,
-	0x8f, 0x3b, 0xc4, 0x95, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x76, 0x5c, 0x6c, 0x05, 0x89, 0x45,
-	0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x0a, 0x7a, 0xb8, 0x5c, 0xad, 0x17,
-	0x00, 0x56, 0xe7, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x97, 0x50, 0x38, 0x17, 0x47,
-	0x49, 0x62, 0x45, 0x7c, 0x51, 0x62, 0x49, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0x0d,
-	0x48, 0xfe, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0xa8, 0x17, 0xa0, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0xb1, 0x9e, 0x4b,
-	0x6a, 0xf2, 0xa5, 0x2d, 0xba, 0x5c, 0x50, 0x1f, 0xba, 0xa4, 0x26, 0x07, 0xb1, 0x97, 0x24, 0x56,
-	0x04, 0x25, 0x96, 0xa4, 0x3a, 0xf9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
-	0x94, 0x09, 0x92, 0xc1, 0x50, 0xc7, 0xea, 0xe6, 0x24, 0x26, 0x15, 0xc3, 0x38, 0xfa, 0x65, 0x46,
-	0xc6, 0xfa, 0x15, 0x88, 0xa0, 0x00, 0x5b, 0x95, 0xc4, 0x06, 0x0e, 0x00, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xfc, 0xa2, 0x9d, 0x5a, 0xbe, 0x01, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4e, 0x13, 0x41,
+	0x18, 0xef, 0x5a, 0xfe, 0x75, 0x5a, 0x20, 0x19, 0x20, 0x19, 0x30, 0x59, 0x36, 0xc6, 0x60, 0x2f,
+	0xec, 0x42, 0xf1, 0x60, 0x8c, 0x31, 0xb1, 0x94, 0x18, 0x0e, 0x1a, 0xb2, 0x90, 0x98, 0x70, 0xd9,
+	0xcc, 0x6e, 0x3f, 0xdb, 0x8d, 0xdb, 0x9d, 0xcd, 0xcc, 0x00, 0x5b, 0x0f, 0xfa, 0x0a, 0x3e, 0x87,
+	0x67, 0x1f, 0x82, 0x23, 0xf1, 0x64, 0x3c, 0xa0, 0x81, 0xb3, 0xef, 0x60, 0x66, 0x76, 0x5a, 0x97,
+	0x43, 0x89, 0x1a, 0x4e, 0xed, 0xcc, 0xf7, 0xfd, 0xfe, 0xcc, 0x6f, 0xbe, 0x59, 0xb4, 0xc1, 0xc4,
+	0x80, 0x89, 0x58, 0x78, 0x92, 0x03, 0x15, 0x27, 0x7c, 0xe8, 0x9d, 0x6e, 0x87, 0x20, 0xe9, 0xb6,
+	0xd7, 0x83, 0x14, 0x44, 0x2c, 0xdc, 0x8c, 0x33, 0xc9, 0x30, 0x31, 0x7d, 0xee, 0xa8, 0xcf, 0x35,
+	0x7d, 0x6b, 0x76, 0xa4, 0x4b, 0x5e, 0x48, 0x05, 0x8c, 0xc1, 0x11, 0x8b, 0xd3, 0x02, 0xb9, 0xb6,
+	0x5a, 0xd4, 0x03, 0xbd, 0xf2, 0x8a, 0x85, 0x29, 0x2d, 0xf7, 0x58, 0x8f, 0x15, 0xfb, 0xea, 0x9f,
+	0xd9, 0x7d, 0x34, 0xd1, 0xd2, 0x58, 0x5b, 0x37, 0x3e, 0xf8, 0x55, 0x43, 0x8d, 0x97, 0x85, 0xcb,
+	0x43, 0x49, 0x25, 0xe0, 0xe7, 0x68, 0x26, 0xa3, 0x9c, 0x0e, 0x04, 0xb1, 0x1c, 0xab, 0x59, 0x6f,
+	0x39, 0xee, 0x24, 0xd7, 0xee, 0x81, 0xee, 0x6b, 0x4f, 0x9d, 0x5f, 0xae, 0x57, 0x7c, 0x83, 0xc2,
+	0x6f, 0xd0, 0x9c, 0xa4, 0x79, 0xc0, 0xa9, 0x04, 0x72, 0xcf, 0xb1, 0x9a, 0xb5, 0xf6, 0x33, 0x55,
+	0xff, 0x7e, 0xb9, 0xbe, 0xd1, 0x8b, 0x65, 0xff, 0x24, 0x74, 0x23, 0x36, 0x30, 0x47, 0x30, 0x3f,
+	0x9b, 0xa2, 0xfb, 0xce, 0x93, 0xc3, 0x0c, 0x84, 0xdb, 0x81, 0xe8, 0xeb, 0x97, 0x4d, 0x64, 0x4e,
+	0xd8, 0x81, 0xc8, 0x9f, 0x95, 0x34, 0xf7, 0x95, 0xb1, 0x65, 0x34, 0x0d, 0x19, 0x8b, 0xfa, 0xa4,
+	0xea, 0x58, 0xcd, 0x29, 0xbf, 0x58, 0xe0, 0x00, 0x35, 0x94, 0x5c, 0xc6, 0x59, 0x04, 0xd0, 0x15,
+	0x64, 0xea, 0x9f, 0x25, 0xf7, 0x53, 0x59, 0x92, 0xdc, 0x4f, 0xa5, 0x5f, 0x97, 0x34, 0x3f, 0x30,
+	0x84, 0xb8, 0x85, 0x56, 0x32, 0xce, 0x42, 0x2a, 0x63, 0x96, 0x06, 0x42, 0x52, 0x2e, 0x83, 0xc2,
+	0xc6, 0xb4, 0xb6, 0xb1, 0x34, 0x2e, 0x1e, 0xaa, 0xda, 0x9e, 0x36, 0xf5, 0x0a, 0x35, 0x74, 0x8f,
+	0xea, 0x97, 0x20, 0xc8, 0x8c, 0x53, 0x6d, 0xd6, 0x5b, 0x0f, 0x27, 0x27, 0xa9, 0x61, 0x3a, 0x7f,
+	0x93, 0x66, 0x1d, 0xc6, 0x3b, 0x02, 0x7f, 0x40, 0x2b, 0xe5, 0x33, 0x06, 0xe1, 0x30, 0xe8, 0x42,
+	0xca, 0x06, 0x64, 0x56, 0xf3, 0xae, 0xba, 0xc6, 0xbb, 0x9a, 0x9e, 0x31, 0xe5, 0x2e, 0x8b, 0xd3,
+	0xf6, 0x96, 0x22, 0xfb, 0xfc, 0x63, 0xbd, 0xf9, 0x17, 0x39, 0x28, 0x80, 0xf0, 0x71, 0xe9, 0xec,
+	0xed, 0x61, 0x47, 0xc9, 0x60, 0x40, 0x8b, 0x1c, 0x04, 0xf0, 0x53, 0x08, 0x38, 0xbc, 0x8d, 0x93,
+	0x44, 0x90, 0xb9, 0x3b, 0x88, 0x79, 0xc1, 0x90, 0xfa, 0x05, 0x27, 0x3e, 0x43, 0xf7, 0x8b, 0xd4,
+	0xe2, 0x34, 0x96, 0x31, 0x4d, 0x02, 0xc8, 0xa3, 0x3e, 0x4d, 0x7b, 0x10, 0x64, 0x8c, 0x25, 0xa4,
+	0xa6, 0x25, 0x9f, 0xfc, 0xb7, 0x1c, 0xd1, 0xe4, 0xfb, 0x05, 0xf7, 0x9e, 0xa1, 0x3e, 0x60, 0x2c,
+	0xc1, 0x2f, 0x8a, 0x91, 0x8d, 0x68, 0x26, 0x08, 0x72, 0xaa, 0xb7, 0x0f, 0xfd, 0x11, 0xcd, 0x77,
+	0x69, 0x66, 0xae, 0x49, 0x0d, 0xe7, 0x2e, 0xcd, 0x04, 0x7e, 0x8a, 0xa6, 0xdf, 0xb3, 0x14, 0x04,
+	0xa9, 0x6b, 0xbc, 0x3d, 0x19, 0x7f, 0xcc, 0xd2, 0xd1, 0x25, 0x17, 0x10, 0x7c, 0x88, 0x16, 0x94,
+	0x3c, 0xe4, 0x30, 0xc8, 0xd4, 0x20, 0x09, 0xd2, 0xd0, 0x24, 0x1b, 0xb7, 0x9a, 0xd8, 0x1b, 0xb5,
+	0x1b, 0xb2, 0x79, 0x59, 0xda, 0x13, 0x38, 0x42, 0xa3, 0x78, 0x83, 0x2e, 0xa7, 0x71, 0x2a, 0xc8,
+	0xfc, 0x1d, 0x5c, 0xd9, 0xbc, 0xe1, 0xec, 0x68, 0x4a, 0xfc, 0x11, 0xad, 0x9c, 0xc5, 0xb2, 0xdf,
+	0x87, 0xa4, 0x1b, 0xdc, 0x78, 0x85, 0x0b, 0x77, 0x3f, 0x98, 0x4b, 0x23, 0xa5, 0xa3, 0xd2, 0xe3,
+	0xdc, 0x42, 0xcb, 0x37, 0xa2, 0x0b, 0x38, 0x3b, 0x51, 0x0f, 0x6e, 0xd1, 0xa9, 0x36, 0x6b, 0x3e,
+	0x2e, 0x47, 0xe2, 0xeb, 0x4a, 0xfb, 0xf5, 0xf9, 0x95, 0x6d, 0x5d, 0x5c, 0xd9, 0xd6, 0xcf, 0x2b,
+	0xdb, 0xfa, 0x74, 0x6d, 0x57, 0x2e, 0xae, 0xed, 0xca, 0xb7, 0x6b, 0xbb, 0x72, 0xfc, 0xb8, 0x64,
+	0xc5, 0x04, 0xbf, 0x99, 0xd0, 0x50, 0x8c, 0x16, 0xde, 0x69, 0x6b, 0xc7, 0xcb, 0xff, 0x7c, 0x50,
+	0xb5, 0xb9, 0x70, 0x46, 0x7f, 0x46, 0x77, 0x7e, 0x0f, 0x00, 0x90, 0x58, 0x83, 0x67, 0x04, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +236,151 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TaxExemptionRoutes) > 0 {
+		for iNdEx := len(m.TaxExemptionRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaxExemptionRoutes[iNdEx])
+			copy(dAtA[i:], m.TaxExemptionRoutes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TaxExemptionRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.WithheldTaxProceeds) > 0 {
+		for iNdEx := len(m.WithheldTaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithheldTaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.ReserveDrains.Size()
+		i -= size
+		if _, err := m.ReserveDrains.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.TaxExemptions) > 0 {
+		for iNdEx := len(m.TaxExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.EpochInitialExchangePool != nil {
+		{
+			size := m.EpochInitialExchangePool.Size()
+			i -= size
+			if _, err := m.EpochInitialExchangePool.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.ReserveRefills.Size()
+		i -= size
+		if _, err := m.ReserveRefills.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.TaxProceedsByDenom) > 0 {
+		for iNdEx := len(m.TaxProceedsByDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceedsByDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EpochStates) > 0 {
+		for iNdEx := len(m.EpochStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ProbationStartEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProbationStartEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TaxProceeds.Size()
+		i -= size
+		if _, err := m.TaxProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.TaxRate.Size()
 		i -= size
@@ -167,6 +425,64 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TaxRate.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.TaxProceeds.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ProbationStartEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.ProbationStartEpoch))
+	}
+	if len(m.EpochStates) > 0 {
+		for _, e := range m.EpochStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaxProceedsByDenom) > 0 {
+		for _, e := range m.TaxProceedsByDenom {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ReserveRefills.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EpochInitialExchangePool != nil {
+		l = m.EpochInitialExchangePool.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaxExemptions) > 0 {
+		for _, e := range m.TaxExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ReserveDrains.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WithheldTaxProceeds) > 0 {
+		for _, e := range m.WithheldTaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaxExemptionRoutes) > 0 {
+		for _, s := range m.TaxExemptionRoutes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -272,6 +588,418 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbationStartEpoch", wireType)
+			}
+			m.ProbationStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbationStartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStates = append(m.EpochStates, EpochState{})
+			if err := m.EpochStates[len(m.EpochStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceedsByDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceedsByDenom = append(m.TaxProceedsByDenom, types.Coin{})
+			if err := m.TaxProceedsByDenom[len(m.TaxProceedsByDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRefills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveRefills.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInitialExchangePool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EpochInitialExchangePool = &v
+			if err := m.EpochInitialExchangePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCaps = append(m.TaxCaps, TaxCap{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, Zone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxExemptions = append(m.TaxExemptions, TaxExemption{})
+			if err := m.TaxExemptions[len(m.TaxExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveDrains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveDrains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithheldTaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithheldTaxProceeds = append(m.WithheldTaxProceeds, types.Coin{})
+			if err := m.WithheldTaxProceeds[len(m.WithheldTaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxExemptionRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxExemptionRoutes = append(m.TaxExemptionRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -25,5 +25,110 @@ func TestGenesisValidation(t *testing.T) {
 	require.NoError(t, ValidateGenesis(genState))
 
 	// Valid
+	genState.Epoch = 2
+	genState.ProbationStartEpoch = 1
+	genState.EpochStates = []EpochState{
+		NewEpochState(0, sdk.NewInt(100), sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+		NewEpochState(1, sdk.ZeroInt(), sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2)),
+	}
 	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - probation starts after the current epoch
+	genState.ProbationStartEpoch = 3
+	require.Error(t, ValidateGenesis(genState))
+	genState.ProbationStartEpoch = 1
+
+	// Error - epoch state of the current epoch
+	genState.EpochStates = append(genState.EpochStates, NewEpochState(2, sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroDec()))
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - duplicate epoch state
+	genState.EpochStates[2] = genState.EpochStates[1]
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - negative tax proceeds
+	genState.EpochStates = genState.EpochStates[:2]
+	genState.EpochStates[1].TaxProceeds = sdk.NewInt(-1)
+	require.Error(t, ValidateGenesis(genState))
+	genState.EpochStates[1].TaxProceeds = sdk.ZeroInt()
+
+	// Error - negative reserve refills
+	genState.EpochStates[1].ReserveRefills = sdk.NewInt(-1)
+	require.Error(t, ValidateGenesis(genState))
+	genState.EpochStates[1].ReserveRefills = sdk.ZeroInt()
+
+	// Valid - negative seigniorage
+	genState.EpochStates[1].Seigniorage = sdk.NewInt(-1)
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - negative current epoch counters
+	genState.ReserveRefills = sdk.NewInt(-1)
+	require.Error(t, ValidateGenesis(genState))
+	genState.ReserveRefills = sdk.ZeroInt()
+
+	exchangePool := sdk.NewInt(-1)
+	genState.EpochInitialExchangePool = &exchangePool
+	require.Error(t, ValidateGenesis(genState))
+	genState.EpochInitialExchangePool = nil
+
+	genState.TaxProceedsByDenom = sdk.Coins{sdk.Coin{Denom: "usdr", Amount: sdk.NewInt(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+	genState.TaxProceedsByDenom = sdk.Coins{}
+
+	genState.WithheldTaxProceeds = sdk.Coins{sdk.Coin{Denom: "usdr", Amount: sdk.NewInt(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+	genState.WithheldTaxProceeds = sdk.Coins{}
+
+	// Valid
+	genState.TaxCaps = []TaxCap{NewTaxCap("uusd", sdk.NewInt(1000))}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - duplicate tax cap
+	genState.TaxCaps = append(genState.TaxCaps, NewTaxCap("uusd", sdk.NewInt(2000)))
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - negative tax cap
+	genState.TaxCaps = []TaxCap{NewTaxCap("uusd", sdk.NewInt(-1))}
+	require.Error(t, ValidateGenesis(genState))
+	genState.TaxCaps = []TaxCap{}
+
+	// Valid
+	addr := sdk.AccAddress([]byte("addr1_______________")).String()
+	genState.Zones = []Zone{NewZone("custody", false, false)}
+	genState.TaxExemptions = []TaxExemption{NewTaxExemption("custody", addr)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - duplicate tax exemption address
+	genState.TaxExemptions = append(genState.TaxExemptions, NewTaxExemption("custody", addr))
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - unknown zone
+	genState.TaxExemptions = []TaxExemption{NewTaxExemption("vault", addr)}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - invalid address
+	genState.TaxExemptions = []TaxExemption{NewTaxExemption("custody", "invalid")}
+	require.Error(t, ValidateGenesis(genState))
+	genState.TaxExemptions = []TaxExemption{}
+
+	// Error - duplicate zone
+	genState.Zones = append(genState.Zones, NewZone("custody", true, false))
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - empty zone name
+	genState.Zones = []Zone{NewZone("", false, false)}
+	require.Error(t, ValidateGenesis(genState))
+	genState.Zones = []Zone{}
+
+	// Valid
+	genState.TaxExemptionRoutes = []string{"/osmosis.market.v1beta1.MsgSwapSend", ContractTaxExemptionRoute("/cosmwasm.wasm.v1.MsgExecuteContract", addr)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - duplicate tax exemption route
+	genState.TaxExemptionRoutes = append(genState.TaxExemptionRoutes, "/osmosis.market.v1beta1.MsgSwapSend")
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - invalid contract address
+	genState.TaxExemptionRoutes = []string{ContractTaxExemptionRoute("/cosmwasm.wasm.v1.MsgExecuteContract", "invalid")}
+	require.Error(t, ValidateGenesis(genState))
 }
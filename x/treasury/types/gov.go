@@ -0,0 +1,385 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeUpdateTaxCaps             = "UpdateTaxCaps"
+	ProposalTypeAddTaxExemptionZone       = "AddTaxExemptionZone"
+	ProposalTypeRemoveTaxExemptionZone    = "RemoveTaxExemptionZone"
+	ProposalTypeAddTaxExemptionAddress    = "AddTaxExemptionAddress"
+	ProposalTypeRemoveTaxExemptionAddress = "RemoveTaxExemptionAddress"
+	ProposalTypeAddTaxExemptionRoute      = "AddTaxExemptionRoute"
+	ProposalTypeRemoveTaxExemptionRoute   = "RemoveTaxExemptionRoute"
+	ProposalTypeWindDown                  = "WindDown"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeUpdateTaxCaps)
+	govtypesv1.RegisterProposalType(ProposalTypeAddTaxExemptionZone)
+	govtypesv1.RegisterProposalType(ProposalTypeRemoveTaxExemptionZone)
+	govtypesv1.RegisterProposalType(ProposalTypeAddTaxExemptionAddress)
+	govtypesv1.RegisterProposalType(ProposalTypeRemoveTaxExemptionAddress)
+	govtypesv1.RegisterProposalType(ProposalTypeAddTaxExemptionRoute)
+	govtypesv1.RegisterProposalType(ProposalTypeRemoveTaxExemptionRoute)
+	govtypesv1.RegisterProposalType(ProposalTypeWindDown)
+}
+
+var (
+	_ govtypesv1.Content = &UpdateTaxCapsProposal{}
+	_ govtypesv1.Content = &AddTaxExemptionZoneProposal{}
+	_ govtypesv1.Content = &RemoveTaxExemptionZoneProposal{}
+	_ govtypesv1.Content = &AddTaxExemptionAddressProposal{}
+	_ govtypesv1.Content = &RemoveTaxExemptionAddressProposal{}
+	_ govtypesv1.Content = &AddTaxExemptionRouteProposal{}
+	_ govtypesv1.Content = &RemoveTaxExemptionRouteProposal{}
+	_ govtypesv1.Content = &WindDownProposal{}
+)
+
+func NewUpdateTaxCapsProposal(title, description string, taxCaps []TaxCap) UpdateTaxCapsProposal {
+	return UpdateTaxCapsProposal{
+		Title:       title,
+		Description: description,
+		TaxCaps:     taxCaps,
+	}
+}
+
+func (p *UpdateTaxCapsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateTaxCapsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateTaxCapsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateTaxCapsProposal) ProposalType() string {
+	return ProposalTypeUpdateTaxCaps
+}
+
+func (p *UpdateTaxCapsProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.TaxCaps) == 0 {
+		return fmt.Errorf("proposal must update at least one tax cap")
+	}
+
+	seen := make(map[string]bool, len(p.TaxCaps))
+	for _, taxCap := range p.TaxCaps {
+		if seen[taxCap.Denom] {
+			return fmt.Errorf("duplicate tax cap for denom %s", taxCap.Denom)
+		}
+		seen[taxCap.Denom] = true
+
+		if err := taxCap.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p UpdateTaxCapsProposal) String() string {
+	var b strings.Builder
+	for _, taxCap := range p.TaxCaps {
+		b.WriteString(fmt.Sprintf("(Denom: %s, Cap: %s) ", taxCap.Denom, taxCap.Cap))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Update Tax Caps Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
+
+func NewAddTaxExemptionZoneProposal(title, description string, zone Zone, addresses []string) AddTaxExemptionZoneProposal {
+	return AddTaxExemptionZoneProposal{
+		Title:       title,
+		Description: description,
+		Zone:        zone,
+		Addresses:   addresses,
+	}
+}
+
+func (p *AddTaxExemptionZoneProposal) GetTitle() string { return p.Title }
+
+func (p *AddTaxExemptionZoneProposal) GetDescription() string { return p.Description }
+
+func (p *AddTaxExemptionZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddTaxExemptionZoneProposal) ProposalType() string {
+	return ProposalTypeAddTaxExemptionZone
+}
+
+func (p *AddTaxExemptionZoneProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Zone.Validate(); err != nil {
+		return err
+	}
+	return validateTaxExemptionAddresses(p.Addresses)
+}
+
+func (p AddTaxExemptionZoneProposal) String() string {
+	return fmt.Sprintf(`Add Tax Exemption Zone Proposal:
+  Title:       %s
+  Description: %s
+  Zone:        %s
+  Outgoing:    %t
+  Incoming:    %t
+  Addresses:   %s
+`, p.Title, p.Description, p.Zone.Name, p.Zone.Outgoing, p.Zone.Incoming, strings.Join(p.Addresses, ", "))
+}
+
+func NewRemoveTaxExemptionZoneProposal(title, description, zone string) RemoveTaxExemptionZoneProposal {
+	return RemoveTaxExemptionZoneProposal{
+		Title:       title,
+		Description: description,
+		Zone:        zone,
+	}
+}
+
+func (p *RemoveTaxExemptionZoneProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveTaxExemptionZoneProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveTaxExemptionZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveTaxExemptionZoneProposal) ProposalType() string {
+	return ProposalTypeRemoveTaxExemptionZone
+}
+
+func (p *RemoveTaxExemptionZoneProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateZoneName(p.Zone)
+}
+
+func (p RemoveTaxExemptionZoneProposal) String() string {
+	return fmt.Sprintf(`Remove Tax Exemption Zone Proposal:
+  Title:       %s
+  Description: %s
+  Zone:        %s
+`, p.Title, p.Description, p.Zone)
+}
+
+func NewAddTaxExemptionAddressProposal(title, description, zone string, addresses []string) AddTaxExemptionAddressProposal {
+	return AddTaxExemptionAddressProposal{
+		Title:       title,
+		Description: description,
+		Zone:        zone,
+		Addresses:   addresses,
+	}
+}
+
+func (p *AddTaxExemptionAddressProposal) GetTitle() string { return p.Title }
+
+func (p *AddTaxExemptionAddressProposal) GetDescription() string { return p.Description }
+
+func (p *AddTaxExemptionAddressProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddTaxExemptionAddressProposal) ProposalType() string {
+	return ProposalTypeAddTaxExemptionAddress
+}
+
+func (p *AddTaxExemptionAddressProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := validateZoneName(p.Zone); err != nil {
+		return err
+	}
+	if len(p.Addresses) == 0 {
+		return fmt.Errorf("proposal must add at least one address")
+	}
+	return validateTaxExemptionAddresses(p.Addresses)
+}
+
+func (p AddTaxExemptionAddressProposal) String() string {
+	return fmt.Sprintf(`Add Tax Exemption Address Proposal:
+  Title:       %s
+  Description: %s
+  Zone:        %s
+  Addresses:   %s
+`, p.Title, p.Description, p.Zone, strings.Join(p.Addresses, ", "))
+}
+
+func NewRemoveTaxExemptionAddressProposal(title, description string, addresses []string) RemoveTaxExemptionAddressProposal {
+	return RemoveTaxExemptionAddressProposal{
+		Title:       title,
+		Description: description,
+		Addresses:   addresses,
+	}
+}
+
+func (p *RemoveTaxExemptionAddressProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveTaxExemptionAddressProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveTaxExemptionAddressProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveTaxExemptionAddressProposal) ProposalType() string {
+	return ProposalTypeRemoveTaxExemptionAddress
+}
+
+func (p *RemoveTaxExemptionAddressProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Addresses) == 0 {
+		return fmt.Errorf("proposal must remove at least one address")
+	}
+	return validateTaxExemptionAddresses(p.Addresses)
+}
+
+func (p RemoveTaxExemptionAddressProposal) String() string {
+	return fmt.Sprintf(`Remove Tax Exemption Address Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, p.Title, p.Description, strings.Join(p.Addresses, ", "))
+}
+
+func NewAddTaxExemptionRouteProposal(title, description string, routes []string) AddTaxExemptionRouteProposal {
+	return AddTaxExemptionRouteProposal{
+		Title:       title,
+		Description: description,
+		Routes:      routes,
+	}
+}
+
+func (p *AddTaxExemptionRouteProposal) GetTitle() string { return p.Title }
+
+func (p *AddTaxExemptionRouteProposal) GetDescription() string { return p.Description }
+
+func (p *AddTaxExemptionRouteProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddTaxExemptionRouteProposal) ProposalType() string {
+	return ProposalTypeAddTaxExemptionRoute
+}
+
+func (p *AddTaxExemptionRouteProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Routes) == 0 {
+		return fmt.Errorf("proposal must add at least one route")
+	}
+	return validateTaxExemptionRoutes(p.Routes)
+}
+
+func (p AddTaxExemptionRouteProposal) String() string {
+	return fmt.Sprintf(`Add Tax Exemption Route Proposal:
+  Title:       %s
+  Description: %s
+  Routes:      %s
+`, p.Title, p.Description, strings.Join(p.Routes, ", "))
+}
+
+func NewRemoveTaxExemptionRouteProposal(title, description string, routes []string) RemoveTaxExemptionRouteProposal {
+	return RemoveTaxExemptionRouteProposal{
+		Title:       title,
+		Description: description,
+		Routes:      routes,
+	}
+}
+
+func (p *RemoveTaxExemptionRouteProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveTaxExemptionRouteProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveTaxExemptionRouteProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveTaxExemptionRouteProposal) ProposalType() string {
+	return ProposalTypeRemoveTaxExemptionRoute
+}
+
+func (p *RemoveTaxExemptionRouteProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Routes) == 0 {
+		return fmt.Errorf("proposal must remove at least one route")
+	}
+	return validateTaxExemptionRoutes(p.Routes)
+}
+
+func (p RemoveTaxExemptionRouteProposal) String() string {
+	return fmt.Sprintf(`Remove Tax Exemption Route Proposal:
+  Title:       %s
+  Description: %s
+  Routes:      %s
+`, p.Title, p.Description, strings.Join(p.Routes, ", "))
+}
+
+func NewWindDownProposal(title, description, denom string, settlementRate sdk.Dec, redemptionPeriod time.Duration) WindDownProposal {
+	return WindDownProposal{
+		Title:            title,
+		Description:      description,
+		Denom:            denom,
+		SettlementRate:   settlementRate,
+		RedemptionPeriod: redemptionPeriod,
+	}
+}
+
+func (p *WindDownProposal) GetTitle() string { return p.Title }
+
+func (p *WindDownProposal) GetDescription() string { return p.Description }
+
+func (p *WindDownProposal) ProposalRoute() string { return RouterKey }
+
+func (p *WindDownProposal) ProposalType() string {
+	return ProposalTypeWindDown
+}
+
+func (p *WindDownProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if p.SettlementRate.IsNil() || p.SettlementRate.IsNegative() {
+		return fmt.Errorf("settlement rate must be non-negative: %s", p.SettlementRate)
+	}
+	if p.RedemptionPeriod <= 0 {
+		return fmt.Errorf("redemption period must be positive: %s", p.RedemptionPeriod)
+	}
+	return nil
+}
+
+func (p WindDownProposal) String() string {
+	return fmt.Sprintf(`Wind Down Proposal:
+  Title:             %s
+  Description:       %s
+  Denom:             %s
+  Settlement Rate:   %s
+  Redemption Period: %s
+`, p.Title, p.Description, p.Denom, p.SettlementRate, p.RedemptionPeriod)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUpdateTaxCapsProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		taxCaps []TaxCap
+		expErr  bool
+	}{
+		{"valid", []TaxCap{NewTaxCap("uusd", sdk.NewInt(1000)), NewTaxCap("ukrw", sdk.ZeroInt())}, false},
+		{"no tax caps", []TaxCap{}, true},
+		{"invalid denom", []TaxCap{NewTaxCap("u", sdk.NewInt(1000))}, true},
+		{"negative cap", []TaxCap{NewTaxCap("uusd", sdk.NewInt(-1))}, true},
+		{"duplicate denom", []TaxCap{NewTaxCap("uusd", sdk.NewInt(1000)), NewTaxCap("uusd", sdk.NewInt(2000))}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := NewUpdateTaxCapsProposal("title", "description", tc.taxCaps)
+			err := proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaxExemptionProposalsValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+	zone := NewZone("custody", false, false)
+
+	addZone := NewAddTaxExemptionZoneProposal("title", "description", zone, []string{addr1, addr2})
+	require.NoError(t, addZone.ValidateBasic())
+	addZone = NewAddTaxExemptionZoneProposal("title", "description", zone, nil)
+	require.NoError(t, addZone.ValidateBasic())
+	addZone = NewAddTaxExemptionZoneProposal("title", "description", NewZone("", false, false), nil)
+	require.Error(t, addZone.ValidateBasic())
+	addZone = NewAddTaxExemptionZoneProposal("title", "description", zone, []string{addr1, addr1})
+	require.Error(t, addZone.ValidateBasic())
+
+	removeZone := NewRemoveTaxExemptionZoneProposal("title", "description", zone.Name)
+	require.NoError(t, removeZone.ValidateBasic())
+	removeZone = NewRemoveTaxExemptionZoneProposal("title", "description", "")
+	require.Error(t, removeZone.ValidateBasic())
+
+	addAddress := NewAddTaxExemptionAddressProposal("title", "description", zone.Name, []string{addr1})
+	require.NoError(t, addAddress.ValidateBasic())
+	addAddress = NewAddTaxExemptionAddressProposal("title", "description", zone.Name, nil)
+	require.Error(t, addAddress.ValidateBasic())
+	addAddress = NewAddTaxExemptionAddressProposal("title", "description", zone.Name, []string{"invalid"})
+	require.Error(t, addAddress.ValidateBasic())
+
+	removeAddress := NewRemoveTaxExemptionAddressProposal("title", "description", []string{addr1, addr2})
+	require.NoError(t, removeAddress.ValidateBasic())
+	removeAddress = NewRemoveTaxExemptionAddressProposal("title", "description", nil)
+	require.Error(t, removeAddress.ValidateBasic())
+
+	route := "/osmosis.market.v1beta1.MsgSwapSend"
+	addRoute := NewAddTaxExemptionRouteProposal("title", "description", []string{route, ContractTaxExemptionRoute("/cosmwasm.wasm.v1.MsgExecuteContract", addr1)})
+	require.NoError(t, addRoute.ValidateBasic())
+	addRoute = NewAddTaxExemptionRouteProposal("title", "description", nil)
+	require.Error(t, addRoute.ValidateBasic())
+	addRoute = NewAddTaxExemptionRouteProposal("title", "description", []string{"osmosis.market.v1beta1.MsgSwapSend"})
+	require.Error(t, addRoute.ValidateBasic())
+	addRoute = NewAddTaxExemptionRouteProposal("title", "description", []string{route, route})
+	require.Error(t, addRoute.ValidateBasic())
+
+	removeRoute := NewRemoveTaxExemptionRouteProposal("title", "description", []string{route})
+	require.NoError(t, removeRoute.ValidateBasic())
+	removeRoute = NewRemoveTaxExemptionRouteProposal("title", "description", nil)
+	require.Error(t, removeRoute.ValidateBasic())
+}
+
+func TestWindDownProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name             string
+		denom            string
+		settlementRate   sdk.Dec
+		redemptionPeriod time.Duration
+		expErr           bool
+	}{
+		{"valid", "ukrw", sdk.NewDecWithPrec(7, 4), time.Hour, false},
+		{"oracle settlement rate", "ukrw", sdk.ZeroDec(), time.Hour, false},
+		{"invalid denom", "u", sdk.ZeroDec(), time.Hour, true},
+		{"negative settlement rate", "ukrw", sdk.NewDec(-1), time.Hour, true},
+		{"no redemption period", "ukrw", sdk.ZeroDec(), 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := NewWindDownProposal("title", "description", tc.denom, tc.settlementRate, tc.redemptionPeriod)
+			err := proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+)
+
 const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "treasury"
@@ -12,13 +18,90 @@ const (
 
 	// QuerierRoute is the querier route for treasury
 	QuerierRoute = ModuleName
+
+	// EpochLength is the number of blocks in a treasury epoch, the unit of the policy windows
+	EpochLength = appparams.BlocksPerWeek
 )
 
 // Keys for treasury store
 // Items are stored with the following key: values
 //
 // - 0x01: sdk.Dec
+//
+// - 0x02: uint64
+//
+// - 0x03: sdk.Int
+//
+// - 0x04: uint64
+//
+// - 0x05<epoch_Bytes>: EpochState
+//
+// - 0x06<denom_Bytes>: sdk.Int
+//
+// - 0x07: sdk.Int
+//
+// - 0x08: sdk.Int
+//
+// - 0x09<denom_Bytes>: sdk.Int
+//
+// - 0x0A<name_Bytes>: Zone
+//
+// - 0x0B<address_Bytes>: string
+//
+// - 0x0C: sdk.Int
+//
+// - 0x0D<denom_Bytes>: sdk.Int
+//
+// - 0x0E<route_Bytes>: []byte{}
 var (
 	// Keys for store prefixes
-	TaxRateKey = []byte{0x01} // a key for a tax-rate
+	TaxRateKey                  = []byte{0x01} // a key for a tax-rate
+	EpochKey                    = []byte{0x02} // a key for the current epoch
+	TaxProceedsKey              = []byte{0x03} // a key for the tax proceeds of the current epoch
+	ProbationStartEpochKey      = []byte{0x04} // a key for the epoch the probation started at
+	EpochStateKey               = []byte{0x05} // prefix for each key to an epoch state
+	TaxProceedsByDenomKey       = []byte{0x06} // prefix for each key to the tax proceeds of a denom in the current epoch
+	ReserveRefillsKey           = []byte{0x07} // a key for the reserve refills of the current epoch
+	EpochInitialExchangePoolKey = []byte{0x08} // a key for the market vault balance at the start of the current epoch
+	TaxCapKey                   = []byte{0x09} // prefix for each key to the tax cap of a denom
+	ZoneKey                     = []byte{0x0A} // prefix for each key to a tax exemption zone
+	TaxExemptionKey             = []byte{0x0B} // prefix for each key to the tax exemption zone of an address
+	ReserveDrainsKey            = []byte{0x0C} // a key for the reserve drains of the current epoch
+	WithheldTaxProceedsKey      = []byte{0x0D} // prefix for each key to the tax withheld in-kind in a denom
+	TaxExemptionRouteKey        = []byte{0x0E} // prefix for each key to a tax exemption route
 )
+
+// GetTaxProceedsByDenomKey - stored by *denom*
+func GetTaxProceedsByDenomKey(denom string) []byte {
+	return append(TaxProceedsByDenomKey, []byte(denom)...)
+}
+
+// GetWithheldTaxProceedsKey - stored by *denom*
+func GetWithheldTaxProceedsKey(denom string) []byte {
+	return append(WithheldTaxProceedsKey, []byte(denom)...)
+}
+
+// GetTaxCapKey - stored by *denom*
+func GetTaxCapKey(denom string) []byte {
+	return append(TaxCapKey, []byte(denom)...)
+}
+
+// GetZoneKey - stored by *name*
+func GetZoneKey(name string) []byte {
+	return append(ZoneKey, []byte(name)...)
+}
+
+// GetTaxExemptionKey - stored by *address*
+func GetTaxExemptionKey(addr sdk.AccAddress) []byte {
+	return append(TaxExemptionKey, addr.Bytes()...)
+}
+
+// GetTaxExemptionRouteKey - stored by *route*
+func GetTaxExemptionRouteKey(route string) []byte {
+	return append(TaxExemptionRouteKey, []byte(route)...)
+}
+
+// GetEpochStateKey - stored by *epoch*
+func GetEpochStateKey(epoch uint64) []byte {
+	return append(EpochStateKey, sdk.Uint64ToBigEndian(epoch)...)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var _ sdk.Msg = &MsgRedeem{}
+
+// treasury message types
+const (
+	TypeMsgRedeem = "redeem"
+)
+
+// NewMsgRedeem creates a MsgRedeem instance
+func NewMsgRedeem(sender sdk.AccAddress, coin sdk.Coin) *MsgRedeem {
+	return &MsgRedeem{
+		Sender: sender.String(),
+		Coin:   coin,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRedeem) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRedeem) Type() string { return TypeMsgRedeem }
+
+// GetSignBytes Implements Msg
+func (msg MsgRedeem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgRedeem) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Coin.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgRedeem(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	tests := []struct {
+		sender sdk.AccAddress
+		coin   sdk.Coin
+		expErr bool
+	}{
+		{addr, sdk.NewInt64Coin("ukrw", 1000), false},
+		{sdk.AccAddress{}, sdk.NewInt64Coin("ukrw", 1000), true},
+		{addr, sdk.NewInt64Coin("ukrw", 0), true},
+		{addr, sdk.Coin{Denom: "ukrw", Amount: sdk.NewInt(-1)}, true},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeem(tc.sender, tc.coin)
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic())
+		} else {
+			require.NoError(t, msg.ValidateBasic())
+		}
+	}
+}
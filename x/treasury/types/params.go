@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 )
 
 // Parameter keys
@@ -16,6 +18,13 @@ var (
 	KeyWindowShort            = []byte("WindowShort")
 	KeyWindowLong             = []byte("WindowLong")
 	KeyWindowProbation        = []byte("WindowProbation")
+	KeyMinTaxRate             = []byte("MinTaxRate")
+	KeyMaxTaxRateChange       = []byte("MaxTaxRateChange")
+	KeyRefillInterval         = []byte("RefillInterval")
+	KeyMaxRefillPerPeriod     = []byte("MaxRefillPerPeriod")
+	KeyDrainSurplus           = []byte("DrainSurplus")
+	KeyRedemptionOnlyRatio    = []byte("RedemptionOnlyRatio")
+	KeyHaltRatio              = []byte("HaltRatio")
 )
 
 // Default parameter values
@@ -26,6 +35,13 @@ var (
 	DefaultTaxRate                = sdk.NewDecWithPrec(1, 3) // 0.1%
 	DefaultMaxFeeMultiplier       = sdk.NewDecWithPrec(1, 0) // 1%
 	DefaultReserveAllowableOffset = sdk.NewDecWithPrec(5, 0) // 5%
+	DefaultMinTaxRate             = sdk.ZeroDec()
+	DefaultMaxTaxRateChange       = sdk.NewDecWithPrec(25, 5) // 0.025%
+	DefaultRefillInterval         = 3 * appparams.BlocksPerMinute
+	DefaultMaxRefillPerPeriod     = sdk.NewInt(1_000_000 * appparams.MicroUnit) // 1,000,000 note
+	DefaultDrainSurplus           = false
+	DefaultRedemptionOnlyRatio    = sdk.NewDecWithPrec(95, 2) // minting pauses once the backing falls outside the 5% reserve allowable offset
+	DefaultHaltRatio              = sdk.NewDecWithPrec(5, 1)  // 50%
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -38,6 +54,13 @@ func DefaultParams() Params {
 		WindowShort:            DefaultWindowShort,
 		WindowLong:             DefaultWindowLong,
 		WindowProbation:        DefaultWindowProbation,
+		MinTaxRate:             DefaultMinTaxRate,
+		MaxTaxRateChange:       DefaultMaxTaxRateChange,
+		RefillInterval:         DefaultRefillInterval,
+		MaxRefillPerPeriod:     DefaultMaxRefillPerPeriod,
+		DrainSurplus:           DefaultDrainSurplus,
+		RedemptionOnlyRatio:    DefaultRedemptionOnlyRatio,
+		HaltRatio:              DefaultHaltRatio,
 	}
 }
 
@@ -61,6 +84,13 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWindowProbation, &p.WindowProbation, validateWindowProbation),
 		paramstypes.NewParamSetPair(KeyReserveAllowableOffset, &p.ReserveAllowableOffset, validateReserveAllowableOffset),
 		paramstypes.NewParamSetPair(KeyMaxFeeMultiplier, &p.MaxFeeMultiplier, validateMaxFeeMultiplier),
+		paramstypes.NewParamSetPair(KeyMinTaxRate, &p.MinTaxRate, validateMinTaxRate),
+		paramstypes.NewParamSetPair(KeyMaxTaxRateChange, &p.MaxTaxRateChange, validateMaxTaxRateChange),
+		paramstypes.NewParamSetPair(KeyRefillInterval, &p.RefillInterval, validateRefillInterval),
+		paramstypes.NewParamSetPair(KeyMaxRefillPerPeriod, &p.MaxRefillPerPeriod, validateMaxRefillPerPeriod),
+		paramstypes.NewParamSetPair(KeyDrainSurplus, &p.DrainSurplus, validateDrainSurplus),
+		paramstypes.NewParamSetPair(KeyRedemptionOnlyRatio, &p.RedemptionOnlyRatio, validateRedemptionOnlyRatio),
+		paramstypes.NewParamSetPair(KeyHaltRatio, &p.HaltRatio, validateHaltRatio),
 	}
 }
 
@@ -72,10 +102,56 @@ func (p Params) Validate() error {
 	if p.WindowLong <= p.WindowShort {
 		return fmt.Errorf("treasury parameter WindowLong must be bigger than WindowShort: (%d, %d)", p.WindowLong, p.WindowShort)
 	}
+	if p.WindowShort == 0 {
+		return fmt.Errorf("treasury parameter WindowShort must be positive: %d", p.WindowShort)
+	}
+	if p.MinTaxRate.IsNil() || p.MinTaxRate.IsNegative() {
+		return fmt.Errorf("treasury parameter MinTaxRate must be positive or zero: %s", p.MinTaxRate)
+	}
+	if p.MinTaxRate.GT(p.MaxFeeMultiplier) {
+		return fmt.Errorf("treasury parameter MinTaxRate must not be bigger than MaxFeeMultiplier: (%s, %s)", p.MinTaxRate, p.MaxFeeMultiplier)
+	}
+	if p.MaxTaxRateChange.IsNil() || !p.MaxTaxRateChange.IsPositive() {
+		return fmt.Errorf("treasury parameter MaxTaxRateChange must be positive: %s", p.MaxTaxRateChange)
+	}
+	if p.RefillInterval == 0 {
+		return fmt.Errorf("treasury parameter RefillInterval must be positive: %d", p.RefillInterval)
+	}
+	if p.MaxRefillPerPeriod.IsNil() || !p.MaxRefillPerPeriod.IsPositive() {
+		return fmt.Errorf("treasury parameter MaxRefillPerPeriod must be positive: %s", p.MaxRefillPerPeriod)
+	}
+	if p.RedemptionOnlyRatio.IsNil() || p.RedemptionOnlyRatio.IsNegative() {
+		return fmt.Errorf("treasury parameter RedemptionOnlyRatio must be positive or zero: %s", p.RedemptionOnlyRatio)
+	}
+	if p.HaltRatio.IsNil() || p.HaltRatio.IsNegative() {
+		return fmt.Errorf("treasury parameter HaltRatio must be positive or zero: %s", p.HaltRatio)
+	}
+	if p.HaltRatio.GT(p.RedemptionOnlyRatio) {
+		return fmt.Errorf("treasury parameter HaltRatio must not be bigger than RedemptionOnlyRatio: (%s, %s)", p.HaltRatio, p.RedemptionOnlyRatio)
+	}
 
 	return nil
 }
 
+// ClampTaxRate constrains a tax rate update within the MinTaxRate and MaxFeeMultiplier bounds,
+// moving it by at most MaxTaxRateChange from the previous rate.
+func (p Params) ClampTaxRate(prevRate sdk.Dec, newRate sdk.Dec) sdk.Dec {
+	if newRate.LT(p.MinTaxRate) {
+		newRate = p.MinTaxRate
+	} else if newRate.GT(p.MaxFeeMultiplier) {
+		newRate = p.MaxFeeMultiplier
+	}
+
+	delta := newRate.Sub(prevRate)
+	if delta.GT(p.MaxTaxRateChange) {
+		newRate = prevRate.Add(p.MaxTaxRateChange)
+	} else if delta.Neg().GT(p.MaxTaxRateChange) {
+		newRate = prevRate.Sub(p.MaxTaxRateChange)
+	}
+
+	return newRate
+}
+
 func validateReserveAllowableOffset(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
@@ -128,3 +204,90 @@ func validateWindowProbation(i interface{}) error {
 
 	return nil
 }
+
+func validateMinTaxRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min tax rate must be positive or zero: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxTaxRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("max tax rate change must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateRefillInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("refill interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxRefillPerPeriod(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max refill per period must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateDrainSurplus(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRedemptionOnlyRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("redemption only ratio must be positive or zero: %s", v)
+	}
+
+	return nil
+}
+
+func validateHaltRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("halt ratio must be positive or zero: %s", v)
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParams(t *testing.T) {
@@ -14,6 +16,54 @@ func TestParams(t *testing.T) {
 	params.WindowLong = 0
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.WindowShort = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinTaxRate = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinTaxRate = params.MaxFeeMultiplier.Add(sdk.OneDec())
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxTaxRateChange = sdk.ZeroDec()
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.RefillInterval = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxRefillPerPeriod = sdk.ZeroInt()
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.RedemptionOnlyRatio = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.HaltRatio = params.RedemptionOnlyRatio.Add(sdk.OneDec())
+	require.Error(t, params.Validate())
+
 	require.NotNil(t, params.ParamSetPairs())
 	require.NotNil(t, params.String())
 }
+
+func TestClampTaxRate(t *testing.T) {
+	params := DefaultParams()
+	params.MinTaxRate = sdk.NewDecWithPrec(1, 3)
+	params.MaxFeeMultiplier = sdk.NewDecWithPrec(1, 2)
+	params.MaxTaxRateChange = sdk.NewDecWithPrec(2, 3)
+
+	prevRate := sdk.NewDecWithPrec(5, 3)
+	require.Equal(t, sdk.NewDecWithPrec(6, 3), params.ClampTaxRate(prevRate, sdk.NewDecWithPrec(6, 3)))
+	require.Equal(t, sdk.NewDecWithPrec(7, 3), params.ClampTaxRate(prevRate, sdk.NewDecWithPrec(9, 3)))
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), params.ClampTaxRate(prevRate, sdk.ZeroDec()))
+
+	// the bounds apply before the change limit
+	require.Equal(t, sdk.NewDecWithPrec(10, 3), params.ClampTaxRate(sdk.NewDecWithPrec(9, 3), sdk.OneDec()))
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), params.ClampTaxRate(sdk.NewDecWithPrec(2, 3), sdk.ZeroDec()))
+}
@@ -7,9 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -109,22 +109,23 @@ func (m *QueryTaxRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTaxRateResponse proto.InternalMessageInfo
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryTaxRateHistoryRequest is the request type for the
+// Query/TaxRateHistory RPC method.
+type QueryTaxRateHistoryRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTaxRateHistoryRequest) Reset()         { *m = QueryTaxRateHistoryRequest{} }
+func (m *QueryTaxRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxRateHistoryRequest) ProtoMessage()    {}
+func (*QueryTaxRateHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_386d011e80124fb4, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTaxRateHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTaxRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTaxRateHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -134,36 +135,41 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryTaxRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxRateHistoryRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryTaxRateHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryTaxRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxRateHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTaxRateHistoryRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QueryTaxRateHistoryResponse is response type for the
+// Query/TaxRateHistory RPC method.
+type QueryTaxRateHistoryResponse struct {
+	// epoch is the current treasury epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// in_probation is true while the tax rate controller is frozen
+	InProbation bool `protobuf:"varint,2,opt,name=in_probation,json=inProbation,proto3" json:"in_probation,omitempty"`
+	// epoch_states defines the recorded epochs in ascending order
+	EpochStates []EpochState `protobuf:"bytes,3,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTaxRateHistoryResponse) Reset()         { *m = QueryTaxRateHistoryResponse{} }
+func (m *QueryTaxRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxRateHistoryResponse) ProtoMessage()    {}
+func (*QueryTaxRateHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_386d011e80124fb4, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTaxRateHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTaxRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTaxRateHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -173,358 +179,5095 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryTaxRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxRateHistoryResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryTaxRateHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryTaxRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxRateHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTaxRateHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryTaxRateHistoryResponse) GetEpoch() uint64 {
 	if m != nil {
-		return m.Params
+		return m.Epoch
 	}
-	return Params{}
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryTaxRateRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxRateRequest")
-	proto.RegisterType((*QueryTaxRateResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxRateResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.treasury.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.treasury.v1beta1.QueryParamsResponse")
+func (m *QueryTaxRateHistoryResponse) GetInProbation() bool {
+	if m != nil {
+		return m.InProbation
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("osmosis/treasury/v1beta1/query.proto", fileDescriptor_386d011e80124fb4)
+func (m *QueryTaxRateHistoryResponse) GetEpochStates() []EpochState {
+	if m != nil {
+		return m.EpochStates
+	}
+	return nil
 }
 
-var fileDescriptor_386d011e80124fb4 = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0xda, 0x40,
-	0x18, 0xc7, 0x13, 0x69, 0xb5, 0x9d, 0xde, 0xa6, 0x16, 0x6c, 0x28, 0x51, 0x82, 0xb4, 0x22, 0xcd,
-	0x0c, 0x6a, 0x8f, 0xa5, 0x07, 0xf1, 0x5c, 0xda, 0xd0, 0x52, 0xe8, 0x45, 0x26, 0xe9, 0x90, 0x86,
-	0x6a, 0x26, 0x66, 0x26, 0xa2, 0xd7, 0xbe, 0x40, 0x0b, 0xbb, 0x0f, 0xb2, 0x87, 0x7d, 0x08, 0x8f,
-	0xb2, 0x7b, 0x59, 0xf6, 0x20, 0x8b, 0xee, 0x83, 0x2c, 0xce, 0x4c, 0x5c, 0x85, 0x0d, 0xeb, 0x29,
-	0x99, 0x6f, 0xfe, 0xf3, 0xff, 0x7e, 0xf3, 0xff, 0x06, 0x34, 0x19, 0x1f, 0x33, 0x1e, 0x71, 0x2c,
-	0x52, 0x4a, 0x78, 0x96, 0xce, 0xf1, 0xb4, 0xe3, 0x53, 0x41, 0x3a, 0x78, 0x92, 0xd1, 0x74, 0x8e,
-	0x92, 0x94, 0x09, 0x06, 0x6b, 0x5a, 0x85, 0x72, 0x15, 0xd2, 0x2a, 0xeb, 0x75, 0x20, 0xb7, 0x86,
-	0x52, 0x87, 0xd5, 0x42, 0x1d, 0xb2, 0xda, 0x6a, 0x85, 0x7d, 0xc2, 0xa9, 0x72, 0xdb, 0x79, 0x27,
-	0x24, 0x8c, 0x62, 0x22, 0x22, 0x16, 0x6b, 0xad, 0xbd, 0xaf, 0xcd, 0x55, 0x01, 0x8b, 0xf2, 0xfd,
-	0x6a, 0xc8, 0x42, 0xa6, 0x7a, 0x6c, 0xff, 0x74, 0xf5, 0x4d, 0xc8, 0x58, 0x38, 0xa2, 0x98, 0x24,
-	0x11, 0x26, 0x71, 0xcc, 0x84, 0xb4, 0xcc, 0xfb, 0xbf, 0x2b, 0xbc, 0xda, 0xee, 0x16, 0x52, 0xe8,
-	0xbc, 0x02, 0x2f, 0xbf, 0x6e, 0xf1, 0xbe, 0x91, 0x99, 0x47, 0x04, 0xf5, 0xe8, 0x24, 0xa3, 0x5c,
-	0x38, 0x0c, 0x54, 0x0f, 0xcb, 0x3c, 0x61, 0x31, 0xa7, 0xf0, 0x07, 0x78, 0x26, 0xc8, 0x6c, 0x98,
-	0x12, 0x41, 0x6b, 0x66, 0xc3, 0x6c, 0x3d, 0xef, 0x7f, 0x5c, 0xac, 0xea, 0xc6, 0xf5, 0xaa, 0xfe,
-	0x36, 0x8c, 0xc4, 0xef, 0xcc, 0x47, 0x01, 0x1b, 0xeb, 0x28, 0xf4, 0xc7, 0xe5, 0xbf, 0xfe, 0x60,
-	0x31, 0x4f, 0x28, 0x47, 0x03, 0x1a, 0x5c, 0x9c, 0xbb, 0x40, 0x27, 0x35, 0xa0, 0x81, 0x57, 0x11,
-	0xaa, 0x81, 0x53, 0x05, 0x50, 0x36, 0xfc, 0x42, 0x52, 0x32, 0xe6, 0x39, 0xc6, 0x77, 0x4d, 0x97,
-	0x57, 0x35, 0xc5, 0x27, 0x50, 0x4e, 0x64, 0x45, 0x32, 0xbc, 0xe8, 0x36, 0x50, 0xd1, 0x8c, 0x90,
-	0x3a, 0xd9, 0x7f, 0xb2, 0xa5, 0xf4, 0xf4, 0xa9, 0xee, 0x59, 0x09, 0x3c, 0x95, 0xbe, 0xf0, 0xd4,
-	0x04, 0x15, 0x7d, 0x47, 0xe8, 0x16, 0xbb, 0x3c, 0x10, 0x91, 0x85, 0x8e, 0x95, 0x2b, 0x68, 0xa7,
-	0xfd, 0xf7, 0xf2, 0xf6, 0xa4, 0xd4, 0x84, 0x0e, 0x2e, 0x9e, 0x8d, 0x8e, 0x16, 0xfe, 0x33, 0x41,
-	0x59, 0x91, 0xc3, 0xf7, 0x8f, 0xb4, 0x39, 0x08, 0xcc, 0x72, 0x8f, 0x54, 0x6b, 0xa6, 0x96, 0x64,
-	0x72, 0x60, 0xa3, 0x98, 0x49, 0x45, 0xd6, 0xff, 0xbc, 0x58, 0xdb, 0xe6, 0x72, 0x6d, 0x9b, 0x37,
-	0x6b, 0xdb, 0xfc, 0xbf, 0xb1, 0x8d, 0xe5, 0xc6, 0x36, 0xae, 0x36, 0xb6, 0xf1, 0xf3, 0xc3, 0xde,
-	0xe0, 0xb5, 0x8b, 0x3b, 0x22, 0x3e, 0xdf, 0x59, 0x4e, 0xbb, 0x3d, 0x3c, 0xbb, 0x37, 0x96, 0x4f,
-	0xc1, 0x2f, 0xcb, 0xe7, 0xd7, 0xbb, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x6c, 0x9b, 0x34, 0x84,
-	0x03, 0x00, 0x00,
+// QueryTaxProceedsRequest is the request type for the Query/TaxProceeds RPC
+// method.
+type QueryTaxProceedsRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryTaxProceedsRequest) Reset()         { *m = QueryTaxProceedsRequest{} }
+func (m *QueryTaxProceedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxProceedsRequest) ProtoMessage()    {}
+func (*QueryTaxProceedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{4}
+}
+func (m *QueryTaxProceedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxProceedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxProceedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxProceedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxProceedsRequest.Merge(m, src)
+}
+func (m *QueryTaxProceedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxProceedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxProceedsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTaxProceedsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TaxRate return the current tax rate
-	TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QueryTaxProceedsResponse is response type for the
+// Query/TaxProceeds RPC method.
+type QueryTaxProceedsResponse struct {
+	// epoch is the current treasury epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// tax_proceeds is the note paid to the reserve as stability tax
+	TaxProceeds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tax_proceeds,json=taxProceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tax_proceeds"`
+	// tax_proceeds_by_denom is the stability tax assessed per taxed denom
+	TaxProceedsByDenom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tax_proceeds_by_denom,json=taxProceedsByDenom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds_by_denom"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTaxProceedsResponse) Reset()         { *m = QueryTaxProceedsResponse{} }
+func (m *QueryTaxProceedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxProceedsResponse) ProtoMessage()    {}
+func (*QueryTaxProceedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTaxProceedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error) {
-	out := new(QueryTaxRateResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTaxProceedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxProceedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTaxProceedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxProceedsResponse.Merge(m, src)
+}
+func (m *QueryTaxProceedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxProceedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxProceedsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaxProceedsResponse proto.InternalMessageInfo
+
+func (m *QueryTaxProceedsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TaxRate return the current tax rate
-	TaxRate(context.Context, *QueryTaxRateRequest) (*QueryTaxRateResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryTaxProceedsResponse) GetTaxProceedsByDenom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxProceedsByDenom
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryIndicatorsRequest is the request type for the Query/Indicators RPC
+// method.
+type QueryIndicatorsRequest struct {
 }
 
-func (*UnimplementedQueryServer) TaxRate(ctx context.Context, req *QueryTaxRateRequest) (*QueryTaxRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxRate not implemented")
-}
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryIndicatorsRequest) Reset()         { *m = QueryIndicatorsRequest{} }
+func (m *QueryIndicatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIndicatorsRequest) ProtoMessage()    {}
+func (*QueryIndicatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{6}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryIndicatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_TaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxRate(ctx, req.(*QueryTaxRateRequest))
+func (m *QueryIndicatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndicatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.treasury.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryIndicatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndicatorsRequest.Merge(m, src)
+}
+func (m *QueryIndicatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndicatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndicatorsRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.treasury.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TaxRate",
-			Handler:    _Query_TaxRate_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/treasury/v1beta1/query.proto",
+var xxx_messageInfo_QueryIndicatorsRequest proto.InternalMessageInfo
+
+// QueryIndicatorsResponse is response type for the
+// Query/Indicators RPC method. The short and long averages are taken over the
+// recorded epochs within WindowShort and WindowLong.
+type QueryIndicatorsResponse struct {
+	TaxProceedsShort     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=tax_proceeds_short,json=taxProceedsShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_proceeds_short"`
+	TaxProceedsLong      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_proceeds_long,json=taxProceedsLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_proceeds_long"`
+	SeigniorageShort     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_short,json=seigniorageShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_short"`
+	SeigniorageLong      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=seigniorage_long,json=seigniorageLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_long"`
+	ReserveCoverageShort github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reserve_coverage_short,json=reserveCoverageShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_coverage_short"`
+	ReserveCoverageLong  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_coverage_long,json=reserveCoverageLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_coverage_long"`
 }
 
-func (m *QueryTaxRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIndicatorsResponse) Reset()         { *m = QueryIndicatorsResponse{} }
+func (m *QueryIndicatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndicatorsResponse) ProtoMessage()    {}
+func (*QueryIndicatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{7}
+}
+func (m *QueryIndicatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndicatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndicatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIndicatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndicatorsResponse.Merge(m, src)
+}
+func (m *QueryIndicatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndicatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndicatorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryIndicatorsResponse proto.InternalMessageInfo
+
+// QueryReserveHistoryRequest is the request type for the
+// Query/ReserveHistory RPC method.
+type QueryReserveHistoryRequest struct {
 }
 
-func (m *QueryTaxRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReserveHistoryRequest) Reset()         { *m = QueryReserveHistoryRequest{} }
+func (m *QueryReserveHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveHistoryRequest) ProtoMessage()    {}
+func (*QueryReserveHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{8}
+}
+func (m *QueryReserveHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryReserveHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveHistoryRequest.Merge(m, src)
+}
+func (m *QueryReserveHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryReserveHistoryRequest proto.InternalMessageInfo
+
+// ReserveRecord defines the reserve flows of an epoch
+type ReserveRecord struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// balance is the reserve pool balance at the end of the epoch
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// refills is the note sent from the reserve to the market vault
+	Refills github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=refills,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refills"`
+	// tax_proceeds is the note paid to the reserve as stability tax
+	TaxProceeds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=tax_proceeds,json=taxProceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tax_proceeds"`
+	// coverage is the ratio of the reserve balance to the exchange requirement
+	Coverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=coverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"coverage"`
+	// drains is the note sent from the market vault back to the reserve
+	Drains github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=drains,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"drains"`
 }
 
-func (m *QueryTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxRate.Size()
-		i -= size
-		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReserveRecord) Reset()         { *m = ReserveRecord{} }
+func (m *ReserveRecord) String() string { return proto.CompactTextString(m) }
+func (*ReserveRecord) ProtoMessage()    {}
+func (*ReserveRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{9}
+}
+func (m *ReserveRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ReserveRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveRecord.Merge(m, src)
+}
+func (m *ReserveRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveRecord.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReserveRecord proto.InternalMessageInfo
+
+func (m *ReserveRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReserveHistoryResponse is response type for the
+// Query/ReserveHistory RPC method.
+type QueryReserveHistoryResponse struct {
+	// reserve_records defines the recorded epochs in ascending order
+	ReserveRecords []ReserveRecord `protobuf:"bytes,1,rep,name=reserve_records,json=reserveRecords,proto3" json:"reserve_records"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryReserveHistoryResponse) Reset()         { *m = QueryReserveHistoryResponse{} }
+func (m *QueryReserveHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveHistoryResponse) ProtoMessage()    {}
+func (*QueryReserveHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{10}
+}
+func (m *QueryReserveHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReserveHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveHistoryResponse.Merge(m, src)
+}
+func (m *QueryReserveHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReserveHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryReserveHistoryResponse) GetReserveRecords() []ReserveRecord {
+	if m != nil {
+		return m.ReserveRecords
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTaxCapRequest is the request type for the Query/TaxCap RPC method.
+type QueryTaxCapRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTaxCapRequest) Reset()         { *m = QueryTaxCapRequest{} }
+func (m *QueryTaxCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxCapRequest) ProtoMessage()    {}
+func (*QueryTaxCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{11}
+}
+func (m *QueryTaxCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTaxCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxCapRequest.Merge(m, src)
+}
+func (m *QueryTaxCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxCapRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTaxCapRequest proto.InternalMessageInfo
+
+func (m *QueryTaxCapRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryTaxRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryTaxCapResponse is response type for the
+// Query/TaxCap RPC method.
+type QueryTaxCapResponse struct {
+	TaxCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tax_cap,json=taxCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tax_cap"`
+}
+
+func (m *QueryTaxCapResponse) Reset()         { *m = QueryTaxCapResponse{} }
+func (m *QueryTaxCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxCapResponse) ProtoMessage()    {}
+func (*QueryTaxCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{12}
+}
+func (m *QueryTaxCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryTaxCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxCapResponse.Merge(m, src)
+}
+func (m *QueryTaxCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxCapResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTaxRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTaxCapResponse proto.InternalMessageInfo
+
+// QueryTaxCapsRequest is the request type for the Query/TaxCaps RPC method.
+type QueryTaxCapsRequest struct {
+}
+
+func (m *QueryTaxCapsRequest) Reset()         { *m = QueryTaxCapsRequest{} }
+func (m *QueryTaxCapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxCapsRequest) ProtoMessage()    {}
+func (*QueryTaxCapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{13}
+}
+func (m *QueryTaxCapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxCapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxCapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.TaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryTaxCapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxCapsRequest.Merge(m, src)
+}
+func (m *QueryTaxCapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxCapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxCapsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTaxCapsRequest proto.InternalMessageInfo
+
+// QueryTaxCapsResponse is response type for the
+// Query/TaxCaps RPC method.
+type QueryTaxCapsResponse struct {
+	TaxCaps []TaxCap `protobuf:"bytes,1,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
+}
+
+func (m *QueryTaxCapsResponse) Reset()         { *m = QueryTaxCapsResponse{} }
+func (m *QueryTaxCapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxCapsResponse) ProtoMessage()    {}
+func (*QueryTaxCapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{14}
+}
+func (m *QueryTaxCapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxCapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxCapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryTaxCapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxCapsResponse.Merge(m, src)
+}
+func (m *QueryTaxCapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxCapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxCapsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTaxCapsResponse proto.InternalMessageInfo
+
+func (m *QueryTaxCapsResponse) GetTaxCaps() []TaxCap {
+	if m != nil {
+		return m.TaxCaps
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
+}
+
+// QueryTaxExemptionZonesRequest is the request type for the
+// Query/TaxExemptionZones RPC method.
+type QueryTaxExemptionZonesRequest struct {
+}
+
+func (m *QueryTaxExemptionZonesRequest) Reset()         { *m = QueryTaxExemptionZonesRequest{} }
+func (m *QueryTaxExemptionZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionZonesRequest) ProtoMessage()    {}
+func (*QueryTaxExemptionZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{15}
+}
+func (m *QueryTaxExemptionZonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionZonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionZonesRequest.Merge(m, src)
+}
+func (m *QueryTaxExemptionZonesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionZonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionZonesRequest proto.InternalMessageInfo
+
+// QueryTaxExemptionZonesResponse is response type for the
+// Query/TaxExemptionZones RPC method.
+type QueryTaxExemptionZonesResponse struct {
+	Zones []Zone `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones"`
+}
+
+func (m *QueryTaxExemptionZonesResponse) Reset()         { *m = QueryTaxExemptionZonesResponse{} }
+func (m *QueryTaxExemptionZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionZonesResponse) ProtoMessage()    {}
+func (*QueryTaxExemptionZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{16}
+}
+func (m *QueryTaxExemptionZonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionZonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionZonesResponse.Merge(m, src)
+}
+func (m *QueryTaxExemptionZonesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionZonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionZonesResponse proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionZonesResponse) GetZones() []Zone {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+// QueryTaxExemptionAddressesRequest is the request type for the
+// Query/TaxExemptionAddresses RPC method.
+type QueryTaxExemptionAddressesRequest struct {
+	// zone defines the zone to query for, all zones if empty.
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryTaxExemptionAddressesRequest) Reset()         { *m = QueryTaxExemptionAddressesRequest{} }
+func (m *QueryTaxExemptionAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionAddressesRequest) ProtoMessage()    {}
+func (*QueryTaxExemptionAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{17}
+}
+func (m *QueryTaxExemptionAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionAddressesRequest.Merge(m, src)
+}
+func (m *QueryTaxExemptionAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionAddressesRequest) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *QueryTaxExemptionAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTaxExemptionAddressesResponse is response type for the
+// Query/TaxExemptionAddresses RPC method.
+type QueryTaxExemptionAddressesResponse struct {
+	TaxExemptions []TaxExemption `protobuf:"bytes,1,rep,name=tax_exemptions,json=taxExemptions,proto3" json:"tax_exemptions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTaxExemptionAddressesResponse) Reset()         { *m = QueryTaxExemptionAddressesResponse{} }
+func (m *QueryTaxExemptionAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionAddressesResponse) ProtoMessage()    {}
+func (*QueryTaxExemptionAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{18}
+}
+func (m *QueryTaxExemptionAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionAddressesResponse.Merge(m, src)
+}
+func (m *QueryTaxExemptionAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionAddressesResponse) GetTaxExemptions() []TaxExemption {
+	if m != nil {
+		return m.TaxExemptions
+	}
+	return nil
+}
+
+func (m *QueryTaxExemptionAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTaxExemptionRoutesRequest is the request type for the
+// Query/TaxExemptionRoutes RPC method.
+type QueryTaxExemptionRoutesRequest struct {
+}
+
+func (m *QueryTaxExemptionRoutesRequest) Reset()         { *m = QueryTaxExemptionRoutesRequest{} }
+func (m *QueryTaxExemptionRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionRoutesRequest) ProtoMessage()    {}
+func (*QueryTaxExemptionRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{19}
+}
+func (m *QueryTaxExemptionRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionRoutesRequest.Merge(m, src)
+}
+func (m *QueryTaxExemptionRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionRoutesRequest proto.InternalMessageInfo
+
+// QueryTaxExemptionRoutesResponse is response type for the
+// Query/TaxExemptionRoutes RPC method.
+type QueryTaxExemptionRoutesResponse struct {
+	Routes []string `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (m *QueryTaxExemptionRoutesResponse) Reset()         { *m = QueryTaxExemptionRoutesResponse{} }
+func (m *QueryTaxExemptionRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionRoutesResponse) ProtoMessage()    {}
+func (*QueryTaxExemptionRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{20}
+}
+func (m *QueryTaxExemptionRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionRoutesResponse.Merge(m, src)
+}
+func (m *QueryTaxExemptionRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionRoutesResponse) GetRoutes() []string {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// QuerySolvencyRequest is the request type for the Query/Solvency RPC method.
+type QuerySolvencyRequest struct {
+}
+
+func (m *QuerySolvencyRequest) Reset()         { *m = QuerySolvencyRequest{} }
+func (m *QuerySolvencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySolvencyRequest) ProtoMessage()    {}
+func (*QuerySolvencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{21}
+}
+func (m *QuerySolvencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySolvencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySolvencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySolvencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySolvencyRequest.Merge(m, src)
+}
+func (m *QuerySolvencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySolvencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySolvencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySolvencyRequest proto.InternalMessageInfo
+
+// StableSupply is the supply of a stable denom and its value in note
+type StableSupply struct {
+	Supply types.Coin `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	// exchange_rate is the note price of one unit of the denom
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// value is the supply valued in note
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *StableSupply) Reset()         { *m = StableSupply{} }
+func (m *StableSupply) String() string { return proto.CompactTextString(m) }
+func (*StableSupply) ProtoMessage()    {}
+func (*StableSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{22}
+}
+func (m *StableSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StableSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StableSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StableSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableSupply.Merge(m, src)
+}
+func (m *StableSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *StableSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableSupply proto.InternalMessageInfo
+
+func (m *StableSupply) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+// QuerySolvencyResponse is response type for the
+// Query/Solvency RPC method.
+type QuerySolvencyResponse struct {
+	// stable_supplies defines the supply of every denom with a note exchange
+	// rate
+	StableSupplies []StableSupply `protobuf:"bytes,1,rep,name=stable_supplies,json=stableSupplies,proto3" json:"stable_supplies"`
+	// exchange_requirement is the note value of all stable supplies
+	ExchangeRequirement github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_requirement,json=exchangeRequirement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_requirement"`
+	// exchange_pool is the market vault balance
+	ExchangePool types.Coin `protobuf:"bytes,3,opt,name=exchange_pool,json=exchangePool,proto3" json:"exchange_pool"`
+	// reserve is the treasury reserve balance
+	Reserve types.Coin `protobuf:"bytes,4,opt,name=reserve,proto3" json:"reserve"`
+	// collateralization_ratio is the ratio of the market vault and reserve
+	// balances to the exchange requirement
+	CollateralizationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=collateralization_ratio,json=collateralizationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateralization_ratio"`
+	// healthy is false when the collateralization ratio falls more than
+	// reserve_allowable_offset percent short of 1
+	Healthy bool `protobuf:"varint,6,opt,name=healthy,proto3" json:"healthy,omitempty"`
+}
+
+func (m *QuerySolvencyResponse) Reset()         { *m = QuerySolvencyResponse{} }
+func (m *QuerySolvencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySolvencyResponse) ProtoMessage()    {}
+func (*QuerySolvencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{23}
+}
+func (m *QuerySolvencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySolvencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySolvencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySolvencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySolvencyResponse.Merge(m, src)
+}
+func (m *QuerySolvencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySolvencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySolvencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySolvencyResponse proto.InternalMessageInfo
+
+func (m *QuerySolvencyResponse) GetStableSupplies() []StableSupply {
+	if m != nil {
+		return m.StableSupplies
+	}
+	return nil
+}
+
+func (m *QuerySolvencyResponse) GetExchangePool() types.Coin {
+	if m != nil {
+		return m.ExchangePool
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySolvencyResponse) GetReserve() types.Coin {
+	if m != nil {
+		return m.Reserve
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySolvencyResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{24}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386d011e80124fb4, []int{25}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryTaxRateRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxRateRequest")
+	proto.RegisterType((*QueryTaxRateResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxRateResponse")
+	proto.RegisterType((*QueryTaxRateHistoryRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxRateHistoryRequest")
+	proto.RegisterType((*QueryTaxRateHistoryResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxRateHistoryResponse")
+	proto.RegisterType((*QueryTaxProceedsRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxProceedsRequest")
+	proto.RegisterType((*QueryTaxProceedsResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxProceedsResponse")
+	proto.RegisterType((*QueryIndicatorsRequest)(nil), "osmosis.treasury.v1beta1.QueryIndicatorsRequest")
+	proto.RegisterType((*QueryIndicatorsResponse)(nil), "osmosis.treasury.v1beta1.QueryIndicatorsResponse")
+	proto.RegisterType((*QueryReserveHistoryRequest)(nil), "osmosis.treasury.v1beta1.QueryReserveHistoryRequest")
+	proto.RegisterType((*ReserveRecord)(nil), "osmosis.treasury.v1beta1.ReserveRecord")
+	proto.RegisterType((*QueryReserveHistoryResponse)(nil), "osmosis.treasury.v1beta1.QueryReserveHistoryResponse")
+	proto.RegisterType((*QueryTaxCapRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxCapRequest")
+	proto.RegisterType((*QueryTaxCapResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxCapResponse")
+	proto.RegisterType((*QueryTaxCapsRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxCapsRequest")
+	proto.RegisterType((*QueryTaxCapsResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxCapsResponse")
+	proto.RegisterType((*QueryTaxExemptionZonesRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxExemptionZonesRequest")
+	proto.RegisterType((*QueryTaxExemptionZonesResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxExemptionZonesResponse")
+	proto.RegisterType((*QueryTaxExemptionAddressesRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxExemptionAddressesRequest")
+	proto.RegisterType((*QueryTaxExemptionAddressesResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxExemptionAddressesResponse")
+	proto.RegisterType((*QueryTaxExemptionRoutesRequest)(nil), "osmosis.treasury.v1beta1.QueryTaxExemptionRoutesRequest")
+	proto.RegisterType((*QueryTaxExemptionRoutesResponse)(nil), "osmosis.treasury.v1beta1.QueryTaxExemptionRoutesResponse")
+	proto.RegisterType((*QuerySolvencyRequest)(nil), "osmosis.treasury.v1beta1.QuerySolvencyRequest")
+	proto.RegisterType((*StableSupply)(nil), "osmosis.treasury.v1beta1.StableSupply")
+	proto.RegisterType((*QuerySolvencyResponse)(nil), "osmosis.treasury.v1beta1.QuerySolvencyResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.treasury.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.treasury.v1beta1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/treasury/v1beta1/query.proto", fileDescriptor_386d011e80124fb4)
+}
+
+var fileDescriptor_386d011e80124fb4 = []byte{
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xe6, 0xc3, 0x49, 0x9f, 0xa4, 0x69, 0x3b, 0xf9, 0xe8, 0xd6, 0x6f, 0x5f, 0x27, 0x5d,
+	0x45, 0x69, 0xde, 0xbc, 0x8d, 0xdd, 0xa4, 0xed, 0xdb, 0x37, 0x50, 0x21, 0x35, 0x49, 0x81, 0x4a,
+	0x80, 0xc2, 0xa6, 0x2d, 0x50, 0x21, 0x59, 0xe3, 0xf5, 0x60, 0x2f, 0x6c, 0x76, 0xb6, 0x3b, 0xeb,
+	0x28, 0x2e, 0x02, 0x04, 0xff, 0x00, 0x20, 0x90, 0x10, 0x27, 0x2e, 0x70, 0x80, 0x13, 0x48, 0x5c,
+	0x39, 0xc0, 0xa9, 0x17, 0xa4, 0x0a, 0x2e, 0x88, 0x43, 0x41, 0x2d, 0xe2, 0xce, 0x3f, 0x80, 0xd0,
+	0xce, 0xc7, 0x7a, 0x37, 0x8e, 0xed, 0x75, 0xb5, 0x97, 0xd6, 0x3b, 0xf3, 0x7c, 0xfc, 0x7e, 0xcf,
+	0x3c, 0x33, 0xf3, 0x9b, 0xc0, 0x02, 0x65, 0xbb, 0x94, 0xd9, 0xac, 0x14, 0xf8, 0x04, 0xb3, 0x86,
+	0xdf, 0x2c, 0xed, 0xad, 0x56, 0x48, 0x80, 0x57, 0x4b, 0x77, 0x1a, 0xc4, 0x6f, 0x16, 0x3d, 0x9f,
+	0x06, 0x14, 0xe9, 0xd2, 0xaa, 0xa8, 0xac, 0x8a, 0xd2, 0x2a, 0x7f, 0xca, 0xe2, 0x53, 0x65, 0x6e,
+	0x57, 0x12, 0x1f, 0xc2, 0x29, 0xbf, 0x2c, 0xbe, 0x4a, 0x15, 0xcc, 0x88, 0x88, 0x16, 0xc5, 0xf6,
+	0x70, 0xcd, 0x76, 0x71, 0x60, 0x53, 0x57, 0xda, 0x16, 0xe2, 0xb6, 0xca, 0xca, 0xa2, 0xb6, 0x9a,
+	0x9f, 0xae, 0xd1, 0x1a, 0x15, 0x39, 0xc2, 0x5f, 0x72, 0xf4, 0x74, 0x8d, 0xd2, 0x9a, 0x43, 0x4a,
+	0xd8, 0xb3, 0x4b, 0xd8, 0x75, 0x69, 0xc0, 0x43, 0xaa, 0xfc, 0x67, 0x3b, 0x52, 0x8b, 0x58, 0x70,
+	0x43, 0x63, 0x06, 0xa6, 0x5e, 0x0c, 0xe1, 0xdd, 0xc0, 0xfb, 0x26, 0x0e, 0x88, 0x49, 0xee, 0x34,
+	0x08, 0x0b, 0x0c, 0x0a, 0xd3, 0xc9, 0x61, 0xe6, 0x51, 0x97, 0x11, 0xf4, 0x12, 0x8c, 0x05, 0x78,
+	0xbf, 0xec, 0xe3, 0x80, 0xe8, 0xda, 0xbc, 0xb6, 0x74, 0x64, 0xe3, 0xca, 0xbd, 0x07, 0x73, 0x03,
+	0xbf, 0x3e, 0x98, 0x5b, 0xac, 0xd9, 0x41, 0xbd, 0x51, 0x29, 0x5a, 0x74, 0x57, 0x96, 0x42, 0xfe,
+	0xb7, 0xc2, 0xaa, 0x6f, 0x94, 0x82, 0xa6, 0x47, 0x58, 0x71, 0x8b, 0x58, 0x3f, 0x7d, 0xbb, 0x02,
+	0xb2, 0x52, 0x5b, 0xc4, 0x32, 0x47, 0x03, 0x91, 0xc0, 0x38, 0x0d, 0xf9, 0x78, 0xc2, 0x67, 0x6d,
+	0x16, 0x50, 0xbf, 0xa9, 0xe0, 0x7c, 0xa1, 0xc1, 0xbf, 0x0e, 0x9d, 0x96, 0xb0, 0xa6, 0x61, 0x84,
+	0x78, 0xd4, 0xaa, 0x73, 0x4c, 0xc3, 0xa6, 0xf8, 0x40, 0x67, 0x60, 0xc2, 0x76, 0xc3, 0xd5, 0xa9,
+	0xf0, 0xda, 0xe8, 0x83, 0xf3, 0xda, 0xd2, 0x98, 0x39, 0x6e, 0xbb, 0xdb, 0x6a, 0x08, 0x3d, 0x0f,
+	0x13, 0xdc, 0xb6, 0xcc, 0x02, 0x1c, 0x10, 0xa6, 0x0f, 0xcd, 0x0f, 0x2d, 0x8d, 0xaf, 0x2d, 0x14,
+	0x3b, 0xad, 0x79, 0xf1, 0x5a, 0x68, 0xbd, 0x13, 0x1a, 0x6f, 0x0c, 0x87, 0xcc, 0xcd, 0x71, 0x12,
+	0x8d, 0x30, 0xe3, 0x14, 0x9c, 0x54, 0x30, 0xb7, 0x7d, 0x6a, 0x11, 0x52, 0x65, 0x8a, 0xc2, 0x87,
+	0x83, 0xa0, 0xb7, 0xcf, 0x75, 0xc5, 0x5f, 0x86, 0x89, 0xb0, 0xd8, 0x9e, 0xb4, 0xd6, 0x07, 0xfb,
+	0x2e, 0xf8, 0x75, 0x37, 0x88, 0x15, 0xfc, 0xba, 0x1b, 0x98, 0xe3, 0x41, 0x2b, 0x3d, 0x7a, 0x1b,
+	0x66, 0xe2, 0x09, 0xca, 0x95, 0x66, 0xb9, 0x4a, 0x5c, 0xba, 0x2b, 0xcb, 0x70, 0xaa, 0x28, 0x1d,
+	0xc3, 0xce, 0x8c, 0x2a, 0xb0, 0x49, 0x6d, 0x77, 0xe3, 0x7c, 0x08, 0xe2, 0xab, 0xdf, 0xe6, 0x96,
+	0x52, 0x80, 0x08, 0x1d, 0x98, 0x89, 0x62, 0x89, 0x37, 0x9a, 0x5b, 0x61, 0x1a, 0x43, 0x87, 0x59,
+	0x5e, 0x92, 0xeb, 0x6e, 0xd5, 0xb6, 0x70, 0x40, 0xfd, 0xa8, 0x5a, 0x5f, 0x8e, 0xc0, 0xc9, 0xb6,
+	0x29, 0x59, 0xac, 0xd7, 0x01, 0x25, 0x50, 0xb3, 0x3a, 0xf5, 0x83, 0x4c, 0xba, 0xf1, 0x78, 0x0c,
+	0xe3, 0x4e, 0x18, 0x15, 0xd5, 0xe1, 0x44, 0x22, 0x97, 0x43, 0xdd, 0x9a, 0x3e, 0x98, 0x41, 0xaa,
+	0x63, 0xb1, 0x54, 0xcf, 0x51, 0xb7, 0x86, 0x6c, 0x38, 0xc1, 0x88, 0x5d, 0x73, 0x6d, 0xea, 0xe3,
+	0x1a, 0x91, 0xa4, 0x86, 0xb2, 0x20, 0x15, 0x0b, 0x2b, 0x48, 0xd5, 0x20, 0x3e, 0x26, 0x38, 0x0d,
+	0x67, 0xc1, 0x29, 0x16, 0x95, 0x73, 0xf2, 0x61, 0xd6, 0x27, 0x8c, 0xf8, 0x7b, 0xa4, 0x6c, 0xd1,
+	0x3d, 0x12, 0x23, 0x36, 0x92, 0x41, 0xba, 0x69, 0x19, 0x7b, 0x53, 0x86, 0x16, 0xe4, 0x3c, 0x98,
+	0x69, 0xcb, 0xc9, 0x19, 0xe6, 0x32, 0x48, 0x39, 0x75, 0x20, 0x65, 0xc8, 0x32, 0x3a, 0xba, 0x4c,
+	0x31, 0x77, 0xe0, 0xe8, 0xfa, 0x73, 0x08, 0x8e, 0xca, 0x19, 0x93, 0x58, 0xd4, 0xaf, 0x76, 0xd8,
+	0xec, 0xb7, 0x60, 0xb4, 0x82, 0x1d, 0xec, 0x5a, 0x24, 0x93, 0x7d, 0xae, 0x82, 0x85, 0x71, 0x7d,
+	0xf2, 0x9a, 0xed, 0x38, 0x4c, 0x1f, 0xca, 0x22, 0xae, 0x0c, 0xd6, 0x76, 0x38, 0x0d, 0x67, 0x7d,
+	0x38, 0xbd, 0x0c, 0x63, 0x6a, 0x01, 0x33, 0x69, 0x97, 0x28, 0x1a, 0xba, 0x01, 0xb9, 0xaa, 0x8f,
+	0x6d, 0x97, 0xe9, 0xb9, 0x0c, 0x40, 0xcb, 0x58, 0x46, 0x43, 0x5e, 0x51, 0x07, 0xdb, 0x40, 0x9e,
+	0x5a, 0xb7, 0xe0, 0x98, 0xea, 0x4b, 0x9f, 0xf7, 0x01, 0xd3, 0x35, 0x7e, 0xca, 0x9e, 0xed, 0x7c,
+	0xd9, 0x24, 0xfa, 0x46, 0xde, 0x37, 0x93, 0x7e, 0x7c, 0x90, 0x19, 0xcb, 0x80, 0xd4, 0xb5, 0xb2,
+	0x89, 0x3d, 0xd9, 0x75, 0x61, 0x8f, 0x89, 0x93, 0x9c, 0x1f, 0x8b, 0xa6, 0xf8, 0x30, 0x1c, 0x98,
+	0x4a, 0xd8, 0x4a, 0x68, 0x37, 0x21, 0xbc, 0x86, 0xcb, 0x16, 0xf6, 0x74, 0x2d, 0x8b, 0x82, 0x04,
+	0x3c, 0x7c, 0x5c, 0x5a, 0x6c, 0x62, 0x2f, 0x3a, 0xda, 0x5f, 0x81, 0xe9, 0xe4, 0xb0, 0x44, 0x71,
+	0x55, 0x48, 0x0b, 0x0b, 0x7b, 0xaa, 0x32, 0xf3, 0x9d, 0x2b, 0x23, 0x9c, 0x65, 0x49, 0x46, 0x45,
+	0x42, 0x66, 0xcc, 0xc1, 0xbf, 0x55, 0xe8, 0x6b, 0xfb, 0x64, 0xd7, 0x0b, 0xaf, 0xf8, 0xdb, 0xd4,
+	0x25, 0x51, 0xee, 0x57, 0xa1, 0xd0, 0xc9, 0x40, 0xa2, 0x78, 0x02, 0x46, 0xee, 0x86, 0x03, 0x12,
+	0x42, 0xa1, 0x33, 0x84, 0xd0, 0x4f, 0x02, 0x10, 0x2e, 0xc6, 0x3b, 0x70, 0xa6, 0x2d, 0xfa, 0xd5,
+	0x6a, 0xd5, 0x27, 0x8c, 0x45, 0x10, 0x10, 0x82, 0xe1, 0xd0, 0x5a, 0x2e, 0x0c, 0xff, 0x8d, 0x9e,
+	0x06, 0x68, 0xa9, 0x42, 0xbe, 0xfd, 0xc7, 0xd7, 0x16, 0x13, 0x97, 0xaf, 0x10, 0xa4, 0x2a, 0xf5,
+	0x36, 0xae, 0x29, 0xa5, 0x66, 0xc6, 0x3c, 0x8d, 0x1f, 0x34, 0x30, 0xba, 0x21, 0x90, 0x1c, 0x77,
+	0x60, 0x32, 0xac, 0x34, 0x51, 0x16, 0x8a, 0xec, 0x62, 0xd7, 0x7a, 0x47, 0x01, 0x25, 0xe9, 0xa3,
+	0x41, 0x6c, 0x8c, 0xa1, 0x67, 0x0e, 0xe1, 0x70, 0xb6, 0x27, 0x07, 0x81, 0x28, 0x41, 0x62, 0xfe,
+	0x90, 0x35, 0x32, 0x69, 0x23, 0x68, 0xad, 0xe2, 0x3a, 0xcc, 0x75, 0xb4, 0x90, 0x14, 0x67, 0x21,
+	0xe7, 0xf3, 0x11, 0x4e, 0xed, 0x88, 0x29, 0xbf, 0x8c, 0x59, 0xd9, 0x7c, 0x3b, 0xd4, 0xd9, 0x23,
+	0xae, 0x15, 0x9d, 0xd2, 0x7f, 0x6b, 0x30, 0xb1, 0x13, 0xe0, 0x8a, 0x43, 0x76, 0x1a, 0x9e, 0xe7,
+	0x34, 0xd1, 0x65, 0xc8, 0x31, 0xfe, 0x8b, 0x2f, 0x54, 0x57, 0x2d, 0x24, 0xca, 0x21, 0xcd, 0x11,
+	0x86, 0xa3, 0x64, 0xdf, 0xaa, 0x63, 0xb7, 0x46, 0x84, 0x4c, 0xce, 0x42, 0x2d, 0x4c, 0xa8, 0x90,
+	0xa1, 0xf8, 0x45, 0x26, 0x8c, 0xec, 0x61, 0xa7, 0x41, 0x32, 0x91, 0x07, 0x22, 0x94, 0xf1, 0xd7,
+	0x10, 0xcc, 0x1c, 0xa8, 0x4c, 0x74, 0x3a, 0x1c, 0x63, 0xbc, 0x32, 0x65, 0xce, 0xd0, 0x26, 0x29,
+	0xda, 0x25, 0x5e, 0x4a, 0x75, 0x6e, 0xb1, 0xd6, 0x98, 0x4d, 0x18, 0xa2, 0x30, 0xdd, 0xaa, 0x13,
+	0xb9, 0xd3, 0xb0, 0x7d, 0xb2, 0x4b, 0xdc, 0x20, 0x93, 0x72, 0x4d, 0x45, 0xe5, 0x6a, 0x05, 0x46,
+	0x5b, 0xb1, 0x85, 0xf1, 0x28, 0x75, 0xf4, 0xa1, 0x74, 0x0b, 0x1b, 0xd5, 0x7e, 0x9b, 0x52, 0x07,
+	0xad, 0xc3, 0xa8, 0x3c, 0x80, 0xf5, 0xe1, 0x74, 0xfe, 0xca, 0x1e, 0x35, 0xe0, 0xa4, 0x45, 0x1d,
+	0x07, 0x07, 0xc4, 0xc7, 0x8e, 0x7d, 0x97, 0x77, 0x7b, 0xd8, 0x22, 0x36, 0xcd, 0xe4, 0x7e, 0x9b,
+	0x6d, 0x0b, 0x6e, 0x86, 0xff, 0x22, 0x1d, 0x46, 0xeb, 0x04, 0x3b, 0x41, 0xbd, 0xc9, 0xaf, 0xbb,
+	0x31, 0x53, 0x7d, 0x1a, 0xd3, 0xf2, 0xea, 0xd8, 0xc6, 0x3e, 0xde, 0x8d, 0x76, 0xd7, 0x4d, 0x98,
+	0x4a, 0x8c, 0xca, 0x36, 0x78, 0x0a, 0x72, 0x1e, 0x1f, 0x91, 0x1b, 0xa2, 0xcb, 0xe1, 0x2c, 0x3c,
+	0xd5, 0xbe, 0x10, 0x5e, 0x6b, 0xef, 0x1e, 0x87, 0x11, 0x1e, 0x17, 0x7d, 0xac, 0xc1, 0xa8, 0x7c,
+	0xc7, 0xa1, 0x95, 0xce, 0x51, 0x0e, 0x79, 0x96, 0xe6, 0x8b, 0x69, 0xcd, 0x05, 0x68, 0x63, 0xf9,
+	0xbd, 0x9f, 0xff, 0xf8, 0x68, 0x70, 0x01, 0x19, 0xa5, 0xce, 0xef, 0x61, 0xf9, 0x9c, 0x45, 0xdf,
+	0x68, 0x30, 0x99, 0x7c, 0x5e, 0xa2, 0x8b, 0xe9, 0xd2, 0x25, 0x15, 0x5f, 0xfe, 0x52, 0x9f, 0x5e,
+	0x12, 0xeb, 0x1a, 0xc7, 0x7a, 0x0e, 0x2d, 0xf7, 0xc6, 0x5a, 0xae, 0x4b, 0x80, 0x9f, 0x6b, 0x30,
+	0x1e, 0x7b, 0x4f, 0xa2, 0xd5, 0xde, 0xa9, 0x0f, 0xbc, 0x4b, 0xf3, 0x6b, 0xfd, 0xb8, 0x48, 0xa8,
+	0x45, 0x0e, 0x75, 0x09, 0x2d, 0x76, 0x87, 0xaa, 0xb4, 0x21, 0xfa, 0x4c, 0x03, 0x68, 0x3d, 0xe4,
+	0xd0, 0xf9, 0x1e, 0x29, 0xdb, 0x9e, 0x83, 0xf9, 0xd5, 0x3e, 0x3c, 0x24, 0xc6, 0x73, 0x1c, 0xe3,
+	0x22, 0x5a, 0xe8, 0x8c, 0xd1, 0x6e, 0x41, 0xfa, 0x5a, 0x83, 0xc9, 0xa4, 0x70, 0xeb, 0xb9, 0xf8,
+	0x87, 0xca, 0xfd, 0xfc, 0xa5, 0x3e, 0xbd, 0x24, 0xda, 0x55, 0x8e, 0xf6, 0xbf, 0xe8, 0x3f, 0x9d,
+	0xd1, 0x2a, 0xf5, 0xa8, 0xd6, 0xfe, 0x53, 0x0d, 0x72, 0x42, 0x06, 0xa1, 0x73, 0xbd, 0xd7, 0xb0,
+	0xa5, 0x0d, 0xf3, 0x2b, 0x29, 0xad, 0xfb, 0xeb, 0xcb, 0x50, 0xb7, 0x95, 0xde, 0xe4, 0x3a, 0xf3,
+	0x2d, 0xb5, 0xc5, 0x43, 0x51, 0x86, 0xd2, 0xa5, 0x63, 0x7d, 0x6c, 0xf1, 0xb8, 0x6c, 0x4c, 0xbb,
+	0xc5, 0x43, 0x78, 0xe8, 0x3b, 0x0d, 0x4e, 0xb4, 0x49, 0x3f, 0x74, 0xb9, 0x77, 0xc6, 0x43, 0xd5,
+	0x64, 0xfe, 0xff, 0xfd, 0x3b, 0x4a, 0xd0, 0x97, 0x38, 0xe8, 0x12, 0x5a, 0xe9, 0x0e, 0x3a, 0x52,
+	0x68, 0x65, 0x2e, 0x30, 0xd1, 0x8f, 0x1a, 0xcc, 0x1c, 0x2a, 0xed, 0xd0, 0x93, 0x7d, 0x40, 0x39,
+	0x28, 0x49, 0xf3, 0x57, 0x1e, 0xcf, 0x59, 0x72, 0x59, 0xe7, 0x5c, 0x2e, 0xa0, 0xd5, 0xb4, 0x5c,
+	0x70, 0x84, 0xfa, 0x7b, 0x0d, 0x50, 0xbb, 0x88, 0x43, 0xfd, 0xd4, 0x35, 0xa1, 0x0c, 0xf3, 0xeb,
+	0x8f, 0xe1, 0x29, 0x69, 0xfc, 0x8f, 0xd3, 0x38, 0x8f, 0x8a, 0x69, 0x69, 0x08, 0x45, 0x89, 0x3e,
+	0xd1, 0x60, 0x4c, 0x69, 0x26, 0xd4, 0xab, 0x79, 0x0f, 0xc8, 0xce, 0x7c, 0x29, 0xb5, 0x7d, 0xfa,
+	0x6e, 0x67, 0x0a, 0xcc, 0xfb, 0x1a, 0xe4, 0xc4, 0x55, 0xdc, 0xf3, 0x80, 0x48, 0x28, 0x80, 0xfc,
+	0x4a, 0x4a, 0x6b, 0x89, 0x69, 0x89, 0x63, 0x32, 0xd0, 0x7c, 0x67, 0x4c, 0x42, 0x03, 0x6c, 0xbc,
+	0x70, 0xef, 0x61, 0x41, 0xbb, 0xff, 0xb0, 0xa0, 0xfd, 0xfe, 0xb0, 0xa0, 0x7d, 0xf0, 0xa8, 0x30,
+	0x70, 0xff, 0x51, 0x61, 0xe0, 0x97, 0x47, 0x85, 0x81, 0xdb, 0x17, 0x63, 0x92, 0x47, 0x46, 0x59,
+	0x71, 0x70, 0x85, 0x45, 0x21, 0xf7, 0xd6, 0x2e, 0x94, 0xf6, 0x5b, 0x81, 0xb9, 0x08, 0xaa, 0xe4,
+	0xf8, 0xdf, 0xb0, 0x2f, 0xfc, 0x33, 0x00, 0x2f, 0x84, 0xfc, 0xe2, 0xc9, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TaxRate return the current tax rate
+	TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error)
+	// TaxRateHistory returns the indicators and tax rates of the past epochs
+	TaxRateHistory(ctx context.Context, in *QueryTaxRateHistoryRequest, opts ...grpc.CallOption) (*QueryTaxRateHistoryResponse, error)
+	// TaxProceeds returns the stability tax collected during the current epoch
+	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
+	// Indicators returns the short and long window averages of the indicators
+	Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error)
+	// ReserveHistory returns the reserve balance and refills of the past epochs
+	ReserveHistory(ctx context.Context, in *QueryReserveHistoryRequest, opts ...grpc.CallOption) (*QueryReserveHistoryResponse, error)
+	// TaxCap returns the stability tax cap of a denom
+	TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error)
+	// TaxCaps returns the stability tax caps of all capped denoms
+	TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error)
+	// TaxExemptionZones returns all tax exemption zones
+	TaxExemptionZones(ctx context.Context, in *QueryTaxExemptionZonesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionZonesResponse, error)
+	// TaxExemptionAddresses returns the addresses of a tax exemption zone, or
+	// of all zones if no zone is given
+	TaxExemptionAddresses(ctx context.Context, in *QueryTaxExemptionAddressesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionAddressesResponse, error)
+	// TaxExemptionRoutes returns the message routes exempt from the stability
+	// tax
+	TaxExemptionRoutes(ctx context.Context, in *QueryTaxExemptionRoutesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionRoutesResponse, error)
+	// Solvency returns how well the stable supply is backed by the market vault
+	// and the treasury reserve
+	Solvency(ctx context.Context, in *QuerySolvencyRequest, opts ...grpc.CallOption) (*QuerySolvencyResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error) {
+	out := new(QueryTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxRateHistory(ctx context.Context, in *QueryTaxRateHistoryRequest, opts ...grpc.CallOption) (*QueryTaxRateHistoryResponse, error) {
+	out := new(QueryTaxRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error) {
+	out := new(QueryTaxProceedsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error) {
+	out := new(QueryIndicatorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/Indicators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveHistory(ctx context.Context, in *QueryReserveHistoryRequest, opts ...grpc.CallOption) (*QueryReserveHistoryResponse, error) {
+	out := new(QueryReserveHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/ReserveHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error) {
+	out := new(QueryTaxCapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error) {
+	out := new(QueryTaxCapsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxExemptionZones(ctx context.Context, in *QueryTaxExemptionZonesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionZonesResponse, error) {
+	out := new(QueryTaxExemptionZonesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxExemptionZones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxExemptionAddresses(ctx context.Context, in *QueryTaxExemptionAddressesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionAddressesResponse, error) {
+	out := new(QueryTaxExemptionAddressesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxExemptionAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxExemptionRoutes(ctx context.Context, in *QueryTaxExemptionRoutesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionRoutesResponse, error) {
+	out := new(QueryTaxExemptionRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/TaxExemptionRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Solvency(ctx context.Context, in *QuerySolvencyRequest, opts ...grpc.CallOption) (*QuerySolvencyResponse, error) {
+	out := new(QuerySolvencyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/Solvency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.treasury.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TaxRate return the current tax rate
+	TaxRate(context.Context, *QueryTaxRateRequest) (*QueryTaxRateResponse, error)
+	// TaxRateHistory returns the indicators and tax rates of the past epochs
+	TaxRateHistory(context.Context, *QueryTaxRateHistoryRequest) (*QueryTaxRateHistoryResponse, error)
+	// TaxProceeds returns the stability tax collected during the current epoch
+	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
+	// Indicators returns the short and long window averages of the indicators
+	Indicators(context.Context, *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error)
+	// ReserveHistory returns the reserve balance and refills of the past epochs
+	ReserveHistory(context.Context, *QueryReserveHistoryRequest) (*QueryReserveHistoryResponse, error)
+	// TaxCap returns the stability tax cap of a denom
+	TaxCap(context.Context, *QueryTaxCapRequest) (*QueryTaxCapResponse, error)
+	// TaxCaps returns the stability tax caps of all capped denoms
+	TaxCaps(context.Context, *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error)
+	// TaxExemptionZones returns all tax exemption zones
+	TaxExemptionZones(context.Context, *QueryTaxExemptionZonesRequest) (*QueryTaxExemptionZonesResponse, error)
+	// TaxExemptionAddresses returns the addresses of a tax exemption zone, or
+	// of all zones if no zone is given
+	TaxExemptionAddresses(context.Context, *QueryTaxExemptionAddressesRequest) (*QueryTaxExemptionAddressesResponse, error)
+	// TaxExemptionRoutes returns the message routes exempt from the stability
+	// tax
+	TaxExemptionRoutes(context.Context, *QueryTaxExemptionRoutesRequest) (*QueryTaxExemptionRoutesResponse, error)
+	// Solvency returns how well the stable supply is backed by the market vault
+	// and the treasury reserve
+	Solvency(context.Context, *QuerySolvencyRequest) (*QuerySolvencyResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TaxRate(ctx context.Context, req *QueryTaxRateRequest) (*QueryTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxRate not implemented")
+}
+func (*UnimplementedQueryServer) TaxRateHistory(ctx context.Context, req *QueryTaxRateHistoryRequest) (*QueryTaxRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxProceeds not implemented")
+}
+func (*UnimplementedQueryServer) Indicators(ctx context.Context, req *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Indicators not implemented")
+}
+func (*UnimplementedQueryServer) ReserveHistory(ctx context.Context, req *QueryReserveHistoryRequest) (*QueryReserveHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveHistory not implemented")
+}
+func (*UnimplementedQueryServer) TaxCap(ctx context.Context, req *QueryTaxCapRequest) (*QueryTaxCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxCap not implemented")
+}
+func (*UnimplementedQueryServer) TaxCaps(ctx context.Context, req *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxCaps not implemented")
+}
+func (*UnimplementedQueryServer) TaxExemptionZones(ctx context.Context, req *QueryTaxExemptionZonesRequest) (*QueryTaxExemptionZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptionZones not implemented")
+}
+func (*UnimplementedQueryServer) TaxExemptionAddresses(ctx context.Context, req *QueryTaxExemptionAddressesRequest) (*QueryTaxExemptionAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptionAddresses not implemented")
+}
+func (*UnimplementedQueryServer) TaxExemptionRoutes(ctx context.Context, req *QueryTaxExemptionRoutesRequest) (*QueryTaxExemptionRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptionRoutes not implemented")
+}
+func (*UnimplementedQueryServer) Solvency(ctx context.Context, req *QuerySolvencyRequest) (*QuerySolvencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solvency not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxRate(ctx, req.(*QueryTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxRateHistory(ctx, req.(*QueryTaxRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxProceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxProceeds(ctx, req.(*QueryTaxProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Indicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndicatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Indicators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/Indicators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Indicators(ctx, req.(*QueryIndicatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/ReserveHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveHistory(ctx, req.(*QueryReserveHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxCap(ctx, req.(*QueryTaxCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxCapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxCaps(ctx, req.(*QueryTaxCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxExemptionZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxExemptionZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxExemptionZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxExemptionZones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxExemptionZones(ctx, req.(*QueryTaxExemptionZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxExemptionAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxExemptionAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxExemptionAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxExemptionAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxExemptionAddresses(ctx, req.(*QueryTaxExemptionAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxExemptionRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxExemptionRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxExemptionRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/TaxExemptionRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxExemptionRoutes(ctx, req.(*QueryTaxExemptionRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Solvency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySolvencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Solvency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/Solvency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Solvency(ctx, req.(*QuerySolvencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.treasury.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.treasury.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TaxRate",
+			Handler:    _Query_TaxRate_Handler,
+		},
+		{
+			MethodName: "TaxRateHistory",
+			Handler:    _Query_TaxRateHistory_Handler,
+		},
+		{
+			MethodName: "TaxProceeds",
+			Handler:    _Query_TaxProceeds_Handler,
+		},
+		{
+			MethodName: "Indicators",
+			Handler:    _Query_Indicators_Handler,
+		},
+		{
+			MethodName: "ReserveHistory",
+			Handler:    _Query_ReserveHistory_Handler,
+		},
+		{
+			MethodName: "TaxCap",
+			Handler:    _Query_TaxCap_Handler,
+		},
+		{
+			MethodName: "TaxCaps",
+			Handler:    _Query_TaxCaps_Handler,
+		},
+		{
+			MethodName: "TaxExemptionZones",
+			Handler:    _Query_TaxExemptionZones_Handler,
+		},
+		{
+			MethodName: "TaxExemptionAddresses",
+			Handler:    _Query_TaxExemptionAddresses_Handler,
+		},
+		{
+			MethodName: "TaxExemptionRoutes",
+			Handler:    _Query_TaxExemptionRoutes_Handler,
+		},
+		{
+			MethodName: "Solvency",
+			Handler:    _Query_Solvency_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/treasury/v1beta1/query.proto",
+}
+
+func (m *QueryTaxRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochStates) > 0 {
+		for iNdEx := len(m.EpochStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.InProbation {
+		i--
+		if m.InProbation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxProceedsByDenom) > 0 {
+		for iNdEx := len(m.TaxProceedsByDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceedsByDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TaxProceeds.Size()
+		i -= size
+		if _, err := m.TaxProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReserveCoverageLong.Size()
+		i -= size
+		if _, err := m.ReserveCoverageLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ReserveCoverageShort.Size()
+		i -= size
+		if _, err := m.ReserveCoverageShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SeigniorageLong.Size()
+		i -= size
+		if _, err := m.SeigniorageLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SeigniorageShort.Size()
+		i -= size
+		if _, err := m.SeigniorageShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TaxProceedsLong.Size()
+		i -= size
+		if _, err := m.TaxProceedsLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TaxProceedsShort.Size()
+		i -= size
+		if _, err := m.TaxProceedsShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Drains.Size()
+		i -= size
+		if _, err := m.Drains.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Coverage.Size()
+		i -= size
+		if _, err := m.Coverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TaxProceeds.Size()
+		i -= size
+		if _, err := m.TaxProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Refills.Size()
+		i -= size
+		if _, err := m.Refills.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveRecords) > 0 {
+		for iNdEx := len(m.ReserveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxCap.Size()
+		i -= size
+		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionZonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionZonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionZonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionZonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionZonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionZonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaxExemptions) > 0 {
+		for iNdEx := len(m.TaxExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Routes[iNdEx])
+			copy(dAtA[i:], m.Routes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Routes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySolvencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySolvencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySolvencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StableSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StableSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StableSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySolvencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySolvencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySolvencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.CollateralizationRatio.Size()
+		i -= size
+		if _, err := m.CollateralizationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ExchangePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangeRequirement.Size()
+		i -= size
+		if _, err := m.ExchangeRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StableSupplies) > 0 {
+		for iNdEx := len(m.StableSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StableSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTaxRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.InProbation {
+		n += 2
+	}
+	if len(m.EpochStates) > 0 {
+		for _, e := range m.EpochStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.TaxProceeds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TaxProceedsByDenom) > 0 {
+		for _, e := range m.TaxProceedsByDenom {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIndicatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIndicatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxProceedsShort.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TaxProceedsLong.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SeigniorageShort.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SeigniorageLong.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReserveCoverageShort.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReserveCoverageLong.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReserveRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Refills.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TaxProceeds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Coverage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Drains.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReserveRecords) > 0 {
+		for _, e := range m.ReserveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionZonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxExemptionZonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxExemptions) > 0 {
+		for _, e := range m.TaxExemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxExemptionRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, s := range m.Routes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySolvencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StableSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySolvencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StableSupplies) > 0 {
+		for _, e := range m.StableSupplies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ExchangeRequirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangePool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CollateralizationRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Healthy {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProbation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProbation = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStates = append(m.EpochStates, EpochState{})
+			if err := m.EpochStates[len(m.EpochStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceedsByDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceedsByDenom = append(m.TaxProceedsByDenom, types.Coin{})
+			if err := m.TaxProceedsByDenom[len(m.TaxProceedsByDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndicatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIndicatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceedsShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxProceedsShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceedsLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxProceedsLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoverageShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveCoverageShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoverageLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveCoverageLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refills.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Drains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveRecords = append(m.ReserveRecords, ReserveRecord{})
+			if err := m.ReserveRecords[len(m.ReserveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCaps = append(m.TaxCaps, TaxCap{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, Zone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxExemptions = append(m.TaxExemptions, TaxExemption{})
+			if err := m.TaxExemptions[len(m.TaxExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySolvencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySolvencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySolvencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+func (m *StableSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -547,12 +5290,113 @@ func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StableSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StableSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -574,7 +5418,7 @@ func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySolvencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,15 +5441,49 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySolvencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySolvencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSupplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableSupplies = append(m.StableSupplies, StableSupply{})
+			if err := m.StableSupplies[len(m.StableSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRequirement", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -633,10 +5511,130 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralizationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
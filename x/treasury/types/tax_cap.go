@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTaxCap creates a new TaxCap instance
+func NewTaxCap(denom string, cap sdk.Int) TaxCap {
+	return TaxCap{
+		Denom: denom,
+		Cap:   cap,
+	}
+}
+
+// Validate performs a basic validation of the tax cap
+func (tc TaxCap) Validate() error {
+	if err := sdk.ValidateDenom(tc.Denom); err != nil {
+		return err
+	}
+	if tc.Cap.IsNil() || tc.Cap.IsNegative() {
+		return fmt.Errorf("tax cap of %s must be positive or zero, is %s", tc.Denom, tc.Cap)
+	}
+	return nil
+}
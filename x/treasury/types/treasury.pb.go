@@ -6,8 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,6 +33,25 @@ type Params struct {
 	WindowShort            uint64                                 `protobuf:"varint,3,opt,name=window_short,json=windowShort,proto3" json:"window_short,omitempty" yaml:"window_short"`
 	WindowLong             uint64                                 `protobuf:"varint,4,opt,name=window_long,json=windowLong,proto3" json:"window_long,omitempty" yaml:"window_long"`
 	WindowProbation        uint64                                 `protobuf:"varint,5,opt,name=window_probation,json=windowProbation,proto3" json:"window_probation,omitempty" yaml:"window_probation"`
+	// min_tax_rate is the floor of the tax rate set by the controller
+	MinTaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_tax_rate,json=minTaxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_tax_rate"`
+	// max_tax_rate_change is the largest change of the tax rate per epoch
+	MaxTaxRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_tax_rate_change,json=maxTaxRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_tax_rate_change"`
+	// refill_interval is the number of blocks between two rebalances of the
+	// market vault against the reserve
+	RefillInterval uint64 `protobuf:"varint,8,opt,name=refill_interval,json=refillInterval,proto3" json:"refill_interval,omitempty" yaml:"refill_interval"`
+	// max_refill_per_period is the largest amount of note moved between the
+	// reserve and the market vault in one rebalance
+	MaxRefillPerPeriod github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_refill_per_period,json=maxRefillPerPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_refill_per_period"`
+	// drain_surplus enables sending the market vault balance above the exchange
+	// requirement back to the reserve
+	DrainSurplus bool `protobuf:"varint,10,opt,name=drain_surplus,json=drainSurplus,proto3" json:"drain_surplus,omitempty" yaml:"drain_surplus"`
+	// redemption_only_ratio is the collateralization ratio below which the
+	// market only accepts swaps into melody
+	RedemptionOnlyRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=redemption_only_ratio,json=redemptionOnlyRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_only_ratio" yaml:"redemption_only_ratio"`
+	// halt_ratio is the collateralization ratio below which the market refuses
+	// all swaps
+	HaltRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=halt_ratio,json=haltRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"halt_ratio" yaml:"halt_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -88,8 +107,263 @@ func (m *Params) GetWindowProbation() uint64 {
 	return 0
 }
 
+func (m *Params) GetRefillInterval() uint64 {
+	if m != nil {
+		return m.RefillInterval
+	}
+	return 0
+}
+
+func (m *Params) GetDrainSurplus() bool {
+	if m != nil {
+		return m.DrainSurplus
+	}
+	return false
+}
+
+// EpochState defines the indicators recorded at the end of a treasury epoch
+type EpochState struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// tax_proceeds is the stability tax collected during the epoch, in note
+	TaxProceeds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tax_proceeds,json=taxProceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tax_proceeds"`
+	// reserve_coverage is the ratio of the reserve pool balance to the exchange
+	// requirement at the end of the epoch
+	ReserveCoverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reserve_coverage,json=reserveCoverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_coverage"`
+	// tax_rate is the tax rate that was in effect during the epoch
+	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	// tax_proceeds_by_denom is the stability tax assessed during the epoch per
+	// taxed denom; it was settled in note as tax_proceeds
+	TaxProceedsByDenom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tax_proceeds_by_denom,json=taxProceedsByDenom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds_by_denom"`
+	// seigniorage is the note the market vault took in through swaps during the
+	// epoch, net of reserve refills; negative when stable coins were redeemed
+	Seigniorage github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=seigniorage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"seigniorage"`
+	// reserve_refills is the note sent from the reserve to the market vault
+	// during the epoch
+	ReserveRefills github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=reserve_refills,json=reserveRefills,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_refills"`
+	// reserve_balance is the reserve pool balance at the end of the epoch
+	ReserveBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=reserve_balance,json=reserveBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_balance"`
+	// reserve_drains is the note sent from the market vault back to the reserve
+	// during the epoch
+	ReserveDrains github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=reserve_drains,json=reserveDrains,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_drains"`
+}
+
+func (m *EpochState) Reset()         { *m = EpochState{} }
+func (m *EpochState) String() string { return proto.CompactTextString(m) }
+func (*EpochState) ProtoMessage()    {}
+func (*EpochState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abed7213967f3070, []int{1}
+}
+func (m *EpochState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochState.Merge(m, src)
+}
+func (m *EpochState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochState proto.InternalMessageInfo
+
+func (m *EpochState) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochState) GetTaxProceedsByDenom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxProceedsByDenom
+	}
+	return nil
+}
+
+// TaxCap defines the largest stability tax charged on a single transfer of a
+// denom, in units of the denom
+type TaxCap struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Cap   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+}
+
+func (m *TaxCap) Reset()         { *m = TaxCap{} }
+func (m *TaxCap) String() string { return proto.CompactTextString(m) }
+func (*TaxCap) ProtoMessage()    {}
+func (*TaxCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abed7213967f3070, []int{2}
+}
+func (m *TaxCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxCap.Merge(m, src)
+}
+func (m *TaxCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxCap proto.InternalMessageInfo
+
+func (m *TaxCap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// Zone is a named group of addresses that can move funds among themselves
+// without paying the stability tax
+type Zone struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// outgoing exempts transfers from the zone to any address
+	Outgoing bool `protobuf:"varint,2,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	// incoming exempts transfers from any address to the zone
+	Incoming bool `protobuf:"varint,3,opt,name=incoming,proto3" json:"incoming,omitempty"`
+}
+
+func (m *Zone) Reset()         { *m = Zone{} }
+func (m *Zone) String() string { return proto.CompactTextString(m) }
+func (*Zone) ProtoMessage()    {}
+func (*Zone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abed7213967f3070, []int{3}
+}
+func (m *Zone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Zone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Zone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Zone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Zone.Merge(m, src)
+}
+func (m *Zone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Zone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Zone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Zone proto.InternalMessageInfo
+
+func (m *Zone) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Zone) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+func (m *Zone) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+// TaxExemption assigns an address to a tax exemption zone
+type TaxExemption struct {
+	Zone    string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *TaxExemption) Reset()         { *m = TaxExemption{} }
+func (m *TaxExemption) String() string { return proto.CompactTextString(m) }
+func (*TaxExemption) ProtoMessage()    {}
+func (*TaxExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abed7213967f3070, []int{4}
+}
+func (m *TaxExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxExemption.Merge(m, src)
+}
+func (m *TaxExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxExemption proto.InternalMessageInfo
+
+func (m *TaxExemption) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *TaxExemption) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.treasury.v1beta1.Params")
+	proto.RegisterType((*EpochState)(nil), "osmosis.treasury.v1beta1.EpochState")
+	proto.RegisterType((*TaxCap)(nil), "osmosis.treasury.v1beta1.TaxCap")
+	proto.RegisterType((*Zone)(nil), "osmosis.treasury.v1beta1.Zone")
+	proto.RegisterType((*TaxExemption)(nil), "osmosis.treasury.v1beta1.TaxExemption")
 }
 
 func init() {
@@ -97,34 +371,67 @@ func init() {
 }
 
 var fileDescriptor_abed7213967f3070 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x6d, 0x5a, 0x2a, 0x38, 0x90, 0xa8, 0x0e, 0xd4, 0x9a, 0x22, 0xd9, 0x95, 0x07, 0xe8,
-	0x12, 0x5b, 0xa5, 0x48, 0x48, 0x11, 0x0b, 0x51, 0xd5, 0x89, 0x3f, 0x95, 0xd9, 0x58, 0xac, 0xb3,
-	0xfb, 0xc6, 0x35, 0x9c, 0xfd, 0x5a, 0x77, 0x17, 0x27, 0xf9, 0x16, 0x8c, 0x8c, 0xfd, 0x0e, 0xf0,
-	0x21, 0x3a, 0x56, 0x4c, 0x88, 0xc1, 0x42, 0xc9, 0xc2, 0x9c, 0x4f, 0x80, 0xe2, 0xbb, 0x98, 0x64,
-	0x67, 0xb2, 0x7f, 0xcf, 0xf3, 0x3e, 0xcf, 0x9d, 0x4e, 0x2f, 0x79, 0x86, 0xb2, 0x40, 0x99, 0xcb,
-	0x50, 0x09, 0x60, 0x72, 0x24, 0xa6, 0x61, 0x7d, 0x9c, 0x80, 0x62, 0xc7, 0x9d, 0x10, 0x54, 0x02,
-	0x15, 0x52, 0xc7, 0x0c, 0x06, 0x9d, 0x6e, 0x06, 0x0f, 0xdc, 0xb4, 0xb5, 0xc2, 0x84, 0x49, 0xe8,
-	0xd2, 0x29, 0xe6, 0xa5, 0x4e, 0x1e, 0x3c, 0xd6, 0x7e, 0xdc, 0x52, 0xa8, 0xc1, 0x58, 0x8f, 0x32,
-	0xcc, 0x50, 0xeb, 0xcb, 0x3f, 0xad, 0xfa, 0xdf, 0xb6, 0xc8, 0xce, 0x39, 0x13, 0xac, 0x90, 0xb4,
-	0x26, 0x8e, 0x00, 0x09, 0xa2, 0x86, 0x98, 0x71, 0x8e, 0x63, 0x96, 0x70, 0x88, 0x71, 0x38, 0x94,
-	0xa0, 0x1c, 0xfb, 0xd0, 0x3e, 0xba, 0x3b, 0x78, 0x75, 0xdd, 0x78, 0xd6, 0xaf, 0xc6, 0x7b, 0x9a,
-	0xe5, 0xea, 0x72, 0x94, 0x04, 0x29, 0x16, 0xe6, 0x0c, 0xf3, 0xe9, 0xc9, 0x8b, 0xcf, 0xa1, 0x9a,
-	0x56, 0x20, 0x83, 0x53, 0x48, 0x7f, 0x7c, 0xef, 0x11, 0x73, 0x85, 0x53, 0x48, 0xa3, 0x3d, 0xd3,
-	0xfe, 0x7a, 0x55, 0xfe, 0xbe, 0xed, 0xa6, 0x9f, 0x08, 0x2d, 0xd8, 0x24, 0x1e, 0x02, 0xc4, 0xc5,
-	0x88, 0xab, 0xbc, 0xe2, 0x39, 0x08, 0xe7, 0xd6, 0x7f, 0x38, 0x71, 0xb7, 0x60, 0x93, 0x33, 0x80,
-	0xb7, 0x5d, 0x2b, 0xed, 0x93, 0xfb, 0xe3, 0xbc, 0xbc, 0xc0, 0x71, 0x2c, 0x2f, 0x51, 0x28, 0x67,
-	0xeb, 0xd0, 0x3e, 0xda, 0x1e, 0xec, 0x2f, 0x1a, 0xef, 0xe1, 0x94, 0x15, 0xbc, 0xef, 0xaf, 0xbb,
-	0x7e, 0x74, 0x4f, 0xe3, 0x87, 0x25, 0xd1, 0x97, 0xc4, 0x60, 0xcc, 0xb1, 0xcc, 0x9c, 0xed, 0x36,
-	0xba, 0xb7, 0x68, 0x3c, 0xba, 0x11, 0x5d, 0x9a, 0x7e, 0x44, 0x34, 0xbd, 0xc1, 0x32, 0xa3, 0x67,
-	0x64, 0xd7, 0x78, 0x95, 0xc0, 0x84, 0xa9, 0x1c, 0x4b, 0xe7, 0x76, 0x9b, 0x7e, 0xb2, 0x68, 0xbc,
-	0xfd, 0x8d, 0x74, 0x37, 0xe1, 0x47, 0x0f, 0xb4, 0x74, 0xbe, 0x52, 0xfa, 0x77, 0xbe, 0x5e, 0x79,
-	0xd6, 0x9f, 0x2b, 0xcf, 0x1e, 0xbc, 0xbb, 0x9e, 0xb9, 0xf6, 0xcd, 0xcc, 0xb5, 0x7f, 0xcf, 0x5c,
-	0xfb, 0xcb, 0xdc, 0xb5, 0x6e, 0xe6, 0xae, 0xf5, 0x73, 0xee, 0x5a, 0x1f, 0x5f, 0xac, 0x3d, 0x94,
-	0xd9, 0xa2, 0x1e, 0x67, 0x89, 0x5c, 0x41, 0x58, 0x3f, 0x3f, 0x09, 0x27, 0xff, 0x36, 0xb0, 0x7d,
-	0xba, 0x64, 0xa7, 0x5d, 0x86, 0x93, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63, 0x37, 0x7a, 0x33,
-	0xa2, 0x02, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xb7, 0x6b, 0xc7, 0x71, 0xc6, 0x6e, 0x13, 0x26, 0x1f, 0x9d, 0x06, 0xe4, 0x8d, 0xf6, 0x00,
+	0xbe, 0xc4, 0xa6, 0x2d, 0x12, 0x52, 0x04, 0x07, 0xec, 0xb4, 0x52, 0x24, 0x68, 0xcd, 0x26, 0x12,
+	0xa8, 0x42, 0x5d, 0x8d, 0xd7, 0x93, 0xf5, 0xd2, 0xdd, 0x99, 0xd5, 0xcc, 0xd8, 0xb1, 0x39, 0xf0,
+	0x37, 0xf4, 0xc8, 0xb1, 0x67, 0xce, 0xfc, 0x11, 0x3d, 0x56, 0x9c, 0x22, 0x0e, 0x06, 0x25, 0x17,
+	0xce, 0x3e, 0x72, 0x42, 0xf3, 0xb1, 0x8e, 0x13, 0x38, 0x34, 0xd2, 0x1e, 0xa2, 0xec, 0xef, 0x7d,
+	0xfc, 0x7e, 0xcf, 0x6f, 0xe6, 0xbd, 0x5d, 0xf0, 0x09, 0x13, 0x09, 0x13, 0x91, 0x68, 0x4b, 0x4e,
+	0xb0, 0x18, 0xf1, 0x69, 0x7b, 0xfc, 0xb0, 0x4f, 0x24, 0x7e, 0xb8, 0x30, 0xb4, 0x52, 0xce, 0x24,
+	0x83, 0xc8, 0x06, 0xb6, 0x16, 0x76, 0x1b, 0xb8, 0xdb, 0x08, 0xb4, 0xab, 0xdd, 0xc7, 0x82, 0x2c,
+	0xb2, 0x03, 0x16, 0x51, 0x93, 0xb9, 0xfb, 0xc0, 0xf8, 0x7d, 0x8d, 0xda, 0x06, 0x58, 0xd7, 0x56,
+	0xc8, 0x42, 0x66, 0xec, 0xea, 0xc9, 0x58, 0xdd, 0x7f, 0xaa, 0xa0, 0xd2, 0xc3, 0x1c, 0x27, 0x02,
+	0x8e, 0x01, 0xe2, 0x44, 0x10, 0x3e, 0x26, 0x3e, 0x8e, 0x63, 0x76, 0x86, 0xfb, 0x31, 0xf1, 0xd9,
+	0xe9, 0xa9, 0x20, 0x12, 0x15, 0xf7, 0x8a, 0xcd, 0xb5, 0xce, 0x17, 0x6f, 0x67, 0x4e, 0xe1, 0x8f,
+	0x99, 0xf3, 0x71, 0x18, 0xc9, 0xe1, 0xa8, 0xdf, 0x0a, 0x58, 0x62, 0x35, 0xec, 0xbf, 0x7d, 0x31,
+	0x78, 0xd5, 0x96, 0xd3, 0x94, 0x88, 0xd6, 0x21, 0x09, 0x7e, 0xff, 0x6d, 0x1f, 0xd8, 0x12, 0x0e,
+	0x49, 0xe0, 0xed, 0x58, 0xf6, 0xaf, 0x32, 0xf2, 0xe7, 0x9a, 0x1b, 0xfe, 0x08, 0x60, 0x82, 0x27,
+	0xfe, 0x29, 0x21, 0x7e, 0x32, 0x8a, 0x65, 0x94, 0xc6, 0x11, 0xe1, 0xe8, 0x4e, 0x0e, 0x8a, 0x1b,
+	0x09, 0x9e, 0x3c, 0x25, 0xe4, 0x9b, 0x05, 0x2b, 0x3c, 0x00, 0xf5, 0xb3, 0x88, 0x0e, 0xd8, 0x99,
+	0x2f, 0x86, 0x8c, 0x4b, 0x54, 0xda, 0x2b, 0x36, 0xcb, 0x9d, 0xfb, 0xf3, 0x99, 0xb3, 0x39, 0xc5,
+	0x49, 0x7c, 0xe0, 0x2e, 0x7b, 0x5d, 0xaf, 0x66, 0xe0, 0xb1, 0x42, 0xf0, 0x73, 0x60, 0xa1, 0x1f,
+	0x33, 0x1a, 0xa2, 0xb2, 0x4e, 0xdd, 0x99, 0xcf, 0x1c, 0x78, 0x2d, 0x55, 0x39, 0x5d, 0x0f, 0x18,
+	0xf4, 0x35, 0xa3, 0x21, 0x7c, 0x0a, 0x36, 0xac, 0x2f, 0xe5, 0xac, 0x8f, 0x65, 0xc4, 0x28, 0x5a,
+	0xd1, 0xd9, 0x1f, 0xce, 0x67, 0xce, 0xfd, 0x6b, 0xd9, 0x8b, 0x08, 0xd7, 0x5b, 0x37, 0xa6, 0x5e,
+	0x66, 0x81, 0x2f, 0x41, 0x3d, 0x89, 0xa8, 0x2f, 0xf1, 0xc4, 0xe7, 0x58, 0x12, 0x54, 0xc9, 0xa1,
+	0x45, 0x20, 0x89, 0xe8, 0x09, 0x9e, 0x78, 0x58, 0x12, 0xf8, 0x0a, 0x6c, 0xaa, 0x83, 0xc8, 0xf8,
+	0xfd, 0x60, 0x88, 0x69, 0x48, 0xd0, 0x6a, 0x4e, 0x27, 0x61, 0x65, 0xba, 0x9a, 0x15, 0x76, 0xc1,
+	0x3a, 0x27, 0xa7, 0x51, 0x1c, 0xfb, 0x11, 0x95, 0x84, 0x8f, 0x71, 0x8c, 0xaa, 0xba, 0x27, 0xbb,
+	0xf3, 0x99, 0xb3, 0x63, 0x7a, 0x72, 0x23, 0xc0, 0xf5, 0xee, 0x19, 0xcb, 0x91, 0x35, 0x40, 0x06,
+	0xb6, 0x55, 0xc5, 0x36, 0x2e, 0x25, 0x5c, 0xfd, 0x45, 0x6c, 0x80, 0xd6, 0x6e, 0x5d, 0xf3, 0x11,
+	0x95, 0x4b, 0x35, 0x1f, 0x51, 0xe9, 0xa9, 0x5b, 0xe9, 0x69, 0xe6, 0x1e, 0xe1, 0x3d, 0xcd, 0x0b,
+	0xbf, 0x04, 0x77, 0x07, 0x1c, 0x47, 0xd4, 0x17, 0x23, 0x9e, 0xc6, 0x23, 0x81, 0xc0, 0x5e, 0xb1,
+	0x59, 0xed, 0xa0, 0xf9, 0xcc, 0xd9, 0x32, 0x35, 0x5f, 0x73, 0xbb, 0x5e, 0x5d, 0xe3, 0x63, 0x03,
+	0xe1, 0xeb, 0x22, 0xd8, 0xe6, 0x64, 0x40, 0x92, 0x54, 0x1d, 0xa8, 0xcf, 0x68, 0x3c, 0x55, 0xad,
+	0x8e, 0x18, 0xaa, 0xe9, 0x82, 0x7f, 0xb8, 0x5d, 0x93, 0xe7, 0x33, 0xe7, 0xa3, 0xac, 0x53, 0xff,
+	0x43, 0xea, 0xde, 0x38, 0x84, 0xcd, 0xab, 0xa8, 0xe7, 0x34, 0x9e, 0x7a, 0x2a, 0x06, 0xa6, 0x00,
+	0x0c, 0x71, 0x2c, 0x6d, 0x19, 0x75, 0x5d, 0xc6, 0xb7, 0xb7, 0x2e, 0xe3, 0x03, 0x53, 0xc6, 0x15,
+	0xd3, 0x4d, 0xed, 0x35, 0xe5, 0xd2, 0x8a, 0x07, 0xd5, 0x5f, 0xde, 0x38, 0x85, 0xbf, 0xdf, 0x38,
+	0x45, 0xf7, 0xbc, 0x02, 0xc0, 0x93, 0x94, 0x05, 0xc3, 0x63, 0xa9, 0xee, 0xdf, 0x16, 0x58, 0x21,
+	0x0a, 0xe9, 0x6d, 0x53, 0xf6, 0x0c, 0x80, 0x3e, 0xa8, 0xab, 0x1b, 0x99, 0x72, 0x16, 0x10, 0x32,
+	0x10, 0xe8, 0x4e, 0x0e, 0x47, 0x5b, 0x93, 0x78, 0xd2, 0xb3, 0x84, 0x30, 0x04, 0x1b, 0xd9, 0xde,
+	0x0b, 0xd8, 0x98, 0x70, 0x1c, 0x12, 0x54, 0xba, 0xb5, 0xc8, 0x7f, 0xef, 0xfc, 0xba, 0x65, 0xed,
+	0x5a, 0x52, 0xf8, 0x1d, 0xa8, 0x2e, 0x66, 0xb7, 0x9c, 0x83, 0xc0, 0xaa, 0xb4, 0x83, 0xfb, 0x33,
+	0xd8, 0x5e, 0x6e, 0x91, 0xdf, 0x9f, 0xfa, 0x03, 0x42, 0x59, 0x82, 0x56, 0xf6, 0x4a, 0xcd, 0xda,
+	0xa3, 0x07, 0x2d, 0x9b, 0xa4, 0xde, 0x1a, 0xd9, 0xab, 0xa4, 0xd5, 0x65, 0x11, 0xed, 0x7c, 0xaa,
+	0x0a, 0xf8, 0xf5, 0x4f, 0xa7, 0xf9, 0x1e, 0x05, 0xa8, 0x04, 0xe1, 0xc1, 0xa5, 0xd6, 0x75, 0xa6,
+	0x87, 0x4a, 0x06, 0xbe, 0x04, 0x35, 0x41, 0xa2, 0x90, 0x46, 0x4c, 0x37, 0xaf, 0x92, 0xc7, 0x09,
+	0x2d, 0x11, 0x42, 0x02, 0xb2, 0x5e, 0xda, 0x51, 0x17, 0x68, 0x35, 0x07, 0x8d, 0x7b, 0x96, 0xd4,
+	0x0c, 0xb9, 0x58, 0x96, 0xe9, 0xe3, 0x18, 0xd3, 0x80, 0xa0, 0x6a, 0x8e, 0x32, 0x1d, 0xc3, 0x09,
+	0x03, 0x90, 0x59, 0x7c, 0xbd, 0x1c, 0x44, 0x2e, 0xdb, 0xea, 0xae, 0xe5, 0x3c, 0xd4, 0x94, 0xae,
+	0x04, 0x95, 0x13, 0x3c, 0xe9, 0xe2, 0x54, 0x4d, 0x95, 0xb9, 0x0c, 0xfa, 0x1d, 0xee, 0x19, 0x00,
+	0x9f, 0x81, 0x52, 0x80, 0xd3, 0x5c, 0x86, 0x49, 0x11, 0x1d, 0x94, 0xf5, 0x40, 0x7f, 0x0f, 0xca,
+	0x2f, 0x18, 0x25, 0x10, 0x82, 0x32, 0xc5, 0x09, 0xb1, 0x92, 0xfa, 0x19, 0xee, 0x82, 0x2a, 0x1b,
+	0xc9, 0x90, 0x45, 0x34, 0xd4, 0xb2, 0x55, 0x6f, 0x81, 0x95, 0x2f, 0xa2, 0x01, 0x4b, 0x94, 0xaf,
+	0x64, 0x7c, 0x19, 0xb6, 0xcc, 0x1d, 0x50, 0x3f, 0xc1, 0x93, 0x27, 0x13, 0xbb, 0xbf, 0x94, 0xc2,
+	0x4f, 0x8c, 0x2e, 0x14, 0xd4, 0x33, 0x44, 0x60, 0x15, 0x0f, 0x06, 0x9c, 0x08, 0xbb, 0x24, 0xbc,
+	0x0c, 0x1a, 0x8e, 0xce, 0xb3, 0xb7, 0x17, 0x8d, 0xe2, 0xbb, 0x8b, 0x46, 0xf1, 0xaf, 0x8b, 0x46,
+	0xf1, 0xf5, 0x65, 0xa3, 0xf0, 0xee, 0xb2, 0x51, 0x38, 0xbf, 0x6c, 0x14, 0x5e, 0x7c, 0xb6, 0xf4,
+	0xc3, 0xed, 0xb7, 0xd7, 0x7e, 0x8c, 0xfb, 0x22, 0x03, 0xed, 0xf1, 0xa3, 0xc7, 0xed, 0xc9, 0xd5,
+	0x77, 0x9b, 0x6e, 0x45, 0xbf, 0xa2, 0x3f, 0xa1, 0x1e, 0xff, 0x3b, 0x00, 0x36, 0x98, 0xcd, 0xad,
+	0xd8, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -161,6 +468,111 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WindowProbation != that1.WindowProbation {
 		return false
 	}
+	if !this.MinTaxRate.Equal(that1.MinTaxRate) {
+		return false
+	}
+	if !this.MaxTaxRateChange.Equal(that1.MaxTaxRateChange) {
+		return false
+	}
+	if this.RefillInterval != that1.RefillInterval {
+		return false
+	}
+	if !this.MaxRefillPerPeriod.Equal(that1.MaxRefillPerPeriod) {
+		return false
+	}
+	if this.DrainSurplus != that1.DrainSurplus {
+		return false
+	}
+	if !this.RedemptionOnlyRatio.Equal(that1.RedemptionOnlyRatio) {
+		return false
+	}
+	if !this.HaltRatio.Equal(that1.HaltRatio) {
+		return false
+	}
+	return true
+}
+func (this *TaxCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaxCap)
+	if !ok {
+		that2, ok := that.(TaxCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Cap.Equal(that1.Cap) {
+		return false
+	}
+	return true
+}
+func (this *Zone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Zone)
+	if !ok {
+		that2, ok := that.(Zone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Outgoing != that1.Outgoing {
+		return false
+	}
+	if this.Incoming != that1.Incoming {
+		return false
+	}
+	return true
+}
+func (this *TaxExemption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaxExemption)
+	if !ok {
+		that2, ok := that.(TaxExemption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Zone != that1.Zone {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -183,6 +595,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.HaltRatio.Size()
+		i -= size
+		if _, err := m.HaltRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.RedemptionOnlyRatio.Size()
+		i -= size
+		if _, err := m.RedemptionOnlyRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.DrainSurplus {
+		i--
+		if m.DrainSurplus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MaxRefillPerPeriod.Size()
+		i -= size
+		if _, err := m.MaxRefillPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.RefillInterval != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.RefillInterval))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxTaxRateChange.Size()
+		i -= size
+		if _, err := m.MaxTaxRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinTaxRate.Size()
+		i -= size
+		if _, err := m.MinTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.WindowProbation != 0 {
 		i = encodeVarintTreasury(dAtA, i, uint64(m.WindowProbation))
 		i--
@@ -214,53 +691,1116 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.ReserveAllowableOffset.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintTreasury(dAtA, i, uint64(size))
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReserveDrains.Size()
+		i -= size
+		if _, err := m.ReserveDrains.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ReserveBalance.Size()
+		i -= size
+		if _, err := m.ReserveBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ReserveRefills.Size()
+		i -= size
+		if _, err := m.ReserveRefills.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Seigniorage.Size()
+		i -= size
+		if _, err := m.Seigniorage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TaxProceedsByDenom) > 0 {
+		for iNdEx := len(m.TaxProceedsByDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceedsByDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReserveCoverage.Size()
+		i -= size
+		if _, err := m.ReserveCoverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TaxProceeds.Size()
+		i -= size
+		if _, err := m.TaxProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Zone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Zone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Incoming {
+		i--
+		if m.Incoming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outgoing {
+		i--
+		if m.Outgoing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReserveAllowableOffset.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.MaxFeeMultiplier.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if m.WindowShort != 0 {
+		n += 1 + sovTreasury(uint64(m.WindowShort))
+	}
+	if m.WindowLong != 0 {
+		n += 1 + sovTreasury(uint64(m.WindowLong))
+	}
+	if m.WindowProbation != 0 {
+		n += 1 + sovTreasury(uint64(m.WindowProbation))
+	}
+	l = m.MinTaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.MaxTaxRateChange.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if m.RefillInterval != 0 {
+		n += 1 + sovTreasury(uint64(m.RefillInterval))
+	}
+	l = m.MaxRefillPerPeriod.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if m.DrainSurplus {
+		n += 2
+	}
+	l = m.RedemptionOnlyRatio.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.HaltRatio.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *EpochState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTreasury(uint64(m.Epoch))
+	}
+	l = m.TaxProceeds.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ReserveCoverage.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.TaxRate.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if len(m.TaxProceedsByDenom) > 0 {
+		for _, e := range m.TaxProceedsByDenom {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	l = m.Seigniorage.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ReserveRefills.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ReserveBalance.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ReserveDrains.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *TaxCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *Zone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if m.Outgoing {
+		n += 2
+	}
+	if m.Incoming {
+		n += 2
+	}
+	return n
+}
+
+func (m *TaxExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	return n
+}
+
+func sovTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTreasury(x uint64) (n int) {
+	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAllowableOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveAllowableOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowShort", wireType)
+			}
+			m.WindowShort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowShort |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLong", wireType)
+			}
+			m.WindowLong = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowLong |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProbation", wireType)
+			}
+			m.WindowProbation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProbation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaxRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTaxRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefillInterval", wireType)
+			}
+			m.RefillInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefillInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefillPerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRefillPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainSurplus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DrainSurplus = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionOnlyRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionOnlyRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HaltRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveCoverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceedsByDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceedsByDenom = append(m.TaxProceedsByDenom, types.Coin{})
+			if err := m.TaxProceedsByDenom[len(m.TaxProceedsByDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seigniorage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Seigniorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRefills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveRefills.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveDrains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveDrains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTreasury(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ReserveAllowableOffset.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.MaxFeeMultiplier.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	if m.WindowShort != 0 {
-		n += 1 + sovTreasury(uint64(m.WindowShort))
-	}
-	if m.WindowLong != 0 {
-		n += 1 + sovTreasury(uint64(m.WindowLong))
-	}
-	if m.WindowProbation != 0 {
-		n += 1 + sovTreasury(uint64(m.WindowProbation))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTreasury(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTreasury(x uint64) (n int) {
-	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TaxCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -283,15 +1823,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaxCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaxCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAllowableOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -319,13 +1859,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveAllowableOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -353,15 +1891,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowShort", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WindowShort = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Zone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Zone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Zone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -371,16 +1959,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowShort |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outgoing", wireType)
 			}
-			m.WindowLong = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -390,16 +1991,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowLong |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Outgoing = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProbation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incoming", wireType)
 			}
-			m.WindowProbation = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -409,11 +2011,126 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProbation |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incoming = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTreasury(dAtA[iNdEx:])
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxZoneNameLength is the maximum length of a tax exemption zone name
+const MaxZoneNameLength = 64
+
+// NewZone creates a new Zone instance
+func NewZone(name string, outgoing, incoming bool) Zone {
+	return Zone{
+		Name:     name,
+		Outgoing: outgoing,
+		Incoming: incoming,
+	}
+}
+
+// Validate performs a basic validation of the zone
+func (z Zone) Validate() error {
+	return validateZoneName(z.Name)
+}
+
+// NewTaxExemption creates a new TaxExemption instance
+func NewTaxExemption(zone, address string) TaxExemption {
+	return TaxExemption{
+		Zone:    zone,
+		Address: address,
+	}
+}
+
+// Validate performs a basic validation of the tax exemption
+func (e TaxExemption) Validate() error {
+	if err := validateZoneName(e.Zone); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid tax exemption address %s: %w", e.Address, err)
+	}
+	return nil
+}
+
+func validateZoneName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("tax exemption zone name must not be empty")
+	}
+	if len(name) > MaxZoneNameLength {
+		return fmt.Errorf("tax exemption zone name must not be longer than %d characters: %s", MaxZoneNameLength, name)
+	}
+	return nil
+}
+
+func validateTaxExemptionAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid tax exemption address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate tax exemption address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
+
+// ContractTaxExemptionRoute returns the route of a message executing a contract,
+// the message type URL followed by the contract address
+func ContractTaxExemptionRoute(typeURL, contract string) string {
+	return typeURL + "/" + contract
+}
+
+// ValidateTaxExemptionRoute validates a route is a message type URL, optionally
+// followed by the address of the contract the message targets
+func ValidateTaxExemptionRoute(route string) error {
+	if !strings.HasPrefix(route, "/") || len(route) == 1 || strings.ContainsAny(route, " \t\n") {
+		return fmt.Errorf("invalid tax exemption route %q: must be a message type URL", route)
+	}
+
+	if i := strings.Index(route[1:], "/"); i >= 0 {
+		contract := route[i+2:]
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid tax exemption route %s: invalid contract address %s: %w", route, contract, err)
+		}
+	}
+	return nil
+}
+
+func validateTaxExemptionRoutes(routes []string) error {
+	seen := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		if err := ValidateTaxExemptionRoute(route); err != nil {
+			return err
+		}
+		if seen[route] {
+			return fmt.Errorf("duplicate tax exemption route %s", route)
+		}
+		seen[route] = true
+	}
+	return nil
+}
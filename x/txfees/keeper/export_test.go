@@ -2,6 +2,6 @@ package keeper
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-func (k Keeper) SwapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) {
-	k.swapNonNativeFeeToDenom(ctx, denomToSwapTo, feeCollectorAddress)
+func (k Keeper) SwapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) sdk.Coin {
+	return k.swapNonNativeFeeToDenom(ctx, denomToSwapTo, feeCollectorAddress)
 }
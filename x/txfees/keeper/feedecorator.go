@@ -78,10 +78,11 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return ctx, err
 	}
 
-	// If there is a fee attached to the tx, make sure the fee denom is a denom accepted by the chain
+	// If there is a fee attached to the tx, make sure the fee denom is a denom accepted by the chain,
+	// either priced by the oracle or registered as a fee token
 	if len(feeCoins) == 1 {
 		feeDenom := feeCoins.GetDenomByIndex(0)
-		if feeDenom != baseDenom {
+		if feeDenom != baseDenom && !mfd.TxFeesKeeper.IsOraclePriced(ctx, feeDenom) {
 			_, err := mfd.TxFeesKeeper.GetFeeToken(ctx, feeDenom)
 			if err != nil {
 				return ctx, err
@@ -89,6 +90,13 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
+	// Oracle prevotes and votes are free and get the highest priority, as long as their gas stays bounded,
+	// they are submitted by the validators or their delegated feeders, and each validator prevotes and votes
+	// once per vote period, in CheckTx and DeliverTx alike. Otherwise they pay fees like any other tx.
+	if txfee_filters.IsOracleTx(tx) && feeTx.GetGas() <= types.MaxGasWantedPerOracleTx && mfd.TxFeesKeeper.validateOracleTx(ctx, tx) == nil {
+		return next(ctx.WithPriority(types.OracleTxPriority), tx, simulate)
+	}
+
 	// Determine if these fees are sufficient for the tx to pass.
 	// Once ABCI++ Process Proposal lands, we can have block validity conditions enforce this.
 	minBaseGasPrice := mfd.getMinBaseGasPrice(ctx, baseDenom, simulate, feeTx)
@@ -112,6 +120,68 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// validateOracleTx checks that every oracle msg of the tx is submitted by its validator or the delegated feeder,
+// and is the first prevote, or the vote revealing the prevote of the previous vote period, the validator submits
+// in the current vote period. It keeps a proposer from filling blocks with free oracle txs.
+func (k Keeper) validateOracleTx(ctx sdk.Context, tx sdk.Tx) error {
+	// CheckTx runs on the state of the last committed block, while the tx is included in the next block at the earliest
+	height := uint64(ctx.BlockHeight())
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		height++
+	}
+
+	votePeriod := k.oracleKeeper.VotePeriod(ctx)
+	period := height / votePeriod
+
+	prevoted := make(map[string]bool)
+	voted := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		feeder, validator, ok := txfee_filters.OracleVoter(msg)
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not an oracle msg", sdk.MsgTypeURL(msg))
+		}
+
+		feederAddr, err := sdk.AccAddressFromBech32(feeder)
+		if err != nil {
+			return err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return err
+		}
+
+		if err := k.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+			return err
+		}
+
+		// the vote of a combined msg is revealed before its prevote is submitted
+		prevote, vote := txfee_filters.OracleSubmissions(msg)
+		if vote {
+			if voted[validator] || prevoted[validator] {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s votes more than once or after its prevote", validator)
+			}
+			if _, err := k.oracleKeeper.GetAggregateExchangeRateVote(ctx, valAddr); err == nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s already voted in the vote period", validator)
+			}
+			aggregatePrevote, err := k.oracleKeeper.GetAggregateExchangeRatePrevote(ctx, valAddr)
+			if err != nil || aggregatePrevote.SubmitBlock/votePeriod+1 != period {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s has no prevote of the previous vote period to reveal", validator)
+			}
+			voted[validator] = true
+		}
+		if prevote {
+			if prevoted[validator] {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s prevotes more than once", validator)
+			}
+			if aggregatePrevote, err := k.oracleKeeper.GetAggregateExchangeRatePrevote(ctx, valAddr); err == nil && aggregatePrevote.SubmitBlock/votePeriod == period {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s already prevoted in the vote period", validator)
+			}
+			prevoted[validator] = true
+		}
+	}
+	return nil
+}
+
 func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom string, simulate bool, feeTx sdk.FeeTx) osmomath.Dec {
 	// In block execution (DeliverTx), its set to the governance decided upon consensus min fee.
 	minBaseGasPrice := types.ConsensusMinFee
@@ -129,8 +199,8 @@ func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom str
 	return minBaseGasPrice
 }
 
-// IsSufficientFee checks if the feeCoin provided (in any asset), is worth enough melody at current spot prices
-// to pay the gas cost of this tx.
+// IsSufficientFee checks if the feeCoin provided (in any asset), is worth enough melody at current oracle
+// exchange rates or spot prices to pay the gas cost of this tx.
 func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice osmomath.Dec, gasRequested uint64, feeCoin sdk.Coin) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
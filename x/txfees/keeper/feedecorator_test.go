@@ -3,10 +3,15 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
+	"github.com/osmosis-labs/osmosis/v23/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v23/x/txfees/types"
 )
 
@@ -15,13 +20,16 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 
 	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
 	mempoolFeeOpts.MinGasPriceForHighGasTx = osmomath.MustNewDecFromStr("0.0025")
-	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	// the fees are priced in note, the base denom of the app, which the oracle rates are quoted in
+	baseDenom := appparams.BaseCoinUnit
 	consensusMinFeeAmt := int64(25)
 	point1BaseDenomMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom,
 		osmomath.MustNewDecFromStr("0.1")))
 
 	// uion is setup with a relative price of 1:1
 	uion := "uion"
+	// uusd is priced by the oracle with a relative price of 1:1
+	uusd := "uusd"
 
 	type testcase struct {
 		name         string
@@ -90,6 +98,20 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 				isCheckTx:    isCheckTx == 1,
 				expectPass:   isCheckTx != 1,
 			},
+			{
+				name:         fmt.Sprintf("works with valid oracle priced fee - %s", txType[isCheckTx]),
+				txFee:        sdk.NewCoins(sdk.NewInt64Coin(uusd, 1000)),
+				minGasPrices: point1BaseDenomMinGasPrices,
+				isCheckTx:    isCheckTx == 1,
+				expectPass:   true,
+			},
+			{
+				name:         fmt.Sprintf("%s work with insufficient oracle priced mempool fee in %s", succesType[isCheckTx], txType[isCheckTx]),
+				txFee:        sdk.NewCoins(sdk.NewInt64Coin(uusd, 25)), // consensus minimum
+				minGasPrices: point1BaseDenomMinGasPrices,
+				isCheckTx:    isCheckTx == 1,
+				expectPass:   isCheckTx != 1,
+			},
 			{
 				name:       "invalid fee denom",
 				txFee:      sdk.NewCoins(sdk.NewInt64Coin("moooooo", 1000)),
@@ -146,6 +168,8 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 	for _, tc := range tests {
 		// reset pool and accounts for each test
 		s.SetupTest(false)
+		s.Require().NoError(s.App.TxFeesKeeper.SetBaseDenom(s.Ctx, baseDenom))
+		s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, uusd, osmomath.OneDec())
 		s.Run(tc.name, func() {
 			// See DeductFeeDecorator AnteHandler for how this is used
 			s.FundAcc(sdk.MustAccAddressFromBech32("symphony1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqymqs4m"), sdk.NewCoins(sdk.NewInt64Coin("note", 1)))
@@ -168,3 +192,182 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestFeeDecorator_OracleTx() {
+	s.SetupTest(false)
+
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, osmomath.MustNewDecFromStr("0.1")))
+	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, types.NewDefaultMempoolFeeOptions())
+	antehandler := sdk.ChainAnteDecorators(mfd)
+	oracleTxGas := uint64(100000)
+
+	validator := s.App.StakingKeeper.GetAllValidators(s.Ctx)[0].GetOperator()
+	feeder := sdk.AccAddress(validator)
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.GetAggregateVoteHash("salt", "1.0note", validator), feeder, validator)
+	vote := oracletypes.NewMsgAggregateExchangeRateVote("salt", "1.0note", feeder, validator)
+	voteAndPrevote := oracletypes.NewMsgAggregateExchangeRateVoteAndPrevote("salt", "1.0note", oracletypes.GetAggregateVoteHash("salt", "1.0note", validator), feeder, validator)
+	send := banktypes.NewMsgSend(feeder, feeder, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)))
+
+	_, _, unauthorized := testdata.KeyTestPubAddr()
+	unauthorizedVote := oracletypes.NewMsgAggregateExchangeRateVote("salt", "1.0note", unauthorized, validator)
+
+	// the validator prevoted in the previous vote period
+	votePeriod := s.App.OracleKeeper.VotePeriod(s.Ctx)
+	height := int64(2*votePeriod + 1)
+	previousPeriodBlock := votePeriod
+	currentPeriodBlock := uint64(height)
+
+	tests := []struct {
+		name        string
+		msgs        []sdk.Msg
+		gas         uint64
+		isCheckTx   bool
+		height      int64 // if blank, set to height
+		prevoteAt   uint64
+		alreadyVote bool
+		expectPass  bool
+	}{
+		{
+			name:       "oracle vote without fee - checktx",
+			msgs:       []sdk.Msg{vote},
+			gas:        oracleTxGas,
+			isCheckTx:  true,
+			prevoteAt:  previousPeriodBlock,
+			expectPass: true,
+		},
+		{
+			name:       "oracle vote without fee - delivertx",
+			msgs:       []sdk.Msg{vote},
+			gas:        oracleTxGas,
+			prevoteAt:  previousPeriodBlock,
+			expectPass: true,
+		},
+		{
+			name:       "oracle vote and prevote without fee",
+			msgs:       []sdk.Msg{vote, prevote},
+			gas:        types.MaxGasWantedPerOracleTx,
+			isCheckTx:  true,
+			prevoteAt:  previousPeriodBlock,
+			expectPass: true,
+		},
+		{
+			name:       "combined oracle vote and prevote without fee",
+			msgs:       []sdk.Msg{voteAndPrevote},
+			gas:        oracleTxGas,
+			isCheckTx:  true,
+			prevoteAt:  previousPeriodBlock,
+			expectPass: true,
+		},
+		{
+			// the tx checked on the last block of the previous vote period is included in the current one
+			name:       "combined oracle vote and prevote checked at the end of the previous vote period without fee - checktx",
+			msgs:       []sdk.Msg{voteAndPrevote},
+			gas:        oracleTxGas,
+			isCheckTx:  true,
+			height:     int64(2*votePeriod - 1),
+			prevoteAt:  previousPeriodBlock,
+			expectPass: true,
+		},
+		{
+			name:      "combined oracle vote and prevote delivered at the end of the previous vote period without fee - delivertx",
+			msgs:      []sdk.Msg{voteAndPrevote},
+			gas:       oracleTxGas,
+			height:    int64(2*votePeriod - 1),
+			prevoteAt: previousPeriodBlock,
+		},
+		{
+			name:       "first oracle prevote without fee - delivertx",
+			msgs:       []sdk.Msg{prevote},
+			gas:        oracleTxGas,
+			expectPass: true,
+		},
+		{
+			name:      "oracle vote above the gas bound without fee",
+			msgs:      []sdk.Msg{vote},
+			gas:       types.MaxGasWantedPerOracleTx + 1,
+			isCheckTx: true,
+			prevoteAt: previousPeriodBlock,
+		},
+		{
+			name:      "oracle vote from an unauthorized feeder without fee - checktx",
+			msgs:      []sdk.Msg{unauthorizedVote},
+			gas:       oracleTxGas,
+			isCheckTx: true,
+			prevoteAt: previousPeriodBlock,
+		},
+		{
+			name:      "oracle vote from an unauthorized feeder without fee - delivertx",
+			msgs:      []sdk.Msg{unauthorizedVote},
+			gas:       oracleTxGas,
+			prevoteAt: previousPeriodBlock,
+		},
+		{
+			name:      "oracle vote mixed with another msg without fee",
+			msgs:      []sdk.Msg{vote, send},
+			gas:       oracleTxGas,
+			isCheckTx: true,
+			prevoteAt: previousPeriodBlock,
+		},
+		{
+			name: "oracle vote without a prevote to reveal without fee - delivertx",
+			msgs: []sdk.Msg{vote},
+			gas:  oracleTxGas,
+		},
+		{
+			name:        "second oracle vote in the vote period without fee - delivertx",
+			msgs:        []sdk.Msg{vote},
+			gas:         oracleTxGas,
+			prevoteAt:   previousPeriodBlock,
+			alreadyVote: true,
+		},
+		{
+			name:      "second oracle prevote in the vote period without fee - delivertx",
+			msgs:      []sdk.Msg{prevote},
+			gas:       oracleTxGas,
+			prevoteAt: currentPeriodBlock,
+		},
+		{
+			name: "two oracle prevotes in a tx without fee - delivertx",
+			msgs: []sdk.Msg{prevote, prevote},
+			gas:  oracleTxGas,
+		},
+		{
+			name:      "oracle vote after the prevote in a tx without fee - delivertx",
+			msgs:      []sdk.Msg{prevote, vote},
+			gas:       oracleTxGas,
+			prevoteAt: previousPeriodBlock,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetGasLimit(tc.gas)
+
+			blockHeight := height
+			if tc.height != 0 {
+				blockHeight = tc.height
+			}
+
+			ctx, _ := s.Ctx.WithBlockHeight(blockHeight).CacheContext()
+			if tc.prevoteAt != 0 {
+				s.App.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, validator,
+					oracletypes.NewAggregateExchangeRatePrevote(oracletypes.GetAggregateVoteHash("salt", "1.0note", validator), validator, tc.prevoteAt))
+			}
+			if tc.alreadyVote {
+				s.App.OracleKeeper.SetAggregateExchangeRateVote(ctx, validator, oracletypes.NewAggregateExchangeRateVote(nil, validator))
+			}
+
+			ctx = ctx.WithIsCheckTx(tc.isCheckTx).WithMinGasPrices(minGasPrices)
+			newCtx, err := antehandler(ctx, txBuilder.GetTx(), false)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(types.OracleTxPriority, newCtx.Priority())
+		})
+	}
+}
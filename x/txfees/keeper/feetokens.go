@@ -22,6 +22,11 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return inputFee, nil
 	}
 
+	// Denoms priced by the oracle don't need a fee token pool
+	if price, err := k.CalcOracleFeePrice(ctx, inputFee.Denom); err == nil {
+		return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).RoundInt()), nil
+	}
+
 	feeToken, err := k.GetFeeToken(ctx, inputFee.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
@@ -60,6 +65,37 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (osmomath.B
 	return spotPrice, nil
 }
 
+// CalcOracleFeePrice returns the value of one unit of the input denom in the base denomination,
+// at the exchange rates of the oracle module.
+// Returns an error if the oracle has no valid exchange rate for the input or the base denom.
+func (k Keeper) CalcOracleFeePrice(ctx sdk.Context, inputDenom string) (osmomath.Dec, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	inputRate, err := k.oracleKeeper.GetMelodyExchangeRate(ctx, inputDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	baseRate, err := k.oracleKeeper.GetMelodyExchangeRate(ctx, baseDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if !inputRate.IsPositive() || !baseRate.IsPositive() {
+		return osmomath.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "%s has no positive oracle price", inputDenom)
+	}
+
+	return inputRate.Quo(baseRate), nil
+}
+
+// IsOraclePriced returns true if the denom can be paid as fee at the oracle exchange rates.
+func (k Keeper) IsOraclePriced(ctx sdk.Context, denom string) bool {
+	_, err := k.CalcOracleFeePrice(ctx, denom)
+	return err == nil
+}
+
 // GetFeeToken returns the fee token record for a specific denom,
 // In our case the baseDenom is note.
 func (k Keeper) GetBaseDenom(ctx sdk.Context) (denom string, err error) {
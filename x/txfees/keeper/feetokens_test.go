@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	"github.com/osmosis-labs/osmosis/v23/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -281,3 +282,32 @@ func (s *KeeperTestSuite) TestFeeTokenConversions() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestOraclePricedFeeToken() {
+	s.SetupTest(false)
+
+	// the oracle rates are quoted in note, the base denom of the app
+	baseDenom := appparams.BaseCoinUnit
+	s.Require().NoError(s.App.TxFeesKeeper.SetBaseDenom(s.Ctx, baseDenom))
+
+	// no oracle price and no fee token pool
+	s.Require().False(s.App.TxFeesKeeper.IsOraclePriced(s.Ctx, "uusd"))
+	_, err := s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, sdk.NewInt64Coin("uusd", 100))
+	s.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+
+	// 1 uusd -> 0.5 note
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, "uusd", osmomath.NewDecWithPrec(5, 1))
+	s.Require().True(s.App.TxFeesKeeper.IsOraclePriced(s.Ctx, "uusd"))
+
+	price, err := s.App.TxFeesKeeper.CalcOracleFeePrice(s.Ctx, "uusd")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewDecWithPrec(5, 1), price)
+
+	converted, err := s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, sdk.NewInt64Coin("uusd", 100))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 50), converted)
+
+	minGasPrice := osmomath.NewDecWithPrec(1, 1)
+	s.Require().NoError(s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, minGasPrice, 500, sdk.NewInt64Coin("uusd", 100)))
+	s.Require().Error(s.App.TxFeesKeeper.IsSufficientFee(s.Ctx, minGasPrice, 501, sdk.NewInt64Coin("uusd", 100)))
+}
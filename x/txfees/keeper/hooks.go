@@ -24,6 +24,7 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 
 // at the end of each epoch, swap all non-OSMO fees into the desired denom and send either to fee collector or community pool.
 // Staking fee collector for staking rewards.
+// - All non-native rewards priced by the oracle get swapped to native denom through the market
 // - All non-native rewards that have a pool with liquidity and a link set in protorev get swapped to native denom
 // - All resulting native tokens get sent to the fee collector.
 // - Any non-native tokens that did not have associated pool stay in the balance of staking fee collector.
@@ -219,7 +220,8 @@ func (k Keeper) calculateDistributeAndTrackTakerFees(ctx sdk.Context, defaultFee
 
 // swapNonNativeFeeToDenom swaps coins into the denomToSwapTo from the given fee collector address.
 // If an error in swap occurs for a given denom, it will be silently skipped.
-// CONTRACT: a pool must exist between each denom in the balance and denomToSwapTo. If doesn't exist. Silently skip swap.
+// Denoms priced by the oracle are converted by the market when denomToSwapTo is priced by the oracle as well.
+// CONTRACT: a pool must exist between each other denom in the balance and denomToSwapTo. If doesn't exist. Silently skip swap.
 // CONTRACT: protorev must be configured to have a pool for the given denom pair. Otherwise, the denom will be skipped.
 func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) sdk.Coin {
 	coinsToSwap := k.bankKeeper.GetAllBalances(ctx, feeCollectorAddress)
@@ -231,6 +233,26 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 			continue
 		}
 
+		// Denoms priced by the oracle are converted by the market at the oracle exchange rate,
+		// so they don't need a pool route. The conversion leaves the virtual pools and the mint caps
+		// to the traders, but is refused like the swaps while the circuit breaker is tripped:
+		// the fees then accrue in the fee collector until the market reopens.
+		if k.canSwapThroughMarket(ctx, coin.Denom, denomToSwapTo) {
+			err := osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(cacheCtx sdk.Context) error {
+				swapCoin, err := k.marketKeeper.ConvertFromAccount(cacheCtx, feeCollectorAddress, coin, denomToSwapTo)
+				if err != nil {
+					coinsNotSwapped = append(coinsNotSwapped, fmt.Sprintf("%s via market", coin.String()))
+				} else {
+					totalCoinOut = totalCoinOut.Add(swapCoin)
+				}
+				return err
+			})
+			if err != nil {
+				incTelementryCounter(txfeestypes.TakerFeeSwapFailedMetricName, coin.String(), err.Error())
+			}
+			continue
+		}
+
 		// Search for the denom pair route via the protorev store.
 		// Since OSMO is one of the protorev denoms, many of the routes will exist in this store.
 		// There will be times when this store does not know about a route, but this is acceptable
@@ -301,6 +323,17 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 	return totalCoinOut
 }
 
+// canSwapThroughMarket returns true if the oracle has an exchange rate for both denoms,
+// so the market can convert one into the other.
+func (k Keeper) canSwapThroughMarket(ctx sdk.Context, denomIn, denomOut string) bool {
+	for _, denom := range []string{denomIn, denomOut} {
+		if _, err := k.oracleKeeper.GetMelodyExchangeRate(ctx, denom); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 // isDenomWhitelisted checks if the denom provided exists in the list of authorized quote denoms.
 // If it does, it returns true, otherwise false.
 func isDenomWhitelisted(denom string, authorizedQuoteDenoms []string) bool {
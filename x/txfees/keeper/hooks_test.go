@@ -9,7 +9,9 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v23/app/apptesting"
+	appparams "github.com/osmosis-labs/osmosis/v23/app/params"
 	gammtypes "github.com/osmosis-labs/osmosis/v23/x/gamm/types"
+	markettypes "github.com/osmosis-labs/osmosis/v23/x/market/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v23/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v23/x/txfees/types"
 
@@ -391,6 +393,82 @@ func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_SimpleCases() {
 // - All non-native rewards that have a pool with liquidity and a link set in protorev get swapped to a denom configured by parameter.
 // - All resulting parameter denom tokens get sent to the community pool.
 // - Any non-native tokens that did not have associated pool stay in the balance of community pool fee collector.
+func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_OraclePriced() {
+	s.Setup()
+
+	testAccount := apptesting.CreateRandomAccounts(1)[0]
+	s.FundAcc(testAccount, sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000), sdk.NewInt64Coin(denomWithNoPool, 1000)))
+	s.FundModuleAcc(markettypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000)))
+
+	// 1 uusd -> 0.5 note
+	s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, "uusd", osmomath.NewDecWithPrec(5, 1))
+
+	s.App.TxFeesKeeper.SwapNonNativeFeeToDenom(s.Ctx, appparams.BaseCoinUnit, testAccount)
+
+	// the oracle priced denom is converted by the market at the oracle rate without a pool,
+	// while the denom without a price nor a pool is kept as is
+	balances := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+	s.Require().True(balances.AmountOf("uusd").IsZero())
+	s.Require().Equal(osmomath.NewInt(1000), balances.AmountOf(denomWithNoPool))
+	s.Require().Equal(osmomath.NewInt(500), balances.AmountOf(appparams.BaseCoinUnit))
+
+	// the conversion moves neither the virtual pools nor the mint caps
+	s.Require().True(s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx).IsZero())
+	s.Require().True(s.App.MarketKeeper.GetBlockNetMint(s.Ctx, "uusd").IsZero())
+	s.Require().True(s.App.MarketKeeper.GetEpochNetMint(s.Ctx, "uusd").IsZero())
+}
+
+func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_OraclePricedCircuitBreaker() {
+	tests := map[string]struct {
+		state        markettypes.CircuitBreakerState
+		denomToSwap  string
+		expectedUusd int64
+		expectedOut  int64
+	}{
+		"redemption only: fees are redeemed for note": {
+			state:        markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY,
+			denomToSwap:  appparams.BaseCoinUnit,
+			expectedUusd: 0,
+			expectedOut:  500,
+		},
+		"redemption only: fees are not converted into other stable denoms": {
+			state:        markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_REDEMPTION_ONLY,
+			denomToSwap:  "ukrw",
+			expectedUusd: 1000,
+			expectedOut:  0,
+		},
+		"halted: fees accrue in the fee collector": {
+			state:        markettypes.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALTED,
+			denomToSwap:  appparams.BaseCoinUnit,
+			expectedUusd: 1000,
+			expectedOut:  0,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			testAccount := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(testAccount, sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)))
+			s.FundModuleAcc(markettypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000)))
+
+			// 1 uusd -> 0.5 note, 1 ukrw -> 0.001 note
+			s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, "uusd", osmomath.NewDecWithPrec(5, 1))
+			s.App.OracleKeeper.SetMelodyExchangeRate(s.Ctx, "ukrw", osmomath.NewDecWithPrec(1, 3))
+			s.Require().NoError(s.App.MarketKeeper.OverrideCircuitBreaker(s.Ctx, tc.state))
+
+			totalCoinOut := s.App.TxFeesKeeper.SwapNonNativeFeeToDenom(s.Ctx, tc.denomToSwap, testAccount)
+			s.Require().Equal(osmomath.NewInt(tc.expectedOut), totalCoinOut.Amount)
+
+			balances := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+			s.Require().Equal(osmomath.NewInt(tc.expectedUusd), balances.AmountOf("uusd"))
+			s.Require().Equal(osmomath.NewInt(tc.expectedOut), balances.AmountOf(tc.denomToSwap))
+			s.Require().True(s.App.MarketKeeper.GetOsmosisPoolDelta(s.Ctx).IsZero())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestAfterEpochEnd() {
 	s.Setup()
 
@@ -24,6 +24,8 @@ type Keeper struct {
 	protorevKeeper     types.ProtorevKeeper
 	distributionKeeper types.DistributionKeeper
 	consensusKeeper    types.ConsensusKeeper
+	oracleKeeper       types.OracleKeeper
+	marketKeeper       types.MarketKeeper
 	dataDir            string
 }
 
@@ -51,6 +53,16 @@ func NewKeeper(
 	}
 }
 
+// SetOracleKeeper sets oracle keeper
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.oracleKeeper = oracleKeeper
+}
+
+// SetMarketKeeper sets market keeper
+func (k *Keeper) SetMarketKeeper(marketKeeper types.MarketKeeper) {
+	k.marketKeeper = marketKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
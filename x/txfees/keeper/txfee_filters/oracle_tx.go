@@ -0,0 +1,58 @@
+package txfee_filters
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracleexported "github.com/osmosis-labs/osmosis/v23/x/oracle/exported"
+)
+
+// IsOracleTx returns true if the tx only contains oracle prevotes and votes, separate or combined.
+func IsOracleTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *oracleexported.MsgAggregateExchangeRatePrevote:
+			continue
+		case *oracleexported.MsgAggregateExchangeRateVote:
+			continue
+		case *oracleexported.MsgAggregateExchangeRateVoteAndPrevote:
+			continue
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// OracleVoter returns the feeder and the validator of an oracle prevote or vote, and false for any other msg.
+func OracleVoter(msg sdk.Msg) (feeder string, validator string, ok bool) {
+	switch msg := msg.(type) {
+	case *oracleexported.MsgAggregateExchangeRatePrevote:
+		return msg.Feeder, msg.Validator, true
+	case *oracleexported.MsgAggregateExchangeRateVote:
+		return msg.Feeder, msg.Validator, true
+	case *oracleexported.MsgAggregateExchangeRateVoteAndPrevote:
+		return msg.Feeder, msg.Validator, true
+	default:
+		return "", "", false
+	}
+}
+
+// OracleSubmissions returns whether an oracle msg submits a prevote and whether it reveals a vote.
+func OracleSubmissions(msg sdk.Msg) (prevote bool, vote bool) {
+	switch msg.(type) {
+	case *oracleexported.MsgAggregateExchangeRatePrevote:
+		return true, false
+	case *oracleexported.MsgAggregateExchangeRateVote:
+		return false, true
+	case *oracleexported.MsgAggregateExchangeRateVoteAndPrevote:
+		return true, true
+	default:
+		return false, false
+	}
+}
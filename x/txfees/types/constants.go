@@ -1,9 +1,20 @@
 package types
 
 import (
+	"math"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // ConsensusMinFee is a governance set parameter from prop 354 (https://www.mintscan.io/symphony/proposals/354)
 // Its intended to be .0025 note / gas
 var ConsensusMinFee osmomath.Dec = osmomath.NewDecWithPrec(25, 4)
+
+// MaxGasWantedPerOracleTx is the gas limit up to which txs made only of oracle prevotes and votes
+// are exempt from the min gas price, about what a combined vote and prevote uses.
+// It is a consensus constant, as the exemption also applies in DeliverTx.
+var MaxGasWantedPerOracleTx = uint64(200 * 1000)
+
+// OracleTxPriority is the CheckTx priority given to the exempted oracle txs,
+// so they are included ahead of user txs during congestion.
+var OracleTxPriority = int64(math.MaxInt64)
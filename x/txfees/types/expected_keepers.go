@@ -7,6 +7,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	oracletypes "github.com/osmosis-labs/osmosis/v23/x/oracle/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v23/x/poolmanager/types"
 )
 
@@ -77,9 +78,19 @@ type BankKeeper interface {
 	SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 }
 
-// OracleKeeper for feeder validation
+// OracleKeeper for feeder validation and pricing the oracle whitelisted fee tokens
 type OracleKeeper interface {
 	ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error
+	// GetMelodyExchangeRate returns the exchange rate of the given denom to melody. Returned value is in melody.
+	GetMelodyExchangeRate(ctx sdk.Context, denom string) (price sdk.Dec, err error)
+	VotePeriod(ctx sdk.Context) uint64
+	GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (oracletypes.AggregateExchangeRatePrevote, error)
+	GetAggregateExchangeRateVote(ctx sdk.Context, voter sdk.ValAddress) (oracletypes.AggregateExchangeRateVote, error)
+}
+
+// MarketKeeper defines the contract needed to swap the oracle priced fee tokens.
+type MarketKeeper interface {
+	ConvertFromAccount(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) (sdk.Coin, error)
 }
 
 // TxFeesKeeper defines the expected transaction fee keeper